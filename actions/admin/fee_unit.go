@@ -0,0 +1,98 @@
+package admin
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/mappings"
+	"github.com/bitcoin-sv/spv-wallet/models/response"
+	"github.com/bitcoin-sv/spv-wallet/server/reqctx"
+	"github.com/gin-gonic/gin"
+)
+
+const defaultFeeUnitHistoryLimit = 20
+
+// @Summary			Get fee unit
+// @Description		Get the fee unit currently used for transactions, its source and the history of its changes
+// @Tags			Admin
+// @Produce			json
+// @Param			limit query int false "Number of history entries to return (default 20, max 100)"
+// @Success			200 {object} response.FeeUnitPolicy "Current fee unit and its history"
+// @Failure			400	"Bad request - Invalid limit"
+// @Failure 		500	"Internal Server Error - Error while getting the fee unit history"
+// @Router			/api/v1/admin/fee-unit [get]
+// @Security		x-auth-xpub
+func feeUnitGet(c *gin.Context, _ *reqctx.AdminContext) {
+	logger := reqctx.Logger(c)
+
+	limit := defaultFeeUnitHistoryLimit
+	if param := c.Query("limit"); param != "" {
+		var err error
+		if limit, err = strconv.Atoi(param); err != nil || limit <= 0 {
+			spverrors.ErrorResponse(c, spverrors.ErrCannotParseQueryParams, logger)
+			return
+		}
+	}
+
+	feeUnitRespond(c, limit)
+}
+
+// @Summary			Override fee unit
+// @Description		Override the fee unit at runtime; the ARC policy and the configured fee unit are not used until the override is cleared
+// @Tags			Admin
+// @Accept			json
+// @Produce			json
+// @Param			FeeUnit body response.FeeUnit true "Fee unit to use for transactions"
+// @Success			200 {object} response.FeeUnitPolicy "Current fee unit and its history"
+// @Failure			400	"Bad request - Invalid fee unit"
+// @Failure 		500	"Internal Server Error - Error while overriding the fee unit"
+// @Router			/api/v1/admin/fee-unit [put]
+// @Security		x-auth-xpub
+func feeUnitOverride(c *gin.Context, _ *reqctx.AdminContext) {
+	logger := reqctx.Logger(c)
+
+	var requestBody response.FeeUnit
+	if err := c.Bind(&requestBody); err != nil {
+		spverrors.ErrorResponse(c, spverrors.ErrCannotBindRequest.WithTrace(err), logger)
+		return
+	}
+
+	service := reqctx.Engine(c).FeeUnitService()
+	if err := service.Override(c.Request.Context(), *mappings.MapFeeUnitModelToEngine(&requestBody)); err != nil {
+		spverrors.ErrorResponse(c, err, logger)
+		return
+	}
+
+	feeUnitRespond(c, defaultFeeUnitHistoryLimit)
+}
+
+// @Summary			Clear fee unit override
+// @Description		Clear the fee unit set at runtime and go back to the configured fee unit or to the ARC policy
+// @Tags			Admin
+// @Produce			json
+// @Success			200 {object} response.FeeUnitPolicy "Current fee unit and its history"
+// @Failure 		500	"Internal Server Error - Error while asking ARC for the fee unit"
+// @Router			/api/v1/admin/fee-unit [delete]
+// @Security		x-auth-xpub
+func feeUnitClearOverride(c *gin.Context, _ *reqctx.AdminContext) {
+	logger := reqctx.Logger(c)
+
+	if err := reqctx.Engine(c).FeeUnitService().ClearOverride(c.Request.Context()); err != nil {
+		spverrors.ErrorResponse(c, err, logger)
+		return
+	}
+
+	feeUnitRespond(c, defaultFeeUnitHistoryLimit)
+}
+
+func feeUnitRespond(c *gin.Context, limit int) {
+	service := reqctx.Engine(c).FeeUnitService()
+	history, err := service.History(c.Request.Context(), limit)
+	if err != nil {
+		spverrors.ErrorResponse(c, err, reqctx.Logger(c))
+		return
+	}
+
+	c.JSON(http.StatusOK, mappings.MapToFeeUnitPolicyContract(service.Current(), history))
+}
@@ -53,6 +53,33 @@ type UpdateContact struct {
 	FullName string `json:"fullName" example:"John Doe"`
 }
 
+// PaymailDomainSettings is the model for the settings of the paymail domain
+type PaymailDomainSettings struct {
+	// Serve the domain only after it passes the DNS/.well-known self-check
+	DomainValidationEnabled bool `json:"domainValidationEnabled" example:"false"`
+	// Sender paymail used for transactions sent from this domain when the sender has no paymail
+	DefaultFromPaymail string `json:"defaultFromPaymail" example:"from@spv-wallet.com"`
+	// Accept incoming P2P transactions only in BEEF format
+	RequireBEEF bool `json:"requireBeef" example:"false"`
+	// Policy of verifying senders of incoming P2P transactions against their PKI (empty - based on paymail.sender_validation_enabled)
+	SenderValidation string `json:"senderValidation" enums:"require,warn,ignore" example:"warn"`
+}
+
+// CreatePaymailDomain is the model for adding a paymail domain
+type CreatePaymailDomain struct {
+	// The domain name
+	Name string `json:"name" example:"spv-wallet.com"`
+	PaymailDomainSettings
+}
+
+// PaymailHostRule is the model for putting the paymail host on the allow or deny list
+type PaymailHostRule struct {
+	// Policy of the paymail host: "allow" (when the allow list is not empty, only hosts on it can be called) or "deny"
+	Policy string `json:"policy" enums:"allow,deny" example:"deny"`
+	// Optional note, e.g. the reason of the rule
+	Note string `json:"note" example:"sanctioned provider"`
+}
+
 // Helper struct for transaction query params
 type transactionQueryParams struct {
 	Context     context.Context
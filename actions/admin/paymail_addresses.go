@@ -1,11 +1,12 @@
 package admin
 
 import (
+	"errors"
 	"net/http"
-	"slices"
 
 	"github.com/bitcoin-sv/go-paymail"
 	"github.com/bitcoin-sv/spv-wallet/actions/common"
+	configerrors "github.com/bitcoin-sv/spv-wallet/config/errors"
 	"github.com/bitcoin-sv/spv-wallet/engine"
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	"github.com/bitcoin-sv/spv-wallet/internal/query"
@@ -133,13 +134,13 @@ func paymailCreateAddress(c *gin.Context, _ *reqctx.AdminContext) {
 		opts = append(opts, engine.WithMetadatas(requestBody.Metadata))
 	}
 
-	config := reqctx.AppConfig(c)
-	if config.Paymail.DomainValidationEnabled {
-		_, actualDomain, _ := paymail.SanitizePaymail(requestBody.Address)
-		if !slices.Contains(config.Paymail.Domains, actualDomain) {
-			spverrors.ErrorResponse(c, spverrors.ErrInvalidDomain, logger)
-			return
+	_, actualDomain, _ := paymail.SanitizePaymail(requestBody.Address)
+	if err := reqctx.Engine(c).PaymailDomainsService().CheckDomain(c.Request.Context(), actualDomain); err != nil {
+		if errors.Is(err, configerrors.ErrUnsupportedDomain) {
+			err = spverrors.ErrInvalidDomain
 		}
+		spverrors.ErrorResponse(c, err, logger)
+		return
 	}
 
 	var paymailAddress *engine.PaymailAddress
@@ -0,0 +1,52 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/mappings"
+	"github.com/bitcoin-sv/spv-wallet/server/reqctx"
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary			Get cached paymail capabilities
+// @Description		Get the cached capabilities of the paymail host or the cached error of the failed capabilities lookup
+// @Tags			Admin
+// @Produce			json
+// @Param			domain path string true "Domain of the paymail host"
+// @Success			200 {object} response.PaymailCapabilitiesCache "Cached paymail capabilities"
+// @Failure			404	"Not found - Capabilities of the domain are not cached"
+// @Failure 		500	"Internal Server Error - Error while reading the cache"
+// @Router			/api/v1/admin/paymail-capabilities/{domain} [get]
+// @Security		x-auth-xpub
+func paymailCapabilitiesGet(c *gin.Context, _ *reqctx.AdminContext) {
+	cached, err := reqctx.Engine(c).PaymailService().GetCachedCapabilities(c.Request.Context(), c.Param("domain"))
+	if err != nil {
+		spverrors.ErrorResponse(c, err, reqctx.Logger(c))
+		return
+	}
+	if cached == nil {
+		spverrors.ErrorResponse(c, spverrors.ErrPaymailCapabilitiesNotCached, reqctx.Logger(c))
+		return
+	}
+
+	c.JSON(http.StatusOK, mappings.MapToPaymailCapabilitiesCacheContract(cached))
+}
+
+// @Summary			Purge cached paymail capabilities
+// @Description		Remove the cached capabilities (or the cached failed lookup) of the paymail host, so they are fetched again on the next call
+// @Tags			Admin
+// @Produce			json
+// @Param			domain path string true "Domain of the paymail host"
+// @Success			200
+// @Failure 		500	"Internal Server Error - Error while removing the capabilities from the cache"
+// @Router			/api/v1/admin/paymail-capabilities/{domain} [delete]
+// @Security		x-auth-xpub
+func paymailCapabilitiesPurge(c *gin.Context, _ *reqctx.AdminContext) {
+	if err := reqctx.Engine(c).PaymailService().PurgeCachedCapabilities(c.Request.Context(), c.Param("domain")); err != nil {
+		spverrors.ErrorResponse(c, err, reqctx.Logger(c))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
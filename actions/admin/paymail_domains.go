@@ -0,0 +1,161 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/paymaildomains"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/mappings"
+	"github.com/bitcoin-sv/spv-wallet/server/reqctx"
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary			Get paymail domains
+// @Description		Get the paymail domains served by the wallet: the ones from the configuration and the ones added at runtime
+// @Tags			Admin
+// @Produce			json
+// @Success			200 {object} []response.PaymailDomain "List of paymail domains"
+// @Failure 		500	"Internal Server Error - Error while getting the paymail domains"
+// @Router			/api/v1/admin/paymail-domains [get]
+// @Security		x-auth-xpub
+func paymailDomainsSearch(c *gin.Context, _ *reqctx.AdminContext) {
+	domains, err := reqctx.Engine(c).PaymailDomainsService().List(c.Request.Context())
+	if err != nil {
+		spverrors.ErrorResponse(c, err, reqctx.Logger(c))
+		return
+	}
+
+	c.JSON(http.StatusOK, mappings.MapToPaymailDomainsContract(domains))
+}
+
+// @Summary			Get paymail domain
+// @Description		Get the paymail domain and its settings
+// @Tags			Admin
+// @Produce			json
+// @Param			domain path string true "Domain name"
+// @Success			200 {object} response.PaymailDomain "Paymail domain"
+// @Failure			400	"Bad request - Invalid domain"
+// @Failure			404	"Not found - Paymail domain not found"
+// @Failure 		500	"Internal Server Error - Error while getting the paymail domain"
+// @Router			/api/v1/admin/paymail-domains/{domain} [get]
+// @Security		x-auth-xpub
+func paymailDomainGet(c *gin.Context, _ *reqctx.AdminContext) {
+	domain, err := reqctx.Engine(c).PaymailDomainsService().Get(c.Request.Context(), c.Param("domain"))
+	if err != nil {
+		spverrors.ErrorResponse(c, err, reqctx.Logger(c))
+		return
+	}
+
+	c.JSON(http.StatusOK, mappings.MapToPaymailDomainContract(domain))
+}
+
+// @Summary			Add paymail domain
+// @Description		Add a paymail domain at runtime; it's served immediately unless domain validation is enabled for it
+// @Tags			Admin
+// @Accept			json
+// @Produce			json
+// @Param			CreatePaymailDomain body CreatePaymailDomain true "Paymail domain and its settings"
+// @Success			201 {object} response.PaymailDomain "Added paymail domain"
+// @Failure			400	"Bad request - Invalid domain or settings"
+// @Failure			409	"Conflict - Paymail domain already exists"
+// @Failure 		500	"Internal Server Error - Error while adding the paymail domain"
+// @Router			/api/v1/admin/paymail-domains [post]
+// @Security		x-auth-xpub
+func paymailDomainCreate(c *gin.Context, _ *reqctx.AdminContext) {
+	logger := reqctx.Logger(c)
+
+	var requestBody CreatePaymailDomain
+	if err := c.Bind(&requestBody); err != nil {
+		spverrors.ErrorResponse(c, spverrors.ErrCannotBindRequest.WithTrace(err), logger)
+		return
+	}
+
+	domain, err := reqctx.Engine(c).PaymailDomainsService().Create(c.Request.Context(), requestBody.Name, mapToDomainSettings(requestBody.PaymailDomainSettings))
+	if err != nil {
+		spverrors.ErrorResponse(c, err, logger)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mappings.MapToPaymailDomainContract(domain))
+}
+
+// @Summary			Update paymail domain
+// @Description		Update the settings of the paymail domain added at runtime
+// @Tags			Admin
+// @Accept			json
+// @Produce			json
+// @Param			domain path string true "Domain name"
+// @Param			PaymailDomainSettings body PaymailDomainSettings true "Settings of the paymail domain"
+// @Success			200 {object} response.PaymailDomain "Updated paymail domain"
+// @Failure			400	"Bad request - Invalid settings or domain from the configuration"
+// @Failure			404	"Not found - Paymail domain not found"
+// @Failure 		500	"Internal Server Error - Error while updating the paymail domain"
+// @Router			/api/v1/admin/paymail-domains/{domain} [put]
+// @Security		x-auth-xpub
+func paymailDomainUpdate(c *gin.Context, _ *reqctx.AdminContext) {
+	logger := reqctx.Logger(c)
+
+	var requestBody PaymailDomainSettings
+	if err := c.Bind(&requestBody); err != nil {
+		spverrors.ErrorResponse(c, spverrors.ErrCannotBindRequest.WithTrace(err), logger)
+		return
+	}
+
+	domain, err := reqctx.Engine(c).PaymailDomainsService().Update(c.Request.Context(), c.Param("domain"), mapToDomainSettings(requestBody))
+	if err != nil {
+		spverrors.ErrorResponse(c, err, logger)
+		return
+	}
+
+	c.JSON(http.StatusOK, mappings.MapToPaymailDomainContract(domain))
+}
+
+// @Summary			Delete paymail domain
+// @Description		Delete the paymail domain added at runtime; paymails in the domain are no longer served
+// @Tags			Admin
+// @Produce			json
+// @Param			domain path string true "Domain name"
+// @Success			200
+// @Failure			400	"Bad request - Domain from the configuration"
+// @Failure			404	"Not found - Paymail domain not found"
+// @Failure 		500	"Internal Server Error - Error while deleting the paymail domain"
+// @Router			/api/v1/admin/paymail-domains/{domain} [delete]
+// @Security		x-auth-xpub
+func paymailDomainDelete(c *gin.Context, _ *reqctx.AdminContext) {
+	if err := reqctx.Engine(c).PaymailDomainsService().Delete(c.Request.Context(), c.Param("domain")); err != nil {
+		spverrors.ErrorResponse(c, err, reqctx.Logger(c))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// @Summary			Check paymail domain
+// @Description		Run the DNS (SRV) and .well-known/bsvalias self-check of the paymail domain; a domain with validation enabled is served only after it passes the check
+// @Tags			Admin
+// @Produce			json
+// @Param			domain path string true "Domain name"
+// @Success			200 {object} response.PaymailDomainCheck "Result of the check"
+// @Failure			400	"Bad request - Invalid domain"
+// @Failure			404	"Not found - Paymail domain not found"
+// @Failure 		500	"Internal Server Error - Error while saving the result of the check"
+// @Router			/api/v1/admin/paymail-domains/{domain}/check [post]
+// @Security		x-auth-xpub
+func paymailDomainCheck(c *gin.Context, _ *reqctx.AdminContext) {
+	result, err := reqctx.Engine(c).PaymailDomainsService().Check(c.Request.Context(), c.Param("domain"))
+	if err != nil {
+		spverrors.ErrorResponse(c, err, reqctx.Logger(c))
+		return
+	}
+
+	c.JSON(http.StatusOK, mappings.MapToPaymailDomainCheckContract(result))
+}
+
+func mapToDomainSettings(settings PaymailDomainSettings) paymaildomains.Settings {
+	return paymaildomains.Settings{
+		DomainValidationEnabled: settings.DomainValidationEnabled,
+		DefaultFromPaymail:      settings.DefaultFromPaymail,
+		RequireBEEF:             settings.RequireBEEF,
+		SenderValidation:        paymaildomains.SenderValidationPolicy(settings.SenderValidation),
+	}
+}
@@ -0,0 +1,104 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/paymailhosts"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/mappings"
+	"github.com/bitcoin-sv/spv-wallet/server/reqctx"
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary			Get paymail host rules
+// @Description		Get the allow and deny lists of paymail hosts the wallet sends payments and contact requests to
+// @Tags			Admin
+// @Produce			json
+// @Success			200 {object} []response.PaymailHostRule "List of paymail host rules"
+// @Failure 		500	"Internal Server Error - Error while getting the paymail host rules"
+// @Router			/api/v1/admin/paymail-hosts/rules [get]
+// @Security		x-auth-xpub
+func paymailHostRulesSearch(c *gin.Context, _ *reqctx.AdminContext) {
+	rules, err := reqctx.Engine(c).PaymailHostsService().Rules(c.Request.Context())
+	if err != nil {
+		spverrors.ErrorResponse(c, err, reqctx.Logger(c))
+		return
+	}
+
+	c.JSON(http.StatusOK, mappings.MapToPaymailHostRulesContract(rules))
+}
+
+// @Summary			Save paymail host rule
+// @Description		Put the paymail host (and its subdomains) on the allow or deny list, replacing the existing rule for the domain
+// @Tags			Admin
+// @Accept			json
+// @Produce			json
+// @Param			domain path string true "Domain of the paymail host"
+// @Param			PaymailHostRule body PaymailHostRule true "Policy of the paymail host"
+// @Success			200 {object} response.PaymailHostRule "Saved paymail host rule"
+// @Failure			400	"Bad request - Invalid domain or policy"
+// @Failure 		500	"Internal Server Error - Error while saving the paymail host rule"
+// @Router			/api/v1/admin/paymail-hosts/rules/{domain} [put]
+// @Security		x-auth-xpub
+func paymailHostRuleSave(c *gin.Context, _ *reqctx.AdminContext) {
+	logger := reqctx.Logger(c)
+
+	var requestBody PaymailHostRule
+	if err := c.Bind(&requestBody); err != nil {
+		spverrors.ErrorResponse(c, spverrors.ErrCannotBindRequest.WithTrace(err), logger)
+		return
+	}
+
+	rule, err := reqctx.Engine(c).PaymailHostsService().SaveRule(c.Request.Context(), c.Param("domain"), paymailhosts.Policy(requestBody.Policy), requestBody.Note)
+	if err != nil {
+		spverrors.ErrorResponse(c, err, logger)
+		return
+	}
+
+	c.JSON(http.StatusOK, mappings.MapToPaymailHostRuleContract(rule))
+}
+
+// @Summary			Delete paymail host rule
+// @Description		Remove the paymail host from the allow or deny list
+// @Tags			Admin
+// @Produce			json
+// @Param			domain path string true "Domain of the paymail host"
+// @Success			200
+// @Failure			400	"Bad request - Invalid domain"
+// @Failure			404	"Not found - Paymail host rule not found"
+// @Failure 		500	"Internal Server Error - Error while deleting the paymail host rule"
+// @Router			/api/v1/admin/paymail-hosts/rules/{domain} [delete]
+// @Security		x-auth-xpub
+func paymailHostRuleDelete(c *gin.Context, _ *reqctx.AdminContext) {
+	if err := reqctx.Engine(c).PaymailHostsService().DeleteRule(c.Request.Context(), c.Param("domain")); err != nil {
+		spverrors.ErrorResponse(c, err, reqctx.Logger(c))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// @Summary			Get paymail hosts reputation
+// @Description		Get the failure and latency statistics of the paymail hosts called by the wallet since it was started
+// @Tags			Admin
+// @Produce			json
+// @Success			200 {object} []response.PaymailHostReputation "Reputation of the paymail hosts"
+// @Router			/api/v1/admin/paymail-hosts/reputation [get]
+// @Security		x-auth-xpub
+func paymailHostsReputation(c *gin.Context, _ *reqctx.AdminContext) {
+	c.JSON(http.StatusOK, mappings.MapToPaymailHostReputationsContract(reqctx.Engine(c).PaymailHostsService().Reputations()))
+}
+
+// @Summary			Reset paymail host reputation
+// @Description		Forget the reputation of the paymail host, which unblocks the host blocked due to repeated failures
+// @Tags			Admin
+// @Produce			json
+// @Param			domain path string true "Domain of the paymail host"
+// @Success			200
+// @Router			/api/v1/admin/paymail-hosts/reputation/{domain} [delete]
+// @Security		x-auth-xpub
+func paymailHostReputationReset(c *gin.Context, _ *reqctx.AdminContext) {
+	reqctx.Engine(c).PaymailHostsService().ResetReputation(c.Param("domain"))
+
+	c.Status(http.StatusOK)
+}
@@ -0,0 +1,49 @@
+package admin
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/models"
+	"github.com/bitcoin-sv/spv-wallet/server/reqctx"
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary			Mine blocks
+// @Description		Mine blocks in the regtest chain mode; the first block includes all broadcasted transactions which are not mined yet
+// @Tags			Admin
+// @Produce			json
+// @Param			blocks query int false "Number of blocks to mine (default 1)"
+// @Success			200 {object} []models.MerkleRoot "Merkle roots of mined blocks"
+// @Failure			400	"Bad request - Invalid number of blocks or the regtest chain mode is not enabled"
+// @Failure 		500	"Internal Server Error - Error while mining blocks"
+// @Router			/api/v1/admin/regtest/mine [post]
+// @Security		x-auth-xpub
+func regtestMine(c *gin.Context, _ *reqctx.AdminContext) {
+	logger := reqctx.Logger(c)
+
+	blocks := 1
+	if param := c.Query("blocks"); param != "" {
+		var err error
+		if blocks, err = strconv.Atoi(param); err != nil {
+			spverrors.ErrorResponse(c, spverrors.ErrCannotParseQueryParams, logger)
+			return
+		}
+	}
+
+	headers, err := reqctx.Engine(c).Chain().MineBlocks(c.Request.Context(), blocks)
+	if err != nil {
+		spverrors.ErrorResponse(c, err, logger)
+		return
+	}
+
+	minedBlocks := make([]models.MerkleRoot, 0, len(headers))
+	for _, header := range headers {
+		minedBlocks = append(minedBlocks, models.MerkleRoot{
+			MerkleRoot:  header.MerkleRoot,
+			BlockHeight: int(header.Height),
+		})
+	}
+	c.JSON(http.StatusOK, minedBlocks)
+}
@@ -33,6 +33,21 @@ func RegisterRoutes(handlersManager *handlers.Manager) {
 	adminGroup.POST("/paymails", handlers.AsAdmin(paymailCreateAddress))
 	adminGroup.DELETE("/paymails/:id", handlers.AsAdmin(paymailDeleteAddress))
 
+	// paymail domains
+	adminGroup.GET("/paymail-domains", handlers.AsAdmin(paymailDomainsSearch))
+	adminGroup.POST("/paymail-domains", handlers.AsAdmin(paymailDomainCreate))
+	adminGroup.GET("/paymail-domains/:domain", handlers.AsAdmin(paymailDomainGet))
+	adminGroup.PUT("/paymail-domains/:domain", handlers.AsAdmin(paymailDomainUpdate))
+	adminGroup.DELETE("/paymail-domains/:domain", handlers.AsAdmin(paymailDomainDelete))
+	adminGroup.POST("/paymail-domains/:domain/check", handlers.AsAdmin(paymailDomainCheck))
+	adminGroup.GET("/paymail-hosts/rules", handlers.AsAdmin(paymailHostRulesSearch))
+	adminGroup.PUT("/paymail-hosts/rules/:domain", handlers.AsAdmin(paymailHostRuleSave))
+	adminGroup.DELETE("/paymail-hosts/rules/:domain", handlers.AsAdmin(paymailHostRuleDelete))
+	adminGroup.GET("/paymail-hosts/reputation", handlers.AsAdmin(paymailHostsReputation))
+	adminGroup.DELETE("/paymail-hosts/reputation/:domain", handlers.AsAdmin(paymailHostReputationReset))
+	adminGroup.GET("/paymail-capabilities/:domain", handlers.AsAdmin(paymailCapabilitiesGet))
+	adminGroup.DELETE("/paymail-capabilities/:domain", handlers.AsAdmin(paymailCapabilitiesPurge))
+
 	// utxos
 	adminGroup.GET("/utxos", handlers.AsAdmin(utxosSearch))
 
@@ -44,4 +59,14 @@ func RegisterRoutes(handlersManager *handlers.Manager) {
 	// xpubs => users
 	adminGroup.POST("/users", handlers.AsAdmin(xpubsCreate)) // create
 	adminGroup.GET("/users", handlers.AsAdmin(xpubsSearch))  // search
+
+	// fee unit
+	adminGroup.GET("/fee-unit", handlers.AsAdmin(feeUnitGet))
+	adminGroup.PUT("/fee-unit", handlers.AsAdmin(feeUnitOverride))
+	adminGroup.DELETE("/fee-unit", handlers.AsAdmin(feeUnitClearOverride))
+
+	// regtest
+	if handlersManager.GetConfig().IsRegtest() {
+		adminGroup.POST("/regtest/mine", handlers.AsAdmin(regtestMine))
+	}
 }
@@ -34,6 +34,21 @@ func (ts *TestSuite) TestXPubRegisterRoutes() {
 			{"POST", "/api/" + config.APIVersion + "/admin/paymails"},       // create
 			{"DELETE", "/api/" + config.APIVersion + "/admin/paymails/:id"}, // delete
 
+			// paymail domains
+			{"GET", "/api/" + config.APIVersion + "/admin/paymail-domains"},                // list
+			{"POST", "/api/" + config.APIVersion + "/admin/paymail-domains"},               // create
+			{"GET", "/api/" + config.APIVersion + "/admin/paymail-domains/:domain"},        // get
+			{"PUT", "/api/" + config.APIVersion + "/admin/paymail-domains/:domain"},        // update
+			{"DELETE", "/api/" + config.APIVersion + "/admin/paymail-domains/:domain"},     // delete
+			{"POST", "/api/" + config.APIVersion + "/admin/paymail-domains/:domain/check"}, // self-check
+			{"GET", "/api/" + config.APIVersion + "/admin/paymail-hosts/rules"},
+			{"PUT", "/api/" + config.APIVersion + "/admin/paymail-hosts/rules/:domain"},
+			{"DELETE", "/api/" + config.APIVersion + "/admin/paymail-hosts/rules/:domain"},
+			{"GET", "/api/" + config.APIVersion + "/admin/paymail-hosts/reputation"},
+			{"DELETE", "/api/" + config.APIVersion + "/admin/paymail-hosts/reputation/:domain"},
+			{"GET", "/api/" + config.APIVersion + "/admin/paymail-capabilities/:domain"},
+			{"DELETE", "/api/" + config.APIVersion + "/admin/paymail-capabilities/:domain"},
+
 			// utxos
 			{"GET", "/api/" + config.APIVersion + "/admin/utxos"}, // get utxo
 
@@ -41,6 +56,11 @@ func (ts *TestSuite) TestXPubRegisterRoutes() {
 			{"POST", "/api/" + config.APIVersion + "/admin/webhooks/subscriptions"},   // subscribe
 			{"DELETE", "/api/" + config.APIVersion + "/admin/webhooks/subscriptions"}, // unsubscribe
 
+			// fee unit
+			{"GET", "/api/" + config.APIVersion + "/admin/fee-unit"},    // get current and history
+			{"PUT", "/api/" + config.APIVersion + "/admin/fee-unit"},    // override
+			{"DELETE", "/api/" + config.APIVersion + "/admin/fee-unit"}, // clear override
+
 			// xpubs
 			{"POST", "/api/" + config.APIVersion + "/admin/users"}, // create
 			{"GET", "/api/" + config.APIVersion + "/admin/users"},  // search
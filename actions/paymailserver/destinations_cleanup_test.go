@@ -0,0 +1,170 @@
+package paymailserver_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/bitcoin-sv/go-sdk/script"
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities"
+	chainmodels "github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnpaidDestinationsCleanup(t *testing.T) {
+	givenForAllTests := testabilities.Given(t)
+	cleanup := givenForAllTests.StartedSPVWalletWithConfiguration(
+		testengine.WithDomainValidationDisabled(),
+		testengine.WithV2(),
+	)
+	defer cleanup()
+
+	// given:
+	given, then := testabilities.NewOf(givenForAllTests, t)
+	client := given.HttpClient().ForAnonymous()
+	recipientClient := given.HttpClient().ForGivenUser(fixtures.RecipientInternal)
+
+	// and:
+	recipientPaymail := fixtures.RecipientInternal.DefaultPaymail()
+	satoshis := uint64(1000)
+
+	// and:
+	requestDestination := func() (reference, address string, lockingScript *script.Script) {
+		res, _ := client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(map[string]any{"satoshis": satoshis}).
+			Post(fmt.Sprintf("https://example.com/v1/bsvalias/p2p-payment-destination/%s", recipientPaymail))
+		then.Response(res).IsOK()
+
+		getter := then.Response(res).JSONValue()
+		lockingScript, err := script.NewFromHex(getter.GetString("outputs[0]/script"))
+		require.NoError(t, err)
+		return getter.GetString("reference"), getter.GetString("outputs[0]/address"), lockingScript
+	}
+
+	// and:
+	requestAddressResolution := func() (address string, lockingScript *script.Script) {
+		res, _ := client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(map[string]any{
+				"dt":           time.Now().UTC().Format(time.RFC3339),
+				"senderHandle": fixtures.SenderExternal.DefaultPaymail(),
+				"amount":       satoshis,
+			}).
+			Post(fmt.Sprintf("https://example.com/v1/bsvalias/address/%s", recipientPaymail))
+		then.Response(res).IsOK()
+
+		getter := then.Response(res).JSONValue()
+		lockingScript, err := script.NewFromHex(getter.GetString("output"))
+		require.NoError(t, err)
+		return getter.GetString("address"), lockingScript
+	}
+
+	// and:
+	_, unpaidAddress, _ := requestDestination()
+	paidReference, paidAddress, paidLockingScript := requestDestination()
+	resolvedAddress, resolvedLockingScript := requestAddressResolution()
+
+	// and:
+	txSpec := given.Tx().
+		WithInput(satoshis+1).
+		WithOutputScript(satoshis, paidLockingScript)
+	given.ARC().WillRespondForBroadcast(200, &chainmodels.TXInfo{
+		TxID:     txSpec.ID(),
+		TXStatus: chainmodels.SeenOnNetwork,
+	})
+	given.BHS().WillRespondForMerkleRootsVerify(200, &chainmodels.MerkleRootsConfirmations{
+		ConfirmationState: chainmodels.MRConfirmed,
+	})
+	res, _ := client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(map[string]any{
+			"beef":      txSpec.BEEF(),
+			"reference": paidReference,
+			"metadata": map[string]any{
+				"sender": fixtures.SenderExternal.DefaultPaymail(),
+			},
+		}).
+		Post(fmt.Sprintf("https://example.com/v1/bsvalias/beef/%s", recipientPaymail))
+	then.Response(res).IsOK()
+
+	// and:
+	sourceTxSpec := given.Faucet(fixtures.Sender).TopUp(bsv.Satoshis(satoshis + 1))
+	resolvedTxSpec := given.Tx().
+		WithSender(fixtures.Sender).
+		WithInputFromUTXO(sourceTxSpec.TX(), 0).
+		WithOutputScript(satoshis, resolvedLockingScript)
+	given.ARC().WillRespondForBroadcastWithSeenOnNetwork(resolvedTxSpec.ID())
+	res, _ = given.HttpClient().ForGivenUser(fixtures.Sender).R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(map[string]any{
+			"hex":    resolvedTxSpec.BEEF(),
+			"format": "BEEF",
+		}).
+		Post("/api/v2/transactions")
+	then.Response(res).IsCreated()
+
+	// and:
+	res, _ = recipientClient.R().
+		SetBody(map[string]any{"satoshis": satoshis}).
+		Post("/api/v2/invoices")
+	then.Response(res).HasStatus(201)
+	res, _ = client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(map[string]any{"reference": then.Response(res).JSONValue().GetString("reference")}).
+		Post(fmt.Sprintf("https://example.com/v1/bsvalias/p2p-invoice-destination/%s", recipientPaymail))
+	then.Response(res).IsOK()
+	invoiceAddress := then.Response(res).JSONValue().GetString("outputs[0]/address")
+
+	// when:
+	err := given.Engine().PaymailDestinationsService().DeleteUnpaid(context.Background(), 0)
+
+	// then:
+	require.NoError(t, err)
+
+	t.Run("unpaid destination is removed", func(t *testing.T) {
+		// when:
+		res, _ := recipientClient.R().
+			SetQueryParam("address", unpaidAddress).
+			Get("/api/v2/paymail-destinations")
+
+		// then:
+		then.Response(res).HasStatus(404)
+	})
+
+	t.Run("unpaid destination of not expired invoice is kept", func(t *testing.T) {
+		// when:
+		res, _ := recipientClient.R().
+			SetQueryParam("address", invoiceAddress).
+			Get("/api/v2/paymail-destinations")
+
+		// then:
+		then.Response(res).IsOK()
+	})
+
+	t.Run("paid destination is kept", func(t *testing.T) {
+		// when:
+		res, _ := recipientClient.R().
+			SetQueryParam("address", paidAddress).
+			Get("/api/v2/paymail-destinations")
+
+		// then:
+		then.Response(res).IsOK()
+		require.Equal(t, txSpec.ID(), then.Response(res).JSONValue().GetString("txID"))
+	})
+
+	t.Run("destination from address resolution paid by recorded transaction is kept", func(t *testing.T) {
+		// when:
+		res, _ := recipientClient.R().
+			SetQueryParam("address", resolvedAddress).
+			Get("/api/v2/paymail-destinations")
+
+		// then:
+		then.Response(res).IsOK()
+		require.Equal(t, resolvedTxSpec.ID(), then.Response(res).JSONValue().GetString("txID"))
+	})
+}
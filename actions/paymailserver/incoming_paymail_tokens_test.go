@@ -0,0 +1,128 @@
+package paymailserver_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bitcoin-sv/go-sdk/script"
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities"
+	chainmodels "github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/ordinals"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIncomingPaymailTokenTransferFromExternalWallet(t *testing.T) {
+	givenForAllTests := testabilities.Given(t)
+	cleanup := givenForAllTests.StartedSPVWalletWithConfiguration(
+		testengine.WithDomainValidationDisabled(),
+		testengine.WithV2(),
+	)
+	defer cleanup()
+
+	// given:
+	sender := fixtures.ExternalFaucet
+	recipientPaymail := fixtures.RecipientInternal.DefaultPaymail()
+
+	// and:
+	senderAddress, err := sender.P2PKHLockingScript().Address()
+	require.NoError(t, err)
+	mintScript, err := ordinals.Lock(senderAddress, &ordinals.Inscription{
+		ContentType: ordinals.BSV21ContentType,
+		Content:     []byte(`{"p":"bsv-20","op":"deploy+mint","sym":"EXT","amt":"1000"}`),
+	})
+	require.NoError(t, err)
+	mintTx := givenForAllTests.Tx().
+		WithSender(sender).
+		WithInput(2).
+		WithOutputScript(1, mintScript).
+		TX()
+	tokenID := mintTx.TxID().String() + "_0"
+
+	// and:
+	requestDestination := func(given testabilities.SPVWalletApplicationFixture, then testabilities.SPVWalletApplicationAssertions) (reference string, address *script.Address) {
+		res, _ := given.HttpClient().ForAnonymous().R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(map[string]any{"satoshis": 1}).
+			Post(fmt.Sprintf("https://example.com/v1/bsvalias/p2p-payment-destination/%s", recipientPaymail))
+		then.Response(res).IsOK()
+
+		getter := then.Response(res).JSONValue()
+		lockingScript, err := script.NewFromHex(getter.GetString("outputs[0]/script"))
+		require.NoError(t, err)
+		address, err = lockingScript.Address()
+		require.NoError(t, err)
+		return getter.GetString("reference"), address
+	}
+
+	// and:
+	sendTransfer := func(given testabilities.SPVWalletApplicationFixture, then testabilities.SPVWalletApplicationAssertions, amount uint64) {
+		reference, address := requestDestination(given, then)
+		transferScript, err := ordinals.Lock(address, ordinals.NewBSV21Transfer(tokenID, amount))
+		require.NoError(t, err)
+
+		// the same fixture as for the mint transaction, so merkle proofs of their sources don't collide
+		txSpec := givenForAllTests.Tx().
+			WithSender(sender).
+			WithInputFromUTXO(mintTx, 0).
+			WithInput(100).
+			WithOutputScript(1, transferScript)
+		given.ARC().WillRespondForBroadcast(200, &chainmodels.TXInfo{
+			TxID:     txSpec.ID(),
+			TXStatus: chainmodels.SeenOnNetwork,
+		})
+		given.BHS().WillRespondForMerkleRootsVerify(200, &chainmodels.MerkleRootsConfirmations{
+			ConfirmationState: chainmodels.MRConfirmed,
+		})
+
+		res, _ := given.HttpClient().ForAnonymous().R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(map[string]any{
+				"beef":      txSpec.BEEF(),
+				"reference": reference,
+				"metadata": map[string]any{
+					"sender": sender.DefaultPaymail(),
+				},
+			}).
+			Post(fmt.Sprintf("https://example.com/v1/bsvalias/beef/%s", recipientPaymail))
+		then.Response(res).IsOK()
+	}
+
+	t.Run("transfer not covered by the token of the source transaction is not credited", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+
+		// when:
+		sendTransfer(given, then, 1001)
+
+		// then:
+		res, _ := given.HttpClient().ForGivenUser(fixtures.RecipientInternal).R().Get("/api/v2/users/current")
+		then.Response(res).IsOK().WithJSONMatching(`{
+			"currentBalance": 0,
+			"tokens": []
+		}`, nil)
+	})
+
+	t.Run("transfer covered by the token of the source transaction is credited", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+
+		// when:
+		sendTransfer(given, then, 600)
+
+		// then:
+		res, _ := given.HttpClient().ForGivenUser(fixtures.RecipientInternal).R().Get("/api/v2/users/current")
+		then.Response(res).IsOK().WithJSONMatching(`{
+			"currentBalance": 0,
+			"tokens": [
+				{
+					"tokenId": "{{ .tokenID }}",
+					"amount": 600
+				}
+			]
+		}`, map[string]any{
+			"tokenID": tokenID,
+		})
+	})
+}
@@ -1,6 +1,7 @@
 package paymailserver_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
@@ -11,6 +12,7 @@ import (
 	chainmodels "github.com/bitcoin-sv/spv-wallet/engine/chain/models"
 	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
 	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
 	"github.com/stretchr/testify/require"
 )
 
@@ -136,7 +138,8 @@ func TestIncomingPaymailRawTX(t *testing.T) {
 
 		// then:
 		then.Response(res).IsOK().WithJSONf(`{
-			"currentBalance": %d
+			"currentBalance": %d,
+			"tokens": []
 		}`, satoshis)
 	})
 
@@ -183,6 +186,7 @@ func TestIncomingPaymailBeef(t *testing.T) {
 
 	var testState struct {
 		reference     string
+		address       string
 		lockingScript *script.Script
 		txID          string
 	}
@@ -231,6 +235,7 @@ func TestIncomingPaymailBeef(t *testing.T) {
 		// update:
 		getter := then.Response(res).JSONValue()
 		testState.reference = getter.GetString("reference")
+		testState.address = getter.GetString("outputs[0]/address")
 
 		// and:
 		lockingScript, err := script.NewFromHex(getter.GetString("outputs[0]/script"))
@@ -298,7 +303,8 @@ func TestIncomingPaymailBeef(t *testing.T) {
 
 		// then:
 		then.Response(res).IsOK().WithJSONf(`{
-			"currentBalance": %d
+			"currentBalance": %d,
+			"tokens": []
 		}`, satoshis)
 	})
 
@@ -334,7 +340,58 @@ func TestIncomingPaymailBeef(t *testing.T) {
 		})
 	})
 
-	t.Run("step 5 - create transaction outline", func(t *testing.T) {
+	t.Run("step 5 - look up paymail destination by outpoint", func(t *testing.T) {
+		// given:
+		recipientClient := given.HttpClient().ForGivenUser(fixtures.RecipientInternal)
+
+		// when:
+		res, _ := recipientClient.R().
+			SetQueryParam("txId", testState.txID).
+			SetQueryParam("vout", "0").
+			Get("/api/v2/paymail-destinations")
+
+		// then:
+		then.Response(res).IsOK().WithJSONMatching(`{
+			"address": "{{ .address }}",
+			"reference": "{{ .reference }}",
+			"paymail": "{{ .recipient }}",
+			"request": "p2p-destination",
+			"satoshis": {{ .satoshis }},
+			"requesterIP": "192.0.2.1:1234",
+			"requesterUserAgent": {{ anything }},
+			"senderPaymail": "{{ .sender }}",
+			"note": "{{ .note }}",
+			"txID": "{{ .txID }}",
+			"vout": 0,
+			"createdAt": "{{ matchTimestamp }}"
+		}`, map[string]any{
+			"address":   testState.address,
+			"reference": testState.reference,
+			"recipient": recipientPaymail,
+			"satoshis":  satoshis,
+			"sender":    senderPaymail,
+			"note":      note,
+			"txID":      testState.txID,
+		})
+	})
+
+	t.Run("step 6 - look up paymail destination by address", func(t *testing.T) {
+		// given:
+		recipientClient := given.HttpClient().ForGivenUser(fixtures.RecipientInternal)
+
+		// when:
+		res, _ := recipientClient.R().
+			SetQueryParam("address", testState.address).
+			Get("/api/v2/paymail-destinations")
+
+		// then:
+		then.Response(res).IsOK()
+		getter := then.Response(res).JSONValue()
+		require.Equal(t, testState.reference, getter.GetString("reference"))
+		require.Equal(t, testState.txID, getter.GetString("txID"))
+	})
+
+	t.Run("step 7 - create transaction outline", func(t *testing.T) {
 		// given:
 		recipientClient := given.HttpClient().ForGivenUser(fixtures.RecipientInternal)
 
@@ -407,4 +464,282 @@ func TestAddressResolution(t *testing.T) {
 			"address": "{{ matchAddress }}",
 			"output": "{{ matchHex }}"
 		}`, nil)
+
+	// when:
+	address := then.Response(res).JSONValue().GetString("address")
+	output := then.Response(res).JSONValue().GetString("output")
+	recipientClient := given.HttpClient().ForGivenUser(fixtures.RecipientInternal)
+	res, _ = recipientClient.R().
+		SetQueryParam("address", address).
+		Get("/api/v2/paymail-destinations")
+
+	// then:
+	then.Response(res).IsOK().WithJSONMatching(`{
+		"address": "{{ .address }}",
+		"reference": "{{ matchHexWithLength 32 }}",
+		"paymail": "{{ .recipient }}",
+		"request": "address-resolution",
+		"satoshis": {{ .satoshis }},
+		"requesterIP": "192.0.2.1:1234",
+		"requesterUserAgent": {{ anything }},
+		"senderPaymail": "{{ .sender }}",
+		"senderName": "External Sender",
+		"purpose": "P2P",
+		"createdAt": "{{ matchTimestamp }}"
+	}`, map[string]any{
+		"address":   address,
+		"recipient": recipientPaymail,
+		"satoshis":  satoshis,
+		"sender":    senderPaymail,
+	})
+
+	t.Run("Destination is paid by recorded transaction", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+
+		// and:
+		sender := fixtures.Sender
+		sourceTxSpec := given.Faucet(sender).TopUp(bsv.Satoshis(satoshis + 1))
+
+		// and:
+		lockingScript, err := script.NewFromHex(output)
+		require.NoError(t, err)
+
+		// and:
+		txSpec := given.Tx().
+			WithSender(sender).
+			WithInputFromUTXO(sourceTxSpec.TX(), 0).
+			WithOutputScript(satoshis, lockingScript)
+
+		// and:
+		given.ARC().WillRespondForBroadcastWithSeenOnNetwork(txSpec.ID())
+
+		// and:
+		res, _ := given.HttpClient().ForGivenUser(sender).R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(map[string]any{
+				"hex":    txSpec.BEEF(),
+				"format": "BEEF",
+			}).
+			Post("/api/v2/transactions")
+		then.Response(res).IsCreated()
+
+		// when:
+		res, _ = recipientClient.R().
+			SetQueryParam("txId", txSpec.ID()).
+			SetQueryParam("vout", "0").
+			Get("/api/v2/paymail-destinations")
+
+		// then:
+		then.Response(res).IsOK().WithJSONMatching(`{
+			"address": "{{ .address }}",
+			"reference": "{{ matchHexWithLength 32 }}",
+			"paymail": "{{ .recipient }}",
+			"request": "address-resolution",
+			"satoshis": {{ .satoshis }},
+			"requesterIP": "192.0.2.1:1234",
+			"requesterUserAgent": {{ anything }},
+			"senderPaymail": "{{ .sender }}",
+			"senderName": "External Sender",
+			"purpose": "P2P",
+			"txID": "{{ .txID }}",
+			"vout": 0,
+			"createdAt": "{{ matchTimestamp }}"
+		}`, map[string]any{
+			"address":   address,
+			"recipient": recipientPaymail,
+			"satoshis":  satoshis,
+			"sender":    senderPaymail,
+			"txID":      txSpec.ID(),
+		})
+	})
+}
+
+func TestIncomingPaymailBeefWithoutSenderSignature(t *testing.T) {
+	givenForAllTests := testabilities.Given(t)
+	cleanup := givenForAllTests.StartedSPVWalletWithConfiguration(
+		testengine.WithDomainValidationDisabled(),
+		testengine.WithSenderValidationEnabled(),
+		testengine.WithV2(),
+	)
+	defer cleanup()
+
+	// given:
+	given, then := testabilities.NewOf(givenForAllTests, t)
+	client := given.HttpClient().ForAnonymous()
+	adminClient := given.HttpClient().ForAdmin()
+
+	// and:
+	domain := "ignore.example.com"
+	recipient := fixtures.User{
+		PrivKey:  "xprv9s21ZrQH143K4Tf1hf7ouMiagMH4JKvE6E2SY8Su55Y6aFi9AfQibzx7i79g1NJkLQbRY4FjKgvpddtYXoD7dA2KbGjHdHcxXVqtd687KrK",
+		Paymails: []fixtures.Paymail{fixtures.Paymail("recipient@" + domain)},
+	}
+	recipientPaymail := recipient.DefaultPaymail()
+	satoshis := uint64(1000)
+
+	// and:
+	res, _ := adminClient.R().
+		SetBody(map[string]any{
+			"name":             domain,
+			"senderValidation": "ignore",
+		}).
+		Post("/api/v1/admin/paymail-domains")
+	then.Response(res).HasStatus(201)
+
+	// and:
+	res, _ = adminClient.R().
+		SetBody(map[string]any{
+			"publicKey": recipient.PublicKey().ToDERHex(),
+			"paymail": map[string]any{
+				"address": recipientPaymail,
+			},
+		}).
+		Post("/api/v2/admin/users")
+	then.Response(res).HasStatus(201)
+
+	// and:
+	res, _ = client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(map[string]any{"satoshis": satoshis}).
+		Post(fmt.Sprintf("https://example.com/v1/bsvalias/p2p-payment-destination/%s", recipientPaymail))
+	then.Response(res).IsOK()
+
+	getter := then.Response(res).JSONValue()
+	lockingScript, err := script.NewFromHex(getter.GetString("outputs[0]/script"))
+	require.NoError(t, err)
+
+	// and:
+	txSpec := given.Tx().
+		WithInput(satoshis+1).
+		WithOutputScript(satoshis, lockingScript)
+
+	given.ARC().WillRespondForBroadcast(200, &chainmodels.TXInfo{
+		TxID:     txSpec.ID(),
+		TXStatus: chainmodels.SeenOnNetwork,
+	})
+	given.BHS().WillRespondForMerkleRootsVerify(200, &chainmodels.MerkleRootsConfirmations{
+		ConfirmationState: chainmodels.MRConfirmed,
+	})
+
+	// when:
+	res, _ = client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(map[string]any{
+			"beef":      txSpec.BEEF(),
+			"reference": getter.GetString("reference"),
+			"metadata": map[string]any{
+				"sender": fixtures.SenderExternal.DefaultPaymail(),
+			},
+		}).
+		Post(fmt.Sprintf("https://example.com/v1/bsvalias/beef/%s", recipientPaymail))
+
+	// then:
+	then.Response(res).IsOK().WithJSONMatching(`{
+		"txid": "{{ .txid }}",
+		"note": ""
+	}`, map[string]any{
+		"txid": txSpec.ID(),
+	})
+}
+
+func TestIncomingPaymailBeefWhileBHSIsDown(t *testing.T) {
+	tests := map[string]struct {
+		confirmationState chainmodels.MerkleRootConfirmationState
+		expectedBalance   bsv.Satoshis
+	}{
+		"record deferred transaction with valid merkle roots": {
+			confirmationState: chainmodels.MRConfirmed,
+			expectedBalance:   1000,
+		},
+		"reject deferred transaction with invalid merkle roots": {
+			confirmationState: chainmodels.MRInvalid,
+			expectedBalance:   0,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given:
+			given, then := testabilities.New(t)
+			cleanup := given.StartedSPVWalletWithConfiguration(
+				testengine.WithDomainValidationDisabled(),
+				testengine.WithV2(),
+				testengine.WithBHSDownQueue(),
+			)
+			defer cleanup()
+
+			// and:
+			client := given.HttpClient().ForAnonymous()
+			recipientPaymail := fixtures.RecipientInternal.DefaultPaymail()
+			satoshis := uint64(1000)
+
+			// and:
+			res, _ := client.R().
+				SetBody(map[string]any{"satoshis": satoshis}).
+				Post(fmt.Sprintf("https://example.com/v1/bsvalias/p2p-payment-destination/%s", recipientPaymail))
+			then.Response(res).IsOK()
+			getter := then.Response(res).JSONValue()
+			lockingScript, err := script.NewFromHex(getter.GetString("outputs[0]/script"))
+			require.NoError(t, err)
+
+			// and:
+			txSpec := given.Tx().
+				WithInput(satoshis+1).
+				WithOutputScript(satoshis, lockingScript)
+
+			// and:
+			given.BHS().WillRespondForMerkleRootsVerify(500, nil)
+
+			// when:
+			res, _ = client.R().
+				SetBody(map[string]any{
+					"beef":      txSpec.BEEF(),
+					"reference": getter.GetString("reference"),
+					"metadata": map[string]any{
+						"sender": fixtures.SenderExternal.DefaultPaymail(),
+					},
+				}).
+				Post(fmt.Sprintf("https://example.com/v1/bsvalias/beef/%s", recipientPaymail))
+
+			// then:
+			then.Response(res).IsOK().WithJSONMatching(`{
+				"txid": "{{ .txid }}",
+				"note": ""
+			}`, map[string]any{
+				"txid": txSpec.ID(),
+			})
+
+			// and:
+			then.User(fixtures.RecipientInternal).Balance().IsZero()
+
+			// when:
+			err = given.Engine().DeferredTransactionsProcessor().ProcessDeferredTransactions(context.Background())
+
+			// then:
+			require.NoError(t, err)
+			then.User(fixtures.RecipientInternal).Balance().IsZero()
+
+			// given:
+			time.Sleep(5 * time.Millisecond)
+			given.ARC().WillRespondForBroadcast(200, &chainmodels.TXInfo{
+				TxID:     txSpec.ID(),
+				TXStatus: chainmodels.SeenOnNetwork,
+			})
+			given.BHS().WillRespondForMerkleRootsVerify(200, &chainmodels.MerkleRootsConfirmations{
+				ConfirmationState: test.confirmationState,
+			})
+
+			// when:
+			err = given.Engine().DeferredTransactionsProcessor().ProcessDeferredTransactions(context.Background())
+
+			// then:
+			require.NoError(t, err)
+			then.User(fixtures.RecipientInternal).Balance().IsEqualTo(test.expectedBalance)
+
+			// and:
+			pending, err := given.Engine().Repositories().DeferredTxs.FindPending(context.Background(), 10)
+			require.NoError(t, err)
+			require.Empty(t, pending)
+		})
+	}
 }
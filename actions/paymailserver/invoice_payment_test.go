@@ -0,0 +1,213 @@
+package paymailserver_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bitcoin-sv/go-sdk/script"
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities/apierror"
+	chainmodels "github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvoicePayment(t *testing.T) {
+	givenForAllTests := testabilities.Given(t)
+	cleanup := givenForAllTests.StartedSPVWalletWithConfiguration(
+		testengine.WithDomainValidationDisabled(),
+		testengine.WithV2(),
+	)
+	defer cleanup()
+
+	var testState struct {
+		invoiceID     uint
+		reference     string
+		lockingScript *script.Script
+		txID          string
+	}
+
+	// given:
+	given, then := testabilities.NewOf(givenForAllTests, t)
+	client := given.HttpClient().ForAnonymous()
+	recipientClient := given.HttpClient().ForGivenUser(fixtures.RecipientInternal)
+
+	// and:
+	senderPaymail := fixtures.SenderExternal.DefaultPaymail()
+	recipientPaymail := fixtures.RecipientInternal.DefaultPaymail()
+	satoshis := uint64(1000)
+	memo := "order #1"
+
+	t.Run("step 1 - create invoice", func(t *testing.T) {
+		// when:
+		res, _ := recipientClient.R().
+			SetBody(map[string]any{
+				"satoshis": satoshis,
+				"memo":     memo,
+			}).
+			Post("/api/v2/invoices")
+
+		// then:
+		then.Response(res).HasStatus(201).WithJSONMatching(`{
+			"id": {{ anything }},
+			"reference": "{{ matchHexWithLength 32 }}",
+			"paymail": "{{ .paymail }}",
+			"satoshis": {{ .satoshis }},
+			"memo": "{{ .memo }}",
+			"status": "pending",
+			"expiresAt": "{{ matchTimestamp }}",
+			"paidSatoshis": 0,
+			"createdAt": "{{ matchTimestamp }}"
+		}`, map[string]any{
+			"paymail":  recipientPaymail,
+			"satoshis": satoshis,
+			"memo":     memo,
+		})
+
+		// update:
+		getter := then.Response(res).JSONValue()
+		getter.GetAsType("id", &testState.invoiceID)
+		testState.reference = getter.GetString("reference")
+	})
+
+	t.Run("step 2 - call p2p-invoice-destination", func(t *testing.T) {
+		// when:
+		res, _ := client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(map[string]any{
+				"reference": testState.reference,
+			}).
+			Post(
+				fmt.Sprintf(
+					"https://example.com/v1/bsvalias/p2p-invoice-destination/%s",
+					recipientPaymail,
+				),
+			)
+
+		// then:
+		then.Response(res).IsOK().WithJSONMatching(`{
+			"outputs": [
+			  {
+			    "address": "{{ matchAddress }}",
+			    "satoshis": {{ .satoshis }},
+			    "script": "{{ matchHex }}"
+			  }
+			],
+			"reference": "{{ .reference }}",
+			"memo": "{{ .memo }}",
+			"expiresAt": "{{ matchTimestamp }}"
+		}`, map[string]any{
+			"satoshis":  satoshis,
+			"reference": testState.reference,
+			"memo":      memo,
+		})
+
+		// update:
+		lockingScript, err := script.NewFromHex(then.Response(res).JSONValue().GetString("outputs[0]/script"))
+		require.NoError(t, err)
+		testState.lockingScript = lockingScript
+	})
+
+	t.Run("step 3 - pay the invoice with beef capability", func(t *testing.T) {
+		// given:
+		txSpec := given.Tx().
+			WithInput(satoshis+1).
+			WithOutputScript(satoshis, testState.lockingScript)
+
+		// and:
+		requestBody := map[string]any{
+			"beef":      txSpec.BEEF(),
+			"reference": testState.reference,
+			"metadata": map[string]any{
+				"sender": senderPaymail,
+			},
+		}
+
+		// and:
+		given.ARC().WillRespondForBroadcast(200, &chainmodels.TXInfo{
+			TxID:     txSpec.ID(),
+			TXStatus: chainmodels.SeenOnNetwork,
+		})
+
+		// and:
+		given.BHS().WillRespondForMerkleRootsVerify(200, &chainmodels.MerkleRootsConfirmations{
+			ConfirmationState: chainmodels.MRConfirmed,
+		})
+
+		// when:
+		res, _ := client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(requestBody).
+			Post(
+				fmt.Sprintf(
+					"https://example.com/v1/bsvalias/beef/%s",
+					recipientPaymail,
+				),
+			)
+
+		// then:
+		then.Response(res).IsOK()
+
+		// update:
+		testState.txID = txSpec.ID()
+	})
+
+	t.Run("step 4 - get paid invoice", func(t *testing.T) {
+		// when:
+		res, _ := recipientClient.R().Get(fmt.Sprintf("/api/v2/invoices/%d", testState.invoiceID))
+
+		// then:
+		then.Response(res).IsOK().WithJSONMatching(`{
+			"id": {{ .id }},
+			"reference": "{{ .reference }}",
+			"paymail": "{{ .paymail }}",
+			"satoshis": {{ .satoshis }},
+			"memo": "{{ .memo }}",
+			"status": "paid",
+			"expiresAt": "{{ matchTimestamp }}",
+			"paidSatoshis": {{ .satoshis }},
+			"txID": "{{ .txID }}",
+			"paidAt": "{{ matchTimestamp }}",
+			"createdAt": "{{ matchTimestamp }}"
+		}`, map[string]any{
+			"id":        testState.invoiceID,
+			"reference": testState.reference,
+			"paymail":   recipientPaymail,
+			"satoshis":  satoshis,
+			"memo":      memo,
+			"txID":      testState.txID,
+		})
+	})
+
+	t.Run("step 5 - try to get destination for paid invoice", func(t *testing.T) {
+		// when:
+		res, _ := client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(map[string]any{
+				"reference": testState.reference,
+			}).
+			Post(
+				fmt.Sprintf(
+					"https://example.com/v1/bsvalias/p2p-invoice-destination/%s",
+					recipientPaymail,
+				),
+			)
+
+		// then:
+		then.Response(res).HasStatus(422).WithJSONf(
+			apierror.ExpectedJSON("error-invoice-not-payable", "invoice is already paid"),
+		)
+	})
+
+	t.Run("step 6 - search invoices", func(t *testing.T) {
+		// when:
+		res, _ := recipientClient.R().Get("/api/v2/invoices")
+
+		// then:
+		then.Response(res).IsOK()
+		getter := then.Response(res).JSONValue()
+		require.Equal(t, testState.reference, getter.GetString("content[0]/reference"))
+		require.Equal(t, "paid", getter.GetString("content[0]/status"))
+	})
+}
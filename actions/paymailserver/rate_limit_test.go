@@ -0,0 +1,134 @@
+package paymailserver_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities/apierror"
+	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/go-resty/resty/v2"
+)
+
+func TestPaymailRateLimit(t *testing.T) {
+	givenForAllTests := testabilities.Given(t)
+	cleanup := givenForAllTests.StartedSPVWalletWithConfiguration(
+		testengine.WithDomainValidationDisabled(),
+		testengine.WithV2(),
+		testengine.WithPaymailRateLimit(0, 2),
+	)
+	defer cleanup()
+
+	// given:
+	given, then := testabilities.NewOf(givenForAllTests, t)
+	client := given.HttpClient().ForAnonymous()
+
+	// and:
+	requestDestination := func(paymail string) {
+		res, _ := client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(map[string]any{"satoshis": 1000}).
+			Post(fmt.Sprintf("https://example.com/v1/bsvalias/p2p-payment-destination/%s", paymail))
+		then.Response(res).IsOK()
+	}
+
+	// and:
+	recipientPaymail := fixtures.RecipientInternal.DefaultPaymail().Address()
+	requestDestination(recipientPaymail)
+	requestDestination(recipientPaymail)
+
+	t.Run("requests to the paymail over the limit are rejected", func(t *testing.T) {
+		// when:
+		res, _ := client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(map[string]any{"satoshis": 1000}).
+			Post(fmt.Sprintf("https://example.com/v1/bsvalias/p2p-payment-destination/%s", recipientPaymail))
+
+		// then:
+		then.Response(res).HasStatus(429).WithJSONf(
+			apierror.ExpectedJSON("error-paymail-rate-limit-exceeded", "too many paymail requests"),
+		)
+	})
+
+	t.Run("requests to other paymail are not limited", func(t *testing.T) {
+		// when:
+		res, _ := client.R().Get(
+			fmt.Sprintf("https://example.com/v1/bsvalias/public-profile/%s", fixtures.Sender.DefaultPaymail().Address()),
+		)
+
+		// then:
+		then.Response(res).IsOK()
+	})
+
+	t.Run("requests to other endpoints are not limited", func(t *testing.T) {
+		// given:
+		recipientClient := given.HttpClient().ForGivenUser(fixtures.RecipientInternal)
+
+		// when:
+		res, _ := recipientClient.R().Get("/api/v2/users/current")
+
+		// then:
+		then.Response(res).IsOK()
+	})
+}
+
+func TestPaymailRateLimitPerIP(t *testing.T) {
+	requestProfile := func(client *resty.Client, forwardedFor string) *resty.Response {
+		res, _ := client.R().
+			SetHeader("X-Forwarded-For", forwardedFor).
+			Get(fmt.Sprintf("https://example.com/v1/bsvalias/public-profile/%s", fixtures.Sender.DefaultPaymail().Address()))
+		return res
+	}
+
+	t.Run("forwarded client IP is ignored when the request doesn't come from a trusted proxy", func(t *testing.T) {
+		// given:
+		given, then := testabilities.New(t)
+		cleanup := given.StartedSPVWalletWithConfiguration(
+			testengine.WithDomainValidationDisabled(),
+			testengine.WithV2(),
+			testengine.WithPaymailRateLimit(2, 0),
+		)
+		defer cleanup()
+
+		// and:
+		client := given.HttpClient().ForAnonymous()
+		then.Response(requestProfile(client, "203.0.113.1")).IsOK()
+		then.Response(requestProfile(client, "203.0.113.2")).IsOK()
+
+		// when:
+		res := requestProfile(client, "203.0.113.3")
+
+		// then:
+		then.Response(res).HasStatus(429)
+	})
+
+	t.Run("forwarded client IP is used when the request comes from a trusted proxy", func(t *testing.T) {
+		// given:
+		given, then := testabilities.New(t)
+		cleanup := given.StartedSPVWalletWithConfiguration(
+			testengine.WithDomainValidationDisabled(),
+			testengine.WithV2(),
+			testengine.WithPaymailRateLimit(2, 0),
+			testengine.WithTrustedProxies("192.0.2.0/24"),
+		)
+		defer cleanup()
+
+		// and:
+		client := given.HttpClient().ForAnonymous()
+		then.Response(requestProfile(client, "203.0.113.1")).IsOK()
+		then.Response(requestProfile(client, "203.0.113.1")).IsOK()
+
+		// when:
+		res := requestProfile(client, "203.0.113.2")
+
+		// then:
+		then.Response(res).IsOK()
+
+		// when:
+		res = requestProfile(client, "203.0.113.1")
+
+		// then:
+		then.Response(res).HasStatus(429)
+	})
+}
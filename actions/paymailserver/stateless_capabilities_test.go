@@ -38,6 +38,7 @@ func TestStatelessCapabilities(t *testing.T) {
 			"6745385c3fc0": false,
 			"a9f510c16bde": "https://example.com/v1/bsvalias/verify-pubkey/{alias}@{domain.tld}/{pubkey}",
 			"f12f968c92d6": "https://example.com/v1/bsvalias/public-profile/{alias}@{domain.tld}",
+			"p2p-invoice-destination": "https://example.com/v1/bsvalias/p2p-invoice-destination/{alias}@{domain.tld}",
 			"paymentDestination": "https://example.com/v1/bsvalias/address/{alias}@{domain.tld}",
 			"pki": "https://example.com/v1/bsvalias/id/{alias}@{domain.tld}"
 		  }
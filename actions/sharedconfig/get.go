@@ -3,6 +3,7 @@ package sharedconfig
 import (
 	"net/http"
 
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	"github.com/bitcoin-sv/spv-wallet/models/response"
 	"github.com/bitcoin-sv/spv-wallet/server/reqctx"
 	"github.com/gin-gonic/gin"
@@ -19,8 +20,14 @@ import (
 // @Security	x-auth-xpub
 func get(c *gin.Context, _ *reqctx.UserContext) {
 	appConfig := reqctx.AppConfig(c)
+	paymailDomains, err := reqctx.Engine(c).PaymailDomainsService().Names(c.Request.Context())
+	if err != nil {
+		spverrors.ErrorResponse(c, err, reqctx.Logger(c))
+		return
+	}
+
 	sharedConfig := response.SharedConfig{
-		PaymailDomains: appConfig.Paymail.Domains,
+		PaymailDomains: paymailDomains,
 		ExperimentalFeatures: map[string]bool{
 			"pikeContactsEnabled": appConfig.ExperimentalFeatures.PikeContactsEnabled,
 			"pikePaymentEnabled":  appConfig.ExperimentalFeatures.PikePaymentEnabled,
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/bitcoin-sv/spv-wallet/config"
+	"github.com/bitcoin-sv/spv-wallet/engine"
 	chainmodels "github.com/bitcoin-sv/spv-wallet/engine/chain/models"
 	testpaymail "github.com/bitcoin-sv/spv-wallet/engine/paymail/testabilities"
 	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
@@ -40,6 +41,9 @@ type SPVWalletApplicationFixture interface {
 
 	EngineFixture() testengine.EngineFixture
 
+	// Engine returns the engine instance used by the started spv-wallet.
+	Engine() engine.ClientInterface
+
 	// Tx creates a new mocked transaction builder
 	Tx() txtestability.TransactionSpec
 
@@ -170,6 +174,10 @@ func (f *appFixture) EngineFixture() testengine.EngineFixture {
 	return f.engineFixture
 }
 
+func (f *appFixture) Engine() engine.ClientInterface {
+	return f.engineWithConfig.Engine
+}
+
 func (f *appFixture) Config() *config.AppConfig {
 	return &f.engineWithConfig.Config
 }
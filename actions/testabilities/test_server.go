@@ -7,12 +7,18 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+// testClientAddr is the address of the connection of every request made with the test http client.
+const testClientAddr = "192.0.2.1:1234"
+
 type testServer struct {
 	handlers *gin.Engine
 }
 
 func (t testServer) RoundTrip(request *http.Request) (*http.Response, error) {
 	r := httptest.NewRecorder()
+	// the request is handled as if it came from the network
+	request = request.Clone(request.Context())
+	request.RemoteAddr = testClientAddr
 	t.handlers.ServeHTTP(r, request)
 	return r.Result(), nil
 }
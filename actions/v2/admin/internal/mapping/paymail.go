@@ -95,3 +95,11 @@ func parsePaymail(r *api.RequestsAddPaymail) (string, string, error) {
 	}
 	return alias, domain, nil
 }
+
+// RequestUpdatePaymailProfileToModel maps an update paymail profile request to paymail profile model
+func RequestUpdatePaymailProfileToModel(r *api.RequestsUpdatePaymailProfile) *paymailsmodels.PaymailProfile {
+	return &paymailsmodels.PaymailProfile{
+		PublicName: r.PublicName,
+		Avatar:     r.AvatarURL,
+	}
+}
@@ -92,7 +92,8 @@ func TestCreateUserWithoutPaymail(t *testing.T) {
 		then.Response(res).
 			IsOK().
 			WithJSONMatching(`{
-				"currentBalance": 0
+				"currentBalance": 0,
+				"tokens": []
 			}`, nil)
 	})
 }
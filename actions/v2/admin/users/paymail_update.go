@@ -0,0 +1,34 @@
+package users
+
+import (
+	"net/http"
+
+	adminerrors "github.com/bitcoin-sv/spv-wallet/actions/v2/admin/errors"
+	"github.com/bitcoin-sv/spv-wallet/actions/v2/admin/internal/mapping"
+	"github.com/bitcoin-sv/spv-wallet/api"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymails/paymailerrors"
+	"github.com/gin-gonic/gin"
+)
+
+// UpdatePaymailProfile updates public name and avatar of the user's paymail
+func (s *APIAdminUsers) UpdatePaymailProfile(c *gin.Context, id string, paymailID uint) {
+	var request api.RequestsUpdatePaymailProfile
+	if err := c.Bind(&request); err != nil {
+		spverrors.ErrorResponse(c, spverrors.ErrCannotBindRequest.Wrap(err), s.logger)
+		return
+	}
+
+	profile := mapping.RequestUpdatePaymailProfileToModel(&request)
+
+	updatedPaymail, err := s.engine.PaymailsService().UpdateProfile(c, id, paymailID, profile)
+	if err != nil {
+		spverrors.MapResponse(c, err, s.logger).
+			If(paymailerrors.ErrPaymailNotFound).Then(paymailerrors.ErrPaymailNotFound).
+			If(paymailerrors.ErrInvalidAvatarURL).Then(adminerrors.ErrInvalidAvatarURL).
+			Else(spverrors.ErrInternal)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapping.PaymailToAdminResponse(updatedPaymail))
+}
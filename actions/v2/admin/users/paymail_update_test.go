@@ -0,0 +1,139 @@
+package users_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities/apierror"
+	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+)
+
+func TestUpdatePaymailProfile(t *testing.T) {
+	// given:
+	givenForAllTests := testabilities.Given(t)
+	cleanup := givenForAllTests.StartedSPVWalletWithConfiguration(
+		testengine.WithV2(),
+	)
+	defer cleanup()
+
+	// and:
+	user := fixtures.Sender
+
+	// and:
+	newPaymail := fixtures.Paymail("sender_profile@" + fixtures.PaymailDomain)
+
+	// and:
+	given, then := testabilities.NewOf(givenForAllTests, t)
+	res, _ := given.HttpClient().ForAdmin().R().
+		SetBody(map[string]any{
+			"address": newPaymail,
+		}).
+		SetPathParam("id", user.ID()).
+		Post("/api/v2/admin/users/{id}/paymails")
+	then.Response(res).IsCreated()
+
+	var paymailID uint
+	then.Response(res).JSONValue().GetAsType("id", &paymailID)
+
+	t.Run("Update paymail profile as admin", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForAdmin()
+
+		// when:
+		res, _ := client.R().
+			SetBody(map[string]any{
+				"publicName": "Profile",
+				"avatarURL":  "https://address-to-avatar.com",
+			}).
+			SetPathParam("id", user.ID()).
+			SetPathParam("paymailId", fmt.Sprint(paymailID)).
+			Patch("/api/v2/admin/users/{id}/paymails/{paymailId}")
+
+		// then:
+		then.Response(res).IsOK().WithJSONMatching(`{
+			"alias": "{{ .alias }}",
+			"avatar": "https://address-to-avatar.com",
+			"domain": "example.com",
+			"id": {{ .id }},
+			"paymail": "{{ .paymail }}",
+			"publicName": "Profile"
+		}`, map[string]any{
+			"id":      paymailID,
+			"alias":   newPaymail.Alias(),
+			"paymail": newPaymail,
+		})
+	})
+
+	t.Run("Reset public name to alias and remove avatar", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForAdmin()
+
+		// when:
+		res, _ := client.R().
+			SetBody(map[string]any{
+				"publicName": "",
+				"avatarURL":  "",
+			}).
+			SetPathParam("id", user.ID()).
+			SetPathParam("paymailId", fmt.Sprint(paymailID)).
+			Patch("/api/v2/admin/users/{id}/paymails/{paymailId}")
+
+		// then:
+		then.Response(res).IsOK().WithJSONMatching(`{
+			"alias": "{{ .alias }}",
+			"avatar": "",
+			"domain": "example.com",
+			"id": {{ .id }},
+			"paymail": "{{ .paymail }}",
+			"publicName": "{{ .alias }}"
+		}`, map[string]any{
+			"id":      paymailID,
+			"alias":   newPaymail.Alias(),
+			"paymail": newPaymail,
+		})
+	})
+
+	t.Run("Try to update paymail profile with wrong avatar url", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForAdmin()
+
+		// when:
+		res, _ := client.R().
+			SetBody(map[string]any{
+				"avatarURL": "/User/path/to/avatar",
+			}).
+			SetPathParam("id", user.ID()).
+			SetPathParam("paymailId", fmt.Sprint(paymailID)).
+			Patch("/api/v2/admin/users/{id}/paymails/{paymailId}")
+
+		// then:
+		then.Response(res).
+			HasStatus(422).
+			WithJSONf(apierror.ExpectedJSON("error-user-invalid-avatar-url", "invalid avatar url"))
+	})
+
+	t.Run("Try to update paymail profile of another user", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForAdmin()
+
+		// when:
+		res, _ := client.R().
+			SetBody(map[string]any{
+				"publicName": "Profile",
+			}).
+			SetPathParam("id", fixtures.RecipientInternal.ID()).
+			SetPathParam("paymailId", fmt.Sprint(paymailID)).
+			Patch("/api/v2/admin/users/{id}/paymails/{paymailId}")
+
+		// then:
+		then.Response(res).
+			HasStatus(404).
+			WithJSONf(apierror.ExpectedJSON("error-paymail-not-found", "paymail not found"))
+	})
+}
@@ -3,9 +3,12 @@ package v2
 import (
 	"github.com/bitcoin-sv/spv-wallet/actions/v2/admin"
 	"github.com/bitcoin-sv/spv-wallet/actions/v2/base"
+	"github.com/bitcoin-sv/spv-wallet/actions/v2/contacts"
 	"github.com/bitcoin-sv/spv-wallet/actions/v2/data"
+	"github.com/bitcoin-sv/spv-wallet/actions/v2/invoices"
 	"github.com/bitcoin-sv/spv-wallet/actions/v2/merkleroots"
 	"github.com/bitcoin-sv/spv-wallet/actions/v2/operations"
+	"github.com/bitcoin-sv/spv-wallet/actions/v2/paymaildestinations"
 	"github.com/bitcoin-sv/spv-wallet/actions/v2/transactions"
 	"github.com/bitcoin-sv/spv-wallet/actions/v2/users"
 	"github.com/bitcoin-sv/spv-wallet/api"
@@ -23,6 +26,9 @@ type apiV2 struct {
 	operations.APIOperations
 	transactions.APITransactions
 	merkleroots.APIMerkleRoots
+	contacts.APIContacts
+	paymaildestinations.APIPaymailDestinations
+	invoices.APIInvoices
 }
 
 // NewV2API creates a new server
@@ -35,5 +41,8 @@ func NewV2API(config *config.AppConfig, engine engine.ClientInterface, logger *z
 		operations.NewAPIOperations(engine, logger),
 		transactions.NewAPITransactions(engine, logger),
 		merkleroots.NewAPIMerkleRoots(engine, logger),
+		contacts.NewAPIContacts(engine, logger),
+		paymaildestinations.NewAPIPaymailDestinations(engine, logger),
+		invoices.NewAPIInvoices(engine, logger),
 	}
 }
@@ -4,13 +4,21 @@ import (
 	"net/http"
 
 	"github.com/bitcoin-sv/spv-wallet/api"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/server/reqctx"
 	"github.com/gin-gonic/gin"
 )
 
 // SharedConfig is the handler for SharedConfig which can be obtained by both admin and user
 func (s *APIBase) SharedConfig(c *gin.Context) {
+	paymailDomains, err := reqctx.Engine(c).PaymailDomainsService().Names(c.Request.Context())
+	if err != nil {
+		spverrors.ErrorResponse(c, err, reqctx.Logger(c))
+		return
+	}
+
 	sharedConfig := api.ResponsesSharedConfig{
-		PaymailDomains: s.config.Paymail.Domains,
+		PaymailDomains: paymailDomains,
 		ExperimentalFeatures: map[string]bool{
 			"pikeContactsEnabled": s.config.ExperimentalFeatures.PikeContactsEnabled,
 			"pikePaymentEnabled":  s.config.ExperimentalFeatures.PikePaymentEnabled,
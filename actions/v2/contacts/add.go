@@ -0,0 +1,38 @@
+package contacts
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/v2/contacts/internal/mapping"
+	"github.com/bitcoin-sv/spv-wallet/api"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/contacts/contactserrors"
+	"github.com/bitcoin-sv/spv-wallet/server/reqctx"
+	"github.com/gin-gonic/gin"
+)
+
+// AddContact adds a contact for the user and sends the PIKE invitation to the contact.
+// The contact is added even if the invitation cannot be delivered (it's reported in the response).
+func (s *APIContacts) AddContact(c *gin.Context) {
+	userContext := reqctx.GetUserContext(c)
+	userID, err := userContext.ShouldGetUserID()
+	if err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+
+	var request api.RequestsAddContact
+	if err := c.Bind(&request); err != nil {
+		spverrors.ErrorResponse(c, spverrors.ErrCannotBindRequest.Wrap(err), s.logger)
+		return
+	}
+
+	contact, err := s.engine.ContactsService().Create(c.Request.Context(), mapping.RequestAddContactToNewContact(userID, &request))
+	if err != nil && !errors.Is(err, contactserrors.ErrAddingContactRequest) {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapping.AddedContactResponse(contact, err))
+}
@@ -0,0 +1,171 @@
+package contacts_test
+
+import (
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities/apierror"
+	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+)
+
+func TestUserContacts(t *testing.T) {
+	givenForAllTests := testabilities.Given(t)
+	cleanup := givenForAllTests.StartedSPVWalletWithConfiguration(
+		testengine.WithV2(),
+	)
+	defer cleanup()
+
+	t.Run("return empty contacts list for user", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForGivenUser(fixtures.Sender)
+
+		// when:
+		res, _ := client.R().Get("/api/v2/contacts")
+
+		// then:
+		then.Response(res).IsOK().WithJSONMatching(`{
+			"content": [],
+			"page": {
+			    "number": 1,
+			    "size": 0,
+			    "totalElements": 0,
+			    "totalPages": 0
+			}
+		}`, nil)
+	})
+
+	t.Run("add contact and confirm it", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForGivenUser(fixtures.Sender)
+
+		// and:
+		given.Paymail().ExternalPaymailHost().WillRespondWithBasicCapabilities()
+
+		// when:
+		res, _ := client.R().
+			SetBody(map[string]any{
+				"paymail":  fixtures.RecipientExternal.DefaultPaymail(),
+				"fullName": "Recipient",
+			}).
+			Post("/api/v2/contacts")
+
+		// then:
+		then.Response(res).HasStatus(201).WithJSONMatching(`{
+			"id": 1,
+			"fullName": "Recipient",
+			"paymail": "{{ .paymail }}",
+			"pubKey": "{{ matchHexWithLength 66 }}",
+			"status": "unconfirmed",
+			"createdAt": "{{ matchTimestamp }}",
+			"updatedAt": "{{ matchTimestamp }}",
+			"invitationSent": false,
+			"invitationError": "cannot send contact request to the contact paymail host"
+		}`, map[string]any{
+			"paymail": fixtures.RecipientExternal.DefaultPaymail(),
+		})
+
+		// when:
+		res, _ = client.R().Get("/api/v2/contacts")
+
+		// then:
+		then.Response(res).IsOK().WithJSONMatching(`{
+			"content": [
+				{
+					"id": 1,
+					"fullName": "Recipient",
+					"paymail": "{{ .paymail }}",
+					"pubKey": "{{ matchHexWithLength 66 }}",
+					"status": "unconfirmed",
+					"createdAt": "{{ matchTimestamp }}",
+					"updatedAt": "{{ matchTimestamp }}"
+				}
+			],
+			"page": {
+			    "number": 1,
+			    "size": 1,
+			    "totalElements": 1,
+			    "totalPages": 1
+			}
+		}`, map[string]any{
+			"paymail": fixtures.RecipientExternal.DefaultPaymail(),
+		})
+
+		// when:
+		res, _ = client.R().Post("/api/v2/contacts/1/accept")
+
+		// then:
+		then.Response(res).HasStatus(422).WithJSONf(apierror.ExpectedJSON("error-contact-invalid-status", "action not allowed for the current contact status"))
+
+		// when:
+		res, _ = client.R().Post("/api/v2/contacts/1/confirm")
+
+		// then:
+		then.Response(res).IsOK().WithJSONMatching(`{
+			"id": 1,
+			"fullName": "Recipient",
+			"paymail": "{{ .paymail }}",
+			"pubKey": "{{ matchHexWithLength 66 }}",
+			"status": "confirmed",
+			"createdAt": "{{ matchTimestamp }}",
+			"updatedAt": "{{ matchTimestamp }}"
+		}`, map[string]any{
+			"paymail": fixtures.RecipientExternal.DefaultPaymail(),
+		})
+
+		// when:
+		res, _ = client.R().Delete("/api/v2/contacts/1")
+
+		// then:
+		then.Response(res).HasStatus(204)
+
+		// when:
+		res, _ = client.R().Get("/api/v2/contacts/1")
+
+		// then:
+		then.Response(res).HasStatus(404).WithJSONf(apierror.ExpectedJSON("error-contact-not-found", "contact not found"))
+	})
+
+	t.Run("try to add own paymail as contact", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForGivenUser(fixtures.Sender)
+
+		// when:
+		res, _ := client.R().
+			SetBody(map[string]any{
+				"paymail":  fixtures.Sender.DefaultPaymail(),
+				"fullName": "Me",
+			}).
+			Post("/api/v2/contacts")
+
+		// then:
+		then.Response(res).HasStatus(400).WithJSONf(apierror.ExpectedJSON("error-contact-to-self", "cannot add own paymail as a contact"))
+	})
+
+	t.Run("try return contacts for admin", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForAdmin()
+
+		// when:
+		res, _ := client.R().Get("/api/v2/contacts")
+
+		// then:
+		then.Response(res).IsUnauthorizedForAdmin()
+	})
+
+	t.Run("try return contacts for anonymous", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForAnonymous()
+
+		// when:
+		res, _ := client.R().Get("/api/v2/contacts")
+
+		// then:
+		then.Response(res).IsUnauthorized()
+	})
+}
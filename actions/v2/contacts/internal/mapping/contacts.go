@@ -0,0 +1,63 @@
+package mapping
+
+import (
+	"github.com/bitcoin-sv/spv-wallet/api"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/contacts/contactsmodels"
+	"github.com/bitcoin-sv/spv-wallet/lox"
+	"github.com/bitcoin-sv/spv-wallet/models"
+	"github.com/samber/lo"
+)
+
+// ContactsPagedResponse maps a paged result of contacts to a response.
+func ContactsPagedResponse(contacts *models.PagedResult[contactsmodels.Contact]) api.ModelsContactsSearchResult {
+	return api.ModelsContactsSearchResult{
+		Page: api.ModelsSearchPage{
+			Size:          contacts.PageDescription.Size,
+			Number:        contacts.PageDescription.Number,
+			TotalElements: contacts.PageDescription.TotalElements,
+			TotalPages:    contacts.PageDescription.TotalPages,
+		},
+		Content: lo.Map(contacts.Content, lox.MappingFn(ContactResponse)),
+	}
+}
+
+// ContactResponse maps a contact to a response.
+func ContactResponse(contact *contactsmodels.Contact) api.ModelsContact {
+	return api.ModelsContact{
+		Id:        contact.ID,
+		FullName:  contact.FullName,
+		Paymail:   contact.Paymail,
+		PubKey:    contact.PubKey,
+		Status:    api.ModelsContactStatus(contact.Status),
+		CreatedAt: contact.CreatedAt,
+		UpdatedAt: contact.UpdatedAt,
+	}
+}
+
+// AddedContactResponse maps a contact added by the user to a response; invitationErr is the reason why the PIKE invitation wasn't delivered.
+func AddedContactResponse(contact *contactsmodels.Contact, invitationErr error) api.ModelsAddedContact {
+	res := api.ModelsAddedContact{
+		Id:             contact.ID,
+		FullName:       contact.FullName,
+		Paymail:        contact.Paymail,
+		PubKey:         contact.PubKey,
+		Status:         api.ModelsAddedContactStatus(contact.Status),
+		CreatedAt:      contact.CreatedAt,
+		UpdatedAt:      contact.UpdatedAt,
+		InvitationSent: invitationErr == nil,
+	}
+	if invitationErr != nil {
+		res.InvitationError = lo.ToPtr(invitationErr.Error())
+	}
+	return res
+}
+
+// RequestAddContactToNewContact maps an add contact request to a new contact model.
+func RequestAddContactToNewContact(userID string, request *api.RequestsAddContact) *contactsmodels.NewContact {
+	return &contactsmodels.NewContact{
+		UserID:           userID,
+		FullName:         request.FullName,
+		Paymail:          request.Paymail,
+		RequesterPaymail: lo.FromPtr(request.RequesterPaymail),
+	}
+}
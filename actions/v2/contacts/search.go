@@ -0,0 +1,50 @@
+package contacts
+
+import (
+	"net/http"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/v2/contacts/internal/mapping"
+	"github.com/bitcoin-sv/spv-wallet/api"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/models/filter"
+	"github.com/bitcoin-sv/spv-wallet/server/reqctx"
+	"github.com/gin-gonic/gin"
+)
+
+// SearchContacts returns contacts of the user based on given paging parameters
+func (s *APIContacts) SearchContacts(c *gin.Context, params api.SearchContactsParams) {
+	userContext := reqctx.GetUserContext(c)
+	userID, err := userContext.ShouldGetUserID()
+	if err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+
+	page := mapToFilter(params)
+	pagedResult, err := s.engine.ContactsService().PaginatedForUser(c.Request.Context(), userID, page)
+	if err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapping.ContactsPagedResponse(pagedResult))
+}
+
+func mapToFilter(params api.SearchContactsParams) filter.Page {
+	page := filter.Page{}
+
+	if params.Page != nil {
+		page.Number = *params.Page
+	}
+	if params.Size != nil {
+		page.Size = *params.Size
+	}
+	if params.Sort != nil {
+		page.Sort = *params.Sort
+	}
+	if params.SortBy != nil {
+		page.SortBy = *params.SortBy
+	}
+
+	return page
+}
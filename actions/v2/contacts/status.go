@@ -0,0 +1,46 @@
+package contacts
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/v2/contacts/internal/mapping"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/contacts/contactsmodels"
+	"github.com/bitcoin-sv/spv-wallet/server/reqctx"
+	"github.com/gin-gonic/gin"
+)
+
+type statusChangeFn = func(ctx context.Context, userID string, contactID uint) (*contactsmodels.Contact, error)
+
+// AcceptContact accepts the invitation received from the contact
+func (s *APIContacts) AcceptContact(c *gin.Context, id uint) {
+	s.changeStatus(c, id, s.engine.ContactsService().Accept)
+}
+
+// RejectContact rejects the invitation received from the contact
+func (s *APIContacts) RejectContact(c *gin.Context, id uint) {
+	s.changeStatus(c, id, s.engine.ContactsService().Reject)
+}
+
+// ConfirmContact confirms the contact
+func (s *APIContacts) ConfirmContact(c *gin.Context, id uint) {
+	s.changeStatus(c, id, s.engine.ContactsService().Confirm)
+}
+
+func (s *APIContacts) changeStatus(c *gin.Context, id uint, change statusChangeFn) {
+	userContext := reqctx.GetUserContext(c)
+	userID, err := userContext.ShouldGetUserID()
+	if err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+
+	contact, err := change(c.Request.Context(), userID, id)
+	if err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapping.ContactResponse(contact))
+}
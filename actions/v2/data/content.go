@@ -0,0 +1,64 @@
+package data
+
+import (
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/bitcoin-sv/spv-wallet/server/reqctx"
+	"github.com/gin-gonic/gin"
+)
+
+// DataContentById returns the raw content of the user's data by its id.
+// The content is stored on-chain by anyone, so the browser is not allowed to sniff its type or run it (the sandbox policy).
+// Only images and texts are displayed inline, other types are downloaded.
+func (s *APIData) DataContentById(c *gin.Context, id string) {
+	userContext := reqctx.GetUserContext(c)
+	userID, err := userContext.ShouldGetUserID()
+	if err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+
+	_, err = bsv.OutpointFromString(id)
+	if err != nil {
+		spverrors.ErrorResponse(c, spverrors.ErrInvalidDataID.Wrap(err), s.logger)
+		return
+	}
+
+	data, err := s.engine.DataService().FindForUser(c.Request.Context(), id, userID)
+	if err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+
+	if data == nil {
+		spverrors.ErrorResponse(c, spverrors.ErrDataNotFound, s.logger)
+		return
+	}
+
+	content, mediaType, filename := data.Content()
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Header("Content-Security-Policy", "sandbox")
+	if disposition := contentDisposition(mediaType, filename); disposition != "" {
+		c.Header("Content-Disposition", disposition)
+	}
+	c.Data(http.StatusOK, mediaType, content)
+}
+
+func contentDisposition(mediaType, filename string) string {
+	var params map[string]string
+	if filename != "" {
+		params = map[string]string{"filename": filename}
+	}
+	mediaType = strings.ToLower(mediaType)
+	if strings.HasPrefix(mediaType, "image/") || strings.HasPrefix(mediaType, "text/") {
+		if params == nil {
+			return ""
+		}
+		return mime.FormatMediaType("inline", params)
+	}
+	return mime.FormatMediaType("attachment", params)
+}
@@ -36,5 +36,11 @@ func (s *APIData) DataById(c *gin.Context, id string) {
 		return
 	}
 
-	c.JSON(http.StatusOK, mapping.DataResponse(data))
+	res, err := mapping.DataResponse(data)
+	if err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
 }
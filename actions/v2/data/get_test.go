@@ -36,7 +36,9 @@ func TestGetData(t *testing.T) {
 	then.Response(res).
 		IsOK().WithJSONMatching(`{
 				"id": "{{ .outpoint }}",
-				"blob": "{{ .blob }}"
+				"createdAt": "{{ matchTimestamp }}",
+				"blob": "{{ .blob }}",
+				"size": {{ len .blob }}
 			}`, map[string]any{
 		"outpoint": dataID,
 		"blob":     dataToStore,
@@ -1,14 +1,109 @@
 package mapping
 
 import (
+	"encoding/hex"
+
 	"github.com/bitcoin-sv/spv-wallet/api"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/data/bitcom"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/data/datamodels"
+	"github.com/bitcoin-sv/spv-wallet/lox"
+	"github.com/bitcoin-sv/spv-wallet/models"
+	"github.com/samber/lo"
 )
 
+// DataPagedResponse maps a paged result of data to a response
+func DataPagedResponse(data *models.PagedResult[datamodels.Data]) (api.ModelsDataSearchResult, error) {
+	catcher := lox.NewErrorCollector()
+	content := lo.Map(data.Content, lox.MapAndCollect(catcher, DataResponse))
+
+	return api.ModelsDataSearchResult{
+		Page: api.ModelsSearchPage{
+			Size:          data.PageDescription.Size,
+			Number:        data.PageDescription.Number,
+			TotalElements: data.PageDescription.TotalElements,
+			TotalPages:    data.PageDescription.TotalPages,
+		},
+		Content: content,
+	}, catcher.Error()
+}
+
 // DataResponse maps a domain data model to a response model
-func DataResponse(data *datamodels.Data) api.ModelsData {
+func DataResponse(data *datamodels.Data) (api.ModelsData, error) {
+	catcher := lox.NewErrorCollector()
+	protocols := lo.Map(data.Protocols, lox.MapAndCollect(catcher, protocolResponse))
+
 	return api.ModelsData{
-		Id:   data.ID(),
-		Blob: string(data.Blob),
+		Id:        data.ID(),
+		CreatedAt: data.CreatedAt,
+		Blob:      string(data.Blob),
+		Size:      len(data.Blob),
+		Protocols: lo.Ternary(len(protocols) > 0, &protocols, nil),
+	}, catcher.Error()
+}
+
+// SearchParamsToFilter maps the search params to the data search filter
+func SearchParamsToFilter(params api.SearchDataParams) (datamodels.SearchFilter, error) {
+	searchFilter := datamodels.SearchFilter{
+		TxID:        params.TxId,
+		CreatedFrom: params.CreatedFrom,
+		CreatedTo:   params.CreatedTo,
+		MinSize:     params.MinSize,
+		MaxSize:     params.MaxSize,
+		MediaType:   params.MediaType,
+		MapApp:      params.MapApp,
+		MapType:     params.MapType,
+		AIPAddress:  params.AipAddress,
+	}
+
+	if params.Prefix != nil {
+		prefix, err := hex.DecodeString(*params.Prefix)
+		if err != nil {
+			return searchFilter, spverrors.ErrInvalidDataSearchFilter.Wrap(spverrors.Wrapf(err, "prefix is not a valid hex"))
+		}
+		searchFilter.Prefix = prefix
+	}
+
+	if params.Protocol != nil {
+		switch protocol := string(*params.Protocol); protocol {
+		case bitcom.ProtocolB, bitcom.ProtocolMAP, bitcom.ProtocolAIP:
+			searchFilter.Protocol = &protocol
+		default:
+			return searchFilter, spverrors.ErrInvalidDataSearchFilter.Wrap(spverrors.Newf("unsupported protocol %s", protocol))
+		}
+	}
+
+	return searchFilter, nil
+}
+
+func protocolResponse(protocol bitcom.Protocol) (api.ModelsBitcomProtocol, error) {
+	var res api.ModelsBitcomProtocol
+	var err error
+	switch {
+	case protocol.B != nil:
+		err = res.FromModelsBProtocol(api.ModelsBProtocol{
+			Content:   protocol.B.Content,
+			MediaType: protocol.B.MediaType,
+			Encoding:  &protocol.B.Encoding,
+			Filename:  lo.EmptyableToPtr(protocol.B.Filename),
+		})
+	case protocol.MAP != nil:
+		err = res.FromModelsMAPProtocol(api.ModelsMAPProtocol{
+			Command: &protocol.MAP.Command,
+			Keys:    protocol.MAP.Keys,
+		})
+	case protocol.AIP != nil:
+		err = res.FromModelsAIPProtocol(api.ModelsAIPProtocol{
+			Algorithm: protocol.AIP.Algorithm,
+			Address:   protocol.AIP.Address,
+			Signature: protocol.AIP.Signature,
+			Valid:     &protocol.AIP.Valid,
+		})
+	default:
+		err = spverrors.Newf("unsupported bitcom protocol %s", protocol.Name)
+	}
+	if err != nil {
+		return res, spverrors.ErrInternal.Wrap(err)
 	}
+	return res, nil
 }
@@ -0,0 +1,62 @@
+package data
+
+import (
+	"net/http"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/v2/data/internal/mapping"
+	"github.com/bitcoin-sv/spv-wallet/api"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/models/filter"
+	"github.com/bitcoin-sv/spv-wallet/server/reqctx"
+	"github.com/gin-gonic/gin"
+)
+
+// SearchData returns data of the user based on given filter and paging parameters
+func (s *APIData) SearchData(c *gin.Context, params api.SearchDataParams) {
+	userContext := reqctx.GetUserContext(c)
+	userID, err := userContext.ShouldGetUserID()
+	if err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+
+	searchFilter, err := mapping.SearchParamsToFilter(params)
+	if err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+
+	page := mapToFilter(params)
+	pagedResult, err := s.engine.DataService().PaginatedForUser(c.Request.Context(), userID, searchFilter, page)
+	if err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+
+	res, err := mapping.DataPagedResponse(pagedResult)
+	if err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func mapToFilter(params api.SearchDataParams) filter.Page {
+	page := filter.Page{}
+
+	if params.Page != nil {
+		page.Number = *params.Page
+	}
+	if params.Size != nil {
+		page.Size = *params.Size
+	}
+	if params.Sort != nil {
+		page.Sort = *params.Sort
+	}
+	if params.SortBy != nil {
+		page.SortBy = *params.SortBy
+	}
+
+	return page
+}
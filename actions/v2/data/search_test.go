@@ -0,0 +1,170 @@
+package data_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities/apierror"
+	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchData(t *testing.T) {
+	// given:
+	givenForAllTests := testabilities.Given(t)
+	cleanup := givenForAllTests.StartedSPVWalletWithConfiguration(
+		testengine.WithV2(),
+	)
+	defer cleanup()
+
+	// and:
+	helloTx, helloID := givenForAllTests.Faucet(fixtures.Sender).StoreData("hello world")
+	_, byeID := givenForAllTests.Faucet(fixtures.Sender).StoreData("bye")
+
+	t.Run("return all data of the user", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForGivenUser(fixtures.Sender)
+
+		// when:
+		res, _ := client.R().Get("/api/v2/data")
+
+		// then:
+		then.Response(res).IsOK().WithJSONMatching(`{
+			"content": [
+				{
+					"id": "{{ .byeID }}",
+					"createdAt": "{{ matchTimestamp }}",
+					"blob": "bye",
+					"size": 3
+				},
+				{
+					"id": "{{ .helloID }}",
+					"createdAt": "{{ matchTimestamp }}",
+					"blob": "hello world",
+					"size": 11
+				}
+			],
+			"page": {
+				"number": 1,
+				"size": 2,
+				"totalElements": 2,
+				"totalPages": 1
+			}
+		}`, map[string]any{
+			"helloID": helloID,
+			"byeID":   byeID,
+		})
+	})
+
+	t.Run("filter data", func(t *testing.T) {
+		filters := map[string]map[string]string{
+			"by txId":   {"txId": helloTx.ID()},
+			"by prefix": {"prefix": hex.EncodeToString([]byte("hello"))},
+			"by size":   {"minSize": "4", "maxSize": "11"},
+		}
+		for name, query := range filters {
+			t.Run(name, func(t *testing.T) {
+				// given:
+				given, then := testabilities.NewOf(givenForAllTests, t)
+				client := given.HttpClient().ForGivenUser(fixtures.Sender)
+
+				// when:
+				res, _ := client.R().
+					SetQueryParams(query).
+					Get("/api/v2/data")
+
+				// then:
+				then.Response(res).IsOK().WithJSONMatching(`{
+					"content": [
+						{
+							"id": "{{ .helloID }}",
+							"createdAt": "{{ matchTimestamp }}",
+							"blob": "hello world",
+							"size": 11
+						}
+					],
+					"page": {
+						"number": 1,
+						"size": 1,
+						"totalElements": 1,
+						"totalPages": 1
+					}
+				}`, map[string]any{
+					"helloID": helloID,
+				})
+			})
+		}
+	})
+
+	t.Run("return no data of other user", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForGivenUser(fixtures.RecipientInternal)
+
+		// when:
+		res, _ := client.R().Get("/api/v2/data")
+
+		// then:
+		then.Response(res).IsOK().WithJSONMatching(`{
+			"content": [],
+			"page": {
+				"number": 1,
+				"size": 0,
+				"totalElements": 0,
+				"totalPages": 0
+			}
+		}`, nil)
+	})
+
+	t.Run("try to search data with invalid prefix", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForGivenUser(fixtures.Sender)
+
+		// when:
+		res, _ := client.R().
+			SetQueryParam("prefix", "not-hex").
+			Get("/api/v2/data")
+
+		// then:
+		then.Response(res).HasStatus(400).WithJSONf(
+			apierror.ExpectedJSON("error-invalid-data-search-filter", "invalid data search filter"),
+		)
+	})
+
+	t.Run("try to search data with unsupported protocol", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForGivenUser(fixtures.Sender)
+
+		// when:
+		res, _ := client.R().
+			SetQueryParam("protocol", "UNKNOWN").
+			Get("/api/v2/data")
+
+		// then:
+		then.Response(res).HasStatus(400).WithJSONf(
+			apierror.ExpectedJSON("error-invalid-data-search-filter", "invalid data search filter"),
+		)
+	})
+
+	t.Run("download the raw blob", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForGivenUser(fixtures.Sender)
+
+		// when:
+		res, _ := client.R().Get("/api/v2/data/" + byeID + "/content")
+
+		// then:
+		then.Response(res).IsOK()
+		require.Equal(t, "application/octet-stream", res.Header().Get("Content-Type"))
+		require.Equal(t, "attachment", res.Header().Get("Content-Disposition"))
+		require.Equal(t, "nosniff", res.Header().Get("X-Content-Type-Options"))
+		require.Equal(t, "sandbox", res.Header().Get("Content-Security-Policy"))
+		require.Equal(t, "bye", res.String())
+	})
+}
@@ -51,7 +51,7 @@ func TestHandlingARCCallback(t *testing.T) {
 			then.ARC().Broadcasted().
 				WithTxID(receiveTxID).
 				WithCallbackURL("https://example.com/transaction/broadcast/callback").
-				WithCallbackToken(testengine.CallbackTestToken)
+				WithCallbackToken(testengine.CallbackTestTokenForTx(receiveTxID))
 
 			// and:
 			then.Alice().Operations().Last().
@@ -14,7 +14,11 @@ type arcActions struct {
 
 func (a *arcActions) SendsCallback(txInfo chainmodels.TXInfo) {
 	client := a.fixture.HttpClient().ForAnonymous()
-	token := a.fixture.Config().ARC.Callback.Token
+	callbackCfg := a.fixture.Config().ARC.Callback
+	token := (&chainmodels.ARCCallbackConfig{
+		Token:               callbackCfg.Token,
+		TokenPerTransaction: callbackCfg.TokenPerTransaction,
+	}).TokenForTx(txInfo.TxID)
 
 	res, _ := client.R().
 		SetHeader("Content-Type", "application/json").
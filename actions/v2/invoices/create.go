@@ -0,0 +1,35 @@
+package invoices
+
+import (
+	"net/http"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/v2/invoices/internal/mapping"
+	"github.com/bitcoin-sv/spv-wallet/api"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/server/reqctx"
+	"github.com/gin-gonic/gin"
+)
+
+// CreateInvoice creates an invoice (payment request) for the user
+func (s *APIInvoices) CreateInvoice(c *gin.Context) {
+	userContext := reqctx.GetUserContext(c)
+	userID, err := userContext.ShouldGetUserID()
+	if err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+
+	var request api.RequestsCreateInvoice
+	if err := c.Bind(&request); err != nil {
+		spverrors.ErrorResponse(c, spverrors.ErrCannotBindRequest.Wrap(err), s.logger)
+		return
+	}
+
+	invoice, err := s.engine.InvoicesService().Create(c.Request.Context(), mapping.RequestCreateInvoiceToNewInvoice(userID, &request))
+	if err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapping.InvoiceResponse(invoice))
+}
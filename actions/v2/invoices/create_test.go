@@ -0,0 +1,112 @@
+package invoices_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities/apierror"
+	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
+)
+
+// NOTE: Successful invoice payment is tested together with the paymail capabilities in actions/paymailserver/invoice_payment_test.go
+func TestCreateInvoiceErrorCases(t *testing.T) {
+	// given:
+	givenForAllTests := testabilities.Given(t)
+	cleanup := givenForAllTests.StartedSPVWalletWithConfiguration(
+		testengine.WithV2(),
+	)
+	defer cleanup()
+
+	t.Run("try to create as admin", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+
+		// and:
+		client := given.HttpClient().ForAdmin()
+
+		// when:
+		res, _ := client.R().
+			SetBody(map[string]any{"satoshis": 1000}).
+			Post("/api/v2/invoices")
+
+		// then:
+		then.Response(res).IsUnauthorizedForAdmin()
+	})
+
+	t.Run("try to create without amount", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+
+		// and:
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetBody(map[string]any{"satoshis": 0}).
+			Post("/api/v2/invoices")
+
+		// then:
+		then.Response(res).HasStatus(400).WithJSONf(
+			apierror.ExpectedJSON("error-invoice-invalid-amount", "invoice amount must be greater than zero"),
+		)
+	})
+
+	t.Run("try to create with expiry in the past", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+
+		// and:
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetBody(map[string]any{
+				"satoshis":  1000,
+				"expiresAt": time.Now().Add(-time.Hour).Format(time.RFC3339),
+			}).
+			Post("/api/v2/invoices")
+
+		// then:
+		then.Response(res).HasStatus(400).WithJSONf(
+			apierror.ExpectedJSON("error-invoice-invalid-expiry", "invoice expiry must be in the future"),
+		)
+	})
+
+	t.Run("try to create for not owned paymail", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+
+		// and:
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetBody(map[string]any{
+				"satoshis": 1000,
+				"paymail":  "someone@example.com",
+			}).
+			Post("/api/v2/invoices")
+
+		// then:
+		then.Response(res).HasStatus(400).WithJSONf(
+			apierror.ExpectedJSON("error-invoice-invalid-paymail", "invalid invoice paymail"),
+		)
+	})
+
+	t.Run("try to get not existing invoice", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+
+		// and:
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().Get("/api/v2/invoices/9999")
+
+		// then:
+		then.Response(res).HasStatus(404).WithJSONf(
+			apierror.ExpectedJSON("error-invoice-not-found", "invoice not found"),
+		)
+	})
+}
@@ -0,0 +1,51 @@
+package mapping
+
+import (
+	"github.com/bitcoin-sv/spv-wallet/api"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/invoices/invoicesmodels"
+	"github.com/bitcoin-sv/spv-wallet/lox"
+	"github.com/bitcoin-sv/spv-wallet/models"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/samber/lo"
+)
+
+// InvoicesPagedResponse maps a paged result of invoices to a response.
+func InvoicesPagedResponse(invoices *models.PagedResult[invoicesmodels.Invoice]) api.ModelsInvoicesSearchResult {
+	return api.ModelsInvoicesSearchResult{
+		Page: api.ModelsSearchPage{
+			Size:          invoices.PageDescription.Size,
+			Number:        invoices.PageDescription.Number,
+			TotalElements: invoices.PageDescription.TotalElements,
+			TotalPages:    invoices.PageDescription.TotalPages,
+		},
+		Content: lo.Map(invoices.Content, lox.MappingFn(InvoiceResponse)),
+	}
+}
+
+// InvoiceResponse maps an invoice to a response.
+func InvoiceResponse(invoice *invoicesmodels.Invoice) api.ModelsInvoice {
+	return api.ModelsInvoice{
+		Id:           invoice.ID,
+		Reference:    invoice.Reference,
+		Paymail:      invoice.Paymail,
+		Satoshis:     uint64(invoice.Satoshis),
+		Memo:         lo.EmptyableToPtr(invoice.Memo),
+		Status:       api.ModelsInvoiceStatus(invoice.Status),
+		ExpiresAt:    invoice.ExpiresAt,
+		PaidSatoshis: uint64(invoice.PaidSatoshis),
+		TxID:         lo.EmptyableToPtr(invoice.TxID),
+		PaidAt:       invoice.PaidAt,
+		CreatedAt:    invoice.CreatedAt,
+	}
+}
+
+// RequestCreateInvoiceToNewInvoice maps a create invoice request to a new invoice model.
+func RequestCreateInvoiceToNewInvoice(userID string, request *api.RequestsCreateInvoice) *invoicesmodels.NewInvoice {
+	return &invoicesmodels.NewInvoice{
+		UserID:    userID,
+		Satoshis:  bsv.Satoshis(request.Satoshis),
+		Memo:      lo.FromPtr(request.Memo),
+		ExpiresAt: lo.FromPtr(request.ExpiresAt),
+		Paymail:   lo.FromPtr(request.Paymail),
+	}
+}
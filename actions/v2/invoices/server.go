@@ -0,0 +1,22 @@
+package invoices
+
+import (
+	"github.com/bitcoin-sv/spv-wallet/engine"
+	"github.com/rs/zerolog"
+)
+
+// APIInvoices represents server with API endpoints
+type APIInvoices struct {
+	engine engine.ClientInterface
+	logger *zerolog.Logger
+}
+
+// NewAPIInvoices creates a new server with API endpoints
+func NewAPIInvoices(engine engine.ClientInterface, log *zerolog.Logger) APIInvoices {
+	logger := log.With().Str("api", "invoices").Logger()
+
+	return APIInvoices{
+		engine: engine,
+		logger: &logger,
+	}
+}
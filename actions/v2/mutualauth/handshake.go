@@ -0,0 +1,37 @@
+package mutualauth
+
+import (
+	"net/http"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/mutualauth/mutualauthmodels"
+	routes "github.com/bitcoin-sv/spv-wallet/server/handlers"
+	"github.com/bitcoin-sv/spv-wallet/server/reqctx"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registers the BRC-103 handshake endpoint
+func RegisterRoutes(handlersManager *routes.Manager) {
+	root := handlersManager.Get(routes.GroupRoot)
+
+	root.POST("/.well-known/auth", handshake)
+}
+
+// handshake establishes a BRC-103 session with the peer identified by the identity key from the initial request
+func handshake(c *gin.Context) {
+	logger := reqctx.Logger(c)
+
+	var request mutualauthmodels.AuthMessage
+	if err := c.ShouldBindJSON(&request); err != nil {
+		spverrors.ErrorResponse(c, spverrors.ErrCannotBindRequest.Wrap(err), logger)
+		return
+	}
+
+	response, err := reqctx.Engine(c).MutualAuthService().Handshake(c.Request.Context(), &request)
+	if err != nil {
+		spverrors.ErrorResponse(c, err, logger)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
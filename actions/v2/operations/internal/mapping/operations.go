@@ -29,6 +29,7 @@ func OperationsResponse(operation *operationsmodels.Operation) api.ModelsOperati
 		TxID:         operation.TxID,
 		Type:         api.ModelsOperationType(operation.Type),
 		Counterparty: operation.Counterparty,
+		SenderPubKey: operation.SenderPubKey,
 		TxStatus:     api.ModelsOperationTxStatus(operation.TxStatus),
 		BlockHeight:  operation.BlockHeight,
 		BlockHash:    operation.BlockHash,
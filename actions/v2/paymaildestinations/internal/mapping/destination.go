@@ -0,0 +1,31 @@
+package mapping
+
+import (
+	"github.com/bitcoin-sv/spv-wallet/api"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymaildestinations/destinationmodels"
+	"github.com/samber/lo"
+)
+
+// PaymailDestinationResponse maps a paymail destination to a response.
+func PaymailDestinationResponse(destination *destinationmodels.Destination) api.ModelsPaymailDestination {
+	res := api.ModelsPaymailDestination{
+		Address:            destination.Address,
+		Reference:          destination.ReferenceID,
+		Paymail:            destination.Paymail,
+		Request:            api.ModelsPaymailDestinationRequest(destination.Request),
+		Satoshis:           uint64(destination.Satoshis),
+		RequesterIP:        lo.EmptyableToPtr(destination.Requester.IP),
+		RequesterUserAgent: lo.EmptyableToPtr(destination.Requester.UserAgent),
+		SenderPaymail:      lo.EmptyableToPtr(destination.Requester.SenderPaymail),
+		SenderName:         lo.EmptyableToPtr(destination.Requester.SenderName),
+		SenderPubKey:       lo.EmptyableToPtr(destination.SenderPubKey),
+		Purpose:            lo.EmptyableToPtr(destination.Requester.Purpose),
+		Note:               lo.EmptyableToPtr(destination.Note),
+		CreatedAt:          destination.CreatedAt,
+	}
+	if destination.Outpoint != nil {
+		res.TxID = &destination.Outpoint.TxID
+		res.Vout = &destination.Outpoint.Vout
+	}
+	return res
+}
@@ -0,0 +1,41 @@
+package paymaildestinations
+
+import (
+	"net/http"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/v2/paymaildestinations/internal/mapping"
+	"github.com/bitcoin-sv/spv-wallet/api"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymaildestinations/destinationmodels"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/bitcoin-sv/spv-wallet/server/reqctx"
+	"github.com/gin-gonic/gin"
+)
+
+// LookupPaymailDestination returns the paymail request which produced the given address or received output of the user
+func (s *APIPaymailDestinations) LookupPaymailDestination(c *gin.Context, params api.LookupPaymailDestinationParams) {
+	userContext := reqctx.GetUserContext(c)
+	userID, err := userContext.ShouldGetUserID()
+	if err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+
+	service := s.engine.PaymailDestinationsService()
+	var destination *destinationmodels.Destination
+	switch {
+	case params.Address != nil && params.TxId == nil && params.Vout == nil:
+		destination, err = service.FindByAddress(c.Request.Context(), userID, *params.Address)
+	case params.Address == nil && params.TxId != nil && params.Vout != nil:
+		outpoint := bsv.Outpoint{TxID: *params.TxId, Vout: *params.Vout}
+		destination, err = service.FindByOutpoint(c.Request.Context(), userID, outpoint)
+	default:
+		err = spverrors.ErrInvalidPaymailDestinationLookup
+	}
+	if err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapping.PaymailDestinationResponse(destination))
+}
@@ -0,0 +1,71 @@
+package paymaildestinations_test
+
+import (
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities/apierror"
+	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
+)
+
+// NOTE: Successful lookups are tested together with the incoming paymail transactions in incoming_paymail_tx_test.go
+func TestLookupPaymailDestinationErrorCases(t *testing.T) {
+	// given:
+	givenForAllTests := testabilities.Given(t)
+	cleanup := givenForAllTests.StartedSPVWalletWithConfiguration(
+		testengine.WithV2(),
+	)
+	defer cleanup()
+
+	t.Run("try to look up as admin", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+
+		// and:
+		client := given.HttpClient().ForAdmin()
+
+		// when:
+		res, _ := client.R().
+			SetQueryParam("address", "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa").
+			Get("/api/v2/paymail-destinations")
+
+		// then:
+		then.Response(res).IsUnauthorizedForAdmin()
+	})
+
+	t.Run("try to look up without address or outpoint", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+
+		// and:
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetQueryParam("txId", "bb8593f85ef8056a77026ad415f02128f3768906de53e9e8bf8749fe2d66cf50").
+			Get("/api/v2/paymail-destinations")
+
+		// then:
+		then.Response(res).HasStatus(400).WithJSONf(
+			apierror.ExpectedJSON("error-paymail-destination-invalid-lookup", "either address or txId with vout must be provided"),
+		)
+	})
+
+	t.Run("try to look up unknown address", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+
+		// and:
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetQueryParam("address", "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa").
+			Get("/api/v2/paymail-destinations")
+
+		// then:
+		then.Response(res).HasStatus(404).WithJSONf(
+			apierror.ExpectedJSON("error-paymail-destination-not-found", "paymail destination not found"),
+		)
+	})
+}
@@ -0,0 +1,22 @@
+package paymaildestinations
+
+import (
+	"github.com/bitcoin-sv/spv-wallet/engine"
+	"github.com/rs/zerolog"
+)
+
+// APIPaymailDestinations represents server with API endpoints
+type APIPaymailDestinations struct {
+	engine engine.ClientInterface
+	logger *zerolog.Logger
+}
+
+// NewAPIPaymailDestinations creates a new server with API endpoints
+func NewAPIPaymailDestinations(engine engine.ClientInterface, log *zerolog.Logger) APIPaymailDestinations {
+	logger := log.With().Str("api", "paymail-destinations").Logger()
+
+	return APIPaymailDestinations{
+		engine: engine,
+		logger: &logger,
+	}
+}
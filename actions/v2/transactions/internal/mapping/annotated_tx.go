@@ -55,6 +55,14 @@ func annotatedOutputToOutline(from api.ModelsOutputAnnotation) *transaction.Outp
 				}
 			},
 		).Else(nil),
+		Address: lo.IfF(
+			from.Address != nil,
+			func() *transaction.AddressAnnotation {
+				return &transaction.AddressAnnotation{
+					Address: from.Address.Address,
+				}
+			},
+		).Else(nil),
 		CustomInstructions: lo.IfF(
 			from.CustomInstructions != nil,
 			func() *bsvmodel.CustomInstructions {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/bitcoin-sv/spv-wallet/api"
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/data/bitcom"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines"
 	"github.com/bitcoin-sv/spv-wallet/lox"
@@ -99,6 +100,14 @@ func outlineOutputToResponse(from *transaction.OutputAnnotation) api.ModelsOutpu
 				}
 			},
 		).Else(nil),
+		Address: lo.IfF(
+			from.Address != nil,
+			func() *api.ModelsAddressAnnotationDetails {
+				return &api.ModelsAddressAnnotationDetails{
+					Address: from.Address.Address,
+				}
+			},
+		).Else(nil),
 	}
 }
 
@@ -113,6 +122,20 @@ func outputSpecFromRequest(req api.RequestsTransactionOutlineOutputSpecification
 		return opReturnSpecFromRequest(req)
 	case "paymail":
 		return paymailSpecFromRequest(req)
+	case "contact":
+		return contactSpecFromRequest(req)
+	case "address":
+		return addressSpecFromRequest(req)
+	case "script":
+		return scriptSpecFromRequest(req)
+	case "bitcom":
+		return bitcomSpecFromRequest(req)
+	case "inscription":
+		return inscriptionSpecFromRequest(req)
+	case "ordinal_transfer":
+		return ordinalTransferSpecFromRequest(req)
+	case "token_transfer":
+		return tokenTransferSpecFromRequest(req)
 	default:
 		return nil, spverrors.ErrCannotBindRequest.Wrap(spverrors.Newf("unsupported output type"))
 	}
@@ -132,6 +155,143 @@ func paymailSpecFromRequest(req api.RequestsTransactionOutlineOutputSpecificatio
 	}, nil
 }
 
+func contactSpecFromRequest(req api.RequestsTransactionOutlineOutputSpecification) (outlines.OutputSpec, error) {
+	specification, err := req.AsRequestsContactOutputSpecification()
+	if err != nil {
+		return nil, spverrors.ErrCannotBindRequest.Wrap(err)
+	}
+
+	return &outlines.Contact{
+		ContactID: specification.ContactId,
+		Satoshis:  bsv.Satoshis(specification.Satoshis),
+		From:      specification.From,
+		Splits:    lo.IfF(lo.IsNotNil(specification.Splits), func() uint64 { return *specification.Splits }).Else(1),
+	}, nil
+}
+
+func addressSpecFromRequest(req api.RequestsTransactionOutlineOutputSpecification) (outlines.OutputSpec, error) {
+	specification, err := req.AsRequestsAddressOutputSpecification()
+	if err != nil {
+		return nil, spverrors.ErrCannotBindRequest.Wrap(err)
+	}
+
+	return &outlines.Address{
+		To:       specification.To,
+		Satoshis: bsv.Satoshis(specification.Satoshis),
+	}, nil
+}
+
+func scriptSpecFromRequest(req api.RequestsTransactionOutlineOutputSpecification) (outlines.OutputSpec, error) {
+	specification, err := req.AsRequestsScriptOutputSpecification()
+	if err != nil {
+		return nil, spverrors.ErrCannotBindRequest.Wrap(err)
+	}
+
+	return &outlines.Script{
+		LockingScript: specification.LockingScript,
+		Satoshis:      bsv.Satoshis(specification.Satoshis),
+	}, nil
+}
+
+func inscriptionSpecFromRequest(req api.RequestsTransactionOutlineOutputSpecification) (outlines.OutputSpec, error) {
+	specification, err := req.AsRequestsInscriptionOutputSpecification()
+	if err != nil {
+		return nil, spverrors.ErrCannotBindRequest.Wrap(err)
+	}
+
+	return &outlines.Inscription{
+		ContentType: specification.ContentType,
+		Content:     specification.Content,
+	}, nil
+}
+
+func ordinalTransferSpecFromRequest(req api.RequestsTransactionOutlineOutputSpecification) (outlines.OutputSpec, error) {
+	specification, err := req.AsRequestsOrdinalTransferOutputSpecification()
+	if err != nil {
+		return nil, spverrors.ErrCannotBindRequest.Wrap(err)
+	}
+
+	return &outlines.OrdinalTransfer{
+		Outpoint: bsv.Outpoint{
+			TxID: specification.TxID,
+			Vout: specification.Vout,
+		},
+		To: specification.To,
+	}, nil
+}
+
+func tokenTransferSpecFromRequest(req api.RequestsTransactionOutlineOutputSpecification) (outlines.OutputSpec, error) {
+	specification, err := req.AsRequestsTokenTransferOutputSpecification()
+	if err != nil {
+		return nil, spverrors.ErrCannotBindRequest.Wrap(err)
+	}
+
+	return &outlines.TokenTransfer{
+		TokenID: specification.TokenId,
+		Amount:  specification.Amount,
+		To:      specification.To,
+	}, nil
+}
+
+func bitcomSpecFromRequest(req api.RequestsTransactionOutlineOutputSpecification) (outlines.OutputSpec, error) {
+	specification, err := req.AsRequestsBitcomOutputSpecification()
+	if err != nil {
+		return nil, spverrors.ErrCannotBindRequest.Wrap(err)
+	}
+
+	catcher := lox.NewErrorCollector()
+	protocols := lo.Map(specification.Protocols, lox.MapAndCollect(catcher, bitcomProtocolFromRequest))
+	if err = catcher.Error(); err != nil {
+		return nil, err
+	}
+
+	return &outlines.Bitcom{
+		Protocols: protocols,
+	}, nil
+}
+
+func bitcomProtocolFromRequest(req api.ModelsBitcomProtocol) (bitcom.Protocol, error) {
+	protocol, err := req.Discriminator()
+	if err != nil {
+		return bitcom.Protocol{}, spverrors.ErrCannotBindRequest.Wrap(err)
+	}
+
+	switch protocol {
+	case bitcom.ProtocolB:
+		b, err := req.AsModelsBProtocol()
+		if err != nil {
+			return bitcom.Protocol{}, spverrors.ErrCannotBindRequest.Wrap(err)
+		}
+		return bitcom.NewB(&bitcom.B{
+			Content:   b.Content,
+			MediaType: b.MediaType,
+			Encoding:  lo.FromPtr(b.Encoding),
+			Filename:  lo.FromPtr(b.Filename),
+		}), nil
+	case bitcom.ProtocolMAP:
+		m, err := req.AsModelsMAPProtocol()
+		if err != nil {
+			return bitcom.Protocol{}, spverrors.ErrCannotBindRequest.Wrap(err)
+		}
+		return bitcom.NewMAP(&bitcom.MAP{
+			Command: lo.FromPtr(m.Command),
+			Keys:    m.Keys,
+		}), nil
+	case bitcom.ProtocolAIP:
+		aip, err := req.AsModelsAIPProtocol()
+		if err != nil {
+			return bitcom.Protocol{}, spverrors.ErrCannotBindRequest.Wrap(err)
+		}
+		return bitcom.NewAIP(&bitcom.AIP{
+			Algorithm: aip.Algorithm,
+			Address:   aip.Address,
+			Signature: aip.Signature,
+		}), nil
+	default:
+		return bitcom.Protocol{}, spverrors.ErrCannotBindRequest.Wrap(spverrors.Newf("unsupported bitcom protocol"))
+	}
+}
+
 func opReturnSpecFromRequest(req api.RequestsTransactionOutlineOutputSpecification) (outlines.OutputSpec, error) {
 	specification, err := req.AsRequestsOpReturnOutputSpecification()
 	if err != nil {
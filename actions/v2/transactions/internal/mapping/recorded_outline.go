@@ -8,6 +8,7 @@ import (
 // RecordedOutline maps domain RecordedOutline to api.ModelsRecordedOutline.
 func RecordedOutline(r *txmodels.RecordedOutline) api.ModelsRecordedOutline {
 	return api.ModelsRecordedOutline{
-		TxID: r.TxID,
+		TxID:     r.TxID,
+		TxStatus: api.ModelsRecordedOutlineTxStatus(r.TxStatus),
 	}
 }
@@ -0,0 +1,16 @@
+package mapping
+
+import (
+	"github.com/bitcoin-sv/spv-wallet/api"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/operations/operationsmodels"
+)
+
+// TransactionStatus maps the user's operation to api.ModelsTransactionStatus.
+func TransactionStatus(operation *operationsmodels.Operation) api.ModelsTransactionStatus {
+	return api.ModelsTransactionStatus{
+		TxID:        operation.TxID,
+		TxStatus:    api.ModelsTransactionStatusTxStatus(operation.TxStatus),
+		BlockHeight: operation.BlockHeight,
+		BlockHash:   operation.BlockHash,
+	}
+}
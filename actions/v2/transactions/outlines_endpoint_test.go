@@ -357,6 +357,172 @@ func TestPOSTTransactionOutlines(t *testing.T) {
 			  }
 			}`,
 		},
+		"create transaction outline for bitcom data": {
+			request: `{
+			  "outputs": [
+				{
+				  "type": "bitcom",
+				  "protocols": [
+					{
+					  "protocol": "B",
+					  "content": "aGVsbG8gd29ybGQ=",
+					  "mediaType": "text/plain"
+					},
+					{
+					  "protocol": "MAP",
+					  "keys": { "app": "spv-wallet", "type": "post" }
+					}
+				  ]
+				}
+			  ]
+			}`,
+			outValues: []bsv.Satoshis{0, initialSatoshis - 1},
+			responseTemplate: `{
+			  "hex": "{{ matchTxByFormat .Format }}",
+			  "format": "{{ .Format }}",
+			  "annotations": {
+				"outputs": {
+					"0": {
+						"bucket": "data"
+					},
+					"1": {
+						"bucket": "bsv",
+						"customInstructions": [
+						  {
+							"instruction": "{{ matchDestination }}",
+							"type": "type42"
+						  }
+						]
+					}
+				},
+				"inputs": {
+				  "0": {
+				    "customInstructions": {{ .CustomInstructions }}
+				  }
+				}
+			  }
+			}`,
+		},
+		"create transaction outline for address output": {
+			request: `{
+			  "outputs": [
+				{
+				  "type": "address",
+				  "to": "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa",
+				  "satoshis": 1000
+				}
+			  ]
+			}`,
+			outValues: []bsv.Satoshis{1000, initialSatoshis - 1000 - 1},
+			responseTemplate: `{
+			  "hex": "{{ matchTxByFormat .Format }}",
+			  "format": "{{ .Format }}",
+			  "annotations": {
+				"outputs": {
+				  "0": {
+					"bucket": "bsv",
+					"address": {
+					  "address": "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa"
+					}
+				  },
+				  "1": {
+					"bucket": "bsv",
+					"customInstructions": [
+					  {
+						"instruction": "{{ matchDestination }}",
+						"type": "type42"
+					  }
+					]
+				  }
+				},
+				"inputs": {
+				  "0": {
+				    "customInstructions": {{ .CustomInstructions }}
+				  }
+				}
+			  }
+			}`,
+		},
+		"create transaction outline for inscription output": {
+			request: `{
+			  "outputs": [
+				{
+				  "type": "inscription",
+				  "contentType": "text/plain",
+				  "content": "aGVsbG8gb3JkaW5hbHM="
+				}
+			  ]
+			}`,
+			outValues: []bsv.Satoshis{1, initialSatoshis - 1 - 1},
+			responseTemplate: `{
+			  "hex": "{{ matchTxByFormat .Format }}",
+			  "format": "{{ .Format }}",
+			  "annotations": {
+				"outputs": {
+				  "0": {
+					"bucket": "ordinals",
+					"customInstructions": [
+					  {
+						"instruction": "{{ matchDestination }}",
+						"type": "type42"
+					  }
+					]
+				  },
+				  "1": {
+					"bucket": "bsv",
+					"customInstructions": [
+					  {
+						"instruction": "{{ matchDestination }}",
+						"type": "type42"
+					  }
+					]
+				  }
+				},
+				"inputs": {
+				  "0": {
+				    "customInstructions": {{ .CustomInstructions }}
+				  }
+				}
+			  }
+			}`,
+		},
+		"create transaction outline for script output": {
+			request: `{
+			  "outputs": [
+				{
+				  "type": "script",
+				  "lockingScript": "76a91462e907b15cbf27d5425399ebf6f0fb50ebb88f1888ac",
+				  "satoshis": 1000
+				}
+			  ]
+			}`,
+			outValues: []bsv.Satoshis{1000, initialSatoshis - 1000 - 1},
+			responseTemplate: `{
+			  "hex": "{{ matchTxByFormat .Format }}",
+			  "format": "{{ .Format }}",
+			  "annotations": {
+				"outputs": {
+				  "0": {
+					"bucket": "bsv"
+				  },
+				  "1": {
+					"bucket": "bsv",
+					"customInstructions": [
+					  {
+						"instruction": "{{ matchDestination }}",
+						"type": "type42"
+					  }
+					]
+				  }
+				},
+				"inputs": {
+				  "0": {
+				    "customInstructions": {{ .CustomInstructions }}
+				  }
+				}
+			  }
+			}`,
+		},
 	}
 
 	for name, test := range successTestCases {
@@ -592,6 +758,32 @@ func TestPOSTTransactionOutlinesErrors(t *testing.T) {
 			expectedStatus: http.StatusBadRequest,
 			expectedErr:    apierror.CannotBindBodyJSON,
 		},
+		"Bad Request: Address output with testnet address": {
+			json: `{
+			  "outputs": [
+				{
+				  "type": "address",
+				  "to": "mpXwg4jMtRhuSpVq4xS3HFHmCmWp9NyGKt",
+				  "satoshis": 1000
+				}
+			  ]
+			}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    apierror.ExpectedJSON("tx-outline-address-invalid", "address must be a valid mainnet P2PKH address"),
+		},
+		"Bad Request: Script output with P2SH script": {
+			json: `{
+			  "outputs": [
+				{
+				  "type": "script",
+				  "lockingScript": "a91462e907b15cbf27d5425399ebf6f0fb50ebb88f1887",
+				  "satoshis": 1000
+				}
+			  ]
+			}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    apierror.ExpectedJSON("tx-outline-script-non-standard", "locking script is not standard or should use another output type"),
+		},
 		"Bad Request: Paymail output with negative satoshis": {
 			json: `{
 			  "outputs": [
@@ -683,6 +875,34 @@ func TestPOSTTransactionOutlinesErrors(t *testing.T) {
 			expectedStatus: http.StatusUnprocessableEntity,
 			expectedErr:    apierror.ExpectedJSON("tx-outline-not-enough-funds", "not enough funds to make the transaction"),
 		},
+		"Unprocessable: not enough tokens": {
+			json: `{
+			  "outputs": [
+				{
+				  "type": "token_transfer",
+				  "tokenId": "a0000000001e1b81dd2c9c0c6cd67f9bdf832e9c2bb12a1d57f30cb6ebbe78d9_0",
+				  "amount": 100,
+				  "to": "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa"
+				}
+			  ]
+			}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedErr:    apierror.ExpectedJSON("tx-outline-not-enough-tokens", "not enough tokens to make the transfer"),
+		},
+		"Bad Request: token transfer without amount": {
+			json: `{
+			  "outputs": [
+				{
+				  "type": "token_transfer",
+				  "tokenId": "a0000000001e1b81dd2c9c0c6cd67f9bdf832e9c2bb12a1d57f30cb6ebbe78d9_0",
+				  "amount": 0,
+				  "to": "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa"
+				}
+			  ]
+			}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    apierror.ExpectedJSON("tx-outline-token-transfer-invalid", "token transfer requires token ID, amount and receiver"),
+		},
 	}
 	for name, test := range badRequestTestCases {
 		t.Run(name, func(t *testing.T) {
@@ -0,0 +1,129 @@
+package transactions_test
+
+import (
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities/apierror"
+	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+)
+
+func TestOutgoingTransactionToAddress(t *testing.T) {
+	// given:
+	given, then := testabilities.New(t)
+	cleanup := given.StartedSPVWalletWithConfiguration(
+		testengine.WithV2(),
+	)
+	defer cleanup()
+
+	// and:
+	sender := fixtures.Sender
+	recipient := fixtures.RecipientExternal
+
+	// and:
+	sourceTxSpec := given.Faucet(sender).TopUp(1001)
+
+	// and:
+	txSpec := given.Tx().
+		WithSender(sender).
+		WithRecipient(recipient).
+		WithInputFromUTXO(sourceTxSpec.TX(), 0).
+		WithOutputScript(1000, recipient.P2PKHLockingScript())
+
+	// and:
+	client := given.HttpClient().ForGivenUser(sender)
+
+	// and:
+	given.ARC().WillRespondForBroadcastWithSeenOnNetwork(txSpec.ID())
+
+	// when:
+	res, _ := client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(map[string]any{
+			"hex":    txSpec.BEEF(),
+			"format": "BEEF",
+			"annotations": map[string]any{
+				"outputs": map[string]any{
+					"0": map[string]any{
+						"bucket": "bsv",
+						"address": map[string]any{
+							"address": recipient.Address().AddressString,
+						},
+					},
+				},
+			},
+		}).
+		Post(transactionsOutlinesRecordURL)
+
+	// then:
+	then.Response(res).
+		IsCreated().
+		WithJSONMatching(`{
+				"txID": "{{ .txID }}",
+				"txStatus": "BROADCASTED"
+			}`, map[string]any{
+			"txID": txSpec.ID(),
+		})
+
+	// and:
+	then.User(sender).Balance().IsZero()
+
+	// and:
+	then.User(sender).Operations().Last().
+		WithTxID(txSpec.ID()).
+		WithValue(-1001).
+		WithType("outgoing").
+		WithCounterparty(recipient.Address().AddressString)
+}
+
+func TestOutgoingTransactionToAddressWithMismatchedAnnotation(t *testing.T) {
+	// given:
+	given, then := testabilities.New(t)
+	cleanup := given.StartedSPVWalletWithConfiguration(
+		testengine.WithV2(),
+	)
+	defer cleanup()
+
+	// and:
+	sender := fixtures.Sender
+	recipient := fixtures.RecipientExternal
+
+	// and:
+	sourceTxSpec := given.Faucet(sender).TopUp(1001)
+
+	// and:
+	txSpec := given.Tx().
+		WithSender(sender).
+		WithRecipient(recipient).
+		WithInputFromUTXO(sourceTxSpec.TX(), 0).
+		WithOutputScript(1000, recipient.P2PKHLockingScript())
+
+	// and:
+	client := given.HttpClient().ForGivenUser(sender)
+
+	// when:
+	res, _ := client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(map[string]any{
+			"hex":    txSpec.BEEF(),
+			"format": "BEEF",
+			"annotations": map[string]any{
+				"outputs": map[string]any{
+					"0": map[string]any{
+						"bucket": "bsv",
+						"address": map[string]any{
+							"address": sender.Address().AddressString,
+						},
+					},
+				},
+			},
+		}).
+		Post(transactionsOutlinesRecordURL)
+
+	// then:
+	then.Response(res).HasStatus(400).WithJSONf(apierror.ExpectedJSON("error-annotation-mismatch", "annotation mismatch"))
+
+	// and:
+	then.User(sender).Balance().IsEqualTo(1001)
+}
@@ -0,0 +1,251 @@
+package transactions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities/apierror"
+	chainmodels "github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+const transactionStatusURL = "/api/v2/transactions/{txID}"
+
+func TestOutlinesRecordAsyncBroadcast(t *testing.T) {
+	// given:
+	givenForAllTests := testabilities.Given(t)
+	cleanup := givenForAllTests.StartedSPVWalletWithConfiguration(
+		testengine.WithV2(),
+		testengine.WithAsyncBroadcast(),
+	)
+	defer cleanup()
+
+	// and:
+	ownedTransaction := givenForAllTests.Faucet(fixtures.Sender).TopUp(1000)
+
+	// and:
+	txSpec := givenForAllTests.Tx().
+		WithSender(fixtures.Sender).
+		WithInputFromUTXO(ownedTransaction.TX(), 0).
+		WithOPReturn(dataOfOpReturnTx)
+
+	t.Run("Record op_return data without waiting for broadcast", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+
+		// and:
+		client := given.HttpClient().ForUser()
+
+		// and:
+		request := `{
+			"hex": "` + txSpec.BEEF() + `",
+			"annotations": {
+				"outputs": {
+					"0": {
+						"bucket": "data"
+					}
+				}
+			}
+		}`
+
+		// when:
+		res, _ := client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(request).
+			Post(transactionsOutlinesRecordURL)
+
+		// then:
+		then.Response(res).
+			HasStatus(201).
+			WithJSONMatching(`{
+				"txID": "{{ .txID }}",
+				"txStatus": "CREATED"
+			}`, map[string]any{
+				"txID": txSpec.ID(),
+			})
+	})
+
+	t.Run("Get status of queued transaction", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+
+		// and:
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetPathParam("txID", txSpec.ID()).
+			Get(transactionStatusURL)
+
+		// then:
+		then.Response(res).IsOK().WithJSONMatching(`{
+			"txID": "{{ .txID }}",
+			"txStatus": "CREATED"
+		}`, map[string]any{
+			"txID": txSpec.ID(),
+		})
+	})
+
+	t.Run("Broadcast queued transaction", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+
+		// and:
+		client := given.HttpClient().ForUser()
+
+		// and:
+		given.ARC().WillRespondForBroadcast(200, &chainmodels.TXInfo{
+			TxID:     txSpec.ID(),
+			TXStatus: chainmodels.SeenOnNetwork,
+		})
+
+		// when:
+		err := given.Engine().TransactionRecordService().BroadcastPending(context.Background())
+
+		// then:
+		require.NoError(t, err)
+
+		// when:
+		res, _ := client.R().
+			SetPathParam("txID", txSpec.ID()).
+			Get(transactionStatusURL)
+
+		// then:
+		then.Response(res).IsOK().WithJSONMatching(`{
+			"txID": "{{ .txID }}",
+			"txStatus": "BROADCASTED"
+		}`, map[string]any{
+			"txID": txSpec.ID(),
+		})
+	})
+
+	t.Run("Get status of unknown transaction", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+
+		// and:
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetPathParam("txID", "a0000000000000000000000000000000000000000000000000000000000000aa").
+			Get(transactionStatusURL)
+
+		// then:
+		then.Response(res).
+			HasStatus(404).
+			WithJSONf(apierror.ExpectedJSON("error-transaction-not-found", "transaction not found"))
+	})
+
+	t.Run("Do not expose status of other user's transaction", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+
+		// and:
+		client := given.HttpClient().ForGivenUser(fixtures.RecipientInternal)
+
+		// when:
+		res, _ := client.R().
+			SetPathParam("txID", txSpec.ID()).
+			Get(transactionStatusURL)
+
+		// then:
+		then.Response(res).
+			HasStatus(404).
+			WithJSONf(apierror.ExpectedJSON("error-transaction-not-found", "transaction not found"))
+	})
+}
+
+func TestOutlinesRecordAsyncBroadcastRejected(t *testing.T) {
+	// given:
+	given, then := testabilities.New(t)
+	cleanup := given.StartedSPVWalletWithConfiguration(
+		testengine.WithV2(),
+		testengine.WithAsyncBroadcast(),
+	)
+	defer cleanup()
+
+	// and:
+	sender := fixtures.Sender
+	change := changeOutputSpec{
+		customInstructions: bsv.CustomInstructions{
+			{
+				Type:        "type42",
+				Instruction: "1-destination-1output4d06387d3be7bd26cfe2b5996",
+			},
+		},
+		satoshis: 600,
+	}
+
+	// and:
+	sourceTxSpec := given.Faucet(sender).TopUp(1001)
+
+	// and:
+	txSpec := given.Tx().
+		WithSender(sender).
+		WithInputFromUTXO(sourceTxSpec.TX(), 0).
+		WithOPReturn(dataOfOpReturnTx).
+		WithOutputScript(uint64(change.satoshis), sender.P2PKHLockingScript(change.customInstructions...))
+
+	// and:
+	client := given.HttpClient().ForGivenUser(sender)
+
+	// when:
+	res, _ := client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(map[string]any{
+			"hex":    txSpec.BEEF(),
+			"format": "BEEF",
+			"annotations": map[string]any{
+				"outputs": lo.Assign(
+					map[string]any{
+						"0": map[string]any{
+							"bucket": "data",
+						},
+					},
+					changeOutputSpecs{change}.toAnnotations(1),
+				),
+			},
+		}).
+		Post(transactionsOutlinesRecordURL)
+
+	// then:
+	then.Response(res).IsCreated()
+
+	// and:
+	then.User(sender).Balance().IsEqualTo(600)
+
+	// given:
+	given.ARC().WillRespondForBroadcast(200, &chainmodels.TXInfo{
+		TxID:     txSpec.ID(),
+		TXStatus: chainmodels.Rejected,
+	})
+
+	// when:
+	err := given.Engine().TransactionRecordService().BroadcastPending(context.Background())
+
+	// then:
+	require.NoError(t, err)
+
+	// and:
+	then.User(sender).Operations().Last().
+		WithTxID(txSpec.ID()).
+		WithTxStatus("PROBLEMATIC")
+
+	// and:
+	then.User(sender).Balance().IsEqualTo(1001)
+
+	// when:
+	res, _ = client.R().Get("/api/v2/users/current")
+
+	// then:
+	then.Response(res).IsOK().WithJSONMatching(`{
+		"currentBalance": 1001,
+		"tokens": []
+	}`, nil)
+}
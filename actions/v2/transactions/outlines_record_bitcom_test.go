@@ -0,0 +1,144 @@
+package transactions_test
+
+import (
+	"testing"
+
+	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities"
+	chainmodels "github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/data/bitcom"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutlinesRecordBitcomData(t *testing.T) {
+	// given:
+	given, then := testabilities.New(t)
+	cleanup := given.StartedSPVWalletWithConfiguration(
+		testengine.WithV2(),
+	)
+	defer cleanup()
+
+	// and:
+	ownedTransaction := given.Faucet(fixtures.Sender).TopUp(1000)
+
+	// and:
+	output, err := sdk.CreateOpReturnOutput([][]byte{
+		[]byte(bitcom.BPrefix), []byte("hello world"), []byte("text/plain"), []byte("utf-8"), []byte("hello.txt"),
+		[]byte(bitcom.Separator),
+		[]byte(bitcom.MAPPrefix), []byte("SET"), []byte("app"), []byte("spv-wallet"), []byte("type"), []byte("post"),
+	})
+	require.NoError(t, err)
+
+	// and:
+	txSpec := given.Tx().
+		WithSender(fixtures.Sender).
+		WithInputFromUTXO(ownedTransaction.TX(), 0).
+		WithOutputScript(0, output.LockingScript)
+
+	// and:
+	client := given.HttpClient().ForUser()
+
+	// and:
+	given.ARC().WillRespondForBroadcast(200, &chainmodels.TXInfo{
+		TxID:     txSpec.ID(),
+		TXStatus: chainmodels.SeenOnNetwork,
+	})
+
+	// when:
+	res, _ := client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(map[string]any{
+			"hex": txSpec.BEEF(),
+			"annotations": map[string]any{
+				"outputs": map[string]any{
+					"0": map[string]any{
+						"bucket": "data",
+					},
+				},
+			},
+		}).
+		Post(transactionsOutlinesRecordURL)
+
+	// then:
+	then.Response(res).HasStatus(201)
+
+	// when:
+	outpoint := bsv.Outpoint{TxID: txSpec.ID(), Vout: 0}
+	res, _ = client.R().
+		Get("/api/v2/data/" + outpoint.String())
+
+	// then:
+	then.Response(res).
+		IsOK().WithJSONMatching(`{
+			"id": "{{ .outpoint }}",
+			"createdAt": "{{ matchTimestamp }}",
+			"blob": {{ anything }},
+			"size": {{ anything }},
+			"protocols": [
+				{
+					"protocol": "B",
+					"content": "aGVsbG8gd29ybGQ=",
+					"mediaType": "text/plain",
+					"encoding": "utf-8",
+					"filename": "hello.txt"
+				},
+				{
+					"protocol": "MAP",
+					"command": "SET",
+					"keys": {
+						"app": "spv-wallet",
+						"type": "post"
+					}
+				}
+			]
+		}`, map[string]any{
+		"outpoint": outpoint.String(),
+	})
+
+	// when:
+	res, _ = client.R().
+		SetQueryParams(map[string]string{
+			"protocol":  "MAP",
+			"mediaType": "text/plain",
+			"mapApp":    "spv-wallet",
+			"mapType":   "post",
+		}).
+		Get("/api/v2/data")
+
+	// then:
+	then.Response(res).
+		IsOK().WithJSONMatching(`{
+			"content": [
+				{
+					"id": "{{ .outpoint }}",
+					"createdAt": "{{ matchTimestamp }}",
+					"blob": {{ anything }},
+					"size": {{ anything }},
+					"protocols": {{ anything }}
+				}
+			],
+			"page": {
+				"number": 1,
+				"size": 1,
+				"totalElements": 1,
+				"totalPages": 1
+			}
+		}`, map[string]any{
+		"outpoint": outpoint.String(),
+	})
+
+	// when:
+	res, _ = client.R().
+		Get("/api/v2/data/" + outpoint.String() + "/content")
+
+	// then:
+	then.Response(res).IsOK()
+	require.Equal(t, "text/plain", res.Header().Get("Content-Type"))
+	require.Equal(t, `inline; filename=hello.txt`, res.Header().Get("Content-Disposition"))
+	require.Equal(t, "nosniff", res.Header().Get("X-Content-Type-Options"))
+	require.Equal(t, "sandbox", res.Header().Get("Content-Security-Policy"))
+	require.Equal(t, "hello world", res.String())
+}
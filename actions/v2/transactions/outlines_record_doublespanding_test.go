@@ -62,7 +62,8 @@ func TestDoubleSpending(t *testing.T) {
 		then.Response(res).
 			HasStatus(201).
 			WithJSONMatching(`{
-				"txID": "{{ .txID }}"
+				"txID": "{{ .txID }}",
+				"txStatus": "BROADCASTED"
 			}`, map[string]any{
 				"txID": txSpec.ID(),
 			})
@@ -76,7 +76,8 @@ func TestOutlinesRecordOpReturn(t *testing.T) {
 		then.Response(res).
 			HasStatus(201).
 			WithJSONMatching(`{
-				"txID": "{{ .txID }}"
+				"txID": "{{ .txID }}",
+				"txStatus": "BROADCASTED"
 			}`, map[string]any{
 				"txID": txSpec.ID(),
 			})
@@ -136,7 +137,9 @@ func TestOutlinesRecordOpReturn(t *testing.T) {
 		then.Response(res).
 			IsOK().WithJSONMatching(`{
 				"id": "{{ .outpoint }}",
-				"blob": "{{ .blob }}"
+				"createdAt": "{{ matchTimestamp }}",
+				"blob": "{{ .blob }}",
+				"size": {{ len .blob }}
 			}`, map[string]any{
 			"outpoint": outpoint.String(),
 			"blob":     dataOfOpReturnTx,
@@ -433,7 +436,8 @@ func TestRecordOpReturnTwiceByTheSameUser(t *testing.T) {
 			then.Response(res).
 				HasStatus(201).
 				WithJSONMatching(`{
-				"txID": "{{ .txID }}"
+				"txID": "{{ .txID }}",
+				"txStatus": "BROADCASTED"
 			}`, map[string]any{
 					"txID": txSpec.ID(),
 				})
@@ -494,7 +498,9 @@ func TestRecordOpReturnTwiceByTheSameUser(t *testing.T) {
 		then.Response(res).
 			IsOK().WithJSONMatching(`{
 				"id": "{{ .outpoint }}",
-				"blob": "{{ .blob }}"
+				"createdAt": "{{ matchTimestamp }}",
+				"blob": "{{ .blob }}",
+				"size": {{ len .blob }}
 			}`, map[string]any{
 			"outpoint": outpoint.String(),
 			"blob":     dataOfOpReturnTx,
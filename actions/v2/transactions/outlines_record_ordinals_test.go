@@ -0,0 +1,203 @@
+package transactions_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bitcoin-sv/go-sdk/script"
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities"
+	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/ordinals"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutlinesRecordIncomingInscription(t *testing.T) {
+	// given:
+	givenForAllTests := testabilities.Given(t)
+	cleanup := givenForAllTests.StartedSPVWalletWithConfiguration(
+		testengine.WithV2(),
+	)
+	defer cleanup()
+
+	var testState struct {
+		lockingScript *script.Script
+	}
+
+	// and:
+	sender := fixtures.Sender
+	recipient := fixtures.RecipientInternal
+
+	// and:
+	sourceTxSpec := givenForAllTests.Faucet(sender).TopUp(1001)
+
+	t.Run("Get destination of the recipient", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+
+		// and:
+		client := given.HttpClient().ForAnonymous()
+
+		// when:
+		res, _ := client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(map[string]any{
+				"satoshis": 1,
+			}).
+			Post(
+				fmt.Sprintf(
+					"https://example.com/v1/bsvalias/p2p-payment-destination/%s",
+					recipient.DefaultPaymail(),
+				),
+			)
+
+		// then:
+		then.Response(res).IsOK()
+
+		// update:
+		destination, err := script.NewFromHex(then.Response(res).JSONValue().GetString("outputs[0]/script"))
+		require.NoError(t, err)
+
+		address, err := destination.Address()
+		require.NoError(t, err)
+
+		testState.lockingScript, err = ordinals.Lock(address, &ordinals.Inscription{
+			ContentType: "text/plain",
+			Content:     []byte("hello ordinals"),
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Record transaction with inscription for the recipient", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+
+		// and:
+		client := given.HttpClient().ForGivenUser(sender)
+
+		// and:
+		txSpec := given.Tx().
+			WithSender(sender).
+			WithRecipient(recipient).
+			WithInputFromUTXO(sourceTxSpec.TX(), 0).
+			WithOutputScript(1, testState.lockingScript)
+
+		// and:
+		given.ARC().WillRespondForBroadcastWithSeenOnNetwork(txSpec.ID())
+
+		// when:
+		res, _ := client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(map[string]any{
+				"hex":    txSpec.BEEF(),
+				"format": "BEEF",
+				"annotations": map[string]any{
+					"outputs": map[string]any{
+						"0": map[string]any{
+							"bucket": "ordinals",
+						},
+					},
+				},
+			}).
+			Post(transactionsOutlinesRecordURL)
+
+		// then:
+		then.Response(res).IsCreated()
+
+		// and:
+		then.User(sender).Balance().IsZero()
+
+		// and:
+		then.User(recipient).Operations().Last().
+			WithTxID(txSpec.ID()).
+			WithValue(1).
+			WithType("incoming")
+
+		// and: the ordinal is not counted as spendable funds
+		then.User(recipient).Balance().IsZero()
+	})
+}
+
+func TestOutlinesRecordIncomingOneSatoshiOutput(t *testing.T) {
+	tests := map[string]struct {
+		annotations     map[string]any
+		expectedBalance bsv.Satoshis
+	}{
+		"kept as ordinal when not annotated": {
+			annotations:     map[string]any{},
+			expectedBalance: 0,
+		},
+		"credited when annotated with bsv bucket": {
+			annotations: map[string]any{
+				"outputs": map[string]any{
+					"0": map[string]any{
+						"bucket": "bsv",
+					},
+				},
+			},
+			expectedBalance: 1,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given:
+			given, then := testabilities.New(t)
+			cleanup := given.StartedSPVWalletWithConfiguration(testengine.WithV2())
+			defer cleanup()
+
+			// and:
+			sender := fixtures.Sender
+			recipient := fixtures.RecipientInternal
+			sourceTxSpec := given.Faucet(sender).TopUp(1001)
+
+			// and:
+			res, _ := given.HttpClient().ForAnonymous().R().
+				SetHeader("Content-Type", "application/json").
+				SetBody(map[string]any{
+					"satoshis": 1,
+				}).
+				Post(
+					fmt.Sprintf(
+						"https://example.com/v1/bsvalias/p2p-payment-destination/%s",
+						recipient.DefaultPaymail(),
+					),
+				)
+			then.Response(res).IsOK()
+
+			lockingScript, err := script.NewFromHex(then.Response(res).JSONValue().GetString("outputs[0]/script"))
+			require.NoError(t, err)
+
+			// and:
+			txSpec := given.Tx().
+				WithSender(sender).
+				WithRecipient(recipient).
+				WithInputFromUTXO(sourceTxSpec.TX(), 0).
+				WithOutputScript(1, lockingScript)
+
+			given.ARC().WillRespondForBroadcastWithSeenOnNetwork(txSpec.ID())
+
+			// when:
+			res, _ = given.HttpClient().ForGivenUser(sender).R().
+				SetHeader("Content-Type", "application/json").
+				SetBody(map[string]any{
+					"hex":         txSpec.BEEF(),
+					"format":      "BEEF",
+					"annotations": test.annotations,
+				}).
+				Post(transactionsOutlinesRecordURL)
+
+			// then:
+			then.Response(res).IsCreated()
+
+			// and:
+			then.User(recipient).Operations().Last().
+				WithTxID(txSpec.ID()).
+				WithValue(1).
+				WithType("incoming")
+
+			// and:
+			then.User(recipient).Balance().IsEqualTo(test.expectedBalance)
+		})
+	}
+}
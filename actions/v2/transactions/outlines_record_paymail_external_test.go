@@ -72,7 +72,8 @@ func TestExternalOutgoingTransaction(t *testing.T) {
 	then.Response(res).
 		IsCreated().
 		WithJSONMatching(`{
-				"txID": "{{ .txID }}"
+				"txID": "{{ .txID }}",
+				"txStatus": "BROADCASTED"
 			}`, map[string]any{
 			"txID": txSpec.ID(),
 		})
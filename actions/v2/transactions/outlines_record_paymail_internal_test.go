@@ -109,7 +109,8 @@ func TestInternalOutgoingTransaction(t *testing.T) {
 		then.Response(res).
 			IsCreated().
 			WithJSONMatching(`{
-				"txID": "{{ .txID }}"
+				"txID": "{{ .txID }}",
+				"txStatus": "BROADCASTED"
 			}`, map[string]any{
 				"txID": txSpec.ID(),
 			})
@@ -0,0 +1,349 @@
+package transactions_test
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/bitcoin-sv/go-sdk/script"
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities"
+	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/ordinals"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutlinesRecordIncomingTokens(t *testing.T) {
+	// given:
+	givenForAllTests := testabilities.Given(t)
+	cleanup := givenForAllTests.StartedSPVWalletWithConfiguration(
+		testengine.WithV2(),
+	)
+	defer cleanup()
+
+	var testState struct {
+		address       *script.Address
+		lockingScript *script.Script
+		txID          string
+	}
+
+	// and:
+	sender := fixtures.Sender
+	recipient := fixtures.RecipientInternal
+
+	// and:
+	sourceTxSpec := givenForAllTests.Faucet(sender).TopUp(1001)
+	forgerySourceTxSpec := givenForAllTests.Faucet(sender).TopUp(1001)
+
+	t.Run("Get destination of the recipient", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+
+		// and:
+		client := given.HttpClient().ForAnonymous()
+
+		// when:
+		res, _ := client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(map[string]any{
+				"satoshis": 1,
+			}).
+			Post(
+				fmt.Sprintf(
+					"https://example.com/v1/bsvalias/p2p-payment-destination/%s",
+					recipient.DefaultPaymail(),
+				),
+			)
+
+		// then:
+		then.Response(res).IsOK()
+
+		// update:
+		destination, err := script.NewFromHex(then.Response(res).JSONValue().GetString("outputs[0]/script"))
+		require.NoError(t, err)
+
+		address, err := destination.Address()
+		require.NoError(t, err)
+		testState.address = address
+
+		testState.lockingScript, err = ordinals.Lock(address, &ordinals.Inscription{
+			ContentType: ordinals.BSV21ContentType,
+			Content:     []byte(`{"p":"bsv-20","op":"deploy+mint","sym":"TKN","amt":"1000"}`),
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Record transaction with minted tokens for the recipient", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+
+		// and:
+		client := given.HttpClient().ForGivenUser(sender)
+
+		// and:
+		txSpec := given.Tx().
+			WithSender(sender).
+			WithRecipient(recipient).
+			WithInputFromUTXO(sourceTxSpec.TX(), 0).
+			WithOutputScript(1, testState.lockingScript)
+
+		// and:
+		given.ARC().WillRespondForBroadcastWithSeenOnNetwork(txSpec.ID())
+
+		// when:
+		res, _ := client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(map[string]any{
+				"hex":    txSpec.BEEF(),
+				"format": "BEEF",
+				"annotations": map[string]any{
+					"outputs": map[string]any{
+						"0": map[string]any{
+							"bucket": "tokens",
+						},
+					},
+				},
+			}).
+			Post(transactionsOutlinesRecordURL)
+
+		// then:
+		then.Response(res).IsCreated()
+
+		// and:
+		then.User(recipient).Operations().Last().
+			WithTxID(txSpec.ID()).
+			WithValue(1).
+			WithType("incoming")
+
+		// update:
+		testState.txID = txSpec.ID()
+	})
+
+	t.Run("Get token balances of the recipient", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+
+		// and:
+		client := given.HttpClient().ForGivenUser(recipient)
+
+		// when:
+		res, _ := client.R().Get("/api/v2/users/current")
+
+		// then:
+		then.Response(res).
+			IsOK().
+			WithJSONMatching(`{
+				"currentBalance": 0,
+				"tokens": [
+					{
+						"tokenId": "{{ .txID }}_0",
+						"amount": 1000
+					}
+				]
+			}`, map[string]any{
+				"txID": testState.txID,
+			})
+	})
+
+	t.Run("Record transaction with token transfer not covered by token inputs", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+
+		// and:
+		client := given.HttpClient().ForGivenUser(sender)
+
+		// and:
+		forgedTransfer, err := ordinals.Lock(testState.address, ordinals.NewBSV21Transfer(testState.txID+"_0", 1000000))
+		require.NoError(t, err)
+
+		// and:
+		txSpec := given.Tx().
+			WithSender(sender).
+			WithRecipient(recipient).
+			WithInputFromUTXO(forgerySourceTxSpec.TX(), 0).
+			WithOutputScript(1, forgedTransfer)
+
+		// and:
+		given.ARC().WillRespondForBroadcastWithSeenOnNetwork(txSpec.ID())
+
+		// when:
+		res, _ := client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(map[string]any{
+				"hex":    txSpec.BEEF(),
+				"format": "BEEF",
+				"annotations": map[string]any{
+					"outputs": map[string]any{
+						"0": map[string]any{
+							"bucket": "tokens",
+						},
+					},
+				},
+			}).
+			Post(transactionsOutlinesRecordURL)
+
+		// then:
+		then.Response(res).IsCreated()
+
+		// and:
+		then.User(recipient).Operations().Last().
+			WithTxID(txSpec.ID()).
+			WithValue(1).
+			WithType("incoming")
+	})
+
+	t.Run("Token balances of the recipient don't include unverified transfer", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+
+		// and:
+		client := given.HttpClient().ForGivenUser(recipient)
+
+		// when:
+		res, _ := client.R().Get("/api/v2/users/current")
+
+		// then:
+		then.Response(res).
+			IsOK().
+			WithJSONMatching(`{
+				"currentBalance": 0,
+				"tokens": [
+					{
+						"tokenId": "{{ .txID }}_0",
+						"amount": 1000
+					}
+				]
+			}`, map[string]any{
+				"txID": testState.txID,
+			})
+	})
+}
+
+func TestOutlinesRecordIncomingSTASTokens(t *testing.T) {
+	// given:
+	givenForAllTests := testabilities.Given(t)
+	cleanup := givenForAllTests.StartedSPVWalletWithConfiguration(
+		testengine.WithV2(),
+	)
+	defer cleanup()
+
+	var testState struct {
+		lockingScript *script.Script
+	}
+
+	// and:
+	sender := fixtures.Sender
+	recipient := fixtures.RecipientInternal
+	issuer := fixtures.RecipientExternal.Address()
+
+	// and:
+	sourceTxSpec := givenForAllTests.Faucet(sender).TopUp(1001)
+
+	t.Run("Get destination of the recipient", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+
+		// and:
+		client := given.HttpClient().ForAnonymous()
+
+		// when:
+		res, _ := client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(map[string]any{
+				"satoshis": 500,
+			}).
+			Post(
+				fmt.Sprintf(
+					"https://example.com/v1/bsvalias/p2p-payment-destination/%s",
+					recipient.DefaultPaymail(),
+				),
+			)
+
+		// then:
+		then.Response(res).IsOK()
+
+		// update:
+		destination, err := script.NewFromHex(then.Response(res).JSONValue().GetString("outputs[0]/script"))
+		require.NoError(t, err)
+
+		address, err := destination.Address()
+		require.NoError(t, err)
+
+		// the owner part, the beginning of the STAS contract and the data with the redemption public key hash, flags and symbol
+		testState.lockingScript, err = script.NewFromHex(
+			"76a914" + hex.EncodeToString(address.PublicKeyHash) + "88ac69" +
+				"76aa607f5f7f7c5e7f7c5d7f7c5c7f7c5b7f7c5a7f7c597f7c587f7c577f7c567f7c557f7c547f7c537f7c527f7c517f7c7e7e7e" +
+				"6a14" + hex.EncodeToString(issuer.PublicKeyHash) + "0100" + "03544b4e",
+		)
+		require.NoError(t, err)
+	})
+
+	t.Run("Record transaction with STAS tokens for the recipient", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+
+		// and:
+		client := given.HttpClient().ForGivenUser(sender)
+
+		// and:
+		txSpec := given.Tx().
+			WithSender(sender).
+			WithRecipient(recipient).
+			WithInputFromUTXO(sourceTxSpec.TX(), 0).
+			WithOutputScript(500, testState.lockingScript)
+
+		// and:
+		given.ARC().WillRespondForBroadcastWithSeenOnNetwork(txSpec.ID())
+
+		// when:
+		res, _ := client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(map[string]any{
+				"hex":    txSpec.BEEF(),
+				"format": "BEEF",
+				"annotations": map[string]any{
+					"outputs": map[string]any{
+						"0": map[string]any{
+							"bucket": "tokens",
+						},
+					},
+				},
+			}).
+			Post(transactionsOutlinesRecordURL)
+
+		// then:
+		then.Response(res).IsCreated()
+
+		// and:
+		then.User(recipient).Operations().Last().
+			WithTxID(txSpec.ID()).
+			WithValue(500).
+			WithType("incoming")
+	})
+
+	t.Run("Get token balances of the recipient", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+
+		// and:
+		client := given.HttpClient().ForGivenUser(recipient)
+
+		// when:
+		res, _ := client.R().Get("/api/v2/users/current")
+
+		// then:
+		then.Response(res).
+			IsOK().
+			WithJSONMatching(`{
+				"currentBalance": 0,
+				"tokens": [
+					{
+						"tokenId": "{{ .issuer }}",
+						"amount": 500
+					}
+				]
+			}`, map[string]any{
+				"issuer": issuer.AddressString,
+			})
+	})
+}
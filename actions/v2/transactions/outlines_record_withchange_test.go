@@ -147,7 +147,8 @@ func TestTransactionWithChange(t *testing.T) {
 			then.Response(res).
 				IsCreated().
 				WithJSONMatching(`{
-				"txID": "{{ .txID }}"
+				"txID": "{{ .txID }}",
+				"txStatus": "BROADCASTED"
 			}`, map[string]any{
 					"txID": txSpec.ID(),
 				})
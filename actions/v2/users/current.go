@@ -3,10 +3,13 @@ package users
 import (
 	"net/http"
 
+	"github.com/bitcoin-sv/spv-wallet/actions/v2/users/internal/mapping"
 	"github.com/bitcoin-sv/spv-wallet/api"
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/lox"
 	"github.com/bitcoin-sv/spv-wallet/server/reqctx"
 	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
 )
 
 // CurrentUser returns current user information
@@ -24,7 +27,14 @@ func (s *APIUsers) CurrentUser(c *gin.Context) {
 		return
 	}
 
+	tokens, err := reqctx.Engine(c).UsersService().GetTokenBalances(c.Request.Context(), userID)
+	if err != nil {
+		spverrors.ErrorResponse(c, err, reqctx.Logger(c))
+		return
+	}
+
 	c.JSON(http.StatusOK, &api.ModelsUserInfo{
 		CurrentBalance: uint64(satoshis),
+		Tokens:         lo.Map(tokens, lox.MappingFn(mapping.TokenBalanceResponse)),
 	})
 }
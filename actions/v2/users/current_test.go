@@ -26,7 +26,8 @@ func TestUserCurrent(t *testing.T) {
 		then.Response(res).
 			IsOK().
 			WithJSONMatching(`{
-				"currentBalance": 0
+				"currentBalance": 0,
+				"tokens": []
 			}`, nil)
 	})
 
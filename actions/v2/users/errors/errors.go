@@ -0,0 +1,6 @@
+package errors
+
+import "github.com/bitcoin-sv/spv-wallet/models"
+
+// ErrInvalidAvatarURL is returned when the avatar url provided by the user is invalid
+var ErrInvalidAvatarURL = models.SPVError{Message: "invalid avatar url", StatusCode: 422, Code: "error-invalid-avatar-url"}
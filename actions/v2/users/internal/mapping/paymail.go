@@ -0,0 +1,26 @@
+package mapping
+
+import (
+	"github.com/bitcoin-sv/spv-wallet/api"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymails/paymailsmodels"
+)
+
+// PaymailResponse maps a paymail to a response
+func PaymailResponse(p *paymailsmodels.Paymail) api.ModelsPaymail {
+	return api.ModelsPaymail{
+		Id:         p.ID,
+		Alias:      p.Alias,
+		Domain:     p.Domain,
+		Paymail:    p.Alias + "@" + p.Domain,
+		PublicName: p.PublicName,
+		Avatar:     p.Avatar,
+	}
+}
+
+// RequestUpdatePaymailProfileToModel maps an update paymail profile request to paymail profile model
+func RequestUpdatePaymailProfileToModel(r *api.RequestsUpdatePaymailProfile) *paymailsmodels.PaymailProfile {
+	return &paymailsmodels.PaymailProfile{
+		PublicName: r.PublicName,
+		Avatar:     r.AvatarURL,
+	}
+}
@@ -0,0 +1,14 @@
+package mapping
+
+import (
+	"github.com/bitcoin-sv/spv-wallet/api"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/users/usersmodels"
+)
+
+// TokenBalanceResponse maps a token balance to a response
+func TokenBalanceResponse(b usersmodels.TokenBalance) api.ModelsTokenBalance {
+	return api.ModelsTokenBalance{
+		TokenId: b.TokenID,
+		Amount:  b.Amount,
+	}
+}
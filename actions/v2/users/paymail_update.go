@@ -0,0 +1,43 @@
+package users
+
+import (
+	"errors"
+	"net/http"
+
+	usererrors "github.com/bitcoin-sv/spv-wallet/actions/v2/users/errors"
+	"github.com/bitcoin-sv/spv-wallet/actions/v2/users/internal/mapping"
+	"github.com/bitcoin-sv/spv-wallet/api"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymails/paymailerrors"
+	"github.com/bitcoin-sv/spv-wallet/server/reqctx"
+	"github.com/gin-gonic/gin"
+)
+
+// UpdateCurrentUserPaymailProfile updates public name and avatar of the current user's paymail
+func (s *APIUsers) UpdateCurrentUserPaymailProfile(c *gin.Context, paymail string) {
+	userContext := reqctx.GetUserContext(c)
+	userID, err := userContext.ShouldGetUserID()
+	if err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+
+	var request api.RequestsUpdatePaymailProfile
+	if err = c.Bind(&request); err != nil {
+		spverrors.ErrorResponse(c, spverrors.ErrCannotBindRequest.Wrap(err), s.logger)
+		return
+	}
+
+	profile := mapping.RequestUpdatePaymailProfileToModel(&request)
+
+	updatedPaymail, err := s.engine.PaymailsService().UpdateProfileByAddress(c.Request.Context(), userID, paymail, profile)
+	if errors.Is(err, paymailerrors.ErrInvalidAvatarURL) {
+		err = usererrors.ErrInvalidAvatarURL.Wrap(err)
+	}
+	if err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapping.PaymailResponse(updatedPaymail))
+}
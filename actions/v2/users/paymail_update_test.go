@@ -0,0 +1,129 @@
+package users_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities/apierror"
+	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+)
+
+func TestUpdateCurrentUserPaymailProfile(t *testing.T) {
+	givenForAllTests := testabilities.Given(t)
+	cleanup := givenForAllTests.StartedSPVWalletWithConfiguration(
+		testengine.WithDomainValidationDisabled(),
+		testengine.WithV2(),
+	)
+	defer cleanup()
+
+	// and:
+	user := fixtures.RecipientInternal
+	pm := user.DefaultPaymail()
+
+	// and:
+	avatarURL := "https://address-to-avatar.com/new.png"
+
+	t.Run("update public name and avatar and serve them in public profile", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForGivenUser(user)
+		anonymous := given.HttpClient().ForAnonymous()
+		publicProfileURL := fmt.Sprintf("https://example.com/v1/bsvalias/public-profile/%s", pm.Address())
+
+		// and:
+		res, _ := anonymous.R().Get(publicProfileURL)
+		then.Response(res).IsOK()
+
+		// when:
+		res, _ = client.R().
+			SetBody(map[string]any{
+				"publicName": "New Name",
+				"avatarURL":  avatarURL,
+			}).
+			SetPathParam("paymail", pm.Address()).
+			Patch("/api/v2/users/current/paymails/{paymail}")
+
+		// then:
+		then.Response(res).IsOK().WithJSONMatching(`{
+			"alias": "{{ .alias }}",
+			"avatar": "{{ .avatar }}",
+			"domain": "{{ .domain }}",
+			"id": "{{ matchNumber }}",
+			"paymail": "{{ .paymail }}",
+			"publicName": "New Name"
+		}`, map[string]any{
+			"alias":   pm.Alias(),
+			"domain":  pm.Domain(),
+			"paymail": pm.Address(),
+			"avatar":  avatarURL,
+		})
+
+		// when:
+		res, _ = anonymous.R().Get(publicProfileURL)
+
+		// then:
+		then.Response(res).IsOK().WithJSONMatching(`{
+			"avatar": "{{ .avatar }}",
+			"name": "New Name"
+		}`, map[string]any{
+			"avatar": avatarURL,
+		})
+	})
+
+	t.Run("try to update profile with invalid avatar url", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForGivenUser(user)
+
+		// when:
+		res, _ := client.R().
+			SetBody(map[string]any{
+				"avatarURL": "/User/path/to/avatar",
+			}).
+			SetPathParam("paymail", pm.Address()).
+			Patch("/api/v2/users/current/paymails/{paymail}")
+
+		// then:
+		then.Response(res).
+			HasStatus(422).
+			WithJSONf(apierror.ExpectedJSON("error-invalid-avatar-url", "invalid avatar url"))
+	})
+
+	t.Run("try to update profile of paymail of another user", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForGivenUser(user)
+
+		// when:
+		res, _ := client.R().
+			SetBody(map[string]any{
+				"publicName": "Not mine",
+			}).
+			SetPathParam("paymail", fixtures.Sender.DefaultPaymail().Address()).
+			Patch("/api/v2/users/current/paymails/{paymail}")
+
+		// then:
+		then.Response(res).
+			HasStatus(404).
+			WithJSONf(apierror.ExpectedJSON("error-paymail-not-found", "paymail not found"))
+	})
+
+	t.Run("try to update profile as admin", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForAdmin()
+
+		// when:
+		res, _ := client.R().
+			SetBody(map[string]any{
+				"publicName": "Admin",
+			}).
+			SetPathParam("paymail", pm.Address()).
+			Patch("/api/v2/users/current/paymails/{paymail}")
+
+		// then:
+		then.Response(res).IsUnauthorizedForAdmin()
+	})
+}
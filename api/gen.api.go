@@ -25,27 +25,75 @@ type ServerInterface interface {
 	// Add paymails to user
 	// (POST /api/v2/admin/users/{id}/paymails)
 	AddPaymailToUser(c *gin.Context, id string)
+	// Update paymail profile
+	// (PATCH /api/v2/admin/users/{id}/paymails/{paymailId})
+	UpdatePaymailProfile(c *gin.Context, id string, paymailId uint)
 	// Get shared config
 	// (GET /api/v2/configs/shared)
 	SharedConfig(c *gin.Context)
+	// Get contacts for user
+	// (GET /api/v2/contacts)
+	SearchContacts(c *gin.Context, params SearchContactsParams)
+	// Add contact
+	// (POST /api/v2/contacts)
+	AddContact(c *gin.Context)
+	// Remove contact
+	// (DELETE /api/v2/contacts/{id})
+	RemoveContact(c *gin.Context, id RequestsContactID)
+	// Get contact by id
+	// (GET /api/v2/contacts/{id})
+	ContactById(c *gin.Context, id RequestsContactID)
+	// Accept contact
+	// (POST /api/v2/contacts/{id}/accept)
+	AcceptContact(c *gin.Context, id RequestsContactID)
+	// Confirm contact
+	// (POST /api/v2/contacts/{id}/confirm)
+	ConfirmContact(c *gin.Context, id RequestsContactID)
+	// Reject contact
+	// (POST /api/v2/contacts/{id}/reject)
+	RejectContact(c *gin.Context, id RequestsContactID)
+	// Search data for user
+	// (GET /api/v2/data)
+	SearchData(c *gin.Context, params SearchDataParams)
 	// Get data for user
 	// (GET /api/v2/data/{id})
 	DataById(c *gin.Context, id string)
+	// Download data content
+	// (GET /api/v2/data/{id}/content)
+	DataContentById(c *gin.Context, id string)
+	// Get invoices for user
+	// (GET /api/v2/invoices)
+	SearchInvoices(c *gin.Context, params SearchInvoicesParams)
+	// Create invoice
+	// (POST /api/v2/invoices)
+	CreateInvoice(c *gin.Context)
+	// Get invoice by id
+	// (GET /api/v2/invoices/{id})
+	InvoiceById(c *gin.Context, id RequestsInvoiceID)
 	// Get Merkleroots
 	// (GET /api/v2/merkleroots)
 	MerkleRoots(c *gin.Context, params MerkleRootsParams)
 	// Get operations for user
 	// (GET /api/v2/operations/search)
 	SearchOperations(c *gin.Context, params SearchOperationsParams)
+	// Look up paymail destination
+	// (GET /api/v2/paymail-destinations)
+	LookupPaymailDestination(c *gin.Context, params LookupPaymailDestinationParams)
 	// Record transaction outline
 	// (POST /api/v2/transactions)
 	RecordTransactionOutline(c *gin.Context)
 	// Create transaction outline
 	// (POST /api/v2/transactions/outlines)
 	CreateTransactionOutline(c *gin.Context, params CreateTransactionOutlineParams)
+	// Get transaction status
+	// (GET /api/v2/transactions/{txID})
+	GetTransactionStatus(c *gin.Context, txid RequestsTxID)
 	// Get current user
 	// (GET /api/v2/users/current)
 	CurrentUser(c *gin.Context)
+	// Update paymail profile
+	// (PATCH /api/v2/users/current/paymails/{paymail})
+	UpdateCurrentUserPaymailProfile(c *gin.Context, paymail string)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -139,6 +187,41 @@ func (siw *ServerInterfaceWrapper) AddPaymailToUser(c *gin.Context) {
 	siw.Handler.AddPaymailToUser(c, id)
 }
 
+// UpdatePaymailProfile operation middleware
+func (siw *ServerInterfaceWrapper) UpdatePaymailProfile(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "paymailId" -------------
+	var paymailId uint
+
+	err = runtime.BindStyledParameterWithOptions("simple", "paymailId", c.Param("paymailId"), &paymailId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter paymailId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(XPubAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdatePaymailProfile(c, id, paymailId)
+}
+
 // SharedConfig operation middleware
 func (siw *ServerInterfaceWrapper) SharedConfig(c *gin.Context) {
 
@@ -154,6 +237,343 @@ func (siw *ServerInterfaceWrapper) SharedConfig(c *gin.Context) {
 	siw.Handler.SharedConfig(c)
 }
 
+// SearchContacts operation middleware
+func (siw *ServerInterfaceWrapper) SearchContacts(c *gin.Context) {
+
+	var err error
+
+	c.Set(XPubAuthScopes, []string{"user"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchContactsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", c.Request.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", c.Request.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sortBy: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SearchContacts(c, params)
+}
+
+// AddContact operation middleware
+func (siw *ServerInterfaceWrapper) AddContact(c *gin.Context) {
+
+	c.Set(XPubAuthScopes, []string{"user"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AddContact(c)
+}
+
+// RemoveContact operation middleware
+func (siw *ServerInterfaceWrapper) RemoveContact(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id RequestsContactID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(XPubAuthScopes, []string{"user"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RemoveContact(c, id)
+}
+
+// ContactById operation middleware
+func (siw *ServerInterfaceWrapper) ContactById(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id RequestsContactID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(XPubAuthScopes, []string{"user"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ContactById(c, id)
+}
+
+// AcceptContact operation middleware
+func (siw *ServerInterfaceWrapper) AcceptContact(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id RequestsContactID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(XPubAuthScopes, []string{"user"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AcceptContact(c, id)
+}
+
+// ConfirmContact operation middleware
+func (siw *ServerInterfaceWrapper) ConfirmContact(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id RequestsContactID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(XPubAuthScopes, []string{"user"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ConfirmContact(c, id)
+}
+
+// RejectContact operation middleware
+func (siw *ServerInterfaceWrapper) RejectContact(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id RequestsContactID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(XPubAuthScopes, []string{"user"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RejectContact(c, id)
+}
+
+// SearchData operation middleware
+func (siw *ServerInterfaceWrapper) SearchData(c *gin.Context) {
+
+	var err error
+
+	c.Set(XPubAuthScopes, []string{"user"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchDataParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", c.Request.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", c.Request.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sortBy: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "txId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "txId", c.Request.URL.Query(), &params.TxId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter txId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "createdFrom" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdFrom", c.Request.URL.Query(), &params.CreatedFrom)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter createdFrom: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "createdTo" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdTo", c.Request.URL.Query(), &params.CreatedTo)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter createdTo: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "prefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "prefix", c.Request.URL.Query(), &params.Prefix)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter prefix: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "minSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minSize", c.Request.URL.Query(), &params.MinSize)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter minSize: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "maxSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxSize", c.Request.URL.Query(), &params.MaxSize)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter maxSize: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "protocol" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "protocol", c.Request.URL.Query(), &params.Protocol)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter protocol: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "mediaType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "mediaType", c.Request.URL.Query(), &params.MediaType)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter mediaType: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "mapApp" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "mapApp", c.Request.URL.Query(), &params.MapApp)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter mapApp: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "mapType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "mapType", c.Request.URL.Query(), &params.MapType)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter mapType: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "aipAddress" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "aipAddress", c.Request.URL.Query(), &params.AipAddress)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter aipAddress: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SearchData(c, params)
+}
+
 // DataById operation middleware
 func (siw *ServerInterfaceWrapper) DataById(c *gin.Context) {
 
@@ -180,6 +600,125 @@ func (siw *ServerInterfaceWrapper) DataById(c *gin.Context) {
 	siw.Handler.DataById(c, id)
 }
 
+// DataContentById operation middleware
+func (siw *ServerInterfaceWrapper) DataContentById(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(XPubAuthScopes, []string{"user"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DataContentById(c, id)
+}
+
+// SearchInvoices operation middleware
+func (siw *ServerInterfaceWrapper) SearchInvoices(c *gin.Context) {
+
+	var err error
+
+	c.Set(XPubAuthScopes, []string{"user"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchInvoicesParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", c.Request.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", c.Request.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sortBy: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SearchInvoices(c, params)
+}
+
+// CreateInvoice operation middleware
+func (siw *ServerInterfaceWrapper) CreateInvoice(c *gin.Context) {
+
+	c.Set(XPubAuthScopes, []string{"user"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateInvoice(c)
+}
+
+// InvoiceById operation middleware
+func (siw *ServerInterfaceWrapper) InvoiceById(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id RequestsInvoiceID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(XPubAuthScopes, []string{"user"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.InvoiceById(c, id)
+}
+
 // MerkleRoots operation middleware
 func (siw *ServerInterfaceWrapper) MerkleRoots(c *gin.Context) {
 
@@ -268,6 +807,50 @@ func (siw *ServerInterfaceWrapper) SearchOperations(c *gin.Context) {
 	siw.Handler.SearchOperations(c, params)
 }
 
+// LookupPaymailDestination operation middleware
+func (siw *ServerInterfaceWrapper) LookupPaymailDestination(c *gin.Context) {
+
+	var err error
+
+	c.Set(XPubAuthScopes, []string{"user"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupPaymailDestinationParams
+
+	// ------------- Optional query parameter "address" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "address", c.Request.URL.Query(), &params.Address)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter address: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "txId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "txId", c.Request.URL.Query(), &params.TxId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter txId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "vout" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "vout", c.Request.URL.Query(), &params.Vout)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vout: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.LookupPaymailDestination(c, params)
+}
+
 // RecordTransactionOutline operation middleware
 func (siw *ServerInterfaceWrapper) RecordTransactionOutline(c *gin.Context) {
 
@@ -311,6 +894,32 @@ func (siw *ServerInterfaceWrapper) CreateTransactionOutline(c *gin.Context) {
 	siw.Handler.CreateTransactionOutline(c, params)
 }
 
+// GetTransactionStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetTransactionStatus(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "txID" -------------
+	var txid RequestsTxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "txID", c.Param("txID"), &txid, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter txID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(XPubAuthScopes, []string{"user"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTransactionStatus(c, txid)
+}
+
 // CurrentUser operation middleware
 func (siw *ServerInterfaceWrapper) CurrentUser(c *gin.Context) {
 
@@ -326,6 +935,32 @@ func (siw *ServerInterfaceWrapper) CurrentUser(c *gin.Context) {
 	siw.Handler.CurrentUser(c)
 }
 
+// UpdateCurrentUserPaymailProfile operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCurrentUserPaymailProfile(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "paymail" -------------
+	var paymail string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "paymail", c.Param("paymail"), &paymail, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter paymail: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(XPubAuthScopes, []string{"user"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateCurrentUserPaymailProfile(c, paymail)
+}
+
 // GinServerOptions provides options for the Gin server.
 type GinServerOptions struct {
 	BaseURL      string
@@ -357,11 +992,27 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.POST(options.BaseURL+"/api/v2/admin/users", wrapper.CreateUser)
 	router.GET(options.BaseURL+"/api/v2/admin/users/:id", wrapper.UserById)
 	router.POST(options.BaseURL+"/api/v2/admin/users/:id/paymails", wrapper.AddPaymailToUser)
+	router.PATCH(options.BaseURL+"/api/v2/admin/users/:id/paymails/:paymailId", wrapper.UpdatePaymailProfile)
 	router.GET(options.BaseURL+"/api/v2/configs/shared", wrapper.SharedConfig)
+	router.GET(options.BaseURL+"/api/v2/contacts", wrapper.SearchContacts)
+	router.POST(options.BaseURL+"/api/v2/contacts", wrapper.AddContact)
+	router.DELETE(options.BaseURL+"/api/v2/contacts/:id", wrapper.RemoveContact)
+	router.GET(options.BaseURL+"/api/v2/contacts/:id", wrapper.ContactById)
+	router.POST(options.BaseURL+"/api/v2/contacts/:id/accept", wrapper.AcceptContact)
+	router.POST(options.BaseURL+"/api/v2/contacts/:id/confirm", wrapper.ConfirmContact)
+	router.POST(options.BaseURL+"/api/v2/contacts/:id/reject", wrapper.RejectContact)
+	router.GET(options.BaseURL+"/api/v2/data", wrapper.SearchData)
 	router.GET(options.BaseURL+"/api/v2/data/:id", wrapper.DataById)
+	router.GET(options.BaseURL+"/api/v2/data/:id/content", wrapper.DataContentById)
+	router.GET(options.BaseURL+"/api/v2/invoices", wrapper.SearchInvoices)
+	router.POST(options.BaseURL+"/api/v2/invoices", wrapper.CreateInvoice)
+	router.GET(options.BaseURL+"/api/v2/invoices/:id", wrapper.InvoiceById)
 	router.GET(options.BaseURL+"/api/v2/merkleroots", wrapper.MerkleRoots)
 	router.GET(options.BaseURL+"/api/v2/operations/search", wrapper.SearchOperations)
+	router.GET(options.BaseURL+"/api/v2/paymail-destinations", wrapper.LookupPaymailDestination)
 	router.POST(options.BaseURL+"/api/v2/transactions", wrapper.RecordTransactionOutline)
 	router.POST(options.BaseURL+"/api/v2/transactions/outlines", wrapper.CreateTransactionOutline)
+	router.GET(options.BaseURL+"/api/v2/transactions/:txID", wrapper.GetTransactionStatus)
 	router.GET(options.BaseURL+"/api/v2/users/current", wrapper.CurrentUser)
+	router.PATCH(options.BaseURL+"/api/v2/users/current/paymails/:paymail", wrapper.UpdateCurrentUserPaymailProfile)
 }
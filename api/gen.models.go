@@ -15,24 +15,70 @@ const (
 	XPubAuthScopes = "XPubAuth.Scopes"
 )
 
+// Defines values for ModelsAIPProtocolProtocol.
+const (
+	ModelsAIPProtocolProtocolAIP ModelsAIPProtocolProtocol = "AIP"
+)
+
+// Defines values for ModelsAddedContactStatus.
+const (
+	ModelsAddedContactStatusAwaiting    ModelsAddedContactStatus = "awaiting"
+	ModelsAddedContactStatusConfirmed   ModelsAddedContactStatus = "confirmed"
+	ModelsAddedContactStatusRejected    ModelsAddedContactStatus = "rejected"
+	ModelsAddedContactStatusUnconfirmed ModelsAddedContactStatus = "unconfirmed"
+)
+
+// Defines values for ModelsAddressAnnotationBucket.
+const (
+	ModelsAddressAnnotationBucketBsv      ModelsAddressAnnotationBucket = "bsv"
+	ModelsAddressAnnotationBucketOrdinals ModelsAddressAnnotationBucket = "ordinals"
+	ModelsAddressAnnotationBucketTokens   ModelsAddressAnnotationBucket = "tokens"
+)
+
 // Defines values for ModelsAnnotatedTransactionOutlineFormat.
 const (
 	ModelsAnnotatedTransactionOutlineFormatBEEF ModelsAnnotatedTransactionOutlineFormat = "BEEF"
 	ModelsAnnotatedTransactionOutlineFormatRAW  ModelsAnnotatedTransactionOutlineFormat = "RAW"
 )
 
+// Defines values for ModelsBProtocolProtocol.
+const (
+	B ModelsBProtocolProtocol = "B"
+)
+
+// Defines values for ModelsContactStatus.
+const (
+	ModelsContactStatusAwaiting    ModelsContactStatus = "awaiting"
+	ModelsContactStatusConfirmed   ModelsContactStatus = "confirmed"
+	ModelsContactStatusRejected    ModelsContactStatus = "rejected"
+	ModelsContactStatusUnconfirmed ModelsContactStatus = "unconfirmed"
+)
+
 // Defines values for ModelsDataAnnotationBucket.
 const (
 	Data ModelsDataAnnotationBucket = "data"
 )
 
+// Defines values for ModelsInvoiceStatus.
+const (
+	Expired   ModelsInvoiceStatus = "expired"
+	Paid      ModelsInvoiceStatus = "paid"
+	Pending   ModelsInvoiceStatus = "pending"
+	Underpaid ModelsInvoiceStatus = "underpaid"
+)
+
+// Defines values for ModelsMAPProtocolProtocol.
+const (
+	MAP ModelsMAPProtocolProtocol = "MAP"
+)
+
 // Defines values for ModelsOperationTxStatus.
 const (
-	BROADCASTED ModelsOperationTxStatus = "BROADCASTED"
-	CREATED     ModelsOperationTxStatus = "CREATED"
-	MINED       ModelsOperationTxStatus = "MINED"
-	PROBLEMATIC ModelsOperationTxStatus = "PROBLEMATIC"
-	REVERTED    ModelsOperationTxStatus = "REVERTED"
+	ModelsOperationTxStatusBROADCASTED ModelsOperationTxStatus = "BROADCASTED"
+	ModelsOperationTxStatusCREATED     ModelsOperationTxStatus = "CREATED"
+	ModelsOperationTxStatusMINED       ModelsOperationTxStatus = "MINED"
+	ModelsOperationTxStatusPROBLEMATIC ModelsOperationTxStatus = "PROBLEMATIC"
+	ModelsOperationTxStatusREVERTED    ModelsOperationTxStatus = "REVERTED"
 )
 
 // Defines values for ModelsOperationType.
@@ -43,12 +89,30 @@ const (
 
 // Defines values for ModelsOutputAnnotationBucket.
 const (
-	ModelsOutputAnnotationBucketBsv ModelsOutputAnnotationBucket = "bsv"
+	ModelsOutputAnnotationBucketBsv      ModelsOutputAnnotationBucket = "bsv"
+	ModelsOutputAnnotationBucketOrdinals ModelsOutputAnnotationBucket = "ordinals"
+	ModelsOutputAnnotationBucketTokens   ModelsOutputAnnotationBucket = "tokens"
 )
 
 // Defines values for ModelsPaymailAnnotationBucket.
 const (
-	ModelsPaymailAnnotationBucketBsv ModelsPaymailAnnotationBucket = "bsv"
+	Bsv      ModelsPaymailAnnotationBucket = "bsv"
+	Ordinals ModelsPaymailAnnotationBucket = "ordinals"
+	Tokens   ModelsPaymailAnnotationBucket = "tokens"
+)
+
+// Defines values for ModelsPaymailDestinationRequest.
+const (
+	AddressResolution ModelsPaymailDestinationRequest = "address-resolution"
+	Invoice           ModelsPaymailDestinationRequest = "invoice"
+	P2pDestination    ModelsPaymailDestinationRequest = "p2p-destination"
+)
+
+// Defines values for ModelsRecordedOutlineTxStatus.
+const (
+	ModelsRecordedOutlineTxStatusBROADCASTED ModelsRecordedOutlineTxStatus = "BROADCASTED"
+	ModelsRecordedOutlineTxStatusCREATED     ModelsRecordedOutlineTxStatus = "CREATED"
+	ModelsRecordedOutlineTxStatusMINED       ModelsRecordedOutlineTxStatus = "MINED"
 )
 
 // Defines values for ModelsTransactionHexFormat.
@@ -57,6 +121,35 @@ const (
 	ModelsTransactionHexFormatRAW  ModelsTransactionHexFormat = "RAW"
 )
 
+// Defines values for ModelsTransactionStatusTxStatus.
+const (
+	BROADCASTED ModelsTransactionStatusTxStatus = "BROADCASTED"
+	CREATED     ModelsTransactionStatusTxStatus = "CREATED"
+	MINED       ModelsTransactionStatusTxStatus = "MINED"
+	PROBLEMATIC ModelsTransactionStatusTxStatus = "PROBLEMATIC"
+	REVERTED    ModelsTransactionStatusTxStatus = "REVERTED"
+)
+
+// Defines values for RequestsAddressOutputSpecificationType.
+const (
+	Address RequestsAddressOutputSpecificationType = "address"
+)
+
+// Defines values for RequestsBitcomOutputSpecificationType.
+const (
+	Bitcom RequestsBitcomOutputSpecificationType = "bitcom"
+)
+
+// Defines values for RequestsContactOutputSpecificationType.
+const (
+	Contact RequestsContactOutputSpecificationType = "contact"
+)
+
+// Defines values for RequestsInscriptionOutputSpecificationType.
+const (
+	Inscription RequestsInscriptionOutputSpecificationType = "inscription"
+)
+
 // Defines values for RequestsOpReturnOutputSpecificationDataType.
 const (
 	Hexes   RequestsOpReturnOutputSpecificationDataType = "hexes"
@@ -68,17 +161,39 @@ const (
 	OpReturn RequestsOpReturnOutputSpecificationType = "op_return"
 )
 
+// Defines values for RequestsOrdinalTransferOutputSpecificationType.
+const (
+	OrdinalTransfer RequestsOrdinalTransferOutputSpecificationType = "ordinal_transfer"
+)
+
 // Defines values for RequestsPaymailOutputSpecificationType.
 const (
 	Paymail RequestsPaymailOutputSpecificationType = "paymail"
 )
 
+// Defines values for RequestsScriptOutputSpecificationType.
+const (
+	Script RequestsScriptOutputSpecificationType = "script"
+)
+
+// Defines values for RequestsTokenTransferOutputSpecificationType.
+const (
+	TokenTransfer RequestsTokenTransferOutputSpecificationType = "token_transfer"
+)
+
 // Defines values for RequestsTransactionOutlineFormat.
 const (
 	BEEF RequestsTransactionOutlineFormat = "BEEF"
 	RAW  RequestsTransactionOutlineFormat = "RAW"
 )
 
+// Defines values for SearchDataParamsProtocol.
+const (
+	SearchDataParamsProtocolAIP SearchDataParamsProtocol = "AIP"
+	SearchDataParamsProtocolB   SearchDataParamsProtocol = "B"
+	SearchDataParamsProtocolMAP SearchDataParamsProtocol = "MAP"
+)
+
 // Defines values for CreateTransactionOutlineParamsFormat.
 const (
 	Beef CreateTransactionOutlineParamsFormat = "beef"
@@ -167,6 +282,36 @@ type ErrorsCannotBindRequest struct {
 	Message interface{} `json:"message"`
 }
 
+// ErrorsContactGettingPKI defines model for errors_ContactGettingPKI.
+type ErrorsContactGettingPKI struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
+// ErrorsContactInvalidStatus defines model for errors_ContactInvalidStatus.
+type ErrorsContactInvalidStatus struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
+// ErrorsContactNotFound defines model for errors_ContactNotFound.
+type ErrorsContactNotFound struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
+// ErrorsContactRejected defines model for errors_ContactRejected.
+type ErrorsContactRejected struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
+// ErrorsContactToSelf defines model for errors_ContactToSelf.
+type ErrorsContactToSelf struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsCreatingUser defines model for errors_CreatingUser.
 type ErrorsCreatingUser struct {
 	Code    interface{} `json:"code"`
@@ -209,30 +354,84 @@ type ErrorsInvalidBatchSize struct {
 	Message interface{} `json:"message"`
 }
 
+// ErrorsInvalidContactPaymail defines model for errors_InvalidContactPaymail.
+type ErrorsInvalidContactPaymail struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsInvalidDataID defines model for errors_InvalidDataID.
 type ErrorsInvalidDataID struct {
 	Code    interface{} `json:"code"`
 	Message interface{} `json:"message"`
 }
 
+// ErrorsInvalidDataSearchFilter defines model for errors_InvalidDataSearchFilter.
+type ErrorsInvalidDataSearchFilter struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsInvalidDomain defines model for errors_InvalidDomain.
 type ErrorsInvalidDomain struct {
 	Code    interface{} `json:"code"`
 	Message interface{} `json:"message"`
 }
 
+// ErrorsInvalidInvoiceAmount defines model for errors_InvalidInvoiceAmount.
+type ErrorsInvalidInvoiceAmount struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
+// ErrorsInvalidInvoiceExpiry defines model for errors_InvalidInvoiceExpiry.
+type ErrorsInvalidInvoiceExpiry struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
+// ErrorsInvalidInvoicePaymail defines model for errors_InvalidInvoicePaymail.
+type ErrorsInvalidInvoicePaymail struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsInvalidPaymail defines model for errors_InvalidPaymail.
 type ErrorsInvalidPaymail struct {
 	Code    interface{} `json:"code"`
 	Message interface{} `json:"message"`
 }
 
+// ErrorsInvalidPaymailAddress defines model for errors_InvalidPaymailAddress.
+type ErrorsInvalidPaymailAddress struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
+// ErrorsInvalidPaymailDestinationLookup defines model for errors_InvalidPaymailDestinationLookup.
+type ErrorsInvalidPaymailDestinationLookup struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsInvalidPubKey defines model for errors_InvalidPubKey.
 type ErrorsInvalidPubKey struct {
 	Code    interface{} `json:"code"`
 	Message interface{} `json:"message"`
 }
 
+// ErrorsInvalidRequesterPaymail defines model for errors_InvalidRequesterPaymail.
+type ErrorsInvalidRequesterPaymail struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
+// ErrorsInvoiceNotFound defines model for errors_InvoiceNotFound.
+type ErrorsInvoiceNotFound struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsMerkleRootNotFound defines model for errors_MerkleRootNotFound.
 type ErrorsMerkleRootNotFound struct {
 	Code    interface{} `json:"code"`
@@ -251,12 +450,30 @@ type ErrorsNoOperations struct {
 	Message interface{} `json:"message"`
 }
 
+// ErrorsPaymailDestinationNotFound defines model for errors_PaymailDestinationNotFound.
+type ErrorsPaymailDestinationNotFound struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsPaymailInconsistent defines model for errors_PaymailInconsistent.
 type ErrorsPaymailInconsistent struct {
 	Code    interface{} `json:"code"`
 	Message interface{} `json:"message"`
 }
 
+// ErrorsPaymailInvalidAvatarURL defines model for errors_PaymailInvalidAvatarURL.
+type ErrorsPaymailInvalidAvatarURL struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
+// ErrorsPaymailNotFound defines model for errors_PaymailNotFound.
+type ErrorsPaymailNotFound struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsSchema defines model for errors_Schema.
 type ErrorsSchema struct {
 	// Code Error code
@@ -266,6 +483,12 @@ type ErrorsSchema struct {
 	Message string `json:"message"`
 }
 
+// ErrorsTransactionNotFound defines model for errors_TransactionNotFound.
+type ErrorsTransactionNotFound struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsTxBroadcast defines model for errors_TxBroadcast.
 type ErrorsTxBroadcast struct {
 	Code    interface{} `json:"code"`
@@ -337,6 +560,71 @@ type ErrorsUserAuthorization struct {
 	union json.RawMessage
 }
 
+// ModelsAIPProtocol Author Identity Protocol signature of the data preceding it in the output. <br>
+// The signed message is OP_RETURN (0x6a) followed by all the pushes before the AIP prefix, including "|" separators.
+type ModelsAIPProtocol struct {
+	Address   string                    `json:"address"`
+	Algorithm string                    `json:"algorithm"`
+	Protocol  ModelsAIPProtocolProtocol `json:"protocol"`
+
+	// Signature Base64 encoded Bitcoin Signed Message signature
+	Signature string `json:"signature"`
+
+	// Valid Whether the signature is valid (only in responses)
+	Valid *bool `json:"valid,omitempty"`
+}
+
+// ModelsAIPProtocolProtocol defines model for ModelsAIPProtocol.Protocol.
+type ModelsAIPProtocolProtocol string
+
+// ModelsAddedContact defines model for models_AddedContact.
+type ModelsAddedContact struct {
+	// CreatedAt Creation date of the contact
+	CreatedAt time.Time `json:"createdAt"`
+
+	// FullName Full name of the contact
+	FullName string `json:"fullName"`
+
+	// Id ID of the contact
+	Id uint `json:"id"`
+
+	// InvitationError Reason why the PIKE invitation could not be delivered (the contact is added anyway)
+	InvitationError *string `json:"invitationError,omitempty"`
+
+	// InvitationSent Whether the PIKE invitation was delivered to the contact's paymail host
+	InvitationSent bool `json:"invitationSent"`
+
+	// Paymail Paymail of the contact
+	Paymail string `json:"paymail"`
+
+	// PubKey PKI of the contact's paymail
+	PubKey string `json:"pubKey"`
+
+	// Status Status of the contact
+	Status ModelsAddedContactStatus `json:"status"`
+
+	// UpdatedAt Last update date of the contact
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ModelsAddedContactStatus Status of the contact
+type ModelsAddedContactStatus string
+
+// ModelsAddressAnnotation defines model for models_AddressAnnotation.
+type ModelsAddressAnnotation struct {
+	Address *ModelsAddressAnnotationDetails `json:"address,omitempty"`
+	Bucket  *ModelsAddressAnnotationBucket  `json:"bucket,omitempty"`
+}
+
+// ModelsAddressAnnotationBucket defines model for ModelsAddressAnnotation.Bucket.
+type ModelsAddressAnnotationBucket string
+
+// ModelsAddressAnnotationDetails defines model for models_AddressAnnotationDetails.
+type ModelsAddressAnnotationDetails struct {
+	// Address Base58 encoded P2PKH address of the receiver
+	Address string `json:"address"`
+}
+
 // ModelsAnnotatedTransactionOutline defines model for models_AnnotatedTransactionOutline.
 type ModelsAnnotatedTransactionOutline struct {
 	Annotations *ModelsOutlineAnnotations `json:"annotations,omitempty"`
@@ -351,6 +639,26 @@ type ModelsAnnotatedTransactionOutline struct {
 // ModelsAnnotatedTransactionOutlineFormat Transaction format
 type ModelsAnnotatedTransactionOutlineFormat string
 
+// ModelsBProtocol File stored with the B:// protocol
+type ModelsBProtocol struct {
+	// Content Base64 encoded content of the file
+	Content []byte `json:"content"`
+
+	// Encoding Encoding of the content, "binary" if not provided
+	Encoding  *string                 `json:"encoding,omitempty"`
+	Filename  *string                 `json:"filename,omitempty"`
+	MediaType string                  `json:"mediaType"`
+	Protocol  ModelsBProtocolProtocol `json:"protocol"`
+}
+
+// ModelsBProtocolProtocol defines model for ModelsBProtocol.Protocol.
+type ModelsBProtocolProtocol string
+
+// ModelsBitcomProtocol defines model for models_BitcomProtocol.
+type ModelsBitcomProtocol struct {
+	union json.RawMessage
+}
+
 // ModelsBucketAnnotation defines model for models_BucketAnnotation.
 type ModelsBucketAnnotation struct {
 	// Bucket Type of bucket where this output should be stored.
@@ -362,6 +670,39 @@ type ModelsChangeAnnotation struct {
 	CustomInstructions *ModelsSPVWalletCustomInstructions `json:"customInstructions,omitempty"`
 }
 
+// ModelsContact defines model for models_Contact.
+type ModelsContact struct {
+	// CreatedAt Creation date of the contact
+	CreatedAt time.Time `json:"createdAt"`
+
+	// FullName Full name of the contact
+	FullName string `json:"fullName"`
+
+	// Id ID of the contact
+	Id uint `json:"id"`
+
+	// Paymail Paymail of the contact
+	Paymail string `json:"paymail"`
+
+	// PubKey PKI of the contact's paymail
+	PubKey string `json:"pubKey"`
+
+	// Status Status of the contact
+	Status ModelsContactStatus `json:"status"`
+
+	// UpdatedAt Last update date of the contact
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ModelsContactStatus Status of the contact
+type ModelsContactStatus string
+
+// ModelsContactsSearchResult defines model for models_ContactsSearchResult.
+type ModelsContactsSearchResult struct {
+	Content []ModelsContact  `json:"content"`
+	Page    ModelsSearchPage `json:"page"`
+}
+
 // ModelsCustomInstructions defines model for models_CustomInstructions.
 type ModelsCustomInstructions struct {
 	union json.RawMessage
@@ -370,10 +711,17 @@ type ModelsCustomInstructions struct {
 // ModelsData defines model for models_Data.
 type ModelsData struct {
 	// Blob Data blob
-	Blob string `json:"blob"`
+	Blob      string    `json:"blob"`
+	CreatedAt time.Time `json:"createdAt"`
 
 	// Id User ID
 	Id string `json:"id"`
+
+	// Protocols Bitcom protocols recognised in the data output
+	Protocols *[]ModelsBitcomProtocol `json:"protocols,omitempty"`
+
+	// Size Size of the data blob in bytes
+	Size int `json:"size"`
 }
 
 // ModelsDataAnnotation defines model for models_DataAnnotation.
@@ -384,6 +732,12 @@ type ModelsDataAnnotation struct {
 // ModelsDataAnnotationBucket defines model for ModelsDataAnnotation.Bucket.
 type ModelsDataAnnotationBucket string
 
+// ModelsDataSearchResult defines model for models_DataSearchResult.
+type ModelsDataSearchResult struct {
+	Content []ModelsData     `json:"content"`
+	Page    ModelsSearchPage `json:"page"`
+}
+
 // ModelsExclusiveStartKeySearchPage defines model for models_ExclusiveStartKeySearchPage.
 type ModelsExclusiveStartKeySearchPage struct {
 	// LastEvaluatedKey Last evaluated key
@@ -413,6 +767,63 @@ type ModelsInputsAnnotations struct {
 	Inputs map[string]ModelsInputAnnotation `json:"inputs"`
 }
 
+// ModelsInvoice defines model for models_Invoice.
+type ModelsInvoice struct {
+	// CreatedAt Creation date of the invoice
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ExpiresAt Expiry of the invoice
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Id ID of the invoice
+	Id uint `json:"id"`
+
+	// Memo Memo presented to the payer
+	Memo *string `json:"memo,omitempty"`
+
+	// PaidAt Date of the payment
+	PaidAt *time.Time `json:"paidAt,omitempty"`
+
+	// PaidSatoshis Amount received with the payment
+	PaidSatoshis uint64 `json:"paidSatoshis"`
+
+	// Paymail Paymail through which the invoice is served
+	Paymail string `json:"paymail"`
+
+	// Reference Single-use reference of the invoice, used by the payer in p2p-invoice-destination and in the p2p transaction
+	Reference string `json:"reference"`
+
+	// Satoshis Requested amount
+	Satoshis uint64 `json:"satoshis"`
+
+	// Status Status of the invoice
+	Status ModelsInvoiceStatus `json:"status"`
+
+	// TxID ID of the transaction paying the invoice
+	TxID *string `json:"txID,omitempty"`
+}
+
+// ModelsInvoiceStatus Status of the invoice
+type ModelsInvoiceStatus string
+
+// ModelsInvoicesSearchResult defines model for models_InvoicesSearchResult.
+type ModelsInvoicesSearchResult struct {
+	Content []ModelsInvoice  `json:"content"`
+	Page    ModelsSearchPage `json:"page"`
+}
+
+// ModelsMAPProtocol Magic Attribute Protocol key/value pairs. <br>
+// The "app" and "type" keys are required when creating outputs; they are written first, followed by the remaining keys in alphabetical order.
+type ModelsMAPProtocol struct {
+	// Command MAP command, only SET is supported when creating outputs
+	Command  *string                   `json:"command,omitempty"`
+	Keys     map[string]string         `json:"keys"`
+	Protocol ModelsMAPProtocolProtocol `json:"protocol"`
+}
+
+// ModelsMAPProtocolProtocol defines model for ModelsMAPProtocol.Protocol.
+type ModelsMAPProtocolProtocol string
+
 // ModelsMerkleRoot defines model for models_MerkleRoot.
 type ModelsMerkleRoot struct {
 	// BlockHeight Block height
@@ -436,6 +847,9 @@ type ModelsOperation struct {
 	// CreatedAt Creation date of operation
 	CreatedAt time.Time `json:"createdAt"`
 
+	// SenderPubKey Public key of the sender verified against the sender's paymail PKI (only for incoming paymail transactions with a verified sender)
+	SenderPubKey *string `json:"senderPubKey,omitempty"`
+
 	// TxID Transaction ID
 	TxID string `json:"txID"`
 
@@ -472,6 +886,7 @@ type ModelsOutlineAnnotations struct {
 
 // ModelsOutputAnnotation defines model for models_OutputAnnotation.
 type ModelsOutputAnnotation struct {
+	Address            *ModelsAddressAnnotationDetails    `json:"address,omitempty"`
 	Bucket             ModelsOutputAnnotationBucket       `json:"bucket"`
 	CustomInstructions *ModelsSPVWalletCustomInstructions `json:"customInstructions,omitempty"`
 	Paymail            *ModelsPaymailAnnotationDetails    `json:"paymail,omitempty"`
@@ -517,12 +932,69 @@ type ModelsPaymailAnnotationDetails struct {
 	Sender string `json:"sender"`
 }
 
+// ModelsPaymailDestination defines model for models_PaymailDestination.
+type ModelsPaymailDestination struct {
+	// Address Address derived for the paymail
+	Address string `json:"address"`
+
+	// CreatedAt Creation date of the destination
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Note Note of the received transaction
+	Note *string `json:"note,omitempty"`
+
+	// Paymail Paymail for which the destination was derived
+	Paymail string `json:"paymail"`
+
+	// Purpose Purpose of the payment (provided in address resolution)
+	Purpose *string `json:"purpose,omitempty"`
+
+	// Reference Reference ID of the destination (as returned by p2p-payment-destination)
+	Reference string `json:"reference"`
+
+	// Request Paymail request which produced the destination
+	Request ModelsPaymailDestinationRequest `json:"request"`
+
+	// RequesterIP IP address of the requester
+	RequesterIP *string `json:"requesterIP,omitempty"`
+
+	// RequesterUserAgent User agent of the requester
+	RequesterUserAgent *string `json:"requesterUserAgent,omitempty"`
+
+	// Satoshis Amount of satoshis requested (or declared by the sender in address resolution)
+	Satoshis uint64 `json:"satoshis"`
+
+	// SenderName Name of the sender (provided in address resolution)
+	SenderName *string `json:"senderName,omitempty"`
+
+	// SenderPaymail Paymail of the sender
+	SenderPaymail *string `json:"senderPaymail,omitempty"`
+
+	// SenderPubKey Public key of the sender verified against the sender's paymail PKI
+	SenderPubKey *string `json:"senderPubKey,omitempty"`
+
+	// TxID ID of the transaction paying to the destination (when received through paymail)
+	TxID *string `json:"txID,omitempty"`
+
+	// Vout Index of the output paying to the destination (when received through paymail)
+	Vout *uint32 `json:"vout,omitempty"`
+}
+
+// ModelsPaymailDestinationRequest Paymail request which produced the destination
+type ModelsPaymailDestinationRequest string
+
 // ModelsRecordedOutline defines model for models_RecordedOutline.
 type ModelsRecordedOutline struct {
 	// TxID ID of the transaction
 	TxID string `json:"txID"`
+
+	// TxStatus Status of the transaction; CREATED means the transaction is queued for broadcasting and its status can be polled with getTransactionStatus
+	TxStatus ModelsRecordedOutlineTxStatus `json:"txStatus"`
 }
 
+// ModelsRecordedOutlineTxStatus Status of the transaction; CREATED means the transaction is queued for broadcasting and its status can be polled with getTransactionStatus
+type ModelsRecordedOutlineTxStatus string
+
 // ModelsSPVWalletCustomInstruction defines model for models_SPVWalletCustomInstruction.
 type ModelsSPVWalletCustomInstruction struct {
 	// Instruction Custom instruction
@@ -556,6 +1028,15 @@ type ModelsSharedConfig struct {
 	PaymailDomains       []string        `json:"paymailDomains"`
 }
 
+// ModelsTokenBalance defines model for models_TokenBalance.
+type ModelsTokenBalance struct {
+	// Amount Amount of the token held by user
+	Amount uint64 `json:"amount"`
+
+	// TokenId ID of the token (outpoint of the deploy+mint inscription of BSV-21 token or redemption address of STAS token)
+	TokenId string `json:"tokenId"`
+}
+
 // ModelsTransactionHex defines model for models_TransactionHex.
 type ModelsTransactionHex struct {
 	// Format Transaction format
@@ -568,6 +1049,24 @@ type ModelsTransactionHex struct {
 // ModelsTransactionHexFormat Transaction format
 type ModelsTransactionHexFormat string
 
+// ModelsTransactionStatus defines model for models_TransactionStatus.
+type ModelsTransactionStatus struct {
+	// BlockHash Block hash of the transaction (when mined)
+	BlockHash *string `json:"blockHash,omitempty"`
+
+	// BlockHeight Block height of the transaction (when mined)
+	BlockHeight *int64 `json:"blockHeight,omitempty"`
+
+	// TxID Transaction ID
+	TxID string `json:"txID"`
+
+	// TxStatus Status of transaction
+	TxStatus ModelsTransactionStatusTxStatus `json:"txStatus"`
+}
+
+// ModelsTransactionStatusTxStatus Status of transaction
+type ModelsTransactionStatusTxStatus string
+
 // ModelsUser defines model for models_User.
 type ModelsUser struct {
 	CreatedAt time.Time       `json:"createdAt"`
@@ -584,6 +1083,18 @@ type ModelsUserDefinedCustomInstructions = string
 type ModelsUserInfo struct {
 	// CurrentBalance Current balance of user
 	CurrentBalance uint64 `json:"currentBalance"`
+
+	// Tokens Balances of BSV-21 and STAS tokens held by user
+	Tokens []ModelsTokenBalance `json:"tokens"`
+}
+
+// RequestsAddContact defines model for requests_AddContact.
+type RequestsAddContact struct {
+	FullName string `json:"fullName"`
+	Paymail  string `json:"paymail"`
+
+	// RequesterPaymail Paymail of the user that will be presented to the contact. If not provided the default paymail of the user is used.
+	RequesterPaymail *string `json:"requesterPaymail,omitempty"`
 }
 
 // RequestsAddPaymail defines model for requests_AddPaymail.
@@ -597,29 +1108,94 @@ type RequestsAddPaymail struct {
 	PublicName *string `json:"publicName,omitempty"`
 }
 
-// RequestsCreateUser defines model for requests_CreateUser.
-type RequestsCreateUser struct {
-	Paymail   *RequestsAddPaymail `json:"paymail,omitempty"`
-	PublicKey string              `json:"publicKey"`
+// RequestsAddressOutputSpecification defines model for requests_AddressOutputSpecification.
+type RequestsAddressOutputSpecification struct {
+	Satoshis uint64 `json:"satoshis"`
+
+	// To Base58 encoded P2PKH address of the receiver (mainnet).
+	To   string                                 `json:"to"`
+	Type RequestsAddressOutputSpecificationType `json:"type"`
 }
 
-// RequestsOpReturnHexesOutput defines model for requests_OpReturnHexesOutput.
-type RequestsOpReturnHexesOutput = []string
+// RequestsAddressOutputSpecificationType defines model for RequestsAddressOutputSpecification.Type.
+type RequestsAddressOutputSpecificationType string
 
-// RequestsOpReturnOutputSpecification defines model for requests_OpReturnOutputSpecification.
-type RequestsOpReturnOutputSpecification struct {
-	Data     RequestsOpReturnOutputSpecification_Data     `json:"data"`
-	DataType *RequestsOpReturnOutputSpecificationDataType `json:"dataType,omitempty"`
-	Type     RequestsOpReturnOutputSpecificationType      `json:"type"`
+// RequestsBitcomOutputSpecification Data output with Bitcom protocols (B://, MAP, AIP) separated by "|".
+type RequestsBitcomOutputSpecification struct {
+	Protocols []ModelsBitcomProtocol                `json:"protocols"`
+	Type      RequestsBitcomOutputSpecificationType `json:"type"`
 }
 
-// RequestsOpReturnOutputSpecification_Data defines model for RequestsOpReturnOutputSpecification.Data.
-type RequestsOpReturnOutputSpecification_Data struct {
-	union json.RawMessage
+// RequestsBitcomOutputSpecificationType defines model for RequestsBitcomOutputSpecification.Type.
+type RequestsBitcomOutputSpecificationType string
+
+// RequestsContactOutputSpecification defines model for requests_ContactOutputSpecification.
+type RequestsContactOutputSpecification struct {
+	ContactId uint    `json:"contactId"`
+	From      *string `json:"from"`
+	Satoshis  uint64  `json:"satoshis"`
+
+	// Splits The number of outputs to be created from the satoshis. <br>
+	// Warning: The satoshis must be evenly divisible by the number of splits. <br>
+	// Warning: If the recipient responds with more than one output, the number of splits must be 1.
+	Splits *uint64                                `json:"splits,omitempty"`
+	Type   RequestsContactOutputSpecificationType `json:"type"`
 }
 
-// RequestsOpReturnOutputSpecificationDataType defines model for RequestsOpReturnOutputSpecification.DataType.
-type RequestsOpReturnOutputSpecificationDataType string
+// RequestsContactOutputSpecificationType defines model for RequestsContactOutputSpecification.Type.
+type RequestsContactOutputSpecificationType string
+
+// RequestsCreateInvoice defines model for requests_CreateInvoice.
+type RequestsCreateInvoice struct {
+	// ExpiresAt Expiry of the invoice; if not provided the invoice expires after 24 hours
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Memo Memo presented to the payer
+	Memo *string `json:"memo,omitempty"`
+
+	// Paymail Paymail of the user through which the invoice is served. If not provided the default paymail of the user is used.
+	Paymail *string `json:"paymail,omitempty"`
+
+	// Satoshis Requested amount
+	Satoshis uint64 `json:"satoshis"`
+}
+
+// RequestsCreateUser defines model for requests_CreateUser.
+type RequestsCreateUser struct {
+	Paymail   *RequestsAddPaymail `json:"paymail,omitempty"`
+	PublicKey string              `json:"publicKey"`
+}
+
+// RequestsInscriptionOutputSpecification 1Sat Ordinals inscription of the content, owned by the user (1 satoshi output).
+type RequestsInscriptionOutputSpecification struct {
+	// Content Base64 encoded content to inscribe.
+	Content []byte `json:"content"`
+
+	// ContentType MIME type of the inscribed content.
+	ContentType string                                     `json:"contentType"`
+	Type        RequestsInscriptionOutputSpecificationType `json:"type"`
+}
+
+// RequestsInscriptionOutputSpecificationType defines model for RequestsInscriptionOutputSpecification.Type.
+type RequestsInscriptionOutputSpecificationType string
+
+// RequestsOpReturnHexesOutput defines model for requests_OpReturnHexesOutput.
+type RequestsOpReturnHexesOutput = []string
+
+// RequestsOpReturnOutputSpecification defines model for requests_OpReturnOutputSpecification.
+type RequestsOpReturnOutputSpecification struct {
+	Data     RequestsOpReturnOutputSpecification_Data     `json:"data"`
+	DataType *RequestsOpReturnOutputSpecificationDataType `json:"dataType,omitempty"`
+	Type     RequestsOpReturnOutputSpecificationType      `json:"type"`
+}
+
+// RequestsOpReturnOutputSpecification_Data defines model for RequestsOpReturnOutputSpecification.Data.
+type RequestsOpReturnOutputSpecification_Data struct {
+	union json.RawMessage
+}
+
+// RequestsOpReturnOutputSpecificationDataType defines model for RequestsOpReturnOutputSpecification.DataType.
+type RequestsOpReturnOutputSpecificationDataType string
 
 // RequestsOpReturnOutputSpecificationType defines model for RequestsOpReturnOutputSpecification.Type.
 type RequestsOpReturnOutputSpecificationType string
@@ -627,6 +1203,22 @@ type RequestsOpReturnOutputSpecificationType string
 // RequestsOpReturnStringsOutput defines model for requests_OpReturnStringsOutput.
 type RequestsOpReturnStringsOutput = []string
 
+// RequestsOrdinalTransferOutputSpecification Transfer of the user's ordinal to the address or paymail of the receiver.
+type RequestsOrdinalTransferOutputSpecification struct {
+	// To Base58 encoded P2PKH address (mainnet) or paymail of the receiver.
+	To string `json:"to"`
+
+	// TxID ID of the transaction with the ordinal output.
+	TxID string                                         `json:"txID"`
+	Type RequestsOrdinalTransferOutputSpecificationType `json:"type"`
+
+	// Vout Index of the ordinal output.
+	Vout uint32 `json:"vout"`
+}
+
+// RequestsOrdinalTransferOutputSpecificationType defines model for RequestsOrdinalTransferOutputSpecification.Type.
+type RequestsOrdinalTransferOutputSpecificationType string
+
 // RequestsPaymailOutputSpecification defines model for requests_PaymailOutputSpecification.
 type RequestsPaymailOutputSpecification struct {
 	From     *string `json:"from"`
@@ -643,6 +1235,33 @@ type RequestsPaymailOutputSpecification struct {
 // RequestsPaymailOutputSpecificationType defines model for RequestsPaymailOutputSpecification.Type.
 type RequestsPaymailOutputSpecificationType string
 
+// RequestsScriptOutputSpecification defines model for requests_ScriptOutputSpecification.
+type RequestsScriptOutputSpecification struct {
+	// LockingScript Hex encoded locking script of the output.
+	LockingScript string                                `json:"lockingScript"`
+	Satoshis      uint64                                `json:"satoshis"`
+	Type          RequestsScriptOutputSpecificationType `json:"type"`
+}
+
+// RequestsScriptOutputSpecificationType defines model for RequestsScriptOutputSpecification.Type.
+type RequestsScriptOutputSpecificationType string
+
+// RequestsTokenTransferOutputSpecification Transfer of the amount of the user's BSV-21 token to the address or paymail of the receiver. <br>
+// The remaining amount of the spent token outputs is returned to the user.
+type RequestsTokenTransferOutputSpecification struct {
+	Amount uint64 `json:"amount"`
+
+	// To Base58 encoded P2PKH address (mainnet) or paymail of the receiver.
+	To string `json:"to"`
+
+	// TokenId ID of the BSV-21 token (outpoint of its deploy+mint inscription).
+	TokenId string                                       `json:"tokenId"`
+	Type    RequestsTokenTransferOutputSpecificationType `json:"type"`
+}
+
+// RequestsTokenTransferOutputSpecificationType defines model for RequestsTokenTransferOutputSpecification.Type.
+type RequestsTokenTransferOutputSpecificationType string
+
 // RequestsTransactionOutline defines model for requests_TransactionOutline.
 type RequestsTransactionOutline struct {
 	Annotations *ModelsOutputsAnnotations `json:"annotations,omitempty"`
@@ -667,6 +1286,21 @@ type RequestsTransactionSpecification struct {
 	Outputs []RequestsTransactionOutlineOutputSpecification `json:"outputs"`
 }
 
+// RequestsUpdatePaymailProfile defines model for requests_UpdatePaymailProfile.
+type RequestsUpdatePaymailProfile struct {
+	// AvatarURL Empty value removes the avatar
+	AvatarURL *string `json:"avatarURL,omitempty"`
+
+	// PublicName If empty will default to the same value as alias
+	PublicName *string `json:"publicName,omitempty"`
+}
+
+// RequestsContactID defines model for requests_ContactID.
+type RequestsContactID = uint
+
+// RequestsInvoiceID defines model for requests_InvoiceID.
+type RequestsInvoiceID = uint
+
 // RequestsPageNumber defines model for requests_PageNumber.
 type RequestsPageNumber = int
 
@@ -679,6 +1313,22 @@ type RequestsSort = string
 // RequestsSortBy defines model for requests_SortBy.
 type RequestsSortBy = string
 
+// RequestsTxID defines model for requests_TxID.
+type RequestsTxID = string
+
+// ResponsesAddContactBadRequest defines model for responses_AddContactBadRequest.
+type ResponsesAddContactBadRequest struct {
+	union json.RawMessage
+}
+
+// ResponsesAddContactFailedDependency defines model for responses_AddContactFailedDependency.
+type ResponsesAddContactFailedDependency struct {
+	union json.RawMessage
+}
+
+// ResponsesAddContactSuccess defines model for responses_AddContactSuccess.
+type ResponsesAddContactSuccess = ModelsAddedContact
+
 // ResponsesAdminAddPaymailSuccess defines model for responses_AdminAddPaymailSuccess.
 type ResponsesAdminAddPaymailSuccess = ModelsPaymail
 
@@ -704,6 +1354,19 @@ type ResponsesAdminUserBadRequest struct {
 	union json.RawMessage
 }
 
+// ResponsesContactSuccess defines model for responses_ContactSuccess.
+type ResponsesContactSuccess = ModelsContact
+
+// ResponsesContactUnprocessable defines model for responses_ContactUnprocessable.
+type ResponsesContactUnprocessable struct {
+	union json.RawMessage
+}
+
+// ResponsesCreateInvoiceBadRequest defines model for responses_CreateInvoiceBadRequest.
+type ResponsesCreateInvoiceBadRequest struct {
+	union json.RawMessage
+}
+
 // ResponsesCreateTransactionOutlineBadRequest defines model for responses_CreateTransactionOutlineBadRequest.
 type ResponsesCreateTransactionOutlineBadRequest struct {
 	union json.RawMessage
@@ -717,6 +1380,11 @@ type ResponsesCreateTransactionOutlineUnprocessable struct {
 	union json.RawMessage
 }
 
+// ResponsesGetContactNotFound defines model for responses_GetContactNotFound.
+type ResponsesGetContactNotFound struct {
+	union json.RawMessage
+}
+
 // ResponsesGetCurrentUserSuccess defines model for responses_GetCurrentUserSuccess.
 type ResponsesGetCurrentUserSuccess = ModelsUserInfo
 
@@ -728,6 +1396,11 @@ type ResponsesGetDataNotFound struct {
 // ResponsesGetDataSuccess defines model for responses_GetDataSuccess.
 type ResponsesGetDataSuccess = ModelsData
 
+// ResponsesGetInvoiceNotFound defines model for responses_GetInvoiceNotFound.
+type ResponsesGetInvoiceNotFound struct {
+	union json.RawMessage
+}
+
 // ResponsesGetMerklerootsBadRequest defines model for responses_GetMerklerootsBadRequest.
 type ResponsesGetMerklerootsBadRequest = ErrorsInvalidBatchSize
 
@@ -745,9 +1418,26 @@ type ResponsesGetMerklerootsNotFound = ErrorsMerkleRootNotFound
 // ResponsesGetMerklerootsSuccess defines model for responses_GetMerklerootsSuccess.
 type ResponsesGetMerklerootsSuccess = ModelsGetMerkleRootResult
 
+// ResponsesGetTransactionNotFound defines model for responses_GetTransactionNotFound.
+type ResponsesGetTransactionNotFound struct {
+	union json.RawMessage
+}
+
 // ResponsesInternalServerError defines model for responses_InternalServerError.
 type ResponsesInternalServerError = ErrorsInternal
 
+// ResponsesInvoiceSuccess defines model for responses_InvoiceSuccess.
+type ResponsesInvoiceSuccess = ModelsInvoice
+
+// ResponsesLookupPaymailDestinationBadRequest defines model for responses_LookupPaymailDestinationBadRequest.
+type ResponsesLookupPaymailDestinationBadRequest = ErrorsInvalidPaymailDestinationLookup
+
+// ResponsesLookupPaymailDestinationNotFound defines model for responses_LookupPaymailDestinationNotFound.
+type ResponsesLookupPaymailDestinationNotFound = ErrorsPaymailDestinationNotFound
+
+// ResponsesLookupPaymailDestinationSuccess defines model for responses_LookupPaymailDestinationSuccess.
+type ResponsesLookupPaymailDestinationSuccess = ModelsPaymailDestination
+
 // ResponsesNotAuthorized defines model for responses_NotAuthorized.
 type ResponsesNotAuthorized = ErrorsAnyAuthorization
 
@@ -770,18 +1460,134 @@ type ResponsesRecordTransactionSuccess = ModelsRecordedOutline
 // ResponsesSearchBadRequest defines model for responses_SearchBadRequest.
 type ResponsesSearchBadRequest = ErrorsInvalidDataID
 
+// ResponsesSearchContactsSuccess defines model for responses_SearchContactsSuccess.
+type ResponsesSearchContactsSuccess = ModelsContactsSearchResult
+
+// ResponsesSearchDataBadRequest defines model for responses_SearchDataBadRequest.
+type ResponsesSearchDataBadRequest struct {
+	union json.RawMessage
+}
+
+// ResponsesSearchDataSuccess defines model for responses_SearchDataSuccess.
+type ResponsesSearchDataSuccess = ModelsDataSearchResult
+
+// ResponsesSearchInvoicesSuccess defines model for responses_SearchInvoicesSuccess.
+type ResponsesSearchInvoicesSuccess = ModelsInvoicesSearchResult
+
 // ResponsesSearchOperationsSuccess defines model for responses_SearchOperationsSuccess.
 type ResponsesSearchOperationsSuccess = ModelsOperationsSearchResult
 
 // ResponsesSharedConfig Shared config
 type ResponsesSharedConfig = ModelsSharedConfig
 
+// ResponsesTransactionStatusSuccess defines model for responses_TransactionStatusSuccess.
+type ResponsesTransactionStatusSuccess = ModelsTransactionStatus
+
+// ResponsesUpdatePaymailProfileBadRequest defines model for responses_UpdatePaymailProfileBadRequest.
+type ResponsesUpdatePaymailProfileBadRequest struct {
+	union json.RawMessage
+}
+
+// ResponsesUpdatePaymailProfileNotFound defines model for responses_UpdatePaymailProfileNotFound.
+type ResponsesUpdatePaymailProfileNotFound struct {
+	union json.RawMessage
+}
+
+// ResponsesUpdatePaymailProfileSuccess defines model for responses_UpdatePaymailProfileSuccess.
+type ResponsesUpdatePaymailProfileSuccess = ModelsPaymail
+
+// ResponsesUpdatePaymailProfileUnprocessable defines model for responses_UpdatePaymailProfileUnprocessable.
+type ResponsesUpdatePaymailProfileUnprocessable struct {
+	union json.RawMessage
+}
+
 // ResponsesUserBadRequest defines model for responses_UserBadRequest.
 type ResponsesUserBadRequest = ErrorsInvalidDataID
 
 // ResponsesUserNotAuthorized defines model for responses_UserNotAuthorized.
 type ResponsesUserNotAuthorized = ErrorsUserAuthorization
 
+// SearchContactsParams defines parameters for SearchContacts.
+type SearchContactsParams struct {
+	// Page Page number for pagination
+	Page *RequestsPageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *RequestsPageSize `form:"size,omitempty" json:"size,omitempty"`
+
+	// Sort Sorting order (asc or desc)
+	Sort *RequestsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// SortBy Field to sort by
+	SortBy *RequestsSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+}
+
+// SearchDataParams defines parameters for SearchData.
+type SearchDataParams struct {
+	// Page Page number for pagination
+	Page *RequestsPageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *RequestsPageSize `form:"size,omitempty" json:"size,omitempty"`
+
+	// Sort Sorting order (asc or desc)
+	Sort *RequestsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// SortBy Field to sort by
+	SortBy *RequestsSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+
+	// TxId ID of the transaction containing the data
+	TxId *string `form:"txId,omitempty" json:"txId,omitempty"`
+
+	// CreatedFrom Data created at or after this time
+	CreatedFrom *time.Time `form:"createdFrom,omitempty" json:"createdFrom,omitempty"`
+
+	// CreatedTo Data created at or before this time
+	CreatedTo *time.Time `form:"createdTo,omitempty" json:"createdTo,omitempty"`
+
+	// Prefix Hex encoded beginning of the data blob
+	Prefix *string `form:"prefix,omitempty" json:"prefix,omitempty"`
+
+	// MinSize Minimal size of the data blob in bytes
+	MinSize *uint `form:"minSize,omitempty" json:"minSize,omitempty"`
+
+	// MaxSize Maximal size of the data blob in bytes
+	MaxSize *uint `form:"maxSize,omitempty" json:"maxSize,omitempty"`
+
+	// Protocol Bitcom protocol recognised in the data
+	Protocol *SearchDataParamsProtocol `form:"protocol,omitempty" json:"protocol,omitempty"`
+
+	// MediaType Media type of the B:// file
+	MediaType *string `form:"mediaType,omitempty" json:"mediaType,omitempty"`
+
+	// MapApp Value of the "app" key of MAP
+	MapApp *string `form:"mapApp,omitempty" json:"mapApp,omitempty"`
+
+	// MapType Value of the "type" key of MAP
+	MapType *string `form:"mapType,omitempty" json:"mapType,omitempty"`
+
+	// AipAddress Address of the valid AIP signature
+	AipAddress *string `form:"aipAddress,omitempty" json:"aipAddress,omitempty"`
+}
+
+// SearchDataParamsProtocol defines parameters for SearchData.
+type SearchDataParamsProtocol string
+
+// SearchInvoicesParams defines parameters for SearchInvoices.
+type SearchInvoicesParams struct {
+	// Page Page number for pagination
+	Page *RequestsPageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *RequestsPageSize `form:"size,omitempty" json:"size,omitempty"`
+
+	// Sort Sorting order (asc or desc)
+	Sort *RequestsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// SortBy Field to sort by
+	SortBy *RequestsSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+}
+
 // MerkleRootsParams defines parameters for MerkleRoots.
 type MerkleRootsParams struct {
 	// BatchSize Batch size of merkleroots to be returned
@@ -806,6 +1612,18 @@ type SearchOperationsParams struct {
 	SortBy *RequestsSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
 }
 
+// LookupPaymailDestinationParams defines parameters for LookupPaymailDestination.
+type LookupPaymailDestinationParams struct {
+	// Address Address derived for the paymail
+	Address *string `form:"address,omitempty" json:"address,omitempty"`
+
+	// TxId ID of the transaction paying to the destination
+	TxId *string `form:"txId,omitempty" json:"txId,omitempty"`
+
+	// Vout Index of the output paying to the destination
+	Vout *uint32 `form:"vout,omitempty" json:"vout,omitempty"`
+}
+
 // CreateTransactionOutlineParams defines parameters for CreateTransactionOutline.
 type CreateTransactionOutlineParams struct {
 	// Format Required format of transaction hex
@@ -821,12 +1639,24 @@ type CreateUserJSONRequestBody = RequestsCreateUser
 // AddPaymailToUserJSONRequestBody defines body for AddPaymailToUser for application/json ContentType.
 type AddPaymailToUserJSONRequestBody = RequestsAddPaymail
 
+// UpdatePaymailProfileJSONRequestBody defines body for UpdatePaymailProfile for application/json ContentType.
+type UpdatePaymailProfileJSONRequestBody = RequestsUpdatePaymailProfile
+
+// AddContactJSONRequestBody defines body for AddContact for application/json ContentType.
+type AddContactJSONRequestBody = RequestsAddContact
+
+// CreateInvoiceJSONRequestBody defines body for CreateInvoice for application/json ContentType.
+type CreateInvoiceJSONRequestBody = RequestsCreateInvoice
+
 // RecordTransactionOutlineJSONRequestBody defines body for RecordTransactionOutline for application/json ContentType.
 type RecordTransactionOutlineJSONRequestBody = RequestsTransactionOutline
 
 // CreateTransactionOutlineJSONRequestBody defines body for CreateTransactionOutline for application/json ContentType.
 type CreateTransactionOutlineJSONRequestBody = RequestsTransactionSpecification
 
+// UpdateCurrentUserPaymailProfileJSONRequestBody defines body for UpdateCurrentUserPaymailProfile for application/json ContentType.
+type UpdateCurrentUserPaymailProfileJSONRequestBody = RequestsUpdatePaymailProfile
+
 // AsErrorsUserAuthOnNonUserEndpoint returns the union data inside the ErrorsAdminAuthorization as a ErrorsUserAuthOnNonUserEndpoint
 func (t ErrorsAdminAuthorization) AsErrorsUserAuthOnNonUserEndpoint() (ErrorsUserAuthOnNonUserEndpoint, error) {
 	var body ErrorsUserAuthOnNonUserEndpoint
@@ -1039,6 +1869,125 @@ func (t *ErrorsUserAuthorization) UnmarshalJSON(b []byte) error {
 	return err
 }
 
+// AsModelsBProtocol returns the union data inside the ModelsBitcomProtocol as a ModelsBProtocol
+func (t ModelsBitcomProtocol) AsModelsBProtocol() (ModelsBProtocol, error) {
+	var body ModelsBProtocol
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromModelsBProtocol overwrites any union data inside the ModelsBitcomProtocol as the provided ModelsBProtocol
+func (t *ModelsBitcomProtocol) FromModelsBProtocol(v ModelsBProtocol) error {
+	v.Protocol = "B"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeModelsBProtocol performs a merge with any union data inside the ModelsBitcomProtocol, using the provided ModelsBProtocol
+func (t *ModelsBitcomProtocol) MergeModelsBProtocol(v ModelsBProtocol) error {
+	v.Protocol = "B"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsModelsMAPProtocol returns the union data inside the ModelsBitcomProtocol as a ModelsMAPProtocol
+func (t ModelsBitcomProtocol) AsModelsMAPProtocol() (ModelsMAPProtocol, error) {
+	var body ModelsMAPProtocol
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromModelsMAPProtocol overwrites any union data inside the ModelsBitcomProtocol as the provided ModelsMAPProtocol
+func (t *ModelsBitcomProtocol) FromModelsMAPProtocol(v ModelsMAPProtocol) error {
+	v.Protocol = "MAP"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeModelsMAPProtocol performs a merge with any union data inside the ModelsBitcomProtocol, using the provided ModelsMAPProtocol
+func (t *ModelsBitcomProtocol) MergeModelsMAPProtocol(v ModelsMAPProtocol) error {
+	v.Protocol = "MAP"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsModelsAIPProtocol returns the union data inside the ModelsBitcomProtocol as a ModelsAIPProtocol
+func (t ModelsBitcomProtocol) AsModelsAIPProtocol() (ModelsAIPProtocol, error) {
+	var body ModelsAIPProtocol
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromModelsAIPProtocol overwrites any union data inside the ModelsBitcomProtocol as the provided ModelsAIPProtocol
+func (t *ModelsBitcomProtocol) FromModelsAIPProtocol(v ModelsAIPProtocol) error {
+	v.Protocol = "AIP"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeModelsAIPProtocol performs a merge with any union data inside the ModelsBitcomProtocol, using the provided ModelsAIPProtocol
+func (t *ModelsBitcomProtocol) MergeModelsAIPProtocol(v ModelsAIPProtocol) error {
+	v.Protocol = "AIP"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ModelsBitcomProtocol) Discriminator() (string, error) {
+	var discriminator struct {
+		Discriminator string `json:"protocol"`
+	}
+	err := json.Unmarshal(t.union, &discriminator)
+	return discriminator.Discriminator, err
+}
+
+func (t ModelsBitcomProtocol) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "AIP":
+		return t.AsModelsAIPProtocol()
+	case "B":
+		return t.AsModelsBProtocol()
+	case "MAP":
+		return t.AsModelsMAPProtocol()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+func (t ModelsBitcomProtocol) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ModelsBitcomProtocol) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
 // AsModelsSPVWalletCustomInstructions returns the union data inside the ModelsCustomInstructions as a ModelsSPVWalletCustomInstructions
 func (t ModelsCustomInstructions) AsModelsSPVWalletCustomInstructions() (ModelsSPVWalletCustomInstructions, error) {
 	var body ModelsSPVWalletCustomInstructions
@@ -1219,24 +2168,234 @@ func (t *RequestsTransactionOutlineOutputSpecification) MergeRequestsPaymailOutp
 	return err
 }
 
-func (t RequestsTransactionOutlineOutputSpecification) Discriminator() (string, error) {
-	var discriminator struct {
-		Discriminator string `json:"type"`
-	}
-	err := json.Unmarshal(t.union, &discriminator)
-	return discriminator.Discriminator, err
+// AsRequestsContactOutputSpecification returns the union data inside the RequestsTransactionOutlineOutputSpecification as a RequestsContactOutputSpecification
+func (t RequestsTransactionOutlineOutputSpecification) AsRequestsContactOutputSpecification() (RequestsContactOutputSpecification, error) {
+	var body RequestsContactOutputSpecification
+	err := json.Unmarshal(t.union, &body)
+	return body, err
 }
 
-func (t RequestsTransactionOutlineOutputSpecification) ValueByDiscriminator() (interface{}, error) {
-	discriminator, err := t.Discriminator()
+// FromRequestsContactOutputSpecification overwrites any union data inside the RequestsTransactionOutlineOutputSpecification as the provided RequestsContactOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) FromRequestsContactOutputSpecification(v RequestsContactOutputSpecification) error {
+	v.Type = "contact"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeRequestsContactOutputSpecification performs a merge with any union data inside the RequestsTransactionOutlineOutputSpecification, using the provided RequestsContactOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) MergeRequestsContactOutputSpecification(v RequestsContactOutputSpecification) error {
+	v.Type = "contact"
+	b, err := json.Marshal(v)
 	if err != nil {
-		return nil, err
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsRequestsAddressOutputSpecification returns the union data inside the RequestsTransactionOutlineOutputSpecification as a RequestsAddressOutputSpecification
+func (t RequestsTransactionOutlineOutputSpecification) AsRequestsAddressOutputSpecification() (RequestsAddressOutputSpecification, error) {
+	var body RequestsAddressOutputSpecification
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromRequestsAddressOutputSpecification overwrites any union data inside the RequestsTransactionOutlineOutputSpecification as the provided RequestsAddressOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) FromRequestsAddressOutputSpecification(v RequestsAddressOutputSpecification) error {
+	v.Type = "address"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeRequestsAddressOutputSpecification performs a merge with any union data inside the RequestsTransactionOutlineOutputSpecification, using the provided RequestsAddressOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) MergeRequestsAddressOutputSpecification(v RequestsAddressOutputSpecification) error {
+	v.Type = "address"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsRequestsScriptOutputSpecification returns the union data inside the RequestsTransactionOutlineOutputSpecification as a RequestsScriptOutputSpecification
+func (t RequestsTransactionOutlineOutputSpecification) AsRequestsScriptOutputSpecification() (RequestsScriptOutputSpecification, error) {
+	var body RequestsScriptOutputSpecification
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromRequestsScriptOutputSpecification overwrites any union data inside the RequestsTransactionOutlineOutputSpecification as the provided RequestsScriptOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) FromRequestsScriptOutputSpecification(v RequestsScriptOutputSpecification) error {
+	v.Type = "script"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeRequestsScriptOutputSpecification performs a merge with any union data inside the RequestsTransactionOutlineOutputSpecification, using the provided RequestsScriptOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) MergeRequestsScriptOutputSpecification(v RequestsScriptOutputSpecification) error {
+	v.Type = "script"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsRequestsBitcomOutputSpecification returns the union data inside the RequestsTransactionOutlineOutputSpecification as a RequestsBitcomOutputSpecification
+func (t RequestsTransactionOutlineOutputSpecification) AsRequestsBitcomOutputSpecification() (RequestsBitcomOutputSpecification, error) {
+	var body RequestsBitcomOutputSpecification
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromRequestsBitcomOutputSpecification overwrites any union data inside the RequestsTransactionOutlineOutputSpecification as the provided RequestsBitcomOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) FromRequestsBitcomOutputSpecification(v RequestsBitcomOutputSpecification) error {
+	v.Type = "bitcom"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeRequestsBitcomOutputSpecification performs a merge with any union data inside the RequestsTransactionOutlineOutputSpecification, using the provided RequestsBitcomOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) MergeRequestsBitcomOutputSpecification(v RequestsBitcomOutputSpecification) error {
+	v.Type = "bitcom"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsRequestsInscriptionOutputSpecification returns the union data inside the RequestsTransactionOutlineOutputSpecification as a RequestsInscriptionOutputSpecification
+func (t RequestsTransactionOutlineOutputSpecification) AsRequestsInscriptionOutputSpecification() (RequestsInscriptionOutputSpecification, error) {
+	var body RequestsInscriptionOutputSpecification
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromRequestsInscriptionOutputSpecification overwrites any union data inside the RequestsTransactionOutlineOutputSpecification as the provided RequestsInscriptionOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) FromRequestsInscriptionOutputSpecification(v RequestsInscriptionOutputSpecification) error {
+	v.Type = "inscription"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeRequestsInscriptionOutputSpecification performs a merge with any union data inside the RequestsTransactionOutlineOutputSpecification, using the provided RequestsInscriptionOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) MergeRequestsInscriptionOutputSpecification(v RequestsInscriptionOutputSpecification) error {
+	v.Type = "inscription"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsRequestsOrdinalTransferOutputSpecification returns the union data inside the RequestsTransactionOutlineOutputSpecification as a RequestsOrdinalTransferOutputSpecification
+func (t RequestsTransactionOutlineOutputSpecification) AsRequestsOrdinalTransferOutputSpecification() (RequestsOrdinalTransferOutputSpecification, error) {
+	var body RequestsOrdinalTransferOutputSpecification
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromRequestsOrdinalTransferOutputSpecification overwrites any union data inside the RequestsTransactionOutlineOutputSpecification as the provided RequestsOrdinalTransferOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) FromRequestsOrdinalTransferOutputSpecification(v RequestsOrdinalTransferOutputSpecification) error {
+	v.Type = "ordinal_transfer"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeRequestsOrdinalTransferOutputSpecification performs a merge with any union data inside the RequestsTransactionOutlineOutputSpecification, using the provided RequestsOrdinalTransferOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) MergeRequestsOrdinalTransferOutputSpecification(v RequestsOrdinalTransferOutputSpecification) error {
+	v.Type = "ordinal_transfer"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsRequestsTokenTransferOutputSpecification returns the union data inside the RequestsTransactionOutlineOutputSpecification as a RequestsTokenTransferOutputSpecification
+func (t RequestsTransactionOutlineOutputSpecification) AsRequestsTokenTransferOutputSpecification() (RequestsTokenTransferOutputSpecification, error) {
+	var body RequestsTokenTransferOutputSpecification
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromRequestsTokenTransferOutputSpecification overwrites any union data inside the RequestsTransactionOutlineOutputSpecification as the provided RequestsTokenTransferOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) FromRequestsTokenTransferOutputSpecification(v RequestsTokenTransferOutputSpecification) error {
+	v.Type = "token_transfer"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeRequestsTokenTransferOutputSpecification performs a merge with any union data inside the RequestsTransactionOutlineOutputSpecification, using the provided RequestsTokenTransferOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) MergeRequestsTokenTransferOutputSpecification(v RequestsTokenTransferOutputSpecification) error {
+	v.Type = "token_transfer"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t RequestsTransactionOutlineOutputSpecification) Discriminator() (string, error) {
+	var discriminator struct {
+		Discriminator string `json:"type"`
+	}
+	err := json.Unmarshal(t.union, &discriminator)
+	return discriminator.Discriminator, err
+}
+
+func (t RequestsTransactionOutlineOutputSpecification) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
 	}
 	switch discriminator {
+	case "address":
+		return t.AsRequestsAddressOutputSpecification()
+	case "bitcom":
+		return t.AsRequestsBitcomOutputSpecification()
+	case "contact":
+		return t.AsRequestsContactOutputSpecification()
+	case "inscription":
+		return t.AsRequestsInscriptionOutputSpecification()
 	case "op_return":
 		return t.AsRequestsOpReturnOutputSpecification()
+	case "ordinal_transfer":
+		return t.AsRequestsOrdinalTransferOutputSpecification()
 	case "paymail":
 		return t.AsRequestsPaymailOutputSpecification()
+	case "script":
+		return t.AsRequestsScriptOutputSpecification()
+	case "token_transfer":
+		return t.AsRequestsTokenTransferOutputSpecification()
 	default:
 		return nil, errors.New("unknown discriminator value: " + discriminator)
 	}
@@ -1252,6 +2411,156 @@ func (t *RequestsTransactionOutlineOutputSpecification) UnmarshalJSON(b []byte)
 	return err
 }
 
+// AsErrorsCannotBindRequest returns the union data inside the ResponsesAddContactBadRequest as a ErrorsCannotBindRequest
+func (t ResponsesAddContactBadRequest) AsErrorsCannotBindRequest() (ErrorsCannotBindRequest, error) {
+	var body ErrorsCannotBindRequest
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsCannotBindRequest overwrites any union data inside the ResponsesAddContactBadRequest as the provided ErrorsCannotBindRequest
+func (t *ResponsesAddContactBadRequest) FromErrorsCannotBindRequest(v ErrorsCannotBindRequest) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsCannotBindRequest performs a merge with any union data inside the ResponsesAddContactBadRequest, using the provided ErrorsCannotBindRequest
+func (t *ResponsesAddContactBadRequest) MergeErrorsCannotBindRequest(v ErrorsCannotBindRequest) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsErrorsInvalidContactPaymail returns the union data inside the ResponsesAddContactBadRequest as a ErrorsInvalidContactPaymail
+func (t ResponsesAddContactBadRequest) AsErrorsInvalidContactPaymail() (ErrorsInvalidContactPaymail, error) {
+	var body ErrorsInvalidContactPaymail
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsInvalidContactPaymail overwrites any union data inside the ResponsesAddContactBadRequest as the provided ErrorsInvalidContactPaymail
+func (t *ResponsesAddContactBadRequest) FromErrorsInvalidContactPaymail(v ErrorsInvalidContactPaymail) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsInvalidContactPaymail performs a merge with any union data inside the ResponsesAddContactBadRequest, using the provided ErrorsInvalidContactPaymail
+func (t *ResponsesAddContactBadRequest) MergeErrorsInvalidContactPaymail(v ErrorsInvalidContactPaymail) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsErrorsInvalidRequesterPaymail returns the union data inside the ResponsesAddContactBadRequest as a ErrorsInvalidRequesterPaymail
+func (t ResponsesAddContactBadRequest) AsErrorsInvalidRequesterPaymail() (ErrorsInvalidRequesterPaymail, error) {
+	var body ErrorsInvalidRequesterPaymail
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsInvalidRequesterPaymail overwrites any union data inside the ResponsesAddContactBadRequest as the provided ErrorsInvalidRequesterPaymail
+func (t *ResponsesAddContactBadRequest) FromErrorsInvalidRequesterPaymail(v ErrorsInvalidRequesterPaymail) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsInvalidRequesterPaymail performs a merge with any union data inside the ResponsesAddContactBadRequest, using the provided ErrorsInvalidRequesterPaymail
+func (t *ResponsesAddContactBadRequest) MergeErrorsInvalidRequesterPaymail(v ErrorsInvalidRequesterPaymail) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsErrorsContactToSelf returns the union data inside the ResponsesAddContactBadRequest as a ErrorsContactToSelf
+func (t ResponsesAddContactBadRequest) AsErrorsContactToSelf() (ErrorsContactToSelf, error) {
+	var body ErrorsContactToSelf
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsContactToSelf overwrites any union data inside the ResponsesAddContactBadRequest as the provided ErrorsContactToSelf
+func (t *ResponsesAddContactBadRequest) FromErrorsContactToSelf(v ErrorsContactToSelf) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsContactToSelf performs a merge with any union data inside the ResponsesAddContactBadRequest, using the provided ErrorsContactToSelf
+func (t *ResponsesAddContactBadRequest) MergeErrorsContactToSelf(v ErrorsContactToSelf) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ResponsesAddContactBadRequest) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ResponsesAddContactBadRequest) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsErrorsContactGettingPKI returns the union data inside the ResponsesAddContactFailedDependency as a ErrorsContactGettingPKI
+func (t ResponsesAddContactFailedDependency) AsErrorsContactGettingPKI() (ErrorsContactGettingPKI, error) {
+	var body ErrorsContactGettingPKI
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsContactGettingPKI overwrites any union data inside the ResponsesAddContactFailedDependency as the provided ErrorsContactGettingPKI
+func (t *ResponsesAddContactFailedDependency) FromErrorsContactGettingPKI(v ErrorsContactGettingPKI) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsContactGettingPKI performs a merge with any union data inside the ResponsesAddContactFailedDependency, using the provided ErrorsContactGettingPKI
+func (t *ResponsesAddContactFailedDependency) MergeErrorsContactGettingPKI(v ErrorsContactGettingPKI) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ResponsesAddContactFailedDependency) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ResponsesAddContactFailedDependency) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
 // AsErrorsInvalidAvatarURL returns the union data inside the ResponsesAdminInvalidAvatarURL as a ErrorsInvalidAvatarURL
 func (t ResponsesAdminInvalidAvatarURL) AsErrorsInvalidAvatarURL() (ErrorsInvalidAvatarURL, error) {
 	var body ErrorsInvalidAvatarURL
@@ -1321,15 +2630,165 @@ func (t ResponsesAdminUserBadRequest) AsErrorsInvalidPubKey() (ErrorsInvalidPubK
 	return body, err
 }
 
-// FromErrorsInvalidPubKey overwrites any union data inside the ResponsesAdminUserBadRequest as the provided ErrorsInvalidPubKey
-func (t *ResponsesAdminUserBadRequest) FromErrorsInvalidPubKey(v ErrorsInvalidPubKey) error {
+// FromErrorsInvalidPubKey overwrites any union data inside the ResponsesAdminUserBadRequest as the provided ErrorsInvalidPubKey
+func (t *ResponsesAdminUserBadRequest) FromErrorsInvalidPubKey(v ErrorsInvalidPubKey) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsInvalidPubKey performs a merge with any union data inside the ResponsesAdminUserBadRequest, using the provided ErrorsInvalidPubKey
+func (t *ResponsesAdminUserBadRequest) MergeErrorsInvalidPubKey(v ErrorsInvalidPubKey) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsErrorsInvalidPaymail returns the union data inside the ResponsesAdminUserBadRequest as a ErrorsInvalidPaymail
+func (t ResponsesAdminUserBadRequest) AsErrorsInvalidPaymail() (ErrorsInvalidPaymail, error) {
+	var body ErrorsInvalidPaymail
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsInvalidPaymail overwrites any union data inside the ResponsesAdminUserBadRequest as the provided ErrorsInvalidPaymail
+func (t *ResponsesAdminUserBadRequest) FromErrorsInvalidPaymail(v ErrorsInvalidPaymail) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsInvalidPaymail performs a merge with any union data inside the ResponsesAdminUserBadRequest, using the provided ErrorsInvalidPaymail
+func (t *ResponsesAdminUserBadRequest) MergeErrorsInvalidPaymail(v ErrorsInvalidPaymail) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsErrorsPaymailInconsistent returns the union data inside the ResponsesAdminUserBadRequest as a ErrorsPaymailInconsistent
+func (t ResponsesAdminUserBadRequest) AsErrorsPaymailInconsistent() (ErrorsPaymailInconsistent, error) {
+	var body ErrorsPaymailInconsistent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsPaymailInconsistent overwrites any union data inside the ResponsesAdminUserBadRequest as the provided ErrorsPaymailInconsistent
+func (t *ResponsesAdminUserBadRequest) FromErrorsPaymailInconsistent(v ErrorsPaymailInconsistent) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsPaymailInconsistent performs a merge with any union data inside the ResponsesAdminUserBadRequest, using the provided ErrorsPaymailInconsistent
+func (t *ResponsesAdminUserBadRequest) MergeErrorsPaymailInconsistent(v ErrorsPaymailInconsistent) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsErrorsInvalidDomain returns the union data inside the ResponsesAdminUserBadRequest as a ErrorsInvalidDomain
+func (t ResponsesAdminUserBadRequest) AsErrorsInvalidDomain() (ErrorsInvalidDomain, error) {
+	var body ErrorsInvalidDomain
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsInvalidDomain overwrites any union data inside the ResponsesAdminUserBadRequest as the provided ErrorsInvalidDomain
+func (t *ResponsesAdminUserBadRequest) FromErrorsInvalidDomain(v ErrorsInvalidDomain) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsInvalidDomain performs a merge with any union data inside the ResponsesAdminUserBadRequest, using the provided ErrorsInvalidDomain
+func (t *ResponsesAdminUserBadRequest) MergeErrorsInvalidDomain(v ErrorsInvalidDomain) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ResponsesAdminUserBadRequest) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ResponsesAdminUserBadRequest) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsErrorsContactInvalidStatus returns the union data inside the ResponsesContactUnprocessable as a ErrorsContactInvalidStatus
+func (t ResponsesContactUnprocessable) AsErrorsContactInvalidStatus() (ErrorsContactInvalidStatus, error) {
+	var body ErrorsContactInvalidStatus
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsContactInvalidStatus overwrites any union data inside the ResponsesContactUnprocessable as the provided ErrorsContactInvalidStatus
+func (t *ResponsesContactUnprocessable) FromErrorsContactInvalidStatus(v ErrorsContactInvalidStatus) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsContactInvalidStatus performs a merge with any union data inside the ResponsesContactUnprocessable, using the provided ErrorsContactInvalidStatus
+func (t *ResponsesContactUnprocessable) MergeErrorsContactInvalidStatus(v ErrorsContactInvalidStatus) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ResponsesContactUnprocessable) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ResponsesContactUnprocessable) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsErrorsCannotBindRequest returns the union data inside the ResponsesCreateInvoiceBadRequest as a ErrorsCannotBindRequest
+func (t ResponsesCreateInvoiceBadRequest) AsErrorsCannotBindRequest() (ErrorsCannotBindRequest, error) {
+	var body ErrorsCannotBindRequest
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsCannotBindRequest overwrites any union data inside the ResponsesCreateInvoiceBadRequest as the provided ErrorsCannotBindRequest
+func (t *ResponsesCreateInvoiceBadRequest) FromErrorsCannotBindRequest(v ErrorsCannotBindRequest) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsInvalidPubKey performs a merge with any union data inside the ResponsesAdminUserBadRequest, using the provided ErrorsInvalidPubKey
-func (t *ResponsesAdminUserBadRequest) MergeErrorsInvalidPubKey(v ErrorsInvalidPubKey) error {
+// MergeErrorsCannotBindRequest performs a merge with any union data inside the ResponsesCreateInvoiceBadRequest, using the provided ErrorsCannotBindRequest
+func (t *ResponsesCreateInvoiceBadRequest) MergeErrorsCannotBindRequest(v ErrorsCannotBindRequest) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1340,22 +2799,22 @@ func (t *ResponsesAdminUserBadRequest) MergeErrorsInvalidPubKey(v ErrorsInvalidP
 	return err
 }
 
-// AsErrorsInvalidPaymail returns the union data inside the ResponsesAdminUserBadRequest as a ErrorsInvalidPaymail
-func (t ResponsesAdminUserBadRequest) AsErrorsInvalidPaymail() (ErrorsInvalidPaymail, error) {
-	var body ErrorsInvalidPaymail
+// AsErrorsInvalidInvoiceAmount returns the union data inside the ResponsesCreateInvoiceBadRequest as a ErrorsInvalidInvoiceAmount
+func (t ResponsesCreateInvoiceBadRequest) AsErrorsInvalidInvoiceAmount() (ErrorsInvalidInvoiceAmount, error) {
+	var body ErrorsInvalidInvoiceAmount
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsInvalidPaymail overwrites any union data inside the ResponsesAdminUserBadRequest as the provided ErrorsInvalidPaymail
-func (t *ResponsesAdminUserBadRequest) FromErrorsInvalidPaymail(v ErrorsInvalidPaymail) error {
+// FromErrorsInvalidInvoiceAmount overwrites any union data inside the ResponsesCreateInvoiceBadRequest as the provided ErrorsInvalidInvoiceAmount
+func (t *ResponsesCreateInvoiceBadRequest) FromErrorsInvalidInvoiceAmount(v ErrorsInvalidInvoiceAmount) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsInvalidPaymail performs a merge with any union data inside the ResponsesAdminUserBadRequest, using the provided ErrorsInvalidPaymail
-func (t *ResponsesAdminUserBadRequest) MergeErrorsInvalidPaymail(v ErrorsInvalidPaymail) error {
+// MergeErrorsInvalidInvoiceAmount performs a merge with any union data inside the ResponsesCreateInvoiceBadRequest, using the provided ErrorsInvalidInvoiceAmount
+func (t *ResponsesCreateInvoiceBadRequest) MergeErrorsInvalidInvoiceAmount(v ErrorsInvalidInvoiceAmount) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1366,22 +2825,22 @@ func (t *ResponsesAdminUserBadRequest) MergeErrorsInvalidPaymail(v ErrorsInvalid
 	return err
 }
 
-// AsErrorsPaymailInconsistent returns the union data inside the ResponsesAdminUserBadRequest as a ErrorsPaymailInconsistent
-func (t ResponsesAdminUserBadRequest) AsErrorsPaymailInconsistent() (ErrorsPaymailInconsistent, error) {
-	var body ErrorsPaymailInconsistent
+// AsErrorsInvalidInvoiceExpiry returns the union data inside the ResponsesCreateInvoiceBadRequest as a ErrorsInvalidInvoiceExpiry
+func (t ResponsesCreateInvoiceBadRequest) AsErrorsInvalidInvoiceExpiry() (ErrorsInvalidInvoiceExpiry, error) {
+	var body ErrorsInvalidInvoiceExpiry
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsPaymailInconsistent overwrites any union data inside the ResponsesAdminUserBadRequest as the provided ErrorsPaymailInconsistent
-func (t *ResponsesAdminUserBadRequest) FromErrorsPaymailInconsistent(v ErrorsPaymailInconsistent) error {
+// FromErrorsInvalidInvoiceExpiry overwrites any union data inside the ResponsesCreateInvoiceBadRequest as the provided ErrorsInvalidInvoiceExpiry
+func (t *ResponsesCreateInvoiceBadRequest) FromErrorsInvalidInvoiceExpiry(v ErrorsInvalidInvoiceExpiry) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsPaymailInconsistent performs a merge with any union data inside the ResponsesAdminUserBadRequest, using the provided ErrorsPaymailInconsistent
-func (t *ResponsesAdminUserBadRequest) MergeErrorsPaymailInconsistent(v ErrorsPaymailInconsistent) error {
+// MergeErrorsInvalidInvoiceExpiry performs a merge with any union data inside the ResponsesCreateInvoiceBadRequest, using the provided ErrorsInvalidInvoiceExpiry
+func (t *ResponsesCreateInvoiceBadRequest) MergeErrorsInvalidInvoiceExpiry(v ErrorsInvalidInvoiceExpiry) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1392,22 +2851,22 @@ func (t *ResponsesAdminUserBadRequest) MergeErrorsPaymailInconsistent(v ErrorsPa
 	return err
 }
 
-// AsErrorsInvalidDomain returns the union data inside the ResponsesAdminUserBadRequest as a ErrorsInvalidDomain
-func (t ResponsesAdminUserBadRequest) AsErrorsInvalidDomain() (ErrorsInvalidDomain, error) {
-	var body ErrorsInvalidDomain
+// AsErrorsInvalidInvoicePaymail returns the union data inside the ResponsesCreateInvoiceBadRequest as a ErrorsInvalidInvoicePaymail
+func (t ResponsesCreateInvoiceBadRequest) AsErrorsInvalidInvoicePaymail() (ErrorsInvalidInvoicePaymail, error) {
+	var body ErrorsInvalidInvoicePaymail
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsInvalidDomain overwrites any union data inside the ResponsesAdminUserBadRequest as the provided ErrorsInvalidDomain
-func (t *ResponsesAdminUserBadRequest) FromErrorsInvalidDomain(v ErrorsInvalidDomain) error {
+// FromErrorsInvalidInvoicePaymail overwrites any union data inside the ResponsesCreateInvoiceBadRequest as the provided ErrorsInvalidInvoicePaymail
+func (t *ResponsesCreateInvoiceBadRequest) FromErrorsInvalidInvoicePaymail(v ErrorsInvalidInvoicePaymail) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsInvalidDomain performs a merge with any union data inside the ResponsesAdminUserBadRequest, using the provided ErrorsInvalidDomain
-func (t *ResponsesAdminUserBadRequest) MergeErrorsInvalidDomain(v ErrorsInvalidDomain) error {
+// MergeErrorsInvalidInvoicePaymail performs a merge with any union data inside the ResponsesCreateInvoiceBadRequest, using the provided ErrorsInvalidInvoicePaymail
+func (t *ResponsesCreateInvoiceBadRequest) MergeErrorsInvalidInvoicePaymail(v ErrorsInvalidInvoicePaymail) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1418,12 +2877,12 @@ func (t *ResponsesAdminUserBadRequest) MergeErrorsInvalidDomain(v ErrorsInvalidD
 	return err
 }
 
-func (t ResponsesAdminUserBadRequest) MarshalJSON() ([]byte, error) {
+func (t ResponsesCreateInvoiceBadRequest) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
 }
 
-func (t *ResponsesAdminUserBadRequest) UnmarshalJSON(b []byte) error {
+func (t *ResponsesCreateInvoiceBadRequest) UnmarshalJSON(b []byte) error {
 	err := t.union.UnmarshalJSON(b)
 	return err
 }
@@ -1620,6 +3079,32 @@ func (t *ResponsesCreateTransactionOutlineUnprocessable) MergeErrorsTxOutlineUse
 	return err
 }
 
+// AsErrorsContactRejected returns the union data inside the ResponsesCreateTransactionOutlineUnprocessable as a ErrorsContactRejected
+func (t ResponsesCreateTransactionOutlineUnprocessable) AsErrorsContactRejected() (ErrorsContactRejected, error) {
+	var body ErrorsContactRejected
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsContactRejected overwrites any union data inside the ResponsesCreateTransactionOutlineUnprocessable as the provided ErrorsContactRejected
+func (t *ResponsesCreateTransactionOutlineUnprocessable) FromErrorsContactRejected(v ErrorsContactRejected) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsContactRejected performs a merge with any union data inside the ResponsesCreateTransactionOutlineUnprocessable, using the provided ErrorsContactRejected
+func (t *ResponsesCreateTransactionOutlineUnprocessable) MergeErrorsContactRejected(v ErrorsContactRejected) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t ResponsesCreateTransactionOutlineUnprocessable) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -1630,6 +3115,42 @@ func (t *ResponsesCreateTransactionOutlineUnprocessable) UnmarshalJSON(b []byte)
 	return err
 }
 
+// AsErrorsContactNotFound returns the union data inside the ResponsesGetContactNotFound as a ErrorsContactNotFound
+func (t ResponsesGetContactNotFound) AsErrorsContactNotFound() (ErrorsContactNotFound, error) {
+	var body ErrorsContactNotFound
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsContactNotFound overwrites any union data inside the ResponsesGetContactNotFound as the provided ErrorsContactNotFound
+func (t *ResponsesGetContactNotFound) FromErrorsContactNotFound(v ErrorsContactNotFound) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsContactNotFound performs a merge with any union data inside the ResponsesGetContactNotFound, using the provided ErrorsContactNotFound
+func (t *ResponsesGetContactNotFound) MergeErrorsContactNotFound(v ErrorsContactNotFound) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ResponsesGetContactNotFound) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ResponsesGetContactNotFound) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
 // AsErrorsDataNotFound returns the union data inside the ResponsesGetDataNotFound as a ErrorsDataNotFound
 func (t ResponsesGetDataNotFound) AsErrorsDataNotFound() (ErrorsDataNotFound, error) {
 	var body ErrorsDataNotFound
@@ -1666,6 +3187,42 @@ func (t *ResponsesGetDataNotFound) UnmarshalJSON(b []byte) error {
 	return err
 }
 
+// AsErrorsInvoiceNotFound returns the union data inside the ResponsesGetInvoiceNotFound as a ErrorsInvoiceNotFound
+func (t ResponsesGetInvoiceNotFound) AsErrorsInvoiceNotFound() (ErrorsInvoiceNotFound, error) {
+	var body ErrorsInvoiceNotFound
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsInvoiceNotFound overwrites any union data inside the ResponsesGetInvoiceNotFound as the provided ErrorsInvoiceNotFound
+func (t *ResponsesGetInvoiceNotFound) FromErrorsInvoiceNotFound(v ErrorsInvoiceNotFound) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsInvoiceNotFound performs a merge with any union data inside the ResponsesGetInvoiceNotFound, using the provided ErrorsInvoiceNotFound
+func (t *ResponsesGetInvoiceNotFound) MergeErrorsInvoiceNotFound(v ErrorsInvoiceNotFound) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ResponsesGetInvoiceNotFound) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ResponsesGetInvoiceNotFound) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
 // AsErrorsBHSUnreachable returns the union data inside the ResponsesGetMerklerootsInternalServerError as a ErrorsBHSUnreachable
 func (t ResponsesGetMerklerootsInternalServerError) AsErrorsBHSUnreachable() (ErrorsBHSUnreachable, error) {
 	var body ErrorsBHSUnreachable
@@ -1858,6 +3415,42 @@ func (t *ResponsesGetMerklerootsInternalServerError) UnmarshalJSON(b []byte) err
 	return err
 }
 
+// AsErrorsTransactionNotFound returns the union data inside the ResponsesGetTransactionNotFound as a ErrorsTransactionNotFound
+func (t ResponsesGetTransactionNotFound) AsErrorsTransactionNotFound() (ErrorsTransactionNotFound, error) {
+	var body ErrorsTransactionNotFound
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsTransactionNotFound overwrites any union data inside the ResponsesGetTransactionNotFound as the provided ErrorsTransactionNotFound
+func (t *ResponsesGetTransactionNotFound) FromErrorsTransactionNotFound(v ErrorsTransactionNotFound) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsTransactionNotFound performs a merge with any union data inside the ResponsesGetTransactionNotFound, using the provided ErrorsTransactionNotFound
+func (t *ResponsesGetTransactionNotFound) MergeErrorsTransactionNotFound(v ErrorsTransactionNotFound) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ResponsesGetTransactionNotFound) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ResponsesGetTransactionNotFound) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
 // AsErrorsInvalidDataID returns the union data inside the ResponsesRecordTransactionBadRequest as a ErrorsInvalidDataID
 func (t ResponsesRecordTransactionBadRequest) AsErrorsInvalidDataID() (ErrorsInvalidDataID, error) {
 	var body ErrorsInvalidDataID
@@ -2085,3 +3678,173 @@ func (t *ResponsesRecordTransactionInternalServerError) UnmarshalJSON(b []byte)
 	err := t.union.UnmarshalJSON(b)
 	return err
 }
+
+// AsErrorsInvalidDataSearchFilter returns the union data inside the ResponsesSearchDataBadRequest as a ErrorsInvalidDataSearchFilter
+func (t ResponsesSearchDataBadRequest) AsErrorsInvalidDataSearchFilter() (ErrorsInvalidDataSearchFilter, error) {
+	var body ErrorsInvalidDataSearchFilter
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsInvalidDataSearchFilter overwrites any union data inside the ResponsesSearchDataBadRequest as the provided ErrorsInvalidDataSearchFilter
+func (t *ResponsesSearchDataBadRequest) FromErrorsInvalidDataSearchFilter(v ErrorsInvalidDataSearchFilter) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsInvalidDataSearchFilter performs a merge with any union data inside the ResponsesSearchDataBadRequest, using the provided ErrorsInvalidDataSearchFilter
+func (t *ResponsesSearchDataBadRequest) MergeErrorsInvalidDataSearchFilter(v ErrorsInvalidDataSearchFilter) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ResponsesSearchDataBadRequest) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ResponsesSearchDataBadRequest) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsErrorsCannotBindRequest returns the union data inside the ResponsesUpdatePaymailProfileBadRequest as a ErrorsCannotBindRequest
+func (t ResponsesUpdatePaymailProfileBadRequest) AsErrorsCannotBindRequest() (ErrorsCannotBindRequest, error) {
+	var body ErrorsCannotBindRequest
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsCannotBindRequest overwrites any union data inside the ResponsesUpdatePaymailProfileBadRequest as the provided ErrorsCannotBindRequest
+func (t *ResponsesUpdatePaymailProfileBadRequest) FromErrorsCannotBindRequest(v ErrorsCannotBindRequest) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsCannotBindRequest performs a merge with any union data inside the ResponsesUpdatePaymailProfileBadRequest, using the provided ErrorsCannotBindRequest
+func (t *ResponsesUpdatePaymailProfileBadRequest) MergeErrorsCannotBindRequest(v ErrorsCannotBindRequest) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsErrorsInvalidPaymailAddress returns the union data inside the ResponsesUpdatePaymailProfileBadRequest as a ErrorsInvalidPaymailAddress
+func (t ResponsesUpdatePaymailProfileBadRequest) AsErrorsInvalidPaymailAddress() (ErrorsInvalidPaymailAddress, error) {
+	var body ErrorsInvalidPaymailAddress
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsInvalidPaymailAddress overwrites any union data inside the ResponsesUpdatePaymailProfileBadRequest as the provided ErrorsInvalidPaymailAddress
+func (t *ResponsesUpdatePaymailProfileBadRequest) FromErrorsInvalidPaymailAddress(v ErrorsInvalidPaymailAddress) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsInvalidPaymailAddress performs a merge with any union data inside the ResponsesUpdatePaymailProfileBadRequest, using the provided ErrorsInvalidPaymailAddress
+func (t *ResponsesUpdatePaymailProfileBadRequest) MergeErrorsInvalidPaymailAddress(v ErrorsInvalidPaymailAddress) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ResponsesUpdatePaymailProfileBadRequest) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ResponsesUpdatePaymailProfileBadRequest) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsErrorsPaymailNotFound returns the union data inside the ResponsesUpdatePaymailProfileNotFound as a ErrorsPaymailNotFound
+func (t ResponsesUpdatePaymailProfileNotFound) AsErrorsPaymailNotFound() (ErrorsPaymailNotFound, error) {
+	var body ErrorsPaymailNotFound
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsPaymailNotFound overwrites any union data inside the ResponsesUpdatePaymailProfileNotFound as the provided ErrorsPaymailNotFound
+func (t *ResponsesUpdatePaymailProfileNotFound) FromErrorsPaymailNotFound(v ErrorsPaymailNotFound) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsPaymailNotFound performs a merge with any union data inside the ResponsesUpdatePaymailProfileNotFound, using the provided ErrorsPaymailNotFound
+func (t *ResponsesUpdatePaymailProfileNotFound) MergeErrorsPaymailNotFound(v ErrorsPaymailNotFound) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ResponsesUpdatePaymailProfileNotFound) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ResponsesUpdatePaymailProfileNotFound) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsErrorsPaymailInvalidAvatarURL returns the union data inside the ResponsesUpdatePaymailProfileUnprocessable as a ErrorsPaymailInvalidAvatarURL
+func (t ResponsesUpdatePaymailProfileUnprocessable) AsErrorsPaymailInvalidAvatarURL() (ErrorsPaymailInvalidAvatarURL, error) {
+	var body ErrorsPaymailInvalidAvatarURL
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsPaymailInvalidAvatarURL overwrites any union data inside the ResponsesUpdatePaymailProfileUnprocessable as the provided ErrorsPaymailInvalidAvatarURL
+func (t *ResponsesUpdatePaymailProfileUnprocessable) FromErrorsPaymailInvalidAvatarURL(v ErrorsPaymailInvalidAvatarURL) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsPaymailInvalidAvatarURL performs a merge with any union data inside the ResponsesUpdatePaymailProfileUnprocessable, using the provided ErrorsPaymailInvalidAvatarURL
+func (t *ResponsesUpdatePaymailProfileUnprocessable) MergeErrorsPaymailInvalidAvatarURL(v ErrorsPaymailInvalidAvatarURL) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ResponsesUpdatePaymailProfileUnprocessable) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ResponsesUpdatePaymailProfileUnprocessable) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
@@ -22,24 +22,70 @@ const (
 	XPubAuthScopes = "XPubAuth.Scopes"
 )
 
+// Defines values for ModelsAIPProtocolProtocol.
+const (
+	ModelsAIPProtocolProtocolAIP ModelsAIPProtocolProtocol = "AIP"
+)
+
+// Defines values for ModelsAddedContactStatus.
+const (
+	ModelsAddedContactStatusAwaiting    ModelsAddedContactStatus = "awaiting"
+	ModelsAddedContactStatusConfirmed   ModelsAddedContactStatus = "confirmed"
+	ModelsAddedContactStatusRejected    ModelsAddedContactStatus = "rejected"
+	ModelsAddedContactStatusUnconfirmed ModelsAddedContactStatus = "unconfirmed"
+)
+
+// Defines values for ModelsAddressAnnotationBucket.
+const (
+	ModelsAddressAnnotationBucketBsv      ModelsAddressAnnotationBucket = "bsv"
+	ModelsAddressAnnotationBucketOrdinals ModelsAddressAnnotationBucket = "ordinals"
+	ModelsAddressAnnotationBucketTokens   ModelsAddressAnnotationBucket = "tokens"
+)
+
 // Defines values for ModelsAnnotatedTransactionOutlineFormat.
 const (
 	ModelsAnnotatedTransactionOutlineFormatBEEF ModelsAnnotatedTransactionOutlineFormat = "BEEF"
 	ModelsAnnotatedTransactionOutlineFormatRAW  ModelsAnnotatedTransactionOutlineFormat = "RAW"
 )
 
+// Defines values for ModelsBProtocolProtocol.
+const (
+	B ModelsBProtocolProtocol = "B"
+)
+
+// Defines values for ModelsContactStatus.
+const (
+	ModelsContactStatusAwaiting    ModelsContactStatus = "awaiting"
+	ModelsContactStatusConfirmed   ModelsContactStatus = "confirmed"
+	ModelsContactStatusRejected    ModelsContactStatus = "rejected"
+	ModelsContactStatusUnconfirmed ModelsContactStatus = "unconfirmed"
+)
+
 // Defines values for ModelsDataAnnotationBucket.
 const (
 	Data ModelsDataAnnotationBucket = "data"
 )
 
+// Defines values for ModelsInvoiceStatus.
+const (
+	Expired   ModelsInvoiceStatus = "expired"
+	Paid      ModelsInvoiceStatus = "paid"
+	Pending   ModelsInvoiceStatus = "pending"
+	Underpaid ModelsInvoiceStatus = "underpaid"
+)
+
+// Defines values for ModelsMAPProtocolProtocol.
+const (
+	MAP ModelsMAPProtocolProtocol = "MAP"
+)
+
 // Defines values for ModelsOperationTxStatus.
 const (
-	BROADCASTED ModelsOperationTxStatus = "BROADCASTED"
-	CREATED     ModelsOperationTxStatus = "CREATED"
-	MINED       ModelsOperationTxStatus = "MINED"
-	PROBLEMATIC ModelsOperationTxStatus = "PROBLEMATIC"
-	REVERTED    ModelsOperationTxStatus = "REVERTED"
+	ModelsOperationTxStatusBROADCASTED ModelsOperationTxStatus = "BROADCASTED"
+	ModelsOperationTxStatusCREATED     ModelsOperationTxStatus = "CREATED"
+	ModelsOperationTxStatusMINED       ModelsOperationTxStatus = "MINED"
+	ModelsOperationTxStatusPROBLEMATIC ModelsOperationTxStatus = "PROBLEMATIC"
+	ModelsOperationTxStatusREVERTED    ModelsOperationTxStatus = "REVERTED"
 )
 
 // Defines values for ModelsOperationType.
@@ -50,12 +96,30 @@ const (
 
 // Defines values for ModelsOutputAnnotationBucket.
 const (
-	ModelsOutputAnnotationBucketBsv ModelsOutputAnnotationBucket = "bsv"
+	ModelsOutputAnnotationBucketBsv      ModelsOutputAnnotationBucket = "bsv"
+	ModelsOutputAnnotationBucketOrdinals ModelsOutputAnnotationBucket = "ordinals"
+	ModelsOutputAnnotationBucketTokens   ModelsOutputAnnotationBucket = "tokens"
 )
 
 // Defines values for ModelsPaymailAnnotationBucket.
 const (
-	ModelsPaymailAnnotationBucketBsv ModelsPaymailAnnotationBucket = "bsv"
+	Bsv      ModelsPaymailAnnotationBucket = "bsv"
+	Ordinals ModelsPaymailAnnotationBucket = "ordinals"
+	Tokens   ModelsPaymailAnnotationBucket = "tokens"
+)
+
+// Defines values for ModelsPaymailDestinationRequest.
+const (
+	AddressResolution ModelsPaymailDestinationRequest = "address-resolution"
+	Invoice           ModelsPaymailDestinationRequest = "invoice"
+	P2pDestination    ModelsPaymailDestinationRequest = "p2p-destination"
+)
+
+// Defines values for ModelsRecordedOutlineTxStatus.
+const (
+	ModelsRecordedOutlineTxStatusBROADCASTED ModelsRecordedOutlineTxStatus = "BROADCASTED"
+	ModelsRecordedOutlineTxStatusCREATED     ModelsRecordedOutlineTxStatus = "CREATED"
+	ModelsRecordedOutlineTxStatusMINED       ModelsRecordedOutlineTxStatus = "MINED"
 )
 
 // Defines values for ModelsTransactionHexFormat.
@@ -64,6 +128,35 @@ const (
 	ModelsTransactionHexFormatRAW  ModelsTransactionHexFormat = "RAW"
 )
 
+// Defines values for ModelsTransactionStatusTxStatus.
+const (
+	BROADCASTED ModelsTransactionStatusTxStatus = "BROADCASTED"
+	CREATED     ModelsTransactionStatusTxStatus = "CREATED"
+	MINED       ModelsTransactionStatusTxStatus = "MINED"
+	PROBLEMATIC ModelsTransactionStatusTxStatus = "PROBLEMATIC"
+	REVERTED    ModelsTransactionStatusTxStatus = "REVERTED"
+)
+
+// Defines values for RequestsAddressOutputSpecificationType.
+const (
+	Address RequestsAddressOutputSpecificationType = "address"
+)
+
+// Defines values for RequestsBitcomOutputSpecificationType.
+const (
+	Bitcom RequestsBitcomOutputSpecificationType = "bitcom"
+)
+
+// Defines values for RequestsContactOutputSpecificationType.
+const (
+	Contact RequestsContactOutputSpecificationType = "contact"
+)
+
+// Defines values for RequestsInscriptionOutputSpecificationType.
+const (
+	Inscription RequestsInscriptionOutputSpecificationType = "inscription"
+)
+
 // Defines values for RequestsOpReturnOutputSpecificationDataType.
 const (
 	Hexes   RequestsOpReturnOutputSpecificationDataType = "hexes"
@@ -75,17 +168,39 @@ const (
 	OpReturn RequestsOpReturnOutputSpecificationType = "op_return"
 )
 
+// Defines values for RequestsOrdinalTransferOutputSpecificationType.
+const (
+	OrdinalTransfer RequestsOrdinalTransferOutputSpecificationType = "ordinal_transfer"
+)
+
 // Defines values for RequestsPaymailOutputSpecificationType.
 const (
 	Paymail RequestsPaymailOutputSpecificationType = "paymail"
 )
 
+// Defines values for RequestsScriptOutputSpecificationType.
+const (
+	Script RequestsScriptOutputSpecificationType = "script"
+)
+
+// Defines values for RequestsTokenTransferOutputSpecificationType.
+const (
+	TokenTransfer RequestsTokenTransferOutputSpecificationType = "token_transfer"
+)
+
 // Defines values for RequestsTransactionOutlineFormat.
 const (
 	BEEF RequestsTransactionOutlineFormat = "BEEF"
 	RAW  RequestsTransactionOutlineFormat = "RAW"
 )
 
+// Defines values for SearchDataParamsProtocol.
+const (
+	SearchDataParamsProtocolAIP SearchDataParamsProtocol = "AIP"
+	SearchDataParamsProtocolB   SearchDataParamsProtocol = "B"
+	SearchDataParamsProtocolMAP SearchDataParamsProtocol = "MAP"
+)
+
 // Defines values for CreateTransactionOutlineParamsFormat.
 const (
 	Beef CreateTransactionOutlineParamsFormat = "beef"
@@ -174,6 +289,36 @@ type ErrorsCannotBindRequest struct {
 	Message interface{} `json:"message"`
 }
 
+// ErrorsContactGettingPKI defines model for errors_ContactGettingPKI.
+type ErrorsContactGettingPKI struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
+// ErrorsContactInvalidStatus defines model for errors_ContactInvalidStatus.
+type ErrorsContactInvalidStatus struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
+// ErrorsContactNotFound defines model for errors_ContactNotFound.
+type ErrorsContactNotFound struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
+// ErrorsContactRejected defines model for errors_ContactRejected.
+type ErrorsContactRejected struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
+// ErrorsContactToSelf defines model for errors_ContactToSelf.
+type ErrorsContactToSelf struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsCreatingUser defines model for errors_CreatingUser.
 type ErrorsCreatingUser struct {
 	Code    interface{} `json:"code"`
@@ -216,30 +361,84 @@ type ErrorsInvalidBatchSize struct {
 	Message interface{} `json:"message"`
 }
 
+// ErrorsInvalidContactPaymail defines model for errors_InvalidContactPaymail.
+type ErrorsInvalidContactPaymail struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsInvalidDataID defines model for errors_InvalidDataID.
 type ErrorsInvalidDataID struct {
 	Code    interface{} `json:"code"`
 	Message interface{} `json:"message"`
 }
 
+// ErrorsInvalidDataSearchFilter defines model for errors_InvalidDataSearchFilter.
+type ErrorsInvalidDataSearchFilter struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsInvalidDomain defines model for errors_InvalidDomain.
 type ErrorsInvalidDomain struct {
 	Code    interface{} `json:"code"`
 	Message interface{} `json:"message"`
 }
 
+// ErrorsInvalidInvoiceAmount defines model for errors_InvalidInvoiceAmount.
+type ErrorsInvalidInvoiceAmount struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
+// ErrorsInvalidInvoiceExpiry defines model for errors_InvalidInvoiceExpiry.
+type ErrorsInvalidInvoiceExpiry struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
+// ErrorsInvalidInvoicePaymail defines model for errors_InvalidInvoicePaymail.
+type ErrorsInvalidInvoicePaymail struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsInvalidPaymail defines model for errors_InvalidPaymail.
 type ErrorsInvalidPaymail struct {
 	Code    interface{} `json:"code"`
 	Message interface{} `json:"message"`
 }
 
+// ErrorsInvalidPaymailAddress defines model for errors_InvalidPaymailAddress.
+type ErrorsInvalidPaymailAddress struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
+// ErrorsInvalidPaymailDestinationLookup defines model for errors_InvalidPaymailDestinationLookup.
+type ErrorsInvalidPaymailDestinationLookup struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsInvalidPubKey defines model for errors_InvalidPubKey.
 type ErrorsInvalidPubKey struct {
 	Code    interface{} `json:"code"`
 	Message interface{} `json:"message"`
 }
 
+// ErrorsInvalidRequesterPaymail defines model for errors_InvalidRequesterPaymail.
+type ErrorsInvalidRequesterPaymail struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
+// ErrorsInvoiceNotFound defines model for errors_InvoiceNotFound.
+type ErrorsInvoiceNotFound struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsMerkleRootNotFound defines model for errors_MerkleRootNotFound.
 type ErrorsMerkleRootNotFound struct {
 	Code    interface{} `json:"code"`
@@ -258,12 +457,30 @@ type ErrorsNoOperations struct {
 	Message interface{} `json:"message"`
 }
 
+// ErrorsPaymailDestinationNotFound defines model for errors_PaymailDestinationNotFound.
+type ErrorsPaymailDestinationNotFound struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsPaymailInconsistent defines model for errors_PaymailInconsistent.
 type ErrorsPaymailInconsistent struct {
 	Code    interface{} `json:"code"`
 	Message interface{} `json:"message"`
 }
 
+// ErrorsPaymailInvalidAvatarURL defines model for errors_PaymailInvalidAvatarURL.
+type ErrorsPaymailInvalidAvatarURL struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
+// ErrorsPaymailNotFound defines model for errors_PaymailNotFound.
+type ErrorsPaymailNotFound struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsSchema defines model for errors_Schema.
 type ErrorsSchema struct {
 	// Code Error code
@@ -273,6 +490,12 @@ type ErrorsSchema struct {
 	Message string `json:"message"`
 }
 
+// ErrorsTransactionNotFound defines model for errors_TransactionNotFound.
+type ErrorsTransactionNotFound struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsTxBroadcast defines model for errors_TxBroadcast.
 type ErrorsTxBroadcast struct {
 	Code    interface{} `json:"code"`
@@ -344,6 +567,71 @@ type ErrorsUserAuthorization struct {
 	union json.RawMessage
 }
 
+// ModelsAIPProtocol Author Identity Protocol signature of the data preceding it in the output. <br>
+// The signed message is OP_RETURN (0x6a) followed by all the pushes before the AIP prefix, including "|" separators.
+type ModelsAIPProtocol struct {
+	Address   string                    `json:"address"`
+	Algorithm string                    `json:"algorithm"`
+	Protocol  ModelsAIPProtocolProtocol `json:"protocol"`
+
+	// Signature Base64 encoded Bitcoin Signed Message signature
+	Signature string `json:"signature"`
+
+	// Valid Whether the signature is valid (only in responses)
+	Valid *bool `json:"valid,omitempty"`
+}
+
+// ModelsAIPProtocolProtocol defines model for ModelsAIPProtocol.Protocol.
+type ModelsAIPProtocolProtocol string
+
+// ModelsAddedContact defines model for models_AddedContact.
+type ModelsAddedContact struct {
+	// CreatedAt Creation date of the contact
+	CreatedAt time.Time `json:"createdAt"`
+
+	// FullName Full name of the contact
+	FullName string `json:"fullName"`
+
+	// Id ID of the contact
+	Id uint `json:"id"`
+
+	// InvitationError Reason why the PIKE invitation could not be delivered (the contact is added anyway)
+	InvitationError *string `json:"invitationError,omitempty"`
+
+	// InvitationSent Whether the PIKE invitation was delivered to the contact's paymail host
+	InvitationSent bool `json:"invitationSent"`
+
+	// Paymail Paymail of the contact
+	Paymail string `json:"paymail"`
+
+	// PubKey PKI of the contact's paymail
+	PubKey string `json:"pubKey"`
+
+	// Status Status of the contact
+	Status ModelsAddedContactStatus `json:"status"`
+
+	// UpdatedAt Last update date of the contact
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ModelsAddedContactStatus Status of the contact
+type ModelsAddedContactStatus string
+
+// ModelsAddressAnnotation defines model for models_AddressAnnotation.
+type ModelsAddressAnnotation struct {
+	Address *ModelsAddressAnnotationDetails `json:"address,omitempty"`
+	Bucket  *ModelsAddressAnnotationBucket  `json:"bucket,omitempty"`
+}
+
+// ModelsAddressAnnotationBucket defines model for ModelsAddressAnnotation.Bucket.
+type ModelsAddressAnnotationBucket string
+
+// ModelsAddressAnnotationDetails defines model for models_AddressAnnotationDetails.
+type ModelsAddressAnnotationDetails struct {
+	// Address Base58 encoded P2PKH address of the receiver
+	Address string `json:"address"`
+}
+
 // ModelsAnnotatedTransactionOutline defines model for models_AnnotatedTransactionOutline.
 type ModelsAnnotatedTransactionOutline struct {
 	Annotations *ModelsOutlineAnnotations `json:"annotations,omitempty"`
@@ -358,6 +646,26 @@ type ModelsAnnotatedTransactionOutline struct {
 // ModelsAnnotatedTransactionOutlineFormat Transaction format
 type ModelsAnnotatedTransactionOutlineFormat string
 
+// ModelsBProtocol File stored with the B:// protocol
+type ModelsBProtocol struct {
+	// Content Base64 encoded content of the file
+	Content []byte `json:"content"`
+
+	// Encoding Encoding of the content, "binary" if not provided
+	Encoding  *string                 `json:"encoding,omitempty"`
+	Filename  *string                 `json:"filename,omitempty"`
+	MediaType string                  `json:"mediaType"`
+	Protocol  ModelsBProtocolProtocol `json:"protocol"`
+}
+
+// ModelsBProtocolProtocol defines model for ModelsBProtocol.Protocol.
+type ModelsBProtocolProtocol string
+
+// ModelsBitcomProtocol defines model for models_BitcomProtocol.
+type ModelsBitcomProtocol struct {
+	union json.RawMessage
+}
+
 // ModelsBucketAnnotation defines model for models_BucketAnnotation.
 type ModelsBucketAnnotation struct {
 	// Bucket Type of bucket where this output should be stored.
@@ -369,6 +677,39 @@ type ModelsChangeAnnotation struct {
 	CustomInstructions *ModelsSPVWalletCustomInstructions `json:"customInstructions,omitempty"`
 }
 
+// ModelsContact defines model for models_Contact.
+type ModelsContact struct {
+	// CreatedAt Creation date of the contact
+	CreatedAt time.Time `json:"createdAt"`
+
+	// FullName Full name of the contact
+	FullName string `json:"fullName"`
+
+	// Id ID of the contact
+	Id uint `json:"id"`
+
+	// Paymail Paymail of the contact
+	Paymail string `json:"paymail"`
+
+	// PubKey PKI of the contact's paymail
+	PubKey string `json:"pubKey"`
+
+	// Status Status of the contact
+	Status ModelsContactStatus `json:"status"`
+
+	// UpdatedAt Last update date of the contact
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ModelsContactStatus Status of the contact
+type ModelsContactStatus string
+
+// ModelsContactsSearchResult defines model for models_ContactsSearchResult.
+type ModelsContactsSearchResult struct {
+	Content []ModelsContact  `json:"content"`
+	Page    ModelsSearchPage `json:"page"`
+}
+
 // ModelsCustomInstructions defines model for models_CustomInstructions.
 type ModelsCustomInstructions struct {
 	union json.RawMessage
@@ -377,10 +718,17 @@ type ModelsCustomInstructions struct {
 // ModelsData defines model for models_Data.
 type ModelsData struct {
 	// Blob Data blob
-	Blob string `json:"blob"`
+	Blob      string    `json:"blob"`
+	CreatedAt time.Time `json:"createdAt"`
 
 	// Id User ID
 	Id string `json:"id"`
+
+	// Protocols Bitcom protocols recognised in the data output
+	Protocols *[]ModelsBitcomProtocol `json:"protocols,omitempty"`
+
+	// Size Size of the data blob in bytes
+	Size int `json:"size"`
 }
 
 // ModelsDataAnnotation defines model for models_DataAnnotation.
@@ -391,6 +739,12 @@ type ModelsDataAnnotation struct {
 // ModelsDataAnnotationBucket defines model for ModelsDataAnnotation.Bucket.
 type ModelsDataAnnotationBucket string
 
+// ModelsDataSearchResult defines model for models_DataSearchResult.
+type ModelsDataSearchResult struct {
+	Content []ModelsData     `json:"content"`
+	Page    ModelsSearchPage `json:"page"`
+}
+
 // ModelsExclusiveStartKeySearchPage defines model for models_ExclusiveStartKeySearchPage.
 type ModelsExclusiveStartKeySearchPage struct {
 	// LastEvaluatedKey Last evaluated key
@@ -420,6 +774,63 @@ type ModelsInputsAnnotations struct {
 	Inputs map[string]ModelsInputAnnotation `json:"inputs"`
 }
 
+// ModelsInvoice defines model for models_Invoice.
+type ModelsInvoice struct {
+	// CreatedAt Creation date of the invoice
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ExpiresAt Expiry of the invoice
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Id ID of the invoice
+	Id uint `json:"id"`
+
+	// Memo Memo presented to the payer
+	Memo *string `json:"memo,omitempty"`
+
+	// PaidAt Date of the payment
+	PaidAt *time.Time `json:"paidAt,omitempty"`
+
+	// PaidSatoshis Amount received with the payment
+	PaidSatoshis uint64 `json:"paidSatoshis"`
+
+	// Paymail Paymail through which the invoice is served
+	Paymail string `json:"paymail"`
+
+	// Reference Single-use reference of the invoice, used by the payer in p2p-invoice-destination and in the p2p transaction
+	Reference string `json:"reference"`
+
+	// Satoshis Requested amount
+	Satoshis uint64 `json:"satoshis"`
+
+	// Status Status of the invoice
+	Status ModelsInvoiceStatus `json:"status"`
+
+	// TxID ID of the transaction paying the invoice
+	TxID *string `json:"txID,omitempty"`
+}
+
+// ModelsInvoiceStatus Status of the invoice
+type ModelsInvoiceStatus string
+
+// ModelsInvoicesSearchResult defines model for models_InvoicesSearchResult.
+type ModelsInvoicesSearchResult struct {
+	Content []ModelsInvoice  `json:"content"`
+	Page    ModelsSearchPage `json:"page"`
+}
+
+// ModelsMAPProtocol Magic Attribute Protocol key/value pairs. <br>
+// The "app" and "type" keys are required when creating outputs; they are written first, followed by the remaining keys in alphabetical order.
+type ModelsMAPProtocol struct {
+	// Command MAP command, only SET is supported when creating outputs
+	Command  *string                   `json:"command,omitempty"`
+	Keys     map[string]string         `json:"keys"`
+	Protocol ModelsMAPProtocolProtocol `json:"protocol"`
+}
+
+// ModelsMAPProtocolProtocol defines model for ModelsMAPProtocol.Protocol.
+type ModelsMAPProtocolProtocol string
+
 // ModelsMerkleRoot defines model for models_MerkleRoot.
 type ModelsMerkleRoot struct {
 	// BlockHeight Block height
@@ -443,6 +854,9 @@ type ModelsOperation struct {
 	// CreatedAt Creation date of operation
 	CreatedAt time.Time `json:"createdAt"`
 
+	// SenderPubKey Public key of the sender verified against the sender's paymail PKI (only for incoming paymail transactions with a verified sender)
+	SenderPubKey *string `json:"senderPubKey,omitempty"`
+
 	// TxID Transaction ID
 	TxID string `json:"txID"`
 
@@ -479,6 +893,7 @@ type ModelsOutlineAnnotations struct {
 
 // ModelsOutputAnnotation defines model for models_OutputAnnotation.
 type ModelsOutputAnnotation struct {
+	Address            *ModelsAddressAnnotationDetails    `json:"address,omitempty"`
 	Bucket             ModelsOutputAnnotationBucket       `json:"bucket"`
 	CustomInstructions *ModelsSPVWalletCustomInstructions `json:"customInstructions,omitempty"`
 	Paymail            *ModelsPaymailAnnotationDetails    `json:"paymail,omitempty"`
@@ -524,12 +939,69 @@ type ModelsPaymailAnnotationDetails struct {
 	Sender string `json:"sender"`
 }
 
+// ModelsPaymailDestination defines model for models_PaymailDestination.
+type ModelsPaymailDestination struct {
+	// Address Address derived for the paymail
+	Address string `json:"address"`
+
+	// CreatedAt Creation date of the destination
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Note Note of the received transaction
+	Note *string `json:"note,omitempty"`
+
+	// Paymail Paymail for which the destination was derived
+	Paymail string `json:"paymail"`
+
+	// Purpose Purpose of the payment (provided in address resolution)
+	Purpose *string `json:"purpose,omitempty"`
+
+	// Reference Reference ID of the destination (as returned by p2p-payment-destination)
+	Reference string `json:"reference"`
+
+	// Request Paymail request which produced the destination
+	Request ModelsPaymailDestinationRequest `json:"request"`
+
+	// RequesterIP IP address of the requester
+	RequesterIP *string `json:"requesterIP,omitempty"`
+
+	// RequesterUserAgent User agent of the requester
+	RequesterUserAgent *string `json:"requesterUserAgent,omitempty"`
+
+	// Satoshis Amount of satoshis requested (or declared by the sender in address resolution)
+	Satoshis uint64 `json:"satoshis"`
+
+	// SenderName Name of the sender (provided in address resolution)
+	SenderName *string `json:"senderName,omitempty"`
+
+	// SenderPaymail Paymail of the sender
+	SenderPaymail *string `json:"senderPaymail,omitempty"`
+
+	// SenderPubKey Public key of the sender verified against the sender's paymail PKI
+	SenderPubKey *string `json:"senderPubKey,omitempty"`
+
+	// TxID ID of the transaction paying to the destination (when received through paymail)
+	TxID *string `json:"txID,omitempty"`
+
+	// Vout Index of the output paying to the destination (when received through paymail)
+	Vout *uint32 `json:"vout,omitempty"`
+}
+
+// ModelsPaymailDestinationRequest Paymail request which produced the destination
+type ModelsPaymailDestinationRequest string
+
 // ModelsRecordedOutline defines model for models_RecordedOutline.
 type ModelsRecordedOutline struct {
 	// TxID ID of the transaction
 	TxID string `json:"txID"`
+
+	// TxStatus Status of the transaction; CREATED means the transaction is queued for broadcasting and its status can be polled with getTransactionStatus
+	TxStatus ModelsRecordedOutlineTxStatus `json:"txStatus"`
 }
 
+// ModelsRecordedOutlineTxStatus Status of the transaction; CREATED means the transaction is queued for broadcasting and its status can be polled with getTransactionStatus
+type ModelsRecordedOutlineTxStatus string
+
 // ModelsSPVWalletCustomInstruction defines model for models_SPVWalletCustomInstruction.
 type ModelsSPVWalletCustomInstruction struct {
 	// Instruction Custom instruction
@@ -563,6 +1035,15 @@ type ModelsSharedConfig struct {
 	PaymailDomains       []string        `json:"paymailDomains"`
 }
 
+// ModelsTokenBalance defines model for models_TokenBalance.
+type ModelsTokenBalance struct {
+	// Amount Amount of the token held by user
+	Amount uint64 `json:"amount"`
+
+	// TokenId ID of the token (outpoint of the deploy+mint inscription of BSV-21 token or redemption address of STAS token)
+	TokenId string `json:"tokenId"`
+}
+
 // ModelsTransactionHex defines model for models_TransactionHex.
 type ModelsTransactionHex struct {
 	// Format Transaction format
@@ -575,6 +1056,24 @@ type ModelsTransactionHex struct {
 // ModelsTransactionHexFormat Transaction format
 type ModelsTransactionHexFormat string
 
+// ModelsTransactionStatus defines model for models_TransactionStatus.
+type ModelsTransactionStatus struct {
+	// BlockHash Block hash of the transaction (when mined)
+	BlockHash *string `json:"blockHash,omitempty"`
+
+	// BlockHeight Block height of the transaction (when mined)
+	BlockHeight *int64 `json:"blockHeight,omitempty"`
+
+	// TxID Transaction ID
+	TxID string `json:"txID"`
+
+	// TxStatus Status of transaction
+	TxStatus ModelsTransactionStatusTxStatus `json:"txStatus"`
+}
+
+// ModelsTransactionStatusTxStatus Status of transaction
+type ModelsTransactionStatusTxStatus string
+
 // ModelsUser defines model for models_User.
 type ModelsUser struct {
 	CreatedAt time.Time       `json:"createdAt"`
@@ -591,6 +1090,18 @@ type ModelsUserDefinedCustomInstructions = string
 type ModelsUserInfo struct {
 	// CurrentBalance Current balance of user
 	CurrentBalance uint64 `json:"currentBalance"`
+
+	// Tokens Balances of BSV-21 and STAS tokens held by user
+	Tokens []ModelsTokenBalance `json:"tokens"`
+}
+
+// RequestsAddContact defines model for requests_AddContact.
+type RequestsAddContact struct {
+	FullName string `json:"fullName"`
+	Paymail  string `json:"paymail"`
+
+	// RequesterPaymail Paymail of the user that will be presented to the contact. If not provided the default paymail of the user is used.
+	RequesterPaymail *string `json:"requesterPaymail,omitempty"`
 }
 
 // RequestsAddPaymail defines model for requests_AddPaymail.
@@ -604,29 +1115,94 @@ type RequestsAddPaymail struct {
 	PublicName *string `json:"publicName,omitempty"`
 }
 
-// RequestsCreateUser defines model for requests_CreateUser.
-type RequestsCreateUser struct {
-	Paymail   *RequestsAddPaymail `json:"paymail,omitempty"`
-	PublicKey string              `json:"publicKey"`
+// RequestsAddressOutputSpecification defines model for requests_AddressOutputSpecification.
+type RequestsAddressOutputSpecification struct {
+	Satoshis uint64 `json:"satoshis"`
+
+	// To Base58 encoded P2PKH address of the receiver (mainnet).
+	To   string                                 `json:"to"`
+	Type RequestsAddressOutputSpecificationType `json:"type"`
 }
 
-// RequestsOpReturnHexesOutput defines model for requests_OpReturnHexesOutput.
-type RequestsOpReturnHexesOutput = []string
+// RequestsAddressOutputSpecificationType defines model for RequestsAddressOutputSpecification.Type.
+type RequestsAddressOutputSpecificationType string
 
-// RequestsOpReturnOutputSpecification defines model for requests_OpReturnOutputSpecification.
-type RequestsOpReturnOutputSpecification struct {
-	Data     RequestsOpReturnOutputSpecification_Data     `json:"data"`
-	DataType *RequestsOpReturnOutputSpecificationDataType `json:"dataType,omitempty"`
-	Type     RequestsOpReturnOutputSpecificationType      `json:"type"`
+// RequestsBitcomOutputSpecification Data output with Bitcom protocols (B://, MAP, AIP) separated by "|".
+type RequestsBitcomOutputSpecification struct {
+	Protocols []ModelsBitcomProtocol                `json:"protocols"`
+	Type      RequestsBitcomOutputSpecificationType `json:"type"`
 }
 
-// RequestsOpReturnOutputSpecification_Data defines model for RequestsOpReturnOutputSpecification.Data.
-type RequestsOpReturnOutputSpecification_Data struct {
-	union json.RawMessage
+// RequestsBitcomOutputSpecificationType defines model for RequestsBitcomOutputSpecification.Type.
+type RequestsBitcomOutputSpecificationType string
+
+// RequestsContactOutputSpecification defines model for requests_ContactOutputSpecification.
+type RequestsContactOutputSpecification struct {
+	ContactId uint    `json:"contactId"`
+	From      *string `json:"from"`
+	Satoshis  uint64  `json:"satoshis"`
+
+	// Splits The number of outputs to be created from the satoshis. <br>
+	// Warning: The satoshis must be evenly divisible by the number of splits. <br>
+	// Warning: If the recipient responds with more than one output, the number of splits must be 1.
+	Splits *uint64                                `json:"splits,omitempty"`
+	Type   RequestsContactOutputSpecificationType `json:"type"`
 }
 
-// RequestsOpReturnOutputSpecificationDataType defines model for RequestsOpReturnOutputSpecification.DataType.
-type RequestsOpReturnOutputSpecificationDataType string
+// RequestsContactOutputSpecificationType defines model for RequestsContactOutputSpecification.Type.
+type RequestsContactOutputSpecificationType string
+
+// RequestsCreateInvoice defines model for requests_CreateInvoice.
+type RequestsCreateInvoice struct {
+	// ExpiresAt Expiry of the invoice; if not provided the invoice expires after 24 hours
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Memo Memo presented to the payer
+	Memo *string `json:"memo,omitempty"`
+
+	// Paymail Paymail of the user through which the invoice is served. If not provided the default paymail of the user is used.
+	Paymail *string `json:"paymail,omitempty"`
+
+	// Satoshis Requested amount
+	Satoshis uint64 `json:"satoshis"`
+}
+
+// RequestsCreateUser defines model for requests_CreateUser.
+type RequestsCreateUser struct {
+	Paymail   *RequestsAddPaymail `json:"paymail,omitempty"`
+	PublicKey string              `json:"publicKey"`
+}
+
+// RequestsInscriptionOutputSpecification 1Sat Ordinals inscription of the content, owned by the user (1 satoshi output).
+type RequestsInscriptionOutputSpecification struct {
+	// Content Base64 encoded content to inscribe.
+	Content []byte `json:"content"`
+
+	// ContentType MIME type of the inscribed content.
+	ContentType string                                     `json:"contentType"`
+	Type        RequestsInscriptionOutputSpecificationType `json:"type"`
+}
+
+// RequestsInscriptionOutputSpecificationType defines model for RequestsInscriptionOutputSpecification.Type.
+type RequestsInscriptionOutputSpecificationType string
+
+// RequestsOpReturnHexesOutput defines model for requests_OpReturnHexesOutput.
+type RequestsOpReturnHexesOutput = []string
+
+// RequestsOpReturnOutputSpecification defines model for requests_OpReturnOutputSpecification.
+type RequestsOpReturnOutputSpecification struct {
+	Data     RequestsOpReturnOutputSpecification_Data     `json:"data"`
+	DataType *RequestsOpReturnOutputSpecificationDataType `json:"dataType,omitempty"`
+	Type     RequestsOpReturnOutputSpecificationType      `json:"type"`
+}
+
+// RequestsOpReturnOutputSpecification_Data defines model for RequestsOpReturnOutputSpecification.Data.
+type RequestsOpReturnOutputSpecification_Data struct {
+	union json.RawMessage
+}
+
+// RequestsOpReturnOutputSpecificationDataType defines model for RequestsOpReturnOutputSpecification.DataType.
+type RequestsOpReturnOutputSpecificationDataType string
 
 // RequestsOpReturnOutputSpecificationType defines model for RequestsOpReturnOutputSpecification.Type.
 type RequestsOpReturnOutputSpecificationType string
@@ -634,6 +1210,22 @@ type RequestsOpReturnOutputSpecificationType string
 // RequestsOpReturnStringsOutput defines model for requests_OpReturnStringsOutput.
 type RequestsOpReturnStringsOutput = []string
 
+// RequestsOrdinalTransferOutputSpecification Transfer of the user's ordinal to the address or paymail of the receiver.
+type RequestsOrdinalTransferOutputSpecification struct {
+	// To Base58 encoded P2PKH address (mainnet) or paymail of the receiver.
+	To string `json:"to"`
+
+	// TxID ID of the transaction with the ordinal output.
+	TxID string                                         `json:"txID"`
+	Type RequestsOrdinalTransferOutputSpecificationType `json:"type"`
+
+	// Vout Index of the ordinal output.
+	Vout uint32 `json:"vout"`
+}
+
+// RequestsOrdinalTransferOutputSpecificationType defines model for RequestsOrdinalTransferOutputSpecification.Type.
+type RequestsOrdinalTransferOutputSpecificationType string
+
 // RequestsPaymailOutputSpecification defines model for requests_PaymailOutputSpecification.
 type RequestsPaymailOutputSpecification struct {
 	From     *string `json:"from"`
@@ -650,6 +1242,33 @@ type RequestsPaymailOutputSpecification struct {
 // RequestsPaymailOutputSpecificationType defines model for RequestsPaymailOutputSpecification.Type.
 type RequestsPaymailOutputSpecificationType string
 
+// RequestsScriptOutputSpecification defines model for requests_ScriptOutputSpecification.
+type RequestsScriptOutputSpecification struct {
+	// LockingScript Hex encoded locking script of the output.
+	LockingScript string                                `json:"lockingScript"`
+	Satoshis      uint64                                `json:"satoshis"`
+	Type          RequestsScriptOutputSpecificationType `json:"type"`
+}
+
+// RequestsScriptOutputSpecificationType defines model for RequestsScriptOutputSpecification.Type.
+type RequestsScriptOutputSpecificationType string
+
+// RequestsTokenTransferOutputSpecification Transfer of the amount of the user's BSV-21 token to the address or paymail of the receiver. <br>
+// The remaining amount of the spent token outputs is returned to the user.
+type RequestsTokenTransferOutputSpecification struct {
+	Amount uint64 `json:"amount"`
+
+	// To Base58 encoded P2PKH address (mainnet) or paymail of the receiver.
+	To string `json:"to"`
+
+	// TokenId ID of the BSV-21 token (outpoint of its deploy+mint inscription).
+	TokenId string                                       `json:"tokenId"`
+	Type    RequestsTokenTransferOutputSpecificationType `json:"type"`
+}
+
+// RequestsTokenTransferOutputSpecificationType defines model for RequestsTokenTransferOutputSpecification.Type.
+type RequestsTokenTransferOutputSpecificationType string
+
 // RequestsTransactionOutline defines model for requests_TransactionOutline.
 type RequestsTransactionOutline struct {
 	Annotations *ModelsOutputsAnnotations `json:"annotations,omitempty"`
@@ -674,6 +1293,21 @@ type RequestsTransactionSpecification struct {
 	Outputs []RequestsTransactionOutlineOutputSpecification `json:"outputs"`
 }
 
+// RequestsUpdatePaymailProfile defines model for requests_UpdatePaymailProfile.
+type RequestsUpdatePaymailProfile struct {
+	// AvatarURL Empty value removes the avatar
+	AvatarURL *string `json:"avatarURL,omitempty"`
+
+	// PublicName If empty will default to the same value as alias
+	PublicName *string `json:"publicName,omitempty"`
+}
+
+// RequestsContactID defines model for requests_ContactID.
+type RequestsContactID = uint
+
+// RequestsInvoiceID defines model for requests_InvoiceID.
+type RequestsInvoiceID = uint
+
 // RequestsPageNumber defines model for requests_PageNumber.
 type RequestsPageNumber = int
 
@@ -686,6 +1320,22 @@ type RequestsSort = string
 // RequestsSortBy defines model for requests_SortBy.
 type RequestsSortBy = string
 
+// RequestsTxID defines model for requests_TxID.
+type RequestsTxID = string
+
+// ResponsesAddContactBadRequest defines model for responses_AddContactBadRequest.
+type ResponsesAddContactBadRequest struct {
+	union json.RawMessage
+}
+
+// ResponsesAddContactFailedDependency defines model for responses_AddContactFailedDependency.
+type ResponsesAddContactFailedDependency struct {
+	union json.RawMessage
+}
+
+// ResponsesAddContactSuccess defines model for responses_AddContactSuccess.
+type ResponsesAddContactSuccess = ModelsAddedContact
+
 // ResponsesAdminAddPaymailSuccess defines model for responses_AdminAddPaymailSuccess.
 type ResponsesAdminAddPaymailSuccess = ModelsPaymail
 
@@ -711,6 +1361,19 @@ type ResponsesAdminUserBadRequest struct {
 	union json.RawMessage
 }
 
+// ResponsesContactSuccess defines model for responses_ContactSuccess.
+type ResponsesContactSuccess = ModelsContact
+
+// ResponsesContactUnprocessable defines model for responses_ContactUnprocessable.
+type ResponsesContactUnprocessable struct {
+	union json.RawMessage
+}
+
+// ResponsesCreateInvoiceBadRequest defines model for responses_CreateInvoiceBadRequest.
+type ResponsesCreateInvoiceBadRequest struct {
+	union json.RawMessage
+}
+
 // ResponsesCreateTransactionOutlineBadRequest defines model for responses_CreateTransactionOutlineBadRequest.
 type ResponsesCreateTransactionOutlineBadRequest struct {
 	union json.RawMessage
@@ -724,6 +1387,11 @@ type ResponsesCreateTransactionOutlineUnprocessable struct {
 	union json.RawMessage
 }
 
+// ResponsesGetContactNotFound defines model for responses_GetContactNotFound.
+type ResponsesGetContactNotFound struct {
+	union json.RawMessage
+}
+
 // ResponsesGetCurrentUserSuccess defines model for responses_GetCurrentUserSuccess.
 type ResponsesGetCurrentUserSuccess = ModelsUserInfo
 
@@ -735,6 +1403,11 @@ type ResponsesGetDataNotFound struct {
 // ResponsesGetDataSuccess defines model for responses_GetDataSuccess.
 type ResponsesGetDataSuccess = ModelsData
 
+// ResponsesGetInvoiceNotFound defines model for responses_GetInvoiceNotFound.
+type ResponsesGetInvoiceNotFound struct {
+	union json.RawMessage
+}
+
 // ResponsesGetMerklerootsBadRequest defines model for responses_GetMerklerootsBadRequest.
 type ResponsesGetMerklerootsBadRequest = ErrorsInvalidBatchSize
 
@@ -752,9 +1425,26 @@ type ResponsesGetMerklerootsNotFound = ErrorsMerkleRootNotFound
 // ResponsesGetMerklerootsSuccess defines model for responses_GetMerklerootsSuccess.
 type ResponsesGetMerklerootsSuccess = ModelsGetMerkleRootResult
 
+// ResponsesGetTransactionNotFound defines model for responses_GetTransactionNotFound.
+type ResponsesGetTransactionNotFound struct {
+	union json.RawMessage
+}
+
 // ResponsesInternalServerError defines model for responses_InternalServerError.
 type ResponsesInternalServerError = ErrorsInternal
 
+// ResponsesInvoiceSuccess defines model for responses_InvoiceSuccess.
+type ResponsesInvoiceSuccess = ModelsInvoice
+
+// ResponsesLookupPaymailDestinationBadRequest defines model for responses_LookupPaymailDestinationBadRequest.
+type ResponsesLookupPaymailDestinationBadRequest = ErrorsInvalidPaymailDestinationLookup
+
+// ResponsesLookupPaymailDestinationNotFound defines model for responses_LookupPaymailDestinationNotFound.
+type ResponsesLookupPaymailDestinationNotFound = ErrorsPaymailDestinationNotFound
+
+// ResponsesLookupPaymailDestinationSuccess defines model for responses_LookupPaymailDestinationSuccess.
+type ResponsesLookupPaymailDestinationSuccess = ModelsPaymailDestination
+
 // ResponsesNotAuthorized defines model for responses_NotAuthorized.
 type ResponsesNotAuthorized = ErrorsAnyAuthorization
 
@@ -777,18 +1467,134 @@ type ResponsesRecordTransactionSuccess = ModelsRecordedOutline
 // ResponsesSearchBadRequest defines model for responses_SearchBadRequest.
 type ResponsesSearchBadRequest = ErrorsInvalidDataID
 
+// ResponsesSearchContactsSuccess defines model for responses_SearchContactsSuccess.
+type ResponsesSearchContactsSuccess = ModelsContactsSearchResult
+
+// ResponsesSearchDataBadRequest defines model for responses_SearchDataBadRequest.
+type ResponsesSearchDataBadRequest struct {
+	union json.RawMessage
+}
+
+// ResponsesSearchDataSuccess defines model for responses_SearchDataSuccess.
+type ResponsesSearchDataSuccess = ModelsDataSearchResult
+
+// ResponsesSearchInvoicesSuccess defines model for responses_SearchInvoicesSuccess.
+type ResponsesSearchInvoicesSuccess = ModelsInvoicesSearchResult
+
 // ResponsesSearchOperationsSuccess defines model for responses_SearchOperationsSuccess.
 type ResponsesSearchOperationsSuccess = ModelsOperationsSearchResult
 
 // ResponsesSharedConfig Shared config
 type ResponsesSharedConfig = ModelsSharedConfig
 
+// ResponsesTransactionStatusSuccess defines model for responses_TransactionStatusSuccess.
+type ResponsesTransactionStatusSuccess = ModelsTransactionStatus
+
+// ResponsesUpdatePaymailProfileBadRequest defines model for responses_UpdatePaymailProfileBadRequest.
+type ResponsesUpdatePaymailProfileBadRequest struct {
+	union json.RawMessage
+}
+
+// ResponsesUpdatePaymailProfileNotFound defines model for responses_UpdatePaymailProfileNotFound.
+type ResponsesUpdatePaymailProfileNotFound struct {
+	union json.RawMessage
+}
+
+// ResponsesUpdatePaymailProfileSuccess defines model for responses_UpdatePaymailProfileSuccess.
+type ResponsesUpdatePaymailProfileSuccess = ModelsPaymail
+
+// ResponsesUpdatePaymailProfileUnprocessable defines model for responses_UpdatePaymailProfileUnprocessable.
+type ResponsesUpdatePaymailProfileUnprocessable struct {
+	union json.RawMessage
+}
+
 // ResponsesUserBadRequest defines model for responses_UserBadRequest.
 type ResponsesUserBadRequest = ErrorsInvalidDataID
 
 // ResponsesUserNotAuthorized defines model for responses_UserNotAuthorized.
 type ResponsesUserNotAuthorized = ErrorsUserAuthorization
 
+// SearchContactsParams defines parameters for SearchContacts.
+type SearchContactsParams struct {
+	// Page Page number for pagination
+	Page *RequestsPageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *RequestsPageSize `form:"size,omitempty" json:"size,omitempty"`
+
+	// Sort Sorting order (asc or desc)
+	Sort *RequestsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// SortBy Field to sort by
+	SortBy *RequestsSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+}
+
+// SearchDataParams defines parameters for SearchData.
+type SearchDataParams struct {
+	// Page Page number for pagination
+	Page *RequestsPageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *RequestsPageSize `form:"size,omitempty" json:"size,omitempty"`
+
+	// Sort Sorting order (asc or desc)
+	Sort *RequestsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// SortBy Field to sort by
+	SortBy *RequestsSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+
+	// TxId ID of the transaction containing the data
+	TxId *string `form:"txId,omitempty" json:"txId,omitempty"`
+
+	// CreatedFrom Data created at or after this time
+	CreatedFrom *time.Time `form:"createdFrom,omitempty" json:"createdFrom,omitempty"`
+
+	// CreatedTo Data created at or before this time
+	CreatedTo *time.Time `form:"createdTo,omitempty" json:"createdTo,omitempty"`
+
+	// Prefix Hex encoded beginning of the data blob
+	Prefix *string `form:"prefix,omitempty" json:"prefix,omitempty"`
+
+	// MinSize Minimal size of the data blob in bytes
+	MinSize *uint `form:"minSize,omitempty" json:"minSize,omitempty"`
+
+	// MaxSize Maximal size of the data blob in bytes
+	MaxSize *uint `form:"maxSize,omitempty" json:"maxSize,omitempty"`
+
+	// Protocol Bitcom protocol recognised in the data
+	Protocol *SearchDataParamsProtocol `form:"protocol,omitempty" json:"protocol,omitempty"`
+
+	// MediaType Media type of the B:// file
+	MediaType *string `form:"mediaType,omitempty" json:"mediaType,omitempty"`
+
+	// MapApp Value of the "app" key of MAP
+	MapApp *string `form:"mapApp,omitempty" json:"mapApp,omitempty"`
+
+	// MapType Value of the "type" key of MAP
+	MapType *string `form:"mapType,omitempty" json:"mapType,omitempty"`
+
+	// AipAddress Address of the valid AIP signature
+	AipAddress *string `form:"aipAddress,omitempty" json:"aipAddress,omitempty"`
+}
+
+// SearchDataParamsProtocol defines parameters for SearchData.
+type SearchDataParamsProtocol string
+
+// SearchInvoicesParams defines parameters for SearchInvoices.
+type SearchInvoicesParams struct {
+	// Page Page number for pagination
+	Page *RequestsPageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *RequestsPageSize `form:"size,omitempty" json:"size,omitempty"`
+
+	// Sort Sorting order (asc or desc)
+	Sort *RequestsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// SortBy Field to sort by
+	SortBy *RequestsSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+}
+
 // MerkleRootsParams defines parameters for MerkleRoots.
 type MerkleRootsParams struct {
 	// BatchSize Batch size of merkleroots to be returned
@@ -813,6 +1619,18 @@ type SearchOperationsParams struct {
 	SortBy *RequestsSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
 }
 
+// LookupPaymailDestinationParams defines parameters for LookupPaymailDestination.
+type LookupPaymailDestinationParams struct {
+	// Address Address derived for the paymail
+	Address *string `form:"address,omitempty" json:"address,omitempty"`
+
+	// TxId ID of the transaction paying to the destination
+	TxId *string `form:"txId,omitempty" json:"txId,omitempty"`
+
+	// Vout Index of the output paying to the destination
+	Vout *uint32 `form:"vout,omitempty" json:"vout,omitempty"`
+}
+
 // CreateTransactionOutlineParams defines parameters for CreateTransactionOutline.
 type CreateTransactionOutlineParams struct {
 	// Format Required format of transaction hex
@@ -828,12 +1646,24 @@ type CreateUserJSONRequestBody = RequestsCreateUser
 // AddPaymailToUserJSONRequestBody defines body for AddPaymailToUser for application/json ContentType.
 type AddPaymailToUserJSONRequestBody = RequestsAddPaymail
 
+// UpdatePaymailProfileJSONRequestBody defines body for UpdatePaymailProfile for application/json ContentType.
+type UpdatePaymailProfileJSONRequestBody = RequestsUpdatePaymailProfile
+
+// AddContactJSONRequestBody defines body for AddContact for application/json ContentType.
+type AddContactJSONRequestBody = RequestsAddContact
+
+// CreateInvoiceJSONRequestBody defines body for CreateInvoice for application/json ContentType.
+type CreateInvoiceJSONRequestBody = RequestsCreateInvoice
+
 // RecordTransactionOutlineJSONRequestBody defines body for RecordTransactionOutline for application/json ContentType.
 type RecordTransactionOutlineJSONRequestBody = RequestsTransactionOutline
 
 // CreateTransactionOutlineJSONRequestBody defines body for CreateTransactionOutline for application/json ContentType.
 type CreateTransactionOutlineJSONRequestBody = RequestsTransactionSpecification
 
+// UpdateCurrentUserPaymailProfileJSONRequestBody defines body for UpdateCurrentUserPaymailProfile for application/json ContentType.
+type UpdateCurrentUserPaymailProfileJSONRequestBody = RequestsUpdatePaymailProfile
+
 // AsErrorsUserAuthOnNonUserEndpoint returns the union data inside the ErrorsAdminAuthorization as a ErrorsUserAuthOnNonUserEndpoint
 func (t ErrorsAdminAuthorization) AsErrorsUserAuthOnNonUserEndpoint() (ErrorsUserAuthOnNonUserEndpoint, error) {
 	var body ErrorsUserAuthOnNonUserEndpoint
@@ -1046,6 +1876,125 @@ func (t *ErrorsUserAuthorization) UnmarshalJSON(b []byte) error {
 	return err
 }
 
+// AsModelsBProtocol returns the union data inside the ModelsBitcomProtocol as a ModelsBProtocol
+func (t ModelsBitcomProtocol) AsModelsBProtocol() (ModelsBProtocol, error) {
+	var body ModelsBProtocol
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromModelsBProtocol overwrites any union data inside the ModelsBitcomProtocol as the provided ModelsBProtocol
+func (t *ModelsBitcomProtocol) FromModelsBProtocol(v ModelsBProtocol) error {
+	v.Protocol = "B"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeModelsBProtocol performs a merge with any union data inside the ModelsBitcomProtocol, using the provided ModelsBProtocol
+func (t *ModelsBitcomProtocol) MergeModelsBProtocol(v ModelsBProtocol) error {
+	v.Protocol = "B"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsModelsMAPProtocol returns the union data inside the ModelsBitcomProtocol as a ModelsMAPProtocol
+func (t ModelsBitcomProtocol) AsModelsMAPProtocol() (ModelsMAPProtocol, error) {
+	var body ModelsMAPProtocol
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromModelsMAPProtocol overwrites any union data inside the ModelsBitcomProtocol as the provided ModelsMAPProtocol
+func (t *ModelsBitcomProtocol) FromModelsMAPProtocol(v ModelsMAPProtocol) error {
+	v.Protocol = "MAP"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeModelsMAPProtocol performs a merge with any union data inside the ModelsBitcomProtocol, using the provided ModelsMAPProtocol
+func (t *ModelsBitcomProtocol) MergeModelsMAPProtocol(v ModelsMAPProtocol) error {
+	v.Protocol = "MAP"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsModelsAIPProtocol returns the union data inside the ModelsBitcomProtocol as a ModelsAIPProtocol
+func (t ModelsBitcomProtocol) AsModelsAIPProtocol() (ModelsAIPProtocol, error) {
+	var body ModelsAIPProtocol
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromModelsAIPProtocol overwrites any union data inside the ModelsBitcomProtocol as the provided ModelsAIPProtocol
+func (t *ModelsBitcomProtocol) FromModelsAIPProtocol(v ModelsAIPProtocol) error {
+	v.Protocol = "AIP"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeModelsAIPProtocol performs a merge with any union data inside the ModelsBitcomProtocol, using the provided ModelsAIPProtocol
+func (t *ModelsBitcomProtocol) MergeModelsAIPProtocol(v ModelsAIPProtocol) error {
+	v.Protocol = "AIP"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ModelsBitcomProtocol) Discriminator() (string, error) {
+	var discriminator struct {
+		Discriminator string `json:"protocol"`
+	}
+	err := json.Unmarshal(t.union, &discriminator)
+	return discriminator.Discriminator, err
+}
+
+func (t ModelsBitcomProtocol) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "AIP":
+		return t.AsModelsAIPProtocol()
+	case "B":
+		return t.AsModelsBProtocol()
+	case "MAP":
+		return t.AsModelsMAPProtocol()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+func (t ModelsBitcomProtocol) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ModelsBitcomProtocol) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
 // AsModelsSPVWalletCustomInstructions returns the union data inside the ModelsCustomInstructions as a ModelsSPVWalletCustomInstructions
 func (t ModelsCustomInstructions) AsModelsSPVWalletCustomInstructions() (ModelsSPVWalletCustomInstructions, error) {
 	var body ModelsSPVWalletCustomInstructions
@@ -1226,55 +2175,52 @@ func (t *RequestsTransactionOutlineOutputSpecification) MergeRequestsPaymailOutp
 	return err
 }
 
-func (t RequestsTransactionOutlineOutputSpecification) Discriminator() (string, error) {
-	var discriminator struct {
-		Discriminator string `json:"type"`
-	}
-	err := json.Unmarshal(t.union, &discriminator)
-	return discriminator.Discriminator, err
+// AsRequestsContactOutputSpecification returns the union data inside the RequestsTransactionOutlineOutputSpecification as a RequestsContactOutputSpecification
+func (t RequestsTransactionOutlineOutputSpecification) AsRequestsContactOutputSpecification() (RequestsContactOutputSpecification, error) {
+	var body RequestsContactOutputSpecification
+	err := json.Unmarshal(t.union, &body)
+	return body, err
 }
 
-func (t RequestsTransactionOutlineOutputSpecification) ValueByDiscriminator() (interface{}, error) {
-	discriminator, err := t.Discriminator()
+// FromRequestsContactOutputSpecification overwrites any union data inside the RequestsTransactionOutlineOutputSpecification as the provided RequestsContactOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) FromRequestsContactOutputSpecification(v RequestsContactOutputSpecification) error {
+	v.Type = "contact"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeRequestsContactOutputSpecification performs a merge with any union data inside the RequestsTransactionOutlineOutputSpecification, using the provided RequestsContactOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) MergeRequestsContactOutputSpecification(v RequestsContactOutputSpecification) error {
+	v.Type = "contact"
+	b, err := json.Marshal(v)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	switch discriminator {
-	case "op_return":
-		return t.AsRequestsOpReturnOutputSpecification()
-	case "paymail":
-		return t.AsRequestsPaymailOutputSpecification()
-	default:
-		return nil, errors.New("unknown discriminator value: " + discriminator)
-	}
-}
-
-func (t RequestsTransactionOutlineOutputSpecification) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
-	return b, err
-}
 
-func (t *RequestsTransactionOutlineOutputSpecification) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
 	return err
 }
 
-// AsErrorsInvalidAvatarURL returns the union data inside the ResponsesAdminInvalidAvatarURL as a ErrorsInvalidAvatarURL
-func (t ResponsesAdminInvalidAvatarURL) AsErrorsInvalidAvatarURL() (ErrorsInvalidAvatarURL, error) {
-	var body ErrorsInvalidAvatarURL
+// AsRequestsAddressOutputSpecification returns the union data inside the RequestsTransactionOutlineOutputSpecification as a RequestsAddressOutputSpecification
+func (t RequestsTransactionOutlineOutputSpecification) AsRequestsAddressOutputSpecification() (RequestsAddressOutputSpecification, error) {
+	var body RequestsAddressOutputSpecification
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsInvalidAvatarURL overwrites any union data inside the ResponsesAdminInvalidAvatarURL as the provided ErrorsInvalidAvatarURL
-func (t *ResponsesAdminInvalidAvatarURL) FromErrorsInvalidAvatarURL(v ErrorsInvalidAvatarURL) error {
+// FromRequestsAddressOutputSpecification overwrites any union data inside the RequestsTransactionOutlineOutputSpecification as the provided RequestsAddressOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) FromRequestsAddressOutputSpecification(v RequestsAddressOutputSpecification) error {
+	v.Type = "address"
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsInvalidAvatarURL performs a merge with any union data inside the ResponsesAdminInvalidAvatarURL, using the provided ErrorsInvalidAvatarURL
-func (t *ResponsesAdminInvalidAvatarURL) MergeErrorsInvalidAvatarURL(v ErrorsInvalidAvatarURL) error {
+// MergeRequestsAddressOutputSpecification performs a merge with any union data inside the RequestsTransactionOutlineOutputSpecification, using the provided RequestsAddressOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) MergeRequestsAddressOutputSpecification(v RequestsAddressOutputSpecification) error {
+	v.Type = "address"
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1285,32 +2231,24 @@ func (t *ResponsesAdminInvalidAvatarURL) MergeErrorsInvalidAvatarURL(v ErrorsInv
 	return err
 }
 
-func (t ResponsesAdminInvalidAvatarURL) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
-	return b, err
-}
-
-func (t *ResponsesAdminInvalidAvatarURL) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
-	return err
-}
-
-// AsErrorsCannotBindRequest returns the union data inside the ResponsesAdminUserBadRequest as a ErrorsCannotBindRequest
-func (t ResponsesAdminUserBadRequest) AsErrorsCannotBindRequest() (ErrorsCannotBindRequest, error) {
-	var body ErrorsCannotBindRequest
+// AsRequestsScriptOutputSpecification returns the union data inside the RequestsTransactionOutlineOutputSpecification as a RequestsScriptOutputSpecification
+func (t RequestsTransactionOutlineOutputSpecification) AsRequestsScriptOutputSpecification() (RequestsScriptOutputSpecification, error) {
+	var body RequestsScriptOutputSpecification
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsCannotBindRequest overwrites any union data inside the ResponsesAdminUserBadRequest as the provided ErrorsCannotBindRequest
-func (t *ResponsesAdminUserBadRequest) FromErrorsCannotBindRequest(v ErrorsCannotBindRequest) error {
+// FromRequestsScriptOutputSpecification overwrites any union data inside the RequestsTransactionOutlineOutputSpecification as the provided RequestsScriptOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) FromRequestsScriptOutputSpecification(v RequestsScriptOutputSpecification) error {
+	v.Type = "script"
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsCannotBindRequest performs a merge with any union data inside the ResponsesAdminUserBadRequest, using the provided ErrorsCannotBindRequest
-func (t *ResponsesAdminUserBadRequest) MergeErrorsCannotBindRequest(v ErrorsCannotBindRequest) error {
+// MergeRequestsScriptOutputSpecification performs a merge with any union data inside the RequestsTransactionOutlineOutputSpecification, using the provided RequestsScriptOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) MergeRequestsScriptOutputSpecification(v RequestsScriptOutputSpecification) error {
+	v.Type = "script"
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1321,22 +2259,24 @@ func (t *ResponsesAdminUserBadRequest) MergeErrorsCannotBindRequest(v ErrorsCann
 	return err
 }
 
-// AsErrorsInvalidPubKey returns the union data inside the ResponsesAdminUserBadRequest as a ErrorsInvalidPubKey
-func (t ResponsesAdminUserBadRequest) AsErrorsInvalidPubKey() (ErrorsInvalidPubKey, error) {
-	var body ErrorsInvalidPubKey
+// AsRequestsBitcomOutputSpecification returns the union data inside the RequestsTransactionOutlineOutputSpecification as a RequestsBitcomOutputSpecification
+func (t RequestsTransactionOutlineOutputSpecification) AsRequestsBitcomOutputSpecification() (RequestsBitcomOutputSpecification, error) {
+	var body RequestsBitcomOutputSpecification
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsInvalidPubKey overwrites any union data inside the ResponsesAdminUserBadRequest as the provided ErrorsInvalidPubKey
-func (t *ResponsesAdminUserBadRequest) FromErrorsInvalidPubKey(v ErrorsInvalidPubKey) error {
+// FromRequestsBitcomOutputSpecification overwrites any union data inside the RequestsTransactionOutlineOutputSpecification as the provided RequestsBitcomOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) FromRequestsBitcomOutputSpecification(v RequestsBitcomOutputSpecification) error {
+	v.Type = "bitcom"
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsInvalidPubKey performs a merge with any union data inside the ResponsesAdminUserBadRequest, using the provided ErrorsInvalidPubKey
-func (t *ResponsesAdminUserBadRequest) MergeErrorsInvalidPubKey(v ErrorsInvalidPubKey) error {
+// MergeRequestsBitcomOutputSpecification performs a merge with any union data inside the RequestsTransactionOutlineOutputSpecification, using the provided RequestsBitcomOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) MergeRequestsBitcomOutputSpecification(v RequestsBitcomOutputSpecification) error {
+	v.Type = "bitcom"
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1347,22 +2287,24 @@ func (t *ResponsesAdminUserBadRequest) MergeErrorsInvalidPubKey(v ErrorsInvalidP
 	return err
 }
 
-// AsErrorsInvalidPaymail returns the union data inside the ResponsesAdminUserBadRequest as a ErrorsInvalidPaymail
-func (t ResponsesAdminUserBadRequest) AsErrorsInvalidPaymail() (ErrorsInvalidPaymail, error) {
-	var body ErrorsInvalidPaymail
+// AsRequestsInscriptionOutputSpecification returns the union data inside the RequestsTransactionOutlineOutputSpecification as a RequestsInscriptionOutputSpecification
+func (t RequestsTransactionOutlineOutputSpecification) AsRequestsInscriptionOutputSpecification() (RequestsInscriptionOutputSpecification, error) {
+	var body RequestsInscriptionOutputSpecification
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsInvalidPaymail overwrites any union data inside the ResponsesAdminUserBadRequest as the provided ErrorsInvalidPaymail
-func (t *ResponsesAdminUserBadRequest) FromErrorsInvalidPaymail(v ErrorsInvalidPaymail) error {
+// FromRequestsInscriptionOutputSpecification overwrites any union data inside the RequestsTransactionOutlineOutputSpecification as the provided RequestsInscriptionOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) FromRequestsInscriptionOutputSpecification(v RequestsInscriptionOutputSpecification) error {
+	v.Type = "inscription"
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsInvalidPaymail performs a merge with any union data inside the ResponsesAdminUserBadRequest, using the provided ErrorsInvalidPaymail
-func (t *ResponsesAdminUserBadRequest) MergeErrorsInvalidPaymail(v ErrorsInvalidPaymail) error {
+// MergeRequestsInscriptionOutputSpecification performs a merge with any union data inside the RequestsTransactionOutlineOutputSpecification, using the provided RequestsInscriptionOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) MergeRequestsInscriptionOutputSpecification(v RequestsInscriptionOutputSpecification) error {
+	v.Type = "inscription"
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1373,22 +2315,24 @@ func (t *ResponsesAdminUserBadRequest) MergeErrorsInvalidPaymail(v ErrorsInvalid
 	return err
 }
 
-// AsErrorsPaymailInconsistent returns the union data inside the ResponsesAdminUserBadRequest as a ErrorsPaymailInconsistent
-func (t ResponsesAdminUserBadRequest) AsErrorsPaymailInconsistent() (ErrorsPaymailInconsistent, error) {
-	var body ErrorsPaymailInconsistent
+// AsRequestsOrdinalTransferOutputSpecification returns the union data inside the RequestsTransactionOutlineOutputSpecification as a RequestsOrdinalTransferOutputSpecification
+func (t RequestsTransactionOutlineOutputSpecification) AsRequestsOrdinalTransferOutputSpecification() (RequestsOrdinalTransferOutputSpecification, error) {
+	var body RequestsOrdinalTransferOutputSpecification
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsPaymailInconsistent overwrites any union data inside the ResponsesAdminUserBadRequest as the provided ErrorsPaymailInconsistent
-func (t *ResponsesAdminUserBadRequest) FromErrorsPaymailInconsistent(v ErrorsPaymailInconsistent) error {
+// FromRequestsOrdinalTransferOutputSpecification overwrites any union data inside the RequestsTransactionOutlineOutputSpecification as the provided RequestsOrdinalTransferOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) FromRequestsOrdinalTransferOutputSpecification(v RequestsOrdinalTransferOutputSpecification) error {
+	v.Type = "ordinal_transfer"
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsPaymailInconsistent performs a merge with any union data inside the ResponsesAdminUserBadRequest, using the provided ErrorsPaymailInconsistent
-func (t *ResponsesAdminUserBadRequest) MergeErrorsPaymailInconsistent(v ErrorsPaymailInconsistent) error {
+// MergeRequestsOrdinalTransferOutputSpecification performs a merge with any union data inside the RequestsTransactionOutlineOutputSpecification, using the provided RequestsOrdinalTransferOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) MergeRequestsOrdinalTransferOutputSpecification(v RequestsOrdinalTransferOutputSpecification) error {
+	v.Type = "ordinal_transfer"
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1399,22 +2343,24 @@ func (t *ResponsesAdminUserBadRequest) MergeErrorsPaymailInconsistent(v ErrorsPa
 	return err
 }
 
-// AsErrorsInvalidDomain returns the union data inside the ResponsesAdminUserBadRequest as a ErrorsInvalidDomain
-func (t ResponsesAdminUserBadRequest) AsErrorsInvalidDomain() (ErrorsInvalidDomain, error) {
-	var body ErrorsInvalidDomain
+// AsRequestsTokenTransferOutputSpecification returns the union data inside the RequestsTransactionOutlineOutputSpecification as a RequestsTokenTransferOutputSpecification
+func (t RequestsTransactionOutlineOutputSpecification) AsRequestsTokenTransferOutputSpecification() (RequestsTokenTransferOutputSpecification, error) {
+	var body RequestsTokenTransferOutputSpecification
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsInvalidDomain overwrites any union data inside the ResponsesAdminUserBadRequest as the provided ErrorsInvalidDomain
-func (t *ResponsesAdminUserBadRequest) FromErrorsInvalidDomain(v ErrorsInvalidDomain) error {
+// FromRequestsTokenTransferOutputSpecification overwrites any union data inside the RequestsTransactionOutlineOutputSpecification as the provided RequestsTokenTransferOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) FromRequestsTokenTransferOutputSpecification(v RequestsTokenTransferOutputSpecification) error {
+	v.Type = "token_transfer"
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsInvalidDomain performs a merge with any union data inside the ResponsesAdminUserBadRequest, using the provided ErrorsInvalidDomain
-func (t *ResponsesAdminUserBadRequest) MergeErrorsInvalidDomain(v ErrorsInvalidDomain) error {
+// MergeRequestsTokenTransferOutputSpecification performs a merge with any union data inside the RequestsTransactionOutlineOutputSpecification, using the provided RequestsTokenTransferOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) MergeRequestsTokenTransferOutputSpecification(v RequestsTokenTransferOutputSpecification) error {
+	v.Type = "token_transfer"
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1425,32 +2371,69 @@ func (t *ResponsesAdminUserBadRequest) MergeErrorsInvalidDomain(v ErrorsInvalidD
 	return err
 }
 
-func (t ResponsesAdminUserBadRequest) MarshalJSON() ([]byte, error) {
+func (t RequestsTransactionOutlineOutputSpecification) Discriminator() (string, error) {
+	var discriminator struct {
+		Discriminator string `json:"type"`
+	}
+	err := json.Unmarshal(t.union, &discriminator)
+	return discriminator.Discriminator, err
+}
+
+func (t RequestsTransactionOutlineOutputSpecification) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "address":
+		return t.AsRequestsAddressOutputSpecification()
+	case "bitcom":
+		return t.AsRequestsBitcomOutputSpecification()
+	case "contact":
+		return t.AsRequestsContactOutputSpecification()
+	case "inscription":
+		return t.AsRequestsInscriptionOutputSpecification()
+	case "op_return":
+		return t.AsRequestsOpReturnOutputSpecification()
+	case "ordinal_transfer":
+		return t.AsRequestsOrdinalTransferOutputSpecification()
+	case "paymail":
+		return t.AsRequestsPaymailOutputSpecification()
+	case "script":
+		return t.AsRequestsScriptOutputSpecification()
+	case "token_transfer":
+		return t.AsRequestsTokenTransferOutputSpecification()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+func (t RequestsTransactionOutlineOutputSpecification) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
 }
 
-func (t *ResponsesAdminUserBadRequest) UnmarshalJSON(b []byte) error {
+func (t *RequestsTransactionOutlineOutputSpecification) UnmarshalJSON(b []byte) error {
 	err := t.union.UnmarshalJSON(b)
 	return err
 }
 
-// AsErrorsTxSpecNoDefaultPaymailAddress returns the union data inside the ResponsesCreateTransactionOutlineBadRequest as a ErrorsTxSpecNoDefaultPaymailAddress
-func (t ResponsesCreateTransactionOutlineBadRequest) AsErrorsTxSpecNoDefaultPaymailAddress() (ErrorsTxSpecNoDefaultPaymailAddress, error) {
-	var body ErrorsTxSpecNoDefaultPaymailAddress
+// AsErrorsCannotBindRequest returns the union data inside the ResponsesAddContactBadRequest as a ErrorsCannotBindRequest
+func (t ResponsesAddContactBadRequest) AsErrorsCannotBindRequest() (ErrorsCannotBindRequest, error) {
+	var body ErrorsCannotBindRequest
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsTxSpecNoDefaultPaymailAddress overwrites any union data inside the ResponsesCreateTransactionOutlineBadRequest as the provided ErrorsTxSpecNoDefaultPaymailAddress
-func (t *ResponsesCreateTransactionOutlineBadRequest) FromErrorsTxSpecNoDefaultPaymailAddress(v ErrorsTxSpecNoDefaultPaymailAddress) error {
+// FromErrorsCannotBindRequest overwrites any union data inside the ResponsesAddContactBadRequest as the provided ErrorsCannotBindRequest
+func (t *ResponsesAddContactBadRequest) FromErrorsCannotBindRequest(v ErrorsCannotBindRequest) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsTxSpecNoDefaultPaymailAddress performs a merge with any union data inside the ResponsesCreateTransactionOutlineBadRequest, using the provided ErrorsTxSpecNoDefaultPaymailAddress
-func (t *ResponsesCreateTransactionOutlineBadRequest) MergeErrorsTxSpecNoDefaultPaymailAddress(v ErrorsTxSpecNoDefaultPaymailAddress) error {
+// MergeErrorsCannotBindRequest performs a merge with any union data inside the ResponsesAddContactBadRequest, using the provided ErrorsCannotBindRequest
+func (t *ResponsesAddContactBadRequest) MergeErrorsCannotBindRequest(v ErrorsCannotBindRequest) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1461,22 +2444,22 @@ func (t *ResponsesCreateTransactionOutlineBadRequest) MergeErrorsTxSpecNoDefault
 	return err
 }
 
-// AsErrorsTxSpecOutputsRequired returns the union data inside the ResponsesCreateTransactionOutlineBadRequest as a ErrorsTxSpecOutputsRequired
-func (t ResponsesCreateTransactionOutlineBadRequest) AsErrorsTxSpecOutputsRequired() (ErrorsTxSpecOutputsRequired, error) {
-	var body ErrorsTxSpecOutputsRequired
+// AsErrorsInvalidContactPaymail returns the union data inside the ResponsesAddContactBadRequest as a ErrorsInvalidContactPaymail
+func (t ResponsesAddContactBadRequest) AsErrorsInvalidContactPaymail() (ErrorsInvalidContactPaymail, error) {
+	var body ErrorsInvalidContactPaymail
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsTxSpecOutputsRequired overwrites any union data inside the ResponsesCreateTransactionOutlineBadRequest as the provided ErrorsTxSpecOutputsRequired
-func (t *ResponsesCreateTransactionOutlineBadRequest) FromErrorsTxSpecOutputsRequired(v ErrorsTxSpecOutputsRequired) error {
+// FromErrorsInvalidContactPaymail overwrites any union data inside the ResponsesAddContactBadRequest as the provided ErrorsInvalidContactPaymail
+func (t *ResponsesAddContactBadRequest) FromErrorsInvalidContactPaymail(v ErrorsInvalidContactPaymail) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsTxSpecOutputsRequired performs a merge with any union data inside the ResponsesCreateTransactionOutlineBadRequest, using the provided ErrorsTxSpecOutputsRequired
-func (t *ResponsesCreateTransactionOutlineBadRequest) MergeErrorsTxSpecOutputsRequired(v ErrorsTxSpecOutputsRequired) error {
+// MergeErrorsInvalidContactPaymail performs a merge with any union data inside the ResponsesAddContactBadRequest, using the provided ErrorsInvalidContactPaymail
+func (t *ResponsesAddContactBadRequest) MergeErrorsInvalidContactPaymail(v ErrorsInvalidContactPaymail) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1487,22 +2470,22 @@ func (t *ResponsesCreateTransactionOutlineBadRequest) MergeErrorsTxSpecOutputsRe
 	return err
 }
 
-// AsErrorsTxSpecOpReturnDataRequired returns the union data inside the ResponsesCreateTransactionOutlineBadRequest as a ErrorsTxSpecOpReturnDataRequired
-func (t ResponsesCreateTransactionOutlineBadRequest) AsErrorsTxSpecOpReturnDataRequired() (ErrorsTxSpecOpReturnDataRequired, error) {
-	var body ErrorsTxSpecOpReturnDataRequired
+// AsErrorsInvalidRequesterPaymail returns the union data inside the ResponsesAddContactBadRequest as a ErrorsInvalidRequesterPaymail
+func (t ResponsesAddContactBadRequest) AsErrorsInvalidRequesterPaymail() (ErrorsInvalidRequesterPaymail, error) {
+	var body ErrorsInvalidRequesterPaymail
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsTxSpecOpReturnDataRequired overwrites any union data inside the ResponsesCreateTransactionOutlineBadRequest as the provided ErrorsTxSpecOpReturnDataRequired
-func (t *ResponsesCreateTransactionOutlineBadRequest) FromErrorsTxSpecOpReturnDataRequired(v ErrorsTxSpecOpReturnDataRequired) error {
+// FromErrorsInvalidRequesterPaymail overwrites any union data inside the ResponsesAddContactBadRequest as the provided ErrorsInvalidRequesterPaymail
+func (t *ResponsesAddContactBadRequest) FromErrorsInvalidRequesterPaymail(v ErrorsInvalidRequesterPaymail) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsTxSpecOpReturnDataRequired performs a merge with any union data inside the ResponsesCreateTransactionOutlineBadRequest, using the provided ErrorsTxSpecOpReturnDataRequired
-func (t *ResponsesCreateTransactionOutlineBadRequest) MergeErrorsTxSpecOpReturnDataRequired(v ErrorsTxSpecOpReturnDataRequired) error {
+// MergeErrorsInvalidRequesterPaymail performs a merge with any union data inside the ResponsesAddContactBadRequest, using the provided ErrorsInvalidRequesterPaymail
+func (t *ResponsesAddContactBadRequest) MergeErrorsInvalidRequesterPaymail(v ErrorsInvalidRequesterPaymail) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1513,22 +2496,22 @@ func (t *ResponsesCreateTransactionOutlineBadRequest) MergeErrorsTxSpecOpReturnD
 	return err
 }
 
-// AsErrorsTxSpecFailedToDecodeHex returns the union data inside the ResponsesCreateTransactionOutlineBadRequest as a ErrorsTxSpecFailedToDecodeHex
-func (t ResponsesCreateTransactionOutlineBadRequest) AsErrorsTxSpecFailedToDecodeHex() (ErrorsTxSpecFailedToDecodeHex, error) {
-	var body ErrorsTxSpecFailedToDecodeHex
+// AsErrorsContactToSelf returns the union data inside the ResponsesAddContactBadRequest as a ErrorsContactToSelf
+func (t ResponsesAddContactBadRequest) AsErrorsContactToSelf() (ErrorsContactToSelf, error) {
+	var body ErrorsContactToSelf
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsTxSpecFailedToDecodeHex overwrites any union data inside the ResponsesCreateTransactionOutlineBadRequest as the provided ErrorsTxSpecFailedToDecodeHex
-func (t *ResponsesCreateTransactionOutlineBadRequest) FromErrorsTxSpecFailedToDecodeHex(v ErrorsTxSpecFailedToDecodeHex) error {
+// FromErrorsContactToSelf overwrites any union data inside the ResponsesAddContactBadRequest as the provided ErrorsContactToSelf
+func (t *ResponsesAddContactBadRequest) FromErrorsContactToSelf(v ErrorsContactToSelf) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsTxSpecFailedToDecodeHex performs a merge with any union data inside the ResponsesCreateTransactionOutlineBadRequest, using the provided ErrorsTxSpecFailedToDecodeHex
-func (t *ResponsesCreateTransactionOutlineBadRequest) MergeErrorsTxSpecFailedToDecodeHex(v ErrorsTxSpecFailedToDecodeHex) error {
+// MergeErrorsContactToSelf performs a merge with any union data inside the ResponsesAddContactBadRequest, using the provided ErrorsContactToSelf
+func (t *ResponsesAddContactBadRequest) MergeErrorsContactToSelf(v ErrorsContactToSelf) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1539,48 +2522,32 @@ func (t *ResponsesCreateTransactionOutlineBadRequest) MergeErrorsTxSpecFailedToD
 	return err
 }
 
-// AsErrorsTxSpecInvalidPaymailReceiver returns the union data inside the ResponsesCreateTransactionOutlineBadRequest as a ErrorsTxSpecInvalidPaymailReceiver
-func (t ResponsesCreateTransactionOutlineBadRequest) AsErrorsTxSpecInvalidPaymailReceiver() (ErrorsTxSpecInvalidPaymailReceiver, error) {
-	var body ErrorsTxSpecInvalidPaymailReceiver
-	err := json.Unmarshal(t.union, &body)
-	return body, err
-}
-
-// FromErrorsTxSpecInvalidPaymailReceiver overwrites any union data inside the ResponsesCreateTransactionOutlineBadRequest as the provided ErrorsTxSpecInvalidPaymailReceiver
-func (t *ResponsesCreateTransactionOutlineBadRequest) FromErrorsTxSpecInvalidPaymailReceiver(v ErrorsTxSpecInvalidPaymailReceiver) error {
-	b, err := json.Marshal(v)
-	t.union = b
-	return err
+func (t ResponsesAddContactBadRequest) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
 }
 
-// MergeErrorsTxSpecInvalidPaymailReceiver performs a merge with any union data inside the ResponsesCreateTransactionOutlineBadRequest, using the provided ErrorsTxSpecInvalidPaymailReceiver
-func (t *ResponsesCreateTransactionOutlineBadRequest) MergeErrorsTxSpecInvalidPaymailReceiver(v ErrorsTxSpecInvalidPaymailReceiver) error {
-	b, err := json.Marshal(v)
-	if err != nil {
-		return err
-	}
-
-	merged, err := runtime.JSONMerge(t.union, b)
-	t.union = merged
+func (t *ResponsesAddContactBadRequest) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
 	return err
 }
 
-// AsErrorsTxSpecInvalidPaymailSender returns the union data inside the ResponsesCreateTransactionOutlineBadRequest as a ErrorsTxSpecInvalidPaymailSender
-func (t ResponsesCreateTransactionOutlineBadRequest) AsErrorsTxSpecInvalidPaymailSender() (ErrorsTxSpecInvalidPaymailSender, error) {
-	var body ErrorsTxSpecInvalidPaymailSender
+// AsErrorsContactGettingPKI returns the union data inside the ResponsesAddContactFailedDependency as a ErrorsContactGettingPKI
+func (t ResponsesAddContactFailedDependency) AsErrorsContactGettingPKI() (ErrorsContactGettingPKI, error) {
+	var body ErrorsContactGettingPKI
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsTxSpecInvalidPaymailSender overwrites any union data inside the ResponsesCreateTransactionOutlineBadRequest as the provided ErrorsTxSpecInvalidPaymailSender
-func (t *ResponsesCreateTransactionOutlineBadRequest) FromErrorsTxSpecInvalidPaymailSender(v ErrorsTxSpecInvalidPaymailSender) error {
+// FromErrorsContactGettingPKI overwrites any union data inside the ResponsesAddContactFailedDependency as the provided ErrorsContactGettingPKI
+func (t *ResponsesAddContactFailedDependency) FromErrorsContactGettingPKI(v ErrorsContactGettingPKI) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsTxSpecInvalidPaymailSender performs a merge with any union data inside the ResponsesCreateTransactionOutlineBadRequest, using the provided ErrorsTxSpecInvalidPaymailSender
-func (t *ResponsesCreateTransactionOutlineBadRequest) MergeErrorsTxSpecInvalidPaymailSender(v ErrorsTxSpecInvalidPaymailSender) error {
+// MergeErrorsContactGettingPKI performs a merge with any union data inside the ResponsesAddContactFailedDependency, using the provided ErrorsContactGettingPKI
+func (t *ResponsesAddContactFailedDependency) MergeErrorsContactGettingPKI(v ErrorsContactGettingPKI) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1591,32 +2558,32 @@ func (t *ResponsesCreateTransactionOutlineBadRequest) MergeErrorsTxSpecInvalidPa
 	return err
 }
 
-func (t ResponsesCreateTransactionOutlineBadRequest) MarshalJSON() ([]byte, error) {
+func (t ResponsesAddContactFailedDependency) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
 }
 
-func (t *ResponsesCreateTransactionOutlineBadRequest) UnmarshalJSON(b []byte) error {
+func (t *ResponsesAddContactFailedDependency) UnmarshalJSON(b []byte) error {
 	err := t.union.UnmarshalJSON(b)
 	return err
 }
 
-// AsErrorsTxOutlineUserHasNotEnoughFunds returns the union data inside the ResponsesCreateTransactionOutlineUnprocessable as a ErrorsTxOutlineUserHasNotEnoughFunds
-func (t ResponsesCreateTransactionOutlineUnprocessable) AsErrorsTxOutlineUserHasNotEnoughFunds() (ErrorsTxOutlineUserHasNotEnoughFunds, error) {
-	var body ErrorsTxOutlineUserHasNotEnoughFunds
+// AsErrorsInvalidAvatarURL returns the union data inside the ResponsesAdminInvalidAvatarURL as a ErrorsInvalidAvatarURL
+func (t ResponsesAdminInvalidAvatarURL) AsErrorsInvalidAvatarURL() (ErrorsInvalidAvatarURL, error) {
+	var body ErrorsInvalidAvatarURL
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsTxOutlineUserHasNotEnoughFunds overwrites any union data inside the ResponsesCreateTransactionOutlineUnprocessable as the provided ErrorsTxOutlineUserHasNotEnoughFunds
-func (t *ResponsesCreateTransactionOutlineUnprocessable) FromErrorsTxOutlineUserHasNotEnoughFunds(v ErrorsTxOutlineUserHasNotEnoughFunds) error {
+// FromErrorsInvalidAvatarURL overwrites any union data inside the ResponsesAdminInvalidAvatarURL as the provided ErrorsInvalidAvatarURL
+func (t *ResponsesAdminInvalidAvatarURL) FromErrorsInvalidAvatarURL(v ErrorsInvalidAvatarURL) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsTxOutlineUserHasNotEnoughFunds performs a merge with any union data inside the ResponsesCreateTransactionOutlineUnprocessable, using the provided ErrorsTxOutlineUserHasNotEnoughFunds
-func (t *ResponsesCreateTransactionOutlineUnprocessable) MergeErrorsTxOutlineUserHasNotEnoughFunds(v ErrorsTxOutlineUserHasNotEnoughFunds) error {
+// MergeErrorsInvalidAvatarURL performs a merge with any union data inside the ResponsesAdminInvalidAvatarURL, using the provided ErrorsInvalidAvatarURL
+func (t *ResponsesAdminInvalidAvatarURL) MergeErrorsInvalidAvatarURL(v ErrorsInvalidAvatarURL) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1627,32 +2594,32 @@ func (t *ResponsesCreateTransactionOutlineUnprocessable) MergeErrorsTxOutlineUse
 	return err
 }
 
-func (t ResponsesCreateTransactionOutlineUnprocessable) MarshalJSON() ([]byte, error) {
+func (t ResponsesAdminInvalidAvatarURL) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
 }
 
-func (t *ResponsesCreateTransactionOutlineUnprocessable) UnmarshalJSON(b []byte) error {
+func (t *ResponsesAdminInvalidAvatarURL) UnmarshalJSON(b []byte) error {
 	err := t.union.UnmarshalJSON(b)
 	return err
 }
 
-// AsErrorsDataNotFound returns the union data inside the ResponsesGetDataNotFound as a ErrorsDataNotFound
-func (t ResponsesGetDataNotFound) AsErrorsDataNotFound() (ErrorsDataNotFound, error) {
-	var body ErrorsDataNotFound
+// AsErrorsCannotBindRequest returns the union data inside the ResponsesAdminUserBadRequest as a ErrorsCannotBindRequest
+func (t ResponsesAdminUserBadRequest) AsErrorsCannotBindRequest() (ErrorsCannotBindRequest, error) {
+	var body ErrorsCannotBindRequest
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsDataNotFound overwrites any union data inside the ResponsesGetDataNotFound as the provided ErrorsDataNotFound
-func (t *ResponsesGetDataNotFound) FromErrorsDataNotFound(v ErrorsDataNotFound) error {
+// FromErrorsCannotBindRequest overwrites any union data inside the ResponsesAdminUserBadRequest as the provided ErrorsCannotBindRequest
+func (t *ResponsesAdminUserBadRequest) FromErrorsCannotBindRequest(v ErrorsCannotBindRequest) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsDataNotFound performs a merge with any union data inside the ResponsesGetDataNotFound, using the provided ErrorsDataNotFound
-func (t *ResponsesGetDataNotFound) MergeErrorsDataNotFound(v ErrorsDataNotFound) error {
+// MergeErrorsCannotBindRequest performs a merge with any union data inside the ResponsesAdminUserBadRequest, using the provided ErrorsCannotBindRequest
+func (t *ResponsesAdminUserBadRequest) MergeErrorsCannotBindRequest(v ErrorsCannotBindRequest) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1663,32 +2630,22 @@ func (t *ResponsesGetDataNotFound) MergeErrorsDataNotFound(v ErrorsDataNotFound)
 	return err
 }
 
-func (t ResponsesGetDataNotFound) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
-	return b, err
-}
-
-func (t *ResponsesGetDataNotFound) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
-	return err
-}
-
-// AsErrorsBHSUnreachable returns the union data inside the ResponsesGetMerklerootsInternalServerError as a ErrorsBHSUnreachable
-func (t ResponsesGetMerklerootsInternalServerError) AsErrorsBHSUnreachable() (ErrorsBHSUnreachable, error) {
-	var body ErrorsBHSUnreachable
+// AsErrorsInvalidPubKey returns the union data inside the ResponsesAdminUserBadRequest as a ErrorsInvalidPubKey
+func (t ResponsesAdminUserBadRequest) AsErrorsInvalidPubKey() (ErrorsInvalidPubKey, error) {
+	var body ErrorsInvalidPubKey
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsBHSUnreachable overwrites any union data inside the ResponsesGetMerklerootsInternalServerError as the provided ErrorsBHSUnreachable
-func (t *ResponsesGetMerklerootsInternalServerError) FromErrorsBHSUnreachable(v ErrorsBHSUnreachable) error {
+// FromErrorsInvalidPubKey overwrites any union data inside the ResponsesAdminUserBadRequest as the provided ErrorsInvalidPubKey
+func (t *ResponsesAdminUserBadRequest) FromErrorsInvalidPubKey(v ErrorsInvalidPubKey) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsBHSUnreachable performs a merge with any union data inside the ResponsesGetMerklerootsInternalServerError, using the provided ErrorsBHSUnreachable
-func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSUnreachable(v ErrorsBHSUnreachable) error {
+// MergeErrorsInvalidPubKey performs a merge with any union data inside the ResponsesAdminUserBadRequest, using the provided ErrorsInvalidPubKey
+func (t *ResponsesAdminUserBadRequest) MergeErrorsInvalidPubKey(v ErrorsInvalidPubKey) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1699,22 +2656,22 @@ func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSUnreachable(v
 	return err
 }
 
-// AsErrorsBHSNoSuccessResponse returns the union data inside the ResponsesGetMerklerootsInternalServerError as a ErrorsBHSNoSuccessResponse
-func (t ResponsesGetMerklerootsInternalServerError) AsErrorsBHSNoSuccessResponse() (ErrorsBHSNoSuccessResponse, error) {
-	var body ErrorsBHSNoSuccessResponse
+// AsErrorsInvalidPaymail returns the union data inside the ResponsesAdminUserBadRequest as a ErrorsInvalidPaymail
+func (t ResponsesAdminUserBadRequest) AsErrorsInvalidPaymail() (ErrorsInvalidPaymail, error) {
+	var body ErrorsInvalidPaymail
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsBHSNoSuccessResponse overwrites any union data inside the ResponsesGetMerklerootsInternalServerError as the provided ErrorsBHSNoSuccessResponse
-func (t *ResponsesGetMerklerootsInternalServerError) FromErrorsBHSNoSuccessResponse(v ErrorsBHSNoSuccessResponse) error {
+// FromErrorsInvalidPaymail overwrites any union data inside the ResponsesAdminUserBadRequest as the provided ErrorsInvalidPaymail
+func (t *ResponsesAdminUserBadRequest) FromErrorsInvalidPaymail(v ErrorsInvalidPaymail) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsBHSNoSuccessResponse performs a merge with any union data inside the ResponsesGetMerklerootsInternalServerError, using the provided ErrorsBHSNoSuccessResponse
-func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSNoSuccessResponse(v ErrorsBHSNoSuccessResponse) error {
+// MergeErrorsInvalidPaymail performs a merge with any union data inside the ResponsesAdminUserBadRequest, using the provided ErrorsInvalidPaymail
+func (t *ResponsesAdminUserBadRequest) MergeErrorsInvalidPaymail(v ErrorsInvalidPaymail) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1725,22 +2682,22 @@ func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSNoSuccessResp
 	return err
 }
 
-// AsErrorsBHSUnauthorized returns the union data inside the ResponsesGetMerklerootsInternalServerError as a ErrorsBHSUnauthorized
-func (t ResponsesGetMerklerootsInternalServerError) AsErrorsBHSUnauthorized() (ErrorsBHSUnauthorized, error) {
-	var body ErrorsBHSUnauthorized
+// AsErrorsPaymailInconsistent returns the union data inside the ResponsesAdminUserBadRequest as a ErrorsPaymailInconsistent
+func (t ResponsesAdminUserBadRequest) AsErrorsPaymailInconsistent() (ErrorsPaymailInconsistent, error) {
+	var body ErrorsPaymailInconsistent
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsBHSUnauthorized overwrites any union data inside the ResponsesGetMerklerootsInternalServerError as the provided ErrorsBHSUnauthorized
-func (t *ResponsesGetMerklerootsInternalServerError) FromErrorsBHSUnauthorized(v ErrorsBHSUnauthorized) error {
+// FromErrorsPaymailInconsistent overwrites any union data inside the ResponsesAdminUserBadRequest as the provided ErrorsPaymailInconsistent
+func (t *ResponsesAdminUserBadRequest) FromErrorsPaymailInconsistent(v ErrorsPaymailInconsistent) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsBHSUnauthorized performs a merge with any union data inside the ResponsesGetMerklerootsInternalServerError, using the provided ErrorsBHSUnauthorized
-func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSUnauthorized(v ErrorsBHSUnauthorized) error {
+// MergeErrorsPaymailInconsistent performs a merge with any union data inside the ResponsesAdminUserBadRequest, using the provided ErrorsPaymailInconsistent
+func (t *ResponsesAdminUserBadRequest) MergeErrorsPaymailInconsistent(v ErrorsPaymailInconsistent) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1751,23 +2708,23 @@ func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSUnauthorized(
 	return err
 }
 
-// AsErrorsBHSBadRequest returns the union data inside the ResponsesGetMerklerootsInternalServerError as a ErrorsBHSBadRequest
-func (t ResponsesGetMerklerootsInternalServerError) AsErrorsBHSBadRequest() (ErrorsBHSBadRequest, error) {
-	var body ErrorsBHSBadRequest
+// AsErrorsInvalidDomain returns the union data inside the ResponsesAdminUserBadRequest as a ErrorsInvalidDomain
+func (t ResponsesAdminUserBadRequest) AsErrorsInvalidDomain() (ErrorsInvalidDomain, error) {
+	var body ErrorsInvalidDomain
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsBHSBadRequest overwrites any union data inside the ResponsesGetMerklerootsInternalServerError as the provided ErrorsBHSBadRequest
-func (t *ResponsesGetMerklerootsInternalServerError) FromErrorsBHSBadRequest(v ErrorsBHSBadRequest) error {
+// FromErrorsInvalidDomain overwrites any union data inside the ResponsesAdminUserBadRequest as the provided ErrorsInvalidDomain
+func (t *ResponsesAdminUserBadRequest) FromErrorsInvalidDomain(v ErrorsInvalidDomain) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsBHSBadRequest performs a merge with any union data inside the ResponsesGetMerklerootsInternalServerError, using the provided ErrorsBHSBadRequest
-func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSBadRequest(v ErrorsBHSBadRequest) error {
-	b, err := json.Marshal(v)
+// MergeErrorsInvalidDomain performs a merge with any union data inside the ResponsesAdminUserBadRequest, using the provided ErrorsInvalidDomain
+func (t *ResponsesAdminUserBadRequest) MergeErrorsInvalidDomain(v ErrorsInvalidDomain) error {
+	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
@@ -1777,22 +2734,32 @@ func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSBadRequest(v
 	return err
 }
 
-// AsErrorsBHSUnhealthy returns the union data inside the ResponsesGetMerklerootsInternalServerError as a ErrorsBHSUnhealthy
-func (t ResponsesGetMerklerootsInternalServerError) AsErrorsBHSUnhealthy() (ErrorsBHSUnhealthy, error) {
-	var body ErrorsBHSUnhealthy
+func (t ResponsesAdminUserBadRequest) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ResponsesAdminUserBadRequest) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsErrorsContactInvalidStatus returns the union data inside the ResponsesContactUnprocessable as a ErrorsContactInvalidStatus
+func (t ResponsesContactUnprocessable) AsErrorsContactInvalidStatus() (ErrorsContactInvalidStatus, error) {
+	var body ErrorsContactInvalidStatus
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsBHSUnhealthy overwrites any union data inside the ResponsesGetMerklerootsInternalServerError as the provided ErrorsBHSUnhealthy
-func (t *ResponsesGetMerklerootsInternalServerError) FromErrorsBHSUnhealthy(v ErrorsBHSUnhealthy) error {
+// FromErrorsContactInvalidStatus overwrites any union data inside the ResponsesContactUnprocessable as the provided ErrorsContactInvalidStatus
+func (t *ResponsesContactUnprocessable) FromErrorsContactInvalidStatus(v ErrorsContactInvalidStatus) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsBHSUnhealthy performs a merge with any union data inside the ResponsesGetMerklerootsInternalServerError, using the provided ErrorsBHSUnhealthy
-func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSUnhealthy(v ErrorsBHSUnhealthy) error {
+// MergeErrorsContactInvalidStatus performs a merge with any union data inside the ResponsesContactUnprocessable, using the provided ErrorsContactInvalidStatus
+func (t *ResponsesContactUnprocessable) MergeErrorsContactInvalidStatus(v ErrorsContactInvalidStatus) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1803,22 +2770,32 @@ func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSUnhealthy(v E
 	return err
 }
 
-// AsErrorsBHSBadURL returns the union data inside the ResponsesGetMerklerootsInternalServerError as a ErrorsBHSBadURL
-func (t ResponsesGetMerklerootsInternalServerError) AsErrorsBHSBadURL() (ErrorsBHSBadURL, error) {
-	var body ErrorsBHSBadURL
+func (t ResponsesContactUnprocessable) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ResponsesContactUnprocessable) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsErrorsCannotBindRequest returns the union data inside the ResponsesCreateInvoiceBadRequest as a ErrorsCannotBindRequest
+func (t ResponsesCreateInvoiceBadRequest) AsErrorsCannotBindRequest() (ErrorsCannotBindRequest, error) {
+	var body ErrorsCannotBindRequest
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsBHSBadURL overwrites any union data inside the ResponsesGetMerklerootsInternalServerError as the provided ErrorsBHSBadURL
-func (t *ResponsesGetMerklerootsInternalServerError) FromErrorsBHSBadURL(v ErrorsBHSBadURL) error {
+// FromErrorsCannotBindRequest overwrites any union data inside the ResponsesCreateInvoiceBadRequest as the provided ErrorsCannotBindRequest
+func (t *ResponsesCreateInvoiceBadRequest) FromErrorsCannotBindRequest(v ErrorsCannotBindRequest) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsBHSBadURL performs a merge with any union data inside the ResponsesGetMerklerootsInternalServerError, using the provided ErrorsBHSBadURL
-func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSBadURL(v ErrorsBHSBadURL) error {
+// MergeErrorsCannotBindRequest performs a merge with any union data inside the ResponsesCreateInvoiceBadRequest, using the provided ErrorsCannotBindRequest
+func (t *ResponsesCreateInvoiceBadRequest) MergeErrorsCannotBindRequest(v ErrorsCannotBindRequest) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1829,22 +2806,22 @@ func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSBadURL(v Erro
 	return err
 }
 
-// AsErrorsBHSParsingResponse returns the union data inside the ResponsesGetMerklerootsInternalServerError as a ErrorsBHSParsingResponse
-func (t ResponsesGetMerklerootsInternalServerError) AsErrorsBHSParsingResponse() (ErrorsBHSParsingResponse, error) {
-	var body ErrorsBHSParsingResponse
+// AsErrorsInvalidInvoiceAmount returns the union data inside the ResponsesCreateInvoiceBadRequest as a ErrorsInvalidInvoiceAmount
+func (t ResponsesCreateInvoiceBadRequest) AsErrorsInvalidInvoiceAmount() (ErrorsInvalidInvoiceAmount, error) {
+	var body ErrorsInvalidInvoiceAmount
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsBHSParsingResponse overwrites any union data inside the ResponsesGetMerklerootsInternalServerError as the provided ErrorsBHSParsingResponse
-func (t *ResponsesGetMerklerootsInternalServerError) FromErrorsBHSParsingResponse(v ErrorsBHSParsingResponse) error {
+// FromErrorsInvalidInvoiceAmount overwrites any union data inside the ResponsesCreateInvoiceBadRequest as the provided ErrorsInvalidInvoiceAmount
+func (t *ResponsesCreateInvoiceBadRequest) FromErrorsInvalidInvoiceAmount(v ErrorsInvalidInvoiceAmount) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsBHSParsingResponse performs a merge with any union data inside the ResponsesGetMerklerootsInternalServerError, using the provided ErrorsBHSParsingResponse
-func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSParsingResponse(v ErrorsBHSParsingResponse) error {
+// MergeErrorsInvalidInvoiceAmount performs a merge with any union data inside the ResponsesCreateInvoiceBadRequest, using the provided ErrorsInvalidInvoiceAmount
+func (t *ResponsesCreateInvoiceBadRequest) MergeErrorsInvalidInvoiceAmount(v ErrorsInvalidInvoiceAmount) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1855,32 +2832,84 @@ func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSParsingRespon
 	return err
 }
 
-func (t ResponsesGetMerklerootsInternalServerError) MarshalJSON() ([]byte, error) {
+// AsErrorsInvalidInvoiceExpiry returns the union data inside the ResponsesCreateInvoiceBadRequest as a ErrorsInvalidInvoiceExpiry
+func (t ResponsesCreateInvoiceBadRequest) AsErrorsInvalidInvoiceExpiry() (ErrorsInvalidInvoiceExpiry, error) {
+	var body ErrorsInvalidInvoiceExpiry
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsInvalidInvoiceExpiry overwrites any union data inside the ResponsesCreateInvoiceBadRequest as the provided ErrorsInvalidInvoiceExpiry
+func (t *ResponsesCreateInvoiceBadRequest) FromErrorsInvalidInvoiceExpiry(v ErrorsInvalidInvoiceExpiry) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsInvalidInvoiceExpiry performs a merge with any union data inside the ResponsesCreateInvoiceBadRequest, using the provided ErrorsInvalidInvoiceExpiry
+func (t *ResponsesCreateInvoiceBadRequest) MergeErrorsInvalidInvoiceExpiry(v ErrorsInvalidInvoiceExpiry) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsErrorsInvalidInvoicePaymail returns the union data inside the ResponsesCreateInvoiceBadRequest as a ErrorsInvalidInvoicePaymail
+func (t ResponsesCreateInvoiceBadRequest) AsErrorsInvalidInvoicePaymail() (ErrorsInvalidInvoicePaymail, error) {
+	var body ErrorsInvalidInvoicePaymail
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsInvalidInvoicePaymail overwrites any union data inside the ResponsesCreateInvoiceBadRequest as the provided ErrorsInvalidInvoicePaymail
+func (t *ResponsesCreateInvoiceBadRequest) FromErrorsInvalidInvoicePaymail(v ErrorsInvalidInvoicePaymail) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsInvalidInvoicePaymail performs a merge with any union data inside the ResponsesCreateInvoiceBadRequest, using the provided ErrorsInvalidInvoicePaymail
+func (t *ResponsesCreateInvoiceBadRequest) MergeErrorsInvalidInvoicePaymail(v ErrorsInvalidInvoicePaymail) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ResponsesCreateInvoiceBadRequest) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
 }
 
-func (t *ResponsesGetMerklerootsInternalServerError) UnmarshalJSON(b []byte) error {
+func (t *ResponsesCreateInvoiceBadRequest) UnmarshalJSON(b []byte) error {
 	err := t.union.UnmarshalJSON(b)
 	return err
 }
 
-// AsErrorsInvalidDataID returns the union data inside the ResponsesRecordTransactionBadRequest as a ErrorsInvalidDataID
-func (t ResponsesRecordTransactionBadRequest) AsErrorsInvalidDataID() (ErrorsInvalidDataID, error) {
-	var body ErrorsInvalidDataID
+// AsErrorsTxSpecNoDefaultPaymailAddress returns the union data inside the ResponsesCreateTransactionOutlineBadRequest as a ErrorsTxSpecNoDefaultPaymailAddress
+func (t ResponsesCreateTransactionOutlineBadRequest) AsErrorsTxSpecNoDefaultPaymailAddress() (ErrorsTxSpecNoDefaultPaymailAddress, error) {
+	var body ErrorsTxSpecNoDefaultPaymailAddress
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsInvalidDataID overwrites any union data inside the ResponsesRecordTransactionBadRequest as the provided ErrorsInvalidDataID
-func (t *ResponsesRecordTransactionBadRequest) FromErrorsInvalidDataID(v ErrorsInvalidDataID) error {
+// FromErrorsTxSpecNoDefaultPaymailAddress overwrites any union data inside the ResponsesCreateTransactionOutlineBadRequest as the provided ErrorsTxSpecNoDefaultPaymailAddress
+func (t *ResponsesCreateTransactionOutlineBadRequest) FromErrorsTxSpecNoDefaultPaymailAddress(v ErrorsTxSpecNoDefaultPaymailAddress) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsInvalidDataID performs a merge with any union data inside the ResponsesRecordTransactionBadRequest, using the provided ErrorsInvalidDataID
-func (t *ResponsesRecordTransactionBadRequest) MergeErrorsInvalidDataID(v ErrorsInvalidDataID) error {
+// MergeErrorsTxSpecNoDefaultPaymailAddress performs a merge with any union data inside the ResponsesCreateTransactionOutlineBadRequest, using the provided ErrorsTxSpecNoDefaultPaymailAddress
+func (t *ResponsesCreateTransactionOutlineBadRequest) MergeErrorsTxSpecNoDefaultPaymailAddress(v ErrorsTxSpecNoDefaultPaymailAddress) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1891,22 +2920,22 @@ func (t *ResponsesRecordTransactionBadRequest) MergeErrorsInvalidDataID(v Errors
 	return err
 }
 
-// AsErrorsAnnotationIndexOutOfRange returns the union data inside the ResponsesRecordTransactionBadRequest as a ErrorsAnnotationIndexOutOfRange
-func (t ResponsesRecordTransactionBadRequest) AsErrorsAnnotationIndexOutOfRange() (ErrorsAnnotationIndexOutOfRange, error) {
-	var body ErrorsAnnotationIndexOutOfRange
+// AsErrorsTxSpecOutputsRequired returns the union data inside the ResponsesCreateTransactionOutlineBadRequest as a ErrorsTxSpecOutputsRequired
+func (t ResponsesCreateTransactionOutlineBadRequest) AsErrorsTxSpecOutputsRequired() (ErrorsTxSpecOutputsRequired, error) {
+	var body ErrorsTxSpecOutputsRequired
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsAnnotationIndexOutOfRange overwrites any union data inside the ResponsesRecordTransactionBadRequest as the provided ErrorsAnnotationIndexOutOfRange
-func (t *ResponsesRecordTransactionBadRequest) FromErrorsAnnotationIndexOutOfRange(v ErrorsAnnotationIndexOutOfRange) error {
+// FromErrorsTxSpecOutputsRequired overwrites any union data inside the ResponsesCreateTransactionOutlineBadRequest as the provided ErrorsTxSpecOutputsRequired
+func (t *ResponsesCreateTransactionOutlineBadRequest) FromErrorsTxSpecOutputsRequired(v ErrorsTxSpecOutputsRequired) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsAnnotationIndexOutOfRange performs a merge with any union data inside the ResponsesRecordTransactionBadRequest, using the provided ErrorsAnnotationIndexOutOfRange
-func (t *ResponsesRecordTransactionBadRequest) MergeErrorsAnnotationIndexOutOfRange(v ErrorsAnnotationIndexOutOfRange) error {
+// MergeErrorsTxSpecOutputsRequired performs a merge with any union data inside the ResponsesCreateTransactionOutlineBadRequest, using the provided ErrorsTxSpecOutputsRequired
+func (t *ResponsesCreateTransactionOutlineBadRequest) MergeErrorsTxSpecOutputsRequired(v ErrorsTxSpecOutputsRequired) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1917,22 +2946,22 @@ func (t *ResponsesRecordTransactionBadRequest) MergeErrorsAnnotationIndexOutOfRa
 	return err
 }
 
-// AsErrorsUTXOSpent returns the union data inside the ResponsesRecordTransactionBadRequest as a ErrorsUTXOSpent
-func (t ResponsesRecordTransactionBadRequest) AsErrorsUTXOSpent() (ErrorsUTXOSpent, error) {
-	var body ErrorsUTXOSpent
+// AsErrorsTxSpecOpReturnDataRequired returns the union data inside the ResponsesCreateTransactionOutlineBadRequest as a ErrorsTxSpecOpReturnDataRequired
+func (t ResponsesCreateTransactionOutlineBadRequest) AsErrorsTxSpecOpReturnDataRequired() (ErrorsTxSpecOpReturnDataRequired, error) {
+	var body ErrorsTxSpecOpReturnDataRequired
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsUTXOSpent overwrites any union data inside the ResponsesRecordTransactionBadRequest as the provided ErrorsUTXOSpent
-func (t *ResponsesRecordTransactionBadRequest) FromErrorsUTXOSpent(v ErrorsUTXOSpent) error {
+// FromErrorsTxSpecOpReturnDataRequired overwrites any union data inside the ResponsesCreateTransactionOutlineBadRequest as the provided ErrorsTxSpecOpReturnDataRequired
+func (t *ResponsesCreateTransactionOutlineBadRequest) FromErrorsTxSpecOpReturnDataRequired(v ErrorsTxSpecOpReturnDataRequired) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsUTXOSpent performs a merge with any union data inside the ResponsesRecordTransactionBadRequest, using the provided ErrorsUTXOSpent
-func (t *ResponsesRecordTransactionBadRequest) MergeErrorsUTXOSpent(v ErrorsUTXOSpent) error {
+// MergeErrorsTxSpecOpReturnDataRequired performs a merge with any union data inside the ResponsesCreateTransactionOutlineBadRequest, using the provided ErrorsTxSpecOpReturnDataRequired
+func (t *ResponsesCreateTransactionOutlineBadRequest) MergeErrorsTxSpecOpReturnDataRequired(v ErrorsTxSpecOpReturnDataRequired) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1943,22 +2972,22 @@ func (t *ResponsesRecordTransactionBadRequest) MergeErrorsUTXOSpent(v ErrorsUTXO
 	return err
 }
 
-// AsErrorsAnnotationIndexConversion returns the union data inside the ResponsesRecordTransactionBadRequest as a ErrorsAnnotationIndexConversion
-func (t ResponsesRecordTransactionBadRequest) AsErrorsAnnotationIndexConversion() (ErrorsAnnotationIndexConversion, error) {
-	var body ErrorsAnnotationIndexConversion
+// AsErrorsTxSpecFailedToDecodeHex returns the union data inside the ResponsesCreateTransactionOutlineBadRequest as a ErrorsTxSpecFailedToDecodeHex
+func (t ResponsesCreateTransactionOutlineBadRequest) AsErrorsTxSpecFailedToDecodeHex() (ErrorsTxSpecFailedToDecodeHex, error) {
+	var body ErrorsTxSpecFailedToDecodeHex
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsAnnotationIndexConversion overwrites any union data inside the ResponsesRecordTransactionBadRequest as the provided ErrorsAnnotationIndexConversion
-func (t *ResponsesRecordTransactionBadRequest) FromErrorsAnnotationIndexConversion(v ErrorsAnnotationIndexConversion) error {
+// FromErrorsTxSpecFailedToDecodeHex overwrites any union data inside the ResponsesCreateTransactionOutlineBadRequest as the provided ErrorsTxSpecFailedToDecodeHex
+func (t *ResponsesCreateTransactionOutlineBadRequest) FromErrorsTxSpecFailedToDecodeHex(v ErrorsTxSpecFailedToDecodeHex) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsAnnotationIndexConversion performs a merge with any union data inside the ResponsesRecordTransactionBadRequest, using the provided ErrorsAnnotationIndexConversion
-func (t *ResponsesRecordTransactionBadRequest) MergeErrorsAnnotationIndexConversion(v ErrorsAnnotationIndexConversion) error {
+// MergeErrorsTxSpecFailedToDecodeHex performs a merge with any union data inside the ResponsesCreateTransactionOutlineBadRequest, using the provided ErrorsTxSpecFailedToDecodeHex
+func (t *ResponsesCreateTransactionOutlineBadRequest) MergeErrorsTxSpecFailedToDecodeHex(v ErrorsTxSpecFailedToDecodeHex) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1969,22 +2998,22 @@ func (t *ResponsesRecordTransactionBadRequest) MergeErrorsAnnotationIndexConvers
 	return err
 }
 
-// AsErrorsNoOperations returns the union data inside the ResponsesRecordTransactionBadRequest as a ErrorsNoOperations
-func (t ResponsesRecordTransactionBadRequest) AsErrorsNoOperations() (ErrorsNoOperations, error) {
-	var body ErrorsNoOperations
+// AsErrorsTxSpecInvalidPaymailReceiver returns the union data inside the ResponsesCreateTransactionOutlineBadRequest as a ErrorsTxSpecInvalidPaymailReceiver
+func (t ResponsesCreateTransactionOutlineBadRequest) AsErrorsTxSpecInvalidPaymailReceiver() (ErrorsTxSpecInvalidPaymailReceiver, error) {
+	var body ErrorsTxSpecInvalidPaymailReceiver
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsNoOperations overwrites any union data inside the ResponsesRecordTransactionBadRequest as the provided ErrorsNoOperations
-func (t *ResponsesRecordTransactionBadRequest) FromErrorsNoOperations(v ErrorsNoOperations) error {
+// FromErrorsTxSpecInvalidPaymailReceiver overwrites any union data inside the ResponsesCreateTransactionOutlineBadRequest as the provided ErrorsTxSpecInvalidPaymailReceiver
+func (t *ResponsesCreateTransactionOutlineBadRequest) FromErrorsTxSpecInvalidPaymailReceiver(v ErrorsTxSpecInvalidPaymailReceiver) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsNoOperations performs a merge with any union data inside the ResponsesRecordTransactionBadRequest, using the provided ErrorsNoOperations
-func (t *ResponsesRecordTransactionBadRequest) MergeErrorsNoOperations(v ErrorsNoOperations) error {
+// MergeErrorsTxSpecInvalidPaymailReceiver performs a merge with any union data inside the ResponsesCreateTransactionOutlineBadRequest, using the provided ErrorsTxSpecInvalidPaymailReceiver
+func (t *ResponsesCreateTransactionOutlineBadRequest) MergeErrorsTxSpecInvalidPaymailReceiver(v ErrorsTxSpecInvalidPaymailReceiver) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1995,32 +3024,58 @@ func (t *ResponsesRecordTransactionBadRequest) MergeErrorsNoOperations(v ErrorsN
 	return err
 }
 
-func (t ResponsesRecordTransactionBadRequest) MarshalJSON() ([]byte, error) {
+// AsErrorsTxSpecInvalidPaymailSender returns the union data inside the ResponsesCreateTransactionOutlineBadRequest as a ErrorsTxSpecInvalidPaymailSender
+func (t ResponsesCreateTransactionOutlineBadRequest) AsErrorsTxSpecInvalidPaymailSender() (ErrorsTxSpecInvalidPaymailSender, error) {
+	var body ErrorsTxSpecInvalidPaymailSender
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsTxSpecInvalidPaymailSender overwrites any union data inside the ResponsesCreateTransactionOutlineBadRequest as the provided ErrorsTxSpecInvalidPaymailSender
+func (t *ResponsesCreateTransactionOutlineBadRequest) FromErrorsTxSpecInvalidPaymailSender(v ErrorsTxSpecInvalidPaymailSender) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsTxSpecInvalidPaymailSender performs a merge with any union data inside the ResponsesCreateTransactionOutlineBadRequest, using the provided ErrorsTxSpecInvalidPaymailSender
+func (t *ResponsesCreateTransactionOutlineBadRequest) MergeErrorsTxSpecInvalidPaymailSender(v ErrorsTxSpecInvalidPaymailSender) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ResponsesCreateTransactionOutlineBadRequest) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
 }
 
-func (t *ResponsesRecordTransactionBadRequest) UnmarshalJSON(b []byte) error {
+func (t *ResponsesCreateTransactionOutlineBadRequest) UnmarshalJSON(b []byte) error {
 	err := t.union.UnmarshalJSON(b)
 	return err
 }
 
-// AsErrorsInternal returns the union data inside the ResponsesRecordTransactionInternalServerError as a ErrorsInternal
-func (t ResponsesRecordTransactionInternalServerError) AsErrorsInternal() (ErrorsInternal, error) {
-	var body ErrorsInternal
+// AsErrorsTxOutlineUserHasNotEnoughFunds returns the union data inside the ResponsesCreateTransactionOutlineUnprocessable as a ErrorsTxOutlineUserHasNotEnoughFunds
+func (t ResponsesCreateTransactionOutlineUnprocessable) AsErrorsTxOutlineUserHasNotEnoughFunds() (ErrorsTxOutlineUserHasNotEnoughFunds, error) {
+	var body ErrorsTxOutlineUserHasNotEnoughFunds
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsInternal overwrites any union data inside the ResponsesRecordTransactionInternalServerError as the provided ErrorsInternal
-func (t *ResponsesRecordTransactionInternalServerError) FromErrorsInternal(v ErrorsInternal) error {
+// FromErrorsTxOutlineUserHasNotEnoughFunds overwrites any union data inside the ResponsesCreateTransactionOutlineUnprocessable as the provided ErrorsTxOutlineUserHasNotEnoughFunds
+func (t *ResponsesCreateTransactionOutlineUnprocessable) FromErrorsTxOutlineUserHasNotEnoughFunds(v ErrorsTxOutlineUserHasNotEnoughFunds) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsInternal performs a merge with any union data inside the ResponsesRecordTransactionInternalServerError, using the provided ErrorsInternal
-func (t *ResponsesRecordTransactionInternalServerError) MergeErrorsInternal(v ErrorsInternal) error {
+// MergeErrorsTxOutlineUserHasNotEnoughFunds performs a merge with any union data inside the ResponsesCreateTransactionOutlineUnprocessable, using the provided ErrorsTxOutlineUserHasNotEnoughFunds
+func (t *ResponsesCreateTransactionOutlineUnprocessable) MergeErrorsTxOutlineUserHasNotEnoughFunds(v ErrorsTxOutlineUserHasNotEnoughFunds) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -2031,22 +3086,22 @@ func (t *ResponsesRecordTransactionInternalServerError) MergeErrorsInternal(v Er
 	return err
 }
 
-// AsErrorsGettingOutputs returns the union data inside the ResponsesRecordTransactionInternalServerError as a ErrorsGettingOutputs
-func (t ResponsesRecordTransactionInternalServerError) AsErrorsGettingOutputs() (ErrorsGettingOutputs, error) {
-	var body ErrorsGettingOutputs
+// AsErrorsContactRejected returns the union data inside the ResponsesCreateTransactionOutlineUnprocessable as a ErrorsContactRejected
+func (t ResponsesCreateTransactionOutlineUnprocessable) AsErrorsContactRejected() (ErrorsContactRejected, error) {
+	var body ErrorsContactRejected
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsGettingOutputs overwrites any union data inside the ResponsesRecordTransactionInternalServerError as the provided ErrorsGettingOutputs
-func (t *ResponsesRecordTransactionInternalServerError) FromErrorsGettingOutputs(v ErrorsGettingOutputs) error {
+// FromErrorsContactRejected overwrites any union data inside the ResponsesCreateTransactionOutlineUnprocessable as the provided ErrorsContactRejected
+func (t *ResponsesCreateTransactionOutlineUnprocessable) FromErrorsContactRejected(v ErrorsContactRejected) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsGettingOutputs performs a merge with any union data inside the ResponsesRecordTransactionInternalServerError, using the provided ErrorsGettingOutputs
-func (t *ResponsesRecordTransactionInternalServerError) MergeErrorsGettingOutputs(v ErrorsGettingOutputs) error {
+// MergeErrorsContactRejected performs a merge with any union data inside the ResponsesCreateTransactionOutlineUnprocessable, using the provided ErrorsContactRejected
+func (t *ResponsesCreateTransactionOutlineUnprocessable) MergeErrorsContactRejected(v ErrorsContactRejected) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -2057,22 +3112,32 @@ func (t *ResponsesRecordTransactionInternalServerError) MergeErrorsGettingOutput
 	return err
 }
 
-// AsErrorsTxBroadcast returns the union data inside the ResponsesRecordTransactionInternalServerError as a ErrorsTxBroadcast
-func (t ResponsesRecordTransactionInternalServerError) AsErrorsTxBroadcast() (ErrorsTxBroadcast, error) {
-	var body ErrorsTxBroadcast
+func (t ResponsesCreateTransactionOutlineUnprocessable) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ResponsesCreateTransactionOutlineUnprocessable) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsErrorsContactNotFound returns the union data inside the ResponsesGetContactNotFound as a ErrorsContactNotFound
+func (t ResponsesGetContactNotFound) AsErrorsContactNotFound() (ErrorsContactNotFound, error) {
+	var body ErrorsContactNotFound
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsTxBroadcast overwrites any union data inside the ResponsesRecordTransactionInternalServerError as the provided ErrorsTxBroadcast
-func (t *ResponsesRecordTransactionInternalServerError) FromErrorsTxBroadcast(v ErrorsTxBroadcast) error {
+// FromErrorsContactNotFound overwrites any union data inside the ResponsesGetContactNotFound as the provided ErrorsContactNotFound
+func (t *ResponsesGetContactNotFound) FromErrorsContactNotFound(v ErrorsContactNotFound) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsTxBroadcast performs a merge with any union data inside the ResponsesRecordTransactionInternalServerError, using the provided ErrorsTxBroadcast
-func (t *ResponsesRecordTransactionInternalServerError) MergeErrorsTxBroadcast(v ErrorsTxBroadcast) error {
+// MergeErrorsContactNotFound performs a merge with any union data inside the ResponsesGetContactNotFound, using the provided ErrorsContactNotFound
+func (t *ResponsesGetContactNotFound) MergeErrorsContactNotFound(v ErrorsContactNotFound) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -2083,1479 +3148,4899 @@ func (t *ResponsesRecordTransactionInternalServerError) MergeErrorsTxBroadcast(v
 	return err
 }
 
-func (t ResponsesRecordTransactionInternalServerError) MarshalJSON() ([]byte, error) {
+func (t ResponsesGetContactNotFound) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
 }
 
-func (t *ResponsesRecordTransactionInternalServerError) UnmarshalJSON(b []byte) error {
+func (t *ResponsesGetContactNotFound) UnmarshalJSON(b []byte) error {
 	err := t.union.UnmarshalJSON(b)
 	return err
 }
 
-// RequestEditorFn  is the function signature for the RequestEditor callback function
-type RequestEditorFn func(ctx context.Context, req *http.Request) error
-
-// Doer performs HTTP requests.
-//
-// The standard http.Client implements this interface.
-type HttpRequestDoer interface {
-	Do(req *http.Request) (*http.Response, error)
+// AsErrorsDataNotFound returns the union data inside the ResponsesGetDataNotFound as a ErrorsDataNotFound
+func (t ResponsesGetDataNotFound) AsErrorsDataNotFound() (ErrorsDataNotFound, error) {
+	var body ErrorsDataNotFound
+	err := json.Unmarshal(t.union, &body)
+	return body, err
 }
 
-// Client which conforms to the OpenAPI3 specification for this service.
-type Client struct {
-	// The endpoint of the server conforming to this interface, with scheme,
-	// https://api.deepmap.com for example. This can contain a path relative
-	// to the server, such as https://api.deepmap.com/dev-test, and all the
-	// paths in the swagger spec will be appended to the server.
-	Server string
-
-	// Doer for performing requests, typically a *http.Client with any
-	// customized settings, such as certificate chains.
-	Client HttpRequestDoer
-
-	// A list of callbacks for modifying requests which are generated before sending over
-	// the network.
-	RequestEditors []RequestEditorFn
+// FromErrorsDataNotFound overwrites any union data inside the ResponsesGetDataNotFound as the provided ErrorsDataNotFound
+func (t *ResponsesGetDataNotFound) FromErrorsDataNotFound(v ErrorsDataNotFound) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
 }
 
-// ClientOption allows setting custom parameters during construction
-type ClientOption func(*Client) error
-
-// Creates a new Client, with reasonable defaults
-func NewClient(server string, opts ...ClientOption) (*Client, error) {
-	// create a client with sane default values
-	client := Client{
-		Server: server,
-	}
-	// mutate client and add all optional params
-	for _, o := range opts {
-		if err := o(&client); err != nil {
-			return nil, err
-		}
-	}
-	// ensure the server URL always has a trailing slash
-	if !strings.HasSuffix(client.Server, "/") {
-		client.Server += "/"
-	}
-	// create httpClient, if not already present
-	if client.Client == nil {
-		client.Client = &http.Client{}
+// MergeErrorsDataNotFound performs a merge with any union data inside the ResponsesGetDataNotFound, using the provided ErrorsDataNotFound
+func (t *ResponsesGetDataNotFound) MergeErrorsDataNotFound(v ErrorsDataNotFound) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
 	}
-	return &client, nil
-}
 
-// WithHTTPClient allows overriding the default Doer, which is
-// automatically created using http.Client. This is useful for tests.
-func WithHTTPClient(doer HttpRequestDoer) ClientOption {
-	return func(c *Client) error {
-		c.Client = doer
-		return nil
-	}
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
 }
 
-// WithRequestEditorFn allows setting up a callback function, which will be
-// called right before sending the request. This can be used to mutate the request.
-func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
-	return func(c *Client) error {
-		c.RequestEditors = append(c.RequestEditors, fn)
-		return nil
-	}
+func (t ResponsesGetDataNotFound) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
 }
 
-// The interface specification for the client above.
-type ClientInterface interface {
-	// AdminStatus request
-	AdminStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
-
-	// CreateUserWithBody request with any body
-	CreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
-
-	CreateUser(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
-
-	// UserById request
-	UserById(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+func (t *ResponsesGetDataNotFound) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
 
-	// AddPaymailToUserWithBody request with any body
-	AddPaymailToUserWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// AsErrorsInvoiceNotFound returns the union data inside the ResponsesGetInvoiceNotFound as a ErrorsInvoiceNotFound
+func (t ResponsesGetInvoiceNotFound) AsErrorsInvoiceNotFound() (ErrorsInvoiceNotFound, error) {
+	var body ErrorsInvoiceNotFound
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
 
-	AddPaymailToUser(ctx context.Context, id string, body AddPaymailToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// FromErrorsInvoiceNotFound overwrites any union data inside the ResponsesGetInvoiceNotFound as the provided ErrorsInvoiceNotFound
+func (t *ResponsesGetInvoiceNotFound) FromErrorsInvoiceNotFound(v ErrorsInvoiceNotFound) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
 
-	// SharedConfig request
-	SharedConfig(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+// MergeErrorsInvoiceNotFound performs a merge with any union data inside the ResponsesGetInvoiceNotFound, using the provided ErrorsInvoiceNotFound
+func (t *ResponsesGetInvoiceNotFound) MergeErrorsInvoiceNotFound(v ErrorsInvoiceNotFound) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
 
-	// DataById request
-	DataById(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
 
-	// MerkleRoots request
-	MerkleRoots(ctx context.Context, params *MerkleRootsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+func (t ResponsesGetInvoiceNotFound) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
 
-	// SearchOperations request
-	SearchOperations(ctx context.Context, params *SearchOperationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+func (t *ResponsesGetInvoiceNotFound) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
 
-	// RecordTransactionOutlineWithBody request with any body
-	RecordTransactionOutlineWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// AsErrorsBHSUnreachable returns the union data inside the ResponsesGetMerklerootsInternalServerError as a ErrorsBHSUnreachable
+func (t ResponsesGetMerklerootsInternalServerError) AsErrorsBHSUnreachable() (ErrorsBHSUnreachable, error) {
+	var body ErrorsBHSUnreachable
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
 
-	RecordTransactionOutline(ctx context.Context, body RecordTransactionOutlineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// FromErrorsBHSUnreachable overwrites any union data inside the ResponsesGetMerklerootsInternalServerError as the provided ErrorsBHSUnreachable
+func (t *ResponsesGetMerklerootsInternalServerError) FromErrorsBHSUnreachable(v ErrorsBHSUnreachable) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
 
-	// CreateTransactionOutlineWithBody request with any body
-	CreateTransactionOutlineWithBody(ctx context.Context, params *CreateTransactionOutlineParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// MergeErrorsBHSUnreachable performs a merge with any union data inside the ResponsesGetMerklerootsInternalServerError, using the provided ErrorsBHSUnreachable
+func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSUnreachable(v ErrorsBHSUnreachable) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
 
-	CreateTransactionOutline(ctx context.Context, params *CreateTransactionOutlineParams, body CreateTransactionOutlineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
 
-	// CurrentUser request
-	CurrentUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+// AsErrorsBHSNoSuccessResponse returns the union data inside the ResponsesGetMerklerootsInternalServerError as a ErrorsBHSNoSuccessResponse
+func (t ResponsesGetMerklerootsInternalServerError) AsErrorsBHSNoSuccessResponse() (ErrorsBHSNoSuccessResponse, error) {
+	var body ErrorsBHSNoSuccessResponse
+	err := json.Unmarshal(t.union, &body)
+	return body, err
 }
 
-func (c *Client) AdminStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminStatusRequest(c.Server)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// FromErrorsBHSNoSuccessResponse overwrites any union data inside the ResponsesGetMerklerootsInternalServerError as the provided ErrorsBHSNoSuccessResponse
+func (t *ResponsesGetMerklerootsInternalServerError) FromErrorsBHSNoSuccessResponse(v ErrorsBHSNoSuccessResponse) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
 }
 
-func (c *Client) CreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateUserRequestWithBody(c.Server, contentType, body)
+// MergeErrorsBHSNoSuccessResponse performs a merge with any union data inside the ResponsesGetMerklerootsInternalServerError, using the provided ErrorsBHSNoSuccessResponse
+func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSNoSuccessResponse(v ErrorsBHSNoSuccessResponse) error {
+	b, err := json.Marshal(v)
 	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+		return err
 	}
-	return c.Client.Do(req)
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
 }
 
-func (c *Client) CreateUser(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateUserRequest(c.Server, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// AsErrorsBHSUnauthorized returns the union data inside the ResponsesGetMerklerootsInternalServerError as a ErrorsBHSUnauthorized
+func (t ResponsesGetMerklerootsInternalServerError) AsErrorsBHSUnauthorized() (ErrorsBHSUnauthorized, error) {
+	var body ErrorsBHSUnauthorized
+	err := json.Unmarshal(t.union, &body)
+	return body, err
 }
 
-func (c *Client) UserById(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserByIdRequest(c.Server, id)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// FromErrorsBHSUnauthorized overwrites any union data inside the ResponsesGetMerklerootsInternalServerError as the provided ErrorsBHSUnauthorized
+func (t *ResponsesGetMerklerootsInternalServerError) FromErrorsBHSUnauthorized(v ErrorsBHSUnauthorized) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
 }
 
-func (c *Client) AddPaymailToUserWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddPaymailToUserRequestWithBody(c.Server, id, contentType, body)
+// MergeErrorsBHSUnauthorized performs a merge with any union data inside the ResponsesGetMerklerootsInternalServerError, using the provided ErrorsBHSUnauthorized
+func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSUnauthorized(v ErrorsBHSUnauthorized) error {
+	b, err := json.Marshal(v)
 	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+		return err
 	}
-	return c.Client.Do(req)
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
 }
 
-func (c *Client) AddPaymailToUser(ctx context.Context, id string, body AddPaymailToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddPaymailToUserRequest(c.Server, id, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// AsErrorsBHSBadRequest returns the union data inside the ResponsesGetMerklerootsInternalServerError as a ErrorsBHSBadRequest
+func (t ResponsesGetMerklerootsInternalServerError) AsErrorsBHSBadRequest() (ErrorsBHSBadRequest, error) {
+	var body ErrorsBHSBadRequest
+	err := json.Unmarshal(t.union, &body)
+	return body, err
 }
 
-func (c *Client) SharedConfig(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSharedConfigRequest(c.Server)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// FromErrorsBHSBadRequest overwrites any union data inside the ResponsesGetMerklerootsInternalServerError as the provided ErrorsBHSBadRequest
+func (t *ResponsesGetMerklerootsInternalServerError) FromErrorsBHSBadRequest(v ErrorsBHSBadRequest) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
 }
 
-func (c *Client) DataById(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDataByIdRequest(c.Server, id)
+// MergeErrorsBHSBadRequest performs a merge with any union data inside the ResponsesGetMerklerootsInternalServerError, using the provided ErrorsBHSBadRequest
+func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSBadRequest(v ErrorsBHSBadRequest) error {
+	b, err := json.Marshal(v)
 	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+		return err
 	}
-	return c.Client.Do(req)
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
 }
 
-func (c *Client) MerkleRoots(ctx context.Context, params *MerkleRootsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewMerkleRootsRequest(c.Server, params)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// AsErrorsBHSUnhealthy returns the union data inside the ResponsesGetMerklerootsInternalServerError as a ErrorsBHSUnhealthy
+func (t ResponsesGetMerklerootsInternalServerError) AsErrorsBHSUnhealthy() (ErrorsBHSUnhealthy, error) {
+	var body ErrorsBHSUnhealthy
+	err := json.Unmarshal(t.union, &body)
+	return body, err
 }
 
-func (c *Client) SearchOperations(ctx context.Context, params *SearchOperationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSearchOperationsRequest(c.Server, params)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// FromErrorsBHSUnhealthy overwrites any union data inside the ResponsesGetMerklerootsInternalServerError as the provided ErrorsBHSUnhealthy
+func (t *ResponsesGetMerklerootsInternalServerError) FromErrorsBHSUnhealthy(v ErrorsBHSUnhealthy) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
 }
 
-func (c *Client) RecordTransactionOutlineWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRecordTransactionOutlineRequestWithBody(c.Server, contentType, body)
+// MergeErrorsBHSUnhealthy performs a merge with any union data inside the ResponsesGetMerklerootsInternalServerError, using the provided ErrorsBHSUnhealthy
+func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSUnhealthy(v ErrorsBHSUnhealthy) error {
+	b, err := json.Marshal(v)
 	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+		return err
 	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) RecordTransactionOutline(ctx context.Context, body RecordTransactionOutlineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRecordTransactionOutlineRequest(c.Server, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
 }
 
-func (c *Client) CreateTransactionOutlineWithBody(ctx context.Context, params *CreateTransactionOutlineParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTransactionOutlineRequestWithBody(c.Server, params, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// AsErrorsBHSBadURL returns the union data inside the ResponsesGetMerklerootsInternalServerError as a ErrorsBHSBadURL
+func (t ResponsesGetMerklerootsInternalServerError) AsErrorsBHSBadURL() (ErrorsBHSBadURL, error) {
+	var body ErrorsBHSBadURL
+	err := json.Unmarshal(t.union, &body)
+	return body, err
 }
 
-func (c *Client) CreateTransactionOutline(ctx context.Context, params *CreateTransactionOutlineParams, body CreateTransactionOutlineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTransactionOutlineRequest(c.Server, params, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// FromErrorsBHSBadURL overwrites any union data inside the ResponsesGetMerklerootsInternalServerError as the provided ErrorsBHSBadURL
+func (t *ResponsesGetMerklerootsInternalServerError) FromErrorsBHSBadURL(v ErrorsBHSBadURL) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
 }
 
-func (c *Client) CurrentUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCurrentUserRequest(c.Server)
+// MergeErrorsBHSBadURL performs a merge with any union data inside the ResponsesGetMerklerootsInternalServerError, using the provided ErrorsBHSBadURL
+func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSBadURL(v ErrorsBHSBadURL) error {
+	b, err := json.Marshal(v)
 	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+		return err
 	}
-	return c.Client.Do(req)
-}
-
-// NewAdminStatusRequest generates requests for AdminStatus
-func NewAdminStatusRequest(server string) (*http.Request, error) {
-	var err error
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
 
-	operationPath := fmt.Sprintf("/api/v2/admin/status")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// AsErrorsBHSParsingResponse returns the union data inside the ResponsesGetMerklerootsInternalServerError as a ErrorsBHSParsingResponse
+func (t ResponsesGetMerklerootsInternalServerError) AsErrorsBHSParsingResponse() (ErrorsBHSParsingResponse, error) {
+	var body ErrorsBHSParsingResponse
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// FromErrorsBHSParsingResponse overwrites any union data inside the ResponsesGetMerklerootsInternalServerError as the provided ErrorsBHSParsingResponse
+func (t *ResponsesGetMerklerootsInternalServerError) FromErrorsBHSParsingResponse(v ErrorsBHSParsingResponse) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+// MergeErrorsBHSParsingResponse performs a merge with any union data inside the ResponsesGetMerklerootsInternalServerError, using the provided ErrorsBHSParsingResponse
+func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSParsingResponse(v ErrorsBHSParsingResponse) error {
+	b, err := json.Marshal(v)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return req, nil
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
 }
 
-// NewCreateUserRequest calls the generic CreateUser builder with application/json body
-func NewCreateUserRequest(server string, body CreateUserJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateUserRequestWithBody(server, "application/json", bodyReader)
+func (t ResponsesGetMerklerootsInternalServerError) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
 }
 
-// NewCreateUserRequestWithBody generates requests for CreateUser with any type of body
-func NewCreateUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+func (t *ResponsesGetMerklerootsInternalServerError) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+// AsErrorsTransactionNotFound returns the union data inside the ResponsesGetTransactionNotFound as a ErrorsTransactionNotFound
+func (t ResponsesGetTransactionNotFound) AsErrorsTransactionNotFound() (ErrorsTransactionNotFound, error) {
+	var body ErrorsTransactionNotFound
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
 
-	operationPath := fmt.Sprintf("/api/v2/admin/users")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// FromErrorsTransactionNotFound overwrites any union data inside the ResponsesGetTransactionNotFound as the provided ErrorsTransactionNotFound
+func (t *ResponsesGetTransactionNotFound) FromErrorsTransactionNotFound(v ErrorsTransactionNotFound) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+// MergeErrorsTransactionNotFound performs a merge with any union data inside the ResponsesGetTransactionNotFound, using the provided ErrorsTransactionNotFound
+func (t *ResponsesGetTransactionNotFound) MergeErrorsTransactionNotFound(v ErrorsTransactionNotFound) error {
+	b, err := json.Marshal(v)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
 
-	req.Header.Add("Content-Type", contentType)
+func (t ResponsesGetTransactionNotFound) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
 
-	return req, nil
+func (t *ResponsesGetTransactionNotFound) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
 }
 
-// NewUserByIdRequest generates requests for UserById
-func NewUserByIdRequest(server string, id string) (*http.Request, error) {
-	var err error
+// AsErrorsInvalidDataID returns the union data inside the ResponsesRecordTransactionBadRequest as a ErrorsInvalidDataID
+func (t ResponsesRecordTransactionBadRequest) AsErrorsInvalidDataID() (ErrorsInvalidDataID, error) {
+	var body ErrorsInvalidDataID
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
 
-	var pathParam0 string
+// FromErrorsInvalidDataID overwrites any union data inside the ResponsesRecordTransactionBadRequest as the provided ErrorsInvalidDataID
+func (t *ResponsesRecordTransactionBadRequest) FromErrorsInvalidDataID(v ErrorsInvalidDataID) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+// MergeErrorsInvalidDataID performs a merge with any union data inside the ResponsesRecordTransactionBadRequest, using the provided ErrorsInvalidDataID
+func (t *ResponsesRecordTransactionBadRequest) MergeErrorsInvalidDataID(v ErrorsInvalidDataID) error {
+	b, err := json.Marshal(v)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
 
-	operationPath := fmt.Sprintf("/api/v2/admin/users/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// AsErrorsAnnotationIndexOutOfRange returns the union data inside the ResponsesRecordTransactionBadRequest as a ErrorsAnnotationIndexOutOfRange
+func (t ResponsesRecordTransactionBadRequest) AsErrorsAnnotationIndexOutOfRange() (ErrorsAnnotationIndexOutOfRange, error) {
+	var body ErrorsAnnotationIndexOutOfRange
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// FromErrorsAnnotationIndexOutOfRange overwrites any union data inside the ResponsesRecordTransactionBadRequest as the provided ErrorsAnnotationIndexOutOfRange
+func (t *ResponsesRecordTransactionBadRequest) FromErrorsAnnotationIndexOutOfRange(v ErrorsAnnotationIndexOutOfRange) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+// MergeErrorsAnnotationIndexOutOfRange performs a merge with any union data inside the ResponsesRecordTransactionBadRequest, using the provided ErrorsAnnotationIndexOutOfRange
+func (t *ResponsesRecordTransactionBadRequest) MergeErrorsAnnotationIndexOutOfRange(v ErrorsAnnotationIndexOutOfRange) error {
+	b, err := json.Marshal(v)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return req, nil
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
 }
 
-// NewAddPaymailToUserRequest calls the generic AddPaymailToUser builder with application/json body
-func NewAddPaymailToUserRequest(server string, id string, body AddPaymailToUserJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// AsErrorsUTXOSpent returns the union data inside the ResponsesRecordTransactionBadRequest as a ErrorsUTXOSpent
+func (t ResponsesRecordTransactionBadRequest) AsErrorsUTXOSpent() (ErrorsUTXOSpent, error) {
+	var body ErrorsUTXOSpent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsUTXOSpent overwrites any union data inside the ResponsesRecordTransactionBadRequest as the provided ErrorsUTXOSpent
+func (t *ResponsesRecordTransactionBadRequest) FromErrorsUTXOSpent(v ErrorsUTXOSpent) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsUTXOSpent performs a merge with any union data inside the ResponsesRecordTransactionBadRequest, using the provided ErrorsUTXOSpent
+func (t *ResponsesRecordTransactionBadRequest) MergeErrorsUTXOSpent(v ErrorsUTXOSpent) error {
+	b, err := json.Marshal(v)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAddPaymailToUserRequestWithBody(server, id, "application/json", bodyReader)
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
 }
 
-// NewAddPaymailToUserRequestWithBody generates requests for AddPaymailToUser with any type of body
-func NewAddPaymailToUserRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+// AsErrorsAnnotationIndexConversion returns the union data inside the ResponsesRecordTransactionBadRequest as a ErrorsAnnotationIndexConversion
+func (t ResponsesRecordTransactionBadRequest) AsErrorsAnnotationIndexConversion() (ErrorsAnnotationIndexConversion, error) {
+	var body ErrorsAnnotationIndexConversion
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
 
-	var pathParam0 string
+// FromErrorsAnnotationIndexConversion overwrites any union data inside the ResponsesRecordTransactionBadRequest as the provided ErrorsAnnotationIndexConversion
+func (t *ResponsesRecordTransactionBadRequest) FromErrorsAnnotationIndexConversion(v ErrorsAnnotationIndexConversion) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+// MergeErrorsAnnotationIndexConversion performs a merge with any union data inside the ResponsesRecordTransactionBadRequest, using the provided ErrorsAnnotationIndexConversion
+func (t *ResponsesRecordTransactionBadRequest) MergeErrorsAnnotationIndexConversion(v ErrorsAnnotationIndexConversion) error {
+	b, err := json.Marshal(v)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
 
-	operationPath := fmt.Sprintf("/api/v2/admin/users/%s/paymails", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// AsErrorsNoOperations returns the union data inside the ResponsesRecordTransactionBadRequest as a ErrorsNoOperations
+func (t ResponsesRecordTransactionBadRequest) AsErrorsNoOperations() (ErrorsNoOperations, error) {
+	var body ErrorsNoOperations
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// FromErrorsNoOperations overwrites any union data inside the ResponsesRecordTransactionBadRequest as the provided ErrorsNoOperations
+func (t *ResponsesRecordTransactionBadRequest) FromErrorsNoOperations(v ErrorsNoOperations) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+// MergeErrorsNoOperations performs a merge with any union data inside the ResponsesRecordTransactionBadRequest, using the provided ErrorsNoOperations
+func (t *ResponsesRecordTransactionBadRequest) MergeErrorsNoOperations(v ErrorsNoOperations) error {
+	b, err := json.Marshal(v)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
 
-	return req, nil
+func (t ResponsesRecordTransactionBadRequest) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
 }
 
-// NewSharedConfigRequest generates requests for SharedConfig
-func NewSharedConfigRequest(server string) (*http.Request, error) {
-	var err error
+func (t *ResponsesRecordTransactionBadRequest) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+// AsErrorsInternal returns the union data inside the ResponsesRecordTransactionInternalServerError as a ErrorsInternal
+func (t ResponsesRecordTransactionInternalServerError) AsErrorsInternal() (ErrorsInternal, error) {
+	var body ErrorsInternal
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
 
-	operationPath := fmt.Sprintf("/api/v2/configs/shared")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// FromErrorsInternal overwrites any union data inside the ResponsesRecordTransactionInternalServerError as the provided ErrorsInternal
+func (t *ResponsesRecordTransactionInternalServerError) FromErrorsInternal(v ErrorsInternal) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+// MergeErrorsInternal performs a merge with any union data inside the ResponsesRecordTransactionInternalServerError, using the provided ErrorsInternal
+func (t *ResponsesRecordTransactionInternalServerError) MergeErrorsInternal(v ErrorsInternal) error {
+	b, err := json.Marshal(v)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsErrorsGettingOutputs returns the union data inside the ResponsesRecordTransactionInternalServerError as a ErrorsGettingOutputs
+func (t ResponsesRecordTransactionInternalServerError) AsErrorsGettingOutputs() (ErrorsGettingOutputs, error) {
+	var body ErrorsGettingOutputs
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsGettingOutputs overwrites any union data inside the ResponsesRecordTransactionInternalServerError as the provided ErrorsGettingOutputs
+func (t *ResponsesRecordTransactionInternalServerError) FromErrorsGettingOutputs(v ErrorsGettingOutputs) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsGettingOutputs performs a merge with any union data inside the ResponsesRecordTransactionInternalServerError, using the provided ErrorsGettingOutputs
+func (t *ResponsesRecordTransactionInternalServerError) MergeErrorsGettingOutputs(v ErrorsGettingOutputs) error {
+	b, err := json.Marshal(v)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return req, nil
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
 }
 
-// NewDataByIdRequest generates requests for DataById
-func NewDataByIdRequest(server string, id string) (*http.Request, error) {
-	var err error
+// AsErrorsTxBroadcast returns the union data inside the ResponsesRecordTransactionInternalServerError as a ErrorsTxBroadcast
+func (t ResponsesRecordTransactionInternalServerError) AsErrorsTxBroadcast() (ErrorsTxBroadcast, error) {
+	var body ErrorsTxBroadcast
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
 
-	var pathParam0 string
+// FromErrorsTxBroadcast overwrites any union data inside the ResponsesRecordTransactionInternalServerError as the provided ErrorsTxBroadcast
+func (t *ResponsesRecordTransactionInternalServerError) FromErrorsTxBroadcast(v ErrorsTxBroadcast) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+// MergeErrorsTxBroadcast performs a merge with any union data inside the ResponsesRecordTransactionInternalServerError, using the provided ErrorsTxBroadcast
+func (t *ResponsesRecordTransactionInternalServerError) MergeErrorsTxBroadcast(v ErrorsTxBroadcast) error {
+	b, err := json.Marshal(v)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
 
-	operationPath := fmt.Sprintf("/api/v2/data/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+func (t ResponsesRecordTransactionInternalServerError) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+func (t *ResponsesRecordTransactionInternalServerError) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+// AsErrorsInvalidDataSearchFilter returns the union data inside the ResponsesSearchDataBadRequest as a ErrorsInvalidDataSearchFilter
+func (t ResponsesSearchDataBadRequest) AsErrorsInvalidDataSearchFilter() (ErrorsInvalidDataSearchFilter, error) {
+	var body ErrorsInvalidDataSearchFilter
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsInvalidDataSearchFilter overwrites any union data inside the ResponsesSearchDataBadRequest as the provided ErrorsInvalidDataSearchFilter
+func (t *ResponsesSearchDataBadRequest) FromErrorsInvalidDataSearchFilter(v ErrorsInvalidDataSearchFilter) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsInvalidDataSearchFilter performs a merge with any union data inside the ResponsesSearchDataBadRequest, using the provided ErrorsInvalidDataSearchFilter
+func (t *ResponsesSearchDataBadRequest) MergeErrorsInvalidDataSearchFilter(v ErrorsInvalidDataSearchFilter) error {
+	b, err := json.Marshal(v)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return req, nil
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
 }
 
-// NewMerkleRootsRequest generates requests for MerkleRoots
-func NewMerkleRootsRequest(server string, params *MerkleRootsParams) (*http.Request, error) {
-	var err error
+func (t ResponsesSearchDataBadRequest) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+func (t *ResponsesSearchDataBadRequest) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
 
-	operationPath := fmt.Sprintf("/api/v2/merkleroots")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// AsErrorsCannotBindRequest returns the union data inside the ResponsesUpdatePaymailProfileBadRequest as a ErrorsCannotBindRequest
+func (t ResponsesUpdatePaymailProfileBadRequest) AsErrorsCannotBindRequest() (ErrorsCannotBindRequest, error) {
+	var body ErrorsCannotBindRequest
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+// FromErrorsCannotBindRequest overwrites any union data inside the ResponsesUpdatePaymailProfileBadRequest as the provided ErrorsCannotBindRequest
+func (t *ResponsesUpdatePaymailProfileBadRequest) FromErrorsCannotBindRequest(v ErrorsCannotBindRequest) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsCannotBindRequest performs a merge with any union data inside the ResponsesUpdatePaymailProfileBadRequest, using the provided ErrorsCannotBindRequest
+func (t *ResponsesUpdatePaymailProfileBadRequest) MergeErrorsCannotBindRequest(v ErrorsCannotBindRequest) error {
+	b, err := json.Marshal(v)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
 
-		if params.BatchSize != nil {
+// AsErrorsInvalidPaymailAddress returns the union data inside the ResponsesUpdatePaymailProfileBadRequest as a ErrorsInvalidPaymailAddress
+func (t ResponsesUpdatePaymailProfileBadRequest) AsErrorsInvalidPaymailAddress() (ErrorsInvalidPaymailAddress, error) {
+	var body ErrorsInvalidPaymailAddress
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "batchSize", runtime.ParamLocationQuery, *params.BatchSize); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// FromErrorsInvalidPaymailAddress overwrites any union data inside the ResponsesUpdatePaymailProfileBadRequest as the provided ErrorsInvalidPaymailAddress
+func (t *ResponsesUpdatePaymailProfileBadRequest) FromErrorsInvalidPaymailAddress(v ErrorsInvalidPaymailAddress) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
 
-		}
+// MergeErrorsInvalidPaymailAddress performs a merge with any union data inside the ResponsesUpdatePaymailProfileBadRequest, using the provided ErrorsInvalidPaymailAddress
+func (t *ResponsesUpdatePaymailProfileBadRequest) MergeErrorsInvalidPaymailAddress(v ErrorsInvalidPaymailAddress) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
 
-		if params.LastEvaluatedKey != nil {
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "lastEvaluatedKey", runtime.ParamLocationQuery, *params.LastEvaluatedKey); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+func (t ResponsesUpdatePaymailProfileBadRequest) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
 
-		}
+func (t *ResponsesUpdatePaymailProfileBadRequest) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
+// AsErrorsPaymailNotFound returns the union data inside the ResponsesUpdatePaymailProfileNotFound as a ErrorsPaymailNotFound
+func (t ResponsesUpdatePaymailProfileNotFound) AsErrorsPaymailNotFound() (ErrorsPaymailNotFound, error) {
+	var body ErrorsPaymailNotFound
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+// FromErrorsPaymailNotFound overwrites any union data inside the ResponsesUpdatePaymailProfileNotFound as the provided ErrorsPaymailNotFound
+func (t *ResponsesUpdatePaymailProfileNotFound) FromErrorsPaymailNotFound(v ErrorsPaymailNotFound) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsPaymailNotFound performs a merge with any union data inside the ResponsesUpdatePaymailProfileNotFound, using the provided ErrorsPaymailNotFound
+func (t *ResponsesUpdatePaymailProfileNotFound) MergeErrorsPaymailNotFound(v ErrorsPaymailNotFound) error {
+	b, err := json.Marshal(v)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return req, nil
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
 }
 
-// NewSearchOperationsRequest generates requests for SearchOperations
-func NewSearchOperationsRequest(server string, params *SearchOperationsParams) (*http.Request, error) {
-	var err error
+func (t ResponsesUpdatePaymailProfileNotFound) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+func (t *ResponsesUpdatePaymailProfileNotFound) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
 
-	operationPath := fmt.Sprintf("/api/v2/operations/search")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// AsErrorsPaymailInvalidAvatarURL returns the union data inside the ResponsesUpdatePaymailProfileUnprocessable as a ErrorsPaymailInvalidAvatarURL
+func (t ResponsesUpdatePaymailProfileUnprocessable) AsErrorsPaymailInvalidAvatarURL() (ErrorsPaymailInvalidAvatarURL, error) {
+	var body ErrorsPaymailInvalidAvatarURL
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+// FromErrorsPaymailInvalidAvatarURL overwrites any union data inside the ResponsesUpdatePaymailProfileUnprocessable as the provided ErrorsPaymailInvalidAvatarURL
+func (t *ResponsesUpdatePaymailProfileUnprocessable) FromErrorsPaymailInvalidAvatarURL(v ErrorsPaymailInvalidAvatarURL) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsPaymailInvalidAvatarURL performs a merge with any union data inside the ResponsesUpdatePaymailProfileUnprocessable, using the provided ErrorsPaymailInvalidAvatarURL
+func (t *ResponsesUpdatePaymailProfileUnprocessable) MergeErrorsPaymailInvalidAvatarURL(v ErrorsPaymailInvalidAvatarURL) error {
+	b, err := json.Marshal(v)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Page != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
 
-		if params.Size != nil {
+func (t ResponsesUpdatePaymailProfileUnprocessable) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "size", runtime.ParamLocationQuery, *params.Size); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+func (t *ResponsesUpdatePaymailProfileUnprocessable) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
 
-		}
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
-		if params.Sort != nil {
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
 
-		}
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
 
-		if params.SortBy != nil {
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortBy", runtime.ParamLocationQuery, *params.SortBy); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
 
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
 		}
-
-		queryURL.RawQuery = queryValues.Encode()
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
 	}
-
-	return req, nil
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
 }
 
-// NewRecordTransactionOutlineRequest calls the generic RecordTransactionOutline builder with application/json body
-func NewRecordTransactionOutlineRequest(server string, body RecordTransactionOutlineJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewRecordTransactionOutlineRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewRecordTransactionOutlineRequestWithBody generates requests for RecordTransactionOutline with any type of body
-func NewRecordTransactionOutlineRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
 	}
+}
 
-	operationPath := fmt.Sprintf("/api/v2/transactions")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// The interface specification for the client above.
+type ClientInterface interface {
+	// AdminStatus request
+	AdminStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	// CreateUserWithBody request with any body
+	CreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	CreateUser(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	req.Header.Add("Content-Type", contentType)
+	// UserById request
+	UserById(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	return req, nil
+	// AddPaymailToUserWithBody request with any body
+	AddPaymailToUserWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddPaymailToUser(ctx context.Context, id string, body AddPaymailToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdatePaymailProfileWithBody request with any body
+	UpdatePaymailProfileWithBody(ctx context.Context, id string, paymailId uint, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdatePaymailProfile(ctx context.Context, id string, paymailId uint, body UpdatePaymailProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SharedConfig request
+	SharedConfig(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchContacts request
+	SearchContacts(ctx context.Context, params *SearchContactsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddContactWithBody request with any body
+	AddContactWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddContact(ctx context.Context, body AddContactJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveContact request
+	RemoveContact(ctx context.Context, id RequestsContactID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ContactById request
+	ContactById(ctx context.Context, id RequestsContactID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AcceptContact request
+	AcceptContact(ctx context.Context, id RequestsContactID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConfirmContact request
+	ConfirmContact(ctx context.Context, id RequestsContactID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RejectContact request
+	RejectContact(ctx context.Context, id RequestsContactID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchData request
+	SearchData(ctx context.Context, params *SearchDataParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DataById request
+	DataById(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DataContentById request
+	DataContentById(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchInvoices request
+	SearchInvoices(ctx context.Context, params *SearchInvoicesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateInvoiceWithBody request with any body
+	CreateInvoiceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateInvoice(ctx context.Context, body CreateInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// InvoiceById request
+	InvoiceById(ctx context.Context, id RequestsInvoiceID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MerkleRoots request
+	MerkleRoots(ctx context.Context, params *MerkleRootsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchOperations request
+	SearchOperations(ctx context.Context, params *SearchOperationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LookupPaymailDestination request
+	LookupPaymailDestination(ctx context.Context, params *LookupPaymailDestinationParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RecordTransactionOutlineWithBody request with any body
+	RecordTransactionOutlineWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RecordTransactionOutline(ctx context.Context, body RecordTransactionOutlineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTransactionOutlineWithBody request with any body
+	CreateTransactionOutlineWithBody(ctx context.Context, params *CreateTransactionOutlineParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTransactionOutline(ctx context.Context, params *CreateTransactionOutlineParams, body CreateTransactionOutlineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTransactionStatus request
+	GetTransactionStatus(ctx context.Context, txid RequestsTxID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CurrentUser request
+	CurrentUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateCurrentUserPaymailProfileWithBody request with any body
+	UpdateCurrentUserPaymailProfileWithBody(ctx context.Context, paymail string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateCurrentUserPaymailProfile(ctx context.Context, paymail string, body UpdateCurrentUserPaymailProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-// NewCreateTransactionOutlineRequest calls the generic CreateTransactionOutline builder with application/json body
-func NewCreateTransactionOutlineRequest(server string, params *CreateTransactionOutlineParams, body CreateTransactionOutlineJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) AdminStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminStatusRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateTransactionOutlineRequestWithBody(server, params, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateTransactionOutlineRequestWithBody generates requests for CreateTransactionOutline with any type of body
-func NewCreateTransactionOutlineRequestWithBody(server string, params *CreateTransactionOutlineParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v2/transactions/outlines")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateUser(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Format != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+func (c *Client) UserById(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserByIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) AddPaymailToUserWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddPaymailToUserRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
+func (c *Client) AddPaymailToUser(ctx context.Context, id string, body AddPaymailToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddPaymailToUserRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) UpdatePaymailProfileWithBody(ctx context.Context, id string, paymailId uint, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePaymailProfileRequestWithBody(c.Server, id, paymailId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCurrentUserRequest generates requests for CurrentUser
-func NewCurrentUserRequest(server string) (*http.Request, error) {
-	var err error
+func (c *Client) UpdatePaymailProfile(ctx context.Context, id string, paymailId uint, body UpdatePaymailProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePaymailProfileRequest(c.Server, id, paymailId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) SharedConfig(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSharedConfigRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/api/v2/users/current")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) SearchContacts(ctx context.Context, params *SearchContactsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchContactsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) AddContactWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddContactRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) AddContact(ctx context.Context, body AddContactJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddContactRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) RemoveContact(ctx context.Context, id RequestsContactID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveContactRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+func (c *Client) ContactById(ctx context.Context, id RequestsContactID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewContactByIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return nil
+	return c.Client.Do(req)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+func (c *Client) AcceptContact(ctx context.Context, id RequestsContactID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAcceptContactRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+func (c *Client) ConfirmContact(ctx context.Context, id RequestsContactID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmContactRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+func (c *Client) RejectContact(ctx context.Context, id RequestsContactID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRejectContactRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// AdminStatusWithResponse request
-	AdminStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminStatusResponse, error)
-
-	// CreateUserWithBodyWithResponse request with any body
-	CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResponse, error)
-
-	CreateUserWithResponse(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResponse, error)
-
-	// UserByIdWithResponse request
-	UserByIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*UserByIdResponse, error)
-
-	// AddPaymailToUserWithBodyWithResponse request with any body
-	AddPaymailToUserWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddPaymailToUserResponse, error)
-
-	AddPaymailToUserWithResponse(ctx context.Context, id string, body AddPaymailToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AddPaymailToUserResponse, error)
-
-	// SharedConfigWithResponse request
-	SharedConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SharedConfigResponse, error)
-
-	// DataByIdWithResponse request
-	DataByIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DataByIdResponse, error)
-
-	// MerkleRootsWithResponse request
-	MerkleRootsWithResponse(ctx context.Context, params *MerkleRootsParams, reqEditors ...RequestEditorFn) (*MerkleRootsResponse, error)
-
-	// SearchOperationsWithResponse request
-	SearchOperationsWithResponse(ctx context.Context, params *SearchOperationsParams, reqEditors ...RequestEditorFn) (*SearchOperationsResponse, error)
-
-	// RecordTransactionOutlineWithBodyWithResponse request with any body
-	RecordTransactionOutlineWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RecordTransactionOutlineResponse, error)
-
-	RecordTransactionOutlineWithResponse(ctx context.Context, body RecordTransactionOutlineJSONRequestBody, reqEditors ...RequestEditorFn) (*RecordTransactionOutlineResponse, error)
-
-	// CreateTransactionOutlineWithBodyWithResponse request with any body
-	CreateTransactionOutlineWithBodyWithResponse(ctx context.Context, params *CreateTransactionOutlineParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTransactionOutlineResponse, error)
-
-	CreateTransactionOutlineWithResponse(ctx context.Context, params *CreateTransactionOutlineParams, body CreateTransactionOutlineJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTransactionOutlineResponse, error)
+func (c *Client) SearchData(ctx context.Context, params *SearchDataParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchDataRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	// CurrentUserWithResponse request
-	CurrentUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CurrentUserResponse, error)
+func (c *Client) DataById(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDataByIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type AdminStatusResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *ResponsesNotAuthorizedToAdminEndpoint
+func (c *Client) DataContentById(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDataContentByIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r AdminStatusResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) SearchInvoices(ctx context.Context, params *SearchInvoicesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchInvoicesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminStatusResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) CreateInvoiceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateInvoiceRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// HTTPResponse returns http.Response from which this response was parsed.
-func (r AdminStatusResponse) Response() *http.Response {
-	return r.HTTPResponse
+func (c *Client) CreateInvoice(ctx context.Context, body CreateInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateInvoiceRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
-func (r AdminStatusResponse) Bytes() []byte {
-	return r.Body
+func (c *Client) InvoiceById(ctx context.Context, id RequestsInvoiceID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInvoiceByIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type CreateUserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *ResponsesAdminCreateUserSuccess
-	JSON400      *ResponsesAdminUserBadRequest
-	JSON401      *ResponsesNotAuthorizedToAdminEndpoint
-	JSON422      *ResponsesAdminInvalidAvatarURL
-	JSON500      *ResponsesAdminCreateUserInternalServerError
+func (c *Client) MerkleRoots(ctx context.Context, params *MerkleRootsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMerkleRootsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateUserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) SearchOperations(ctx context.Context, params *SearchOperationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchOperationsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateUserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) LookupPaymailDestination(ctx context.Context, params *LookupPaymailDestinationParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLookupPaymailDestinationRequest(c.Server, params)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// HTTPResponse returns http.Response from which this response was parsed.
-func (r CreateUserResponse) Response() *http.Response {
-	return r.HTTPResponse
+func (c *Client) RecordTransactionOutlineWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRecordTransactionOutlineRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
-func (r CreateUserResponse) Bytes() []byte {
-	return r.Body
+func (c *Client) RecordTransactionOutline(ctx context.Context, body RecordTransactionOutlineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRecordTransactionOutlineRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type UserByIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ResponsesAdminGetUser
-	JSON500      *ResponsesAdminGetUserInternalServerError
+func (c *Client) CreateTransactionOutlineWithBody(ctx context.Context, params *CreateTransactionOutlineParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTransactionOutlineRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r UserByIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) CreateTransactionOutline(ctx context.Context, params *CreateTransactionOutlineParams, body CreateTransactionOutlineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTransactionOutlineRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserByIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) GetTransactionStatus(ctx context.Context, txid RequestsTxID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTransactionStatusRequest(c.Server, txid)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// HTTPResponse returns http.Response from which this response was parsed.
-func (r UserByIdResponse) Response() *http.Response {
-	return r.HTTPResponse
+func (c *Client) CurrentUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCurrentUserRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
-func (r UserByIdResponse) Bytes() []byte {
-	return r.Body
+func (c *Client) UpdateCurrentUserPaymailProfileWithBody(ctx context.Context, paymail string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCurrentUserPaymailProfileRequestWithBody(c.Server, paymail, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type AddPaymailToUserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *ResponsesAdminAddPaymailSuccess
-	JSON400      *ResponsesAdminUserBadRequest
-	JSON401      *ResponsesNotAuthorizedToAdminEndpoint
-	JSON422      *ResponsesAdminInvalidAvatarURL
-}
-
-// Status returns HTTPResponse.Status
-func (r AddPaymailToUserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) UpdateCurrentUserPaymailProfile(ctx context.Context, paymail string, body UpdateCurrentUserPaymailProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCurrentUserPaymailProfileRequest(c.Server, paymail, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r AddPaymailToUserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return 0
+	return c.Client.Do(req)
 }
 
-// HTTPResponse returns http.Response from which this response was parsed.
-func (r AddPaymailToUserResponse) Response() *http.Response {
-	return r.HTTPResponse
-}
+// NewAdminStatusRequest generates requests for AdminStatus
+func NewAdminStatusRequest(server string) (*http.Request, error) {
+	var err error
 
-// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
-func (r AddPaymailToUserResponse) Bytes() []byte {
-	return r.Body
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-type SharedConfigResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ResponsesSharedConfig
-	JSON401      *ResponsesNotAuthorized
-}
+	operationPath := fmt.Sprintf("/api/v2/admin/status")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r SharedConfigResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SharedConfigResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// HTTPResponse returns http.Response from which this response was parsed.
-func (r SharedConfigResponse) Response() *http.Response {
-	return r.HTTPResponse
+	return req, nil
 }
 
-// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
-func (r SharedConfigResponse) Bytes() []byte {
-	return r.Body
+// NewCreateUserRequest calls the generic CreateUser builder with application/json body
+func NewCreateUserRequest(server string, body CreateUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateUserRequestWithBody(server, "application/json", bodyReader)
 }
 
-type DataByIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ResponsesGetDataSuccess
-	JSON400      *ResponsesUserBadRequest
-	JSON401      *ResponsesUserNotAuthorized
-	JSON404      *ResponsesGetDataNotFound
-	JSON500      *ResponsesInternalServerError
-}
+// NewCreateUserRequestWithBody generates requests for CreateUser with any type of body
+func NewCreateUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r DataByIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DataByIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/api/v2/admin/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-// HTTPResponse returns http.Response from which this response was parsed.
-func (r DataByIdResponse) Response() *http.Response {
-	return r.HTTPResponse
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
-func (r DataByIdResponse) Bytes() []byte {
-	return r.Body
-}
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-type MerkleRootsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ResponsesGetMerklerootsSuccess
-	JSON400      *ResponsesGetMerklerootsBadRequest
-	JSON401      *ResponsesUserNotAuthorized
-	JSON404      *ResponsesGetMerklerootsNotFound
-	JSON409      *ResponsesGetMerklerootsConflict
-	JSON500      *ResponsesGetMerklerootsInternalServerError
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r MerkleRootsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r MerkleRootsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewUserByIdRequest generates requests for UserById
+func NewUserByIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// HTTPResponse returns http.Response from which this response was parsed.
-func (r MerkleRootsResponse) Response() *http.Response {
-	return r.HTTPResponse
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
-func (r MerkleRootsResponse) Bytes() []byte {
-	return r.Body
-}
+	operationPath := fmt.Sprintf("/api/v2/admin/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-type SearchOperationsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ResponsesSearchOperationsSuccess
-	JSON400      *ResponsesSearchBadRequest
-	JSON401      *ResponsesUserNotAuthorized
-	JSON500      *ResponsesInternalServerError
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r SearchOperationsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SearchOperationsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewAddPaymailToUserRequest calls the generic AddPaymailToUser builder with application/json body
+func NewAddPaymailToUserRequest(server string, id string, body AddPaymailToUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewAddPaymailToUserRequestWithBody(server, id, "application/json", bodyReader)
 }
 
-// HTTPResponse returns http.Response from which this response was parsed.
-func (r SearchOperationsResponse) Response() *http.Response {
-	return r.HTTPResponse
-}
+// NewAddPaymailToUserRequestWithBody generates requests for AddPaymailToUser with any type of body
+func NewAddPaymailToUserRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
-func (r SearchOperationsResponse) Bytes() []byte {
-	return r.Body
-}
+	var pathParam0 string
 
-type RecordTransactionOutlineResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *ResponsesRecordTransactionSuccess
-	JSON400      *ResponsesRecordTransactionBadRequest
-	JSON401      *ResponsesUserNotAuthorized
-	JSON500      *ResponsesRecordTransactionInternalServerError
-}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r RecordTransactionOutlineResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RecordTransactionOutlineResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/api/v2/admin/users/%s/paymails", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-// HTTPResponse returns http.Response from which this response was parsed.
-func (r RecordTransactionOutlineResponse) Response() *http.Response {
-	return r.HTTPResponse
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
-func (r RecordTransactionOutlineResponse) Bytes() []byte {
-	return r.Body
-}
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-type CreateTransactionOutlineResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ResponsesCreateTransactionOutlineSuccess
-	JSON400      *ResponsesCreateTransactionOutlineBadRequest
-	JSON401      *ResponsesUserNotAuthorized
-	JSON422      *ResponsesCreateTransactionOutlineUnprocessable
-	JSON500      *ResponsesInternalServerError
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateTransactionOutlineResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewUpdatePaymailProfileRequest calls the generic UpdatePaymailProfile builder with application/json body
+func NewUpdatePaymailProfileRequest(server string, id string, paymailId uint, body UpdatePaymailProfileJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdatePaymailProfileRequestWithBody(server, id, paymailId, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateTransactionOutlineResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewUpdatePaymailProfileRequestWithBody generates requests for UpdatePaymailProfile with any type of body
+func NewUpdatePaymailProfileRequestWithBody(server string, id string, paymailId uint, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// HTTPResponse returns http.Response from which this response was parsed.
-func (r CreateTransactionOutlineResponse) Response() *http.Response {
-	return r.HTTPResponse
-}
+	var pathParam1 string
 
-// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
-func (r CreateTransactionOutlineResponse) Bytes() []byte {
-	return r.Body
-}
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "paymailId", runtime.ParamLocationPath, paymailId)
+	if err != nil {
+		return nil, err
+	}
 
-type CurrentUserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ResponsesGetCurrentUserSuccess
-	JSON401      *ResponsesUserNotAuthorized
-	JSON500      *ResponsesInternalServerError
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/admin/users/%s/paymails/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CurrentUserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewSharedConfigRequest generates requests for SharedConfig
+func NewSharedConfigRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	operationPath := fmt.Sprintf("/api/v2/configs/shared")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CurrentUserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewSearchContactsRequest generates requests for SearchContacts
+func NewSearchContactsRequest(server string, params *SearchContactsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	operationPath := fmt.Sprintf("/api/v2/contacts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Size != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "size", runtime.ParamLocationQuery, *params.Size); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SortBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortBy", runtime.ParamLocationQuery, *params.SortBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// HTTPResponse returns http.Response from which this response was parsed.
-func (r CurrentUserResponse) Response() *http.Response {
-	return r.HTTPResponse
+// NewAddContactRequest calls the generic AddContact builder with application/json body
+func NewAddContactRequest(server string, body AddContactJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddContactRequestWithBody(server, "application/json", bodyReader)
 }
 
-// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
-func (r CurrentUserResponse) Bytes() []byte {
-	return r.Body
+// NewAddContactRequestWithBody generates requests for AddContact with any type of body
+func NewAddContactRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/contacts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// AdminStatusWithResponse request returning *AdminStatusResponse
-func (c *ClientWithResponses) AdminStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminStatusResponse, error) {
-	rsp, err := c.AdminStatus(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+// NewRemoveContactRequest generates requests for RemoveContact
+func NewRemoveContactRequest(server string, id RequestsContactID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/contacts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewContactByIdRequest generates requests for ContactById
+func NewContactByIdRequest(server string, id RequestsContactID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/contacts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAcceptContactRequest generates requests for AcceptContact
+func NewAcceptContactRequest(server string, id RequestsContactID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/contacts/%s/accept", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewConfirmContactRequest generates requests for ConfirmContact
+func NewConfirmContactRequest(server string, id RequestsContactID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/contacts/%s/confirm", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRejectContactRequest generates requests for RejectContact
+func NewRejectContactRequest(server string, id RequestsContactID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/contacts/%s/reject", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSearchDataRequest generates requests for SearchData
+func NewSearchDataRequest(server string, params *SearchDataParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/data")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Size != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "size", runtime.ParamLocationQuery, *params.Size); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SortBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortBy", runtime.ParamLocationQuery, *params.SortBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TxId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "txId", runtime.ParamLocationQuery, *params.TxId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedFrom != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdFrom", runtime.ParamLocationQuery, *params.CreatedFrom); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedTo != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdTo", runtime.ParamLocationQuery, *params.CreatedTo); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Prefix != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "prefix", runtime.ParamLocationQuery, *params.Prefix); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MinSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "minSize", runtime.ParamLocationQuery, *params.MinSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "maxSize", runtime.ParamLocationQuery, *params.MaxSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Protocol != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "protocol", runtime.ParamLocationQuery, *params.Protocol); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MediaType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "mediaType", runtime.ParamLocationQuery, *params.MediaType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MapApp != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "mapApp", runtime.ParamLocationQuery, *params.MapApp); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MapType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "mapType", runtime.ParamLocationQuery, *params.MapType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AipAddress != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "aipAddress", runtime.ParamLocationQuery, *params.AipAddress); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDataByIdRequest generates requests for DataById
+func NewDataByIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/data/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDataContentByIdRequest generates requests for DataContentById
+func NewDataContentByIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/data/%s/content", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSearchInvoicesRequest generates requests for SearchInvoices
+func NewSearchInvoicesRequest(server string, params *SearchInvoicesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/invoices")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Size != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "size", runtime.ParamLocationQuery, *params.Size); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SortBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortBy", runtime.ParamLocationQuery, *params.SortBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateInvoiceRequest calls the generic CreateInvoice builder with application/json body
+func NewCreateInvoiceRequest(server string, body CreateInvoiceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateInvoiceRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateInvoiceRequestWithBody generates requests for CreateInvoice with any type of body
+func NewCreateInvoiceRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/invoices")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewInvoiceByIdRequest generates requests for InvoiceById
+func NewInvoiceByIdRequest(server string, id RequestsInvoiceID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/invoices/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewMerkleRootsRequest generates requests for MerkleRoots
+func NewMerkleRootsRequest(server string, params *MerkleRootsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/merkleroots")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.BatchSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "batchSize", runtime.ParamLocationQuery, *params.BatchSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.LastEvaluatedKey != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "lastEvaluatedKey", runtime.ParamLocationQuery, *params.LastEvaluatedKey); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSearchOperationsRequest generates requests for SearchOperations
+func NewSearchOperationsRequest(server string, params *SearchOperationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/operations/search")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Size != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "size", runtime.ParamLocationQuery, *params.Size); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SortBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortBy", runtime.ParamLocationQuery, *params.SortBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLookupPaymailDestinationRequest generates requests for LookupPaymailDestination
+func NewLookupPaymailDestinationRequest(server string, params *LookupPaymailDestinationParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/paymail-destinations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Address != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "address", runtime.ParamLocationQuery, *params.Address); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TxId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "txId", runtime.ParamLocationQuery, *params.TxId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Vout != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "vout", runtime.ParamLocationQuery, *params.Vout); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRecordTransactionOutlineRequest calls the generic RecordTransactionOutline builder with application/json body
+func NewRecordTransactionOutlineRequest(server string, body RecordTransactionOutlineJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRecordTransactionOutlineRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRecordTransactionOutlineRequestWithBody generates requests for RecordTransactionOutline with any type of body
+func NewRecordTransactionOutlineRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/transactions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateTransactionOutlineRequest calls the generic CreateTransactionOutline builder with application/json body
+func NewCreateTransactionOutlineRequest(server string, params *CreateTransactionOutlineParams, body CreateTransactionOutlineJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTransactionOutlineRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewCreateTransactionOutlineRequestWithBody generates requests for CreateTransactionOutline with any type of body
+func NewCreateTransactionOutlineRequestWithBody(server string, params *CreateTransactionOutlineParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/transactions/outlines")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTransactionStatusRequest generates requests for GetTransactionStatus
+func NewGetTransactionStatusRequest(server string, txid RequestsTxID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "txID", runtime.ParamLocationPath, txid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/transactions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCurrentUserRequest generates requests for CurrentUser
+func NewCurrentUserRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/users/current")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateCurrentUserPaymailProfileRequest calls the generic UpdateCurrentUserPaymailProfile builder with application/json body
+func NewUpdateCurrentUserPaymailProfileRequest(server string, paymail string, body UpdateCurrentUserPaymailProfileJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateCurrentUserPaymailProfileRequestWithBody(server, paymail, "application/json", bodyReader)
+}
+
+// NewUpdateCurrentUserPaymailProfileRequestWithBody generates requests for UpdateCurrentUserPaymailProfile with any type of body
+func NewUpdateCurrentUserPaymailProfileRequestWithBody(server string, paymail string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "paymail", runtime.ParamLocationPath, paymail)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/users/current/paymails/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// AdminStatusWithResponse request
+	AdminStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminStatusResponse, error)
+
+	// CreateUserWithBodyWithResponse request with any body
+	CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResponse, error)
+
+	CreateUserWithResponse(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResponse, error)
+
+	// UserByIdWithResponse request
+	UserByIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*UserByIdResponse, error)
+
+	// AddPaymailToUserWithBodyWithResponse request with any body
+	AddPaymailToUserWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddPaymailToUserResponse, error)
+
+	AddPaymailToUserWithResponse(ctx context.Context, id string, body AddPaymailToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AddPaymailToUserResponse, error)
+
+	// UpdatePaymailProfileWithBodyWithResponse request with any body
+	UpdatePaymailProfileWithBodyWithResponse(ctx context.Context, id string, paymailId uint, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePaymailProfileResponse, error)
+
+	UpdatePaymailProfileWithResponse(ctx context.Context, id string, paymailId uint, body UpdatePaymailProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePaymailProfileResponse, error)
+
+	// SharedConfigWithResponse request
+	SharedConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SharedConfigResponse, error)
+
+	// SearchContactsWithResponse request
+	SearchContactsWithResponse(ctx context.Context, params *SearchContactsParams, reqEditors ...RequestEditorFn) (*SearchContactsResponse, error)
+
+	// AddContactWithBodyWithResponse request with any body
+	AddContactWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddContactResponse, error)
+
+	AddContactWithResponse(ctx context.Context, body AddContactJSONRequestBody, reqEditors ...RequestEditorFn) (*AddContactResponse, error)
+
+	// RemoveContactWithResponse request
+	RemoveContactWithResponse(ctx context.Context, id RequestsContactID, reqEditors ...RequestEditorFn) (*RemoveContactResponse, error)
+
+	// ContactByIdWithResponse request
+	ContactByIdWithResponse(ctx context.Context, id RequestsContactID, reqEditors ...RequestEditorFn) (*ContactByIdResponse, error)
+
+	// AcceptContactWithResponse request
+	AcceptContactWithResponse(ctx context.Context, id RequestsContactID, reqEditors ...RequestEditorFn) (*AcceptContactResponse, error)
+
+	// ConfirmContactWithResponse request
+	ConfirmContactWithResponse(ctx context.Context, id RequestsContactID, reqEditors ...RequestEditorFn) (*ConfirmContactResponse, error)
+
+	// RejectContactWithResponse request
+	RejectContactWithResponse(ctx context.Context, id RequestsContactID, reqEditors ...RequestEditorFn) (*RejectContactResponse, error)
+
+	// SearchDataWithResponse request
+	SearchDataWithResponse(ctx context.Context, params *SearchDataParams, reqEditors ...RequestEditorFn) (*SearchDataResponse, error)
+
+	// DataByIdWithResponse request
+	DataByIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DataByIdResponse, error)
+
+	// DataContentByIdWithResponse request
+	DataContentByIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DataContentByIdResponse, error)
+
+	// SearchInvoicesWithResponse request
+	SearchInvoicesWithResponse(ctx context.Context, params *SearchInvoicesParams, reqEditors ...RequestEditorFn) (*SearchInvoicesResponse, error)
+
+	// CreateInvoiceWithBodyWithResponse request with any body
+	CreateInvoiceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInvoiceResponse, error)
+
+	CreateInvoiceWithResponse(ctx context.Context, body CreateInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInvoiceResponse, error)
+
+	// InvoiceByIdWithResponse request
+	InvoiceByIdWithResponse(ctx context.Context, id RequestsInvoiceID, reqEditors ...RequestEditorFn) (*InvoiceByIdResponse, error)
+
+	// MerkleRootsWithResponse request
+	MerkleRootsWithResponse(ctx context.Context, params *MerkleRootsParams, reqEditors ...RequestEditorFn) (*MerkleRootsResponse, error)
+
+	// SearchOperationsWithResponse request
+	SearchOperationsWithResponse(ctx context.Context, params *SearchOperationsParams, reqEditors ...RequestEditorFn) (*SearchOperationsResponse, error)
+
+	// LookupPaymailDestinationWithResponse request
+	LookupPaymailDestinationWithResponse(ctx context.Context, params *LookupPaymailDestinationParams, reqEditors ...RequestEditorFn) (*LookupPaymailDestinationResponse, error)
+
+	// RecordTransactionOutlineWithBodyWithResponse request with any body
+	RecordTransactionOutlineWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RecordTransactionOutlineResponse, error)
+
+	RecordTransactionOutlineWithResponse(ctx context.Context, body RecordTransactionOutlineJSONRequestBody, reqEditors ...RequestEditorFn) (*RecordTransactionOutlineResponse, error)
+
+	// CreateTransactionOutlineWithBodyWithResponse request with any body
+	CreateTransactionOutlineWithBodyWithResponse(ctx context.Context, params *CreateTransactionOutlineParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTransactionOutlineResponse, error)
+
+	CreateTransactionOutlineWithResponse(ctx context.Context, params *CreateTransactionOutlineParams, body CreateTransactionOutlineJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTransactionOutlineResponse, error)
+
+	// GetTransactionStatusWithResponse request
+	GetTransactionStatusWithResponse(ctx context.Context, txid RequestsTxID, reqEditors ...RequestEditorFn) (*GetTransactionStatusResponse, error)
+
+	// CurrentUserWithResponse request
+	CurrentUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CurrentUserResponse, error)
+
+	// UpdateCurrentUserPaymailProfileWithBodyWithResponse request with any body
+	UpdateCurrentUserPaymailProfileWithBodyWithResponse(ctx context.Context, paymail string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCurrentUserPaymailProfileResponse, error)
+
+	UpdateCurrentUserPaymailProfileWithResponse(ctx context.Context, paymail string, body UpdateCurrentUserPaymailProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCurrentUserPaymailProfileResponse, error)
+}
+
+type AdminStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ResponsesNotAuthorizedToAdminEndpoint
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r AdminStatusResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r AdminStatusResponse) Bytes() []byte {
+	return r.Body
+}
+
+type CreateUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ResponsesAdminCreateUserSuccess
+	JSON400      *ResponsesAdminUserBadRequest
+	JSON401      *ResponsesNotAuthorizedToAdminEndpoint
+	JSON422      *ResponsesAdminInvalidAvatarURL
+	JSON500      *ResponsesAdminCreateUserInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r CreateUserResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r CreateUserResponse) Bytes() []byte {
+	return r.Body
+}
+
+type UserByIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesAdminGetUser
+	JSON500      *ResponsesAdminGetUserInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UserByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r UserByIdResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r UserByIdResponse) Bytes() []byte {
+	return r.Body
+}
+
+type AddPaymailToUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ResponsesAdminAddPaymailSuccess
+	JSON400      *ResponsesAdminUserBadRequest
+	JSON401      *ResponsesNotAuthorizedToAdminEndpoint
+	JSON422      *ResponsesAdminInvalidAvatarURL
+}
+
+// Status returns HTTPResponse.Status
+func (r AddPaymailToUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddPaymailToUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r AddPaymailToUserResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r AddPaymailToUserResponse) Bytes() []byte {
+	return r.Body
+}
+
+type UpdatePaymailProfileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesUpdatePaymailProfileSuccess
+	JSON400      *ResponsesAdminUserBadRequest
+	JSON401      *ResponsesNotAuthorizedToAdminEndpoint
+	JSON404      *ResponsesUpdatePaymailProfileNotFound
+	JSON422      *ResponsesAdminInvalidAvatarURL
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdatePaymailProfileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdatePaymailProfileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r UpdatePaymailProfileResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r UpdatePaymailProfileResponse) Bytes() []byte {
+	return r.Body
+}
+
+type SharedConfigResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesSharedConfig
+	JSON401      *ResponsesNotAuthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r SharedConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SharedConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r SharedConfigResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r SharedConfigResponse) Bytes() []byte {
+	return r.Body
+}
+
+type SearchContactsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesSearchContactsSuccess
+	JSON400      *ResponsesSearchBadRequest
+	JSON401      *ResponsesUserNotAuthorized
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchContactsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchContactsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r SearchContactsResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r SearchContactsResponse) Bytes() []byte {
+	return r.Body
+}
+
+type AddContactResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ResponsesAddContactSuccess
+	JSON400      *ResponsesAddContactBadRequest
+	JSON401      *ResponsesUserNotAuthorized
+	JSON424      *ResponsesAddContactFailedDependency
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r AddContactResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddContactResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r AddContactResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r AddContactResponse) Bytes() []byte {
+	return r.Body
+}
+
+type RemoveContactResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ResponsesUserNotAuthorized
+	JSON404      *ResponsesGetContactNotFound
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveContactResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveContactResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r RemoveContactResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r RemoveContactResponse) Bytes() []byte {
+	return r.Body
+}
+
+type ContactByIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesContactSuccess
+	JSON401      *ResponsesUserNotAuthorized
+	JSON404      *ResponsesGetContactNotFound
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ContactByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ContactByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r ContactByIdResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r ContactByIdResponse) Bytes() []byte {
+	return r.Body
+}
+
+type AcceptContactResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesContactSuccess
+	JSON401      *ResponsesUserNotAuthorized
+	JSON404      *ResponsesGetContactNotFound
+	JSON422      *ResponsesContactUnprocessable
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r AcceptContactResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AcceptContactResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r AcceptContactResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r AcceptContactResponse) Bytes() []byte {
+	return r.Body
+}
+
+type ConfirmContactResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesContactSuccess
+	JSON401      *ResponsesUserNotAuthorized
+	JSON404      *ResponsesGetContactNotFound
+	JSON422      *ResponsesContactUnprocessable
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ConfirmContactResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConfirmContactResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r ConfirmContactResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r ConfirmContactResponse) Bytes() []byte {
+	return r.Body
+}
+
+type RejectContactResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesContactSuccess
+	JSON401      *ResponsesUserNotAuthorized
+	JSON404      *ResponsesGetContactNotFound
+	JSON422      *ResponsesContactUnprocessable
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r RejectContactResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RejectContactResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r RejectContactResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r RejectContactResponse) Bytes() []byte {
+	return r.Body
+}
+
+type SearchDataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesSearchDataSuccess
+	JSON400      *ResponsesSearchDataBadRequest
+	JSON401      *ResponsesUserNotAuthorized
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchDataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchDataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r SearchDataResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r SearchDataResponse) Bytes() []byte {
+	return r.Body
+}
+
+type DataByIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesGetDataSuccess
+	JSON400      *ResponsesUserBadRequest
+	JSON401      *ResponsesUserNotAuthorized
+	JSON404      *ResponsesGetDataNotFound
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DataByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DataByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r DataByIdResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r DataByIdResponse) Bytes() []byte {
+	return r.Body
+}
+
+type DataContentByIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ResponsesUserBadRequest
+	JSON401      *ResponsesUserNotAuthorized
+	JSON404      *ResponsesGetDataNotFound
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DataContentByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DataContentByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r DataContentByIdResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r DataContentByIdResponse) Bytes() []byte {
+	return r.Body
+}
+
+type SearchInvoicesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesSearchInvoicesSuccess
+	JSON400      *ResponsesSearchBadRequest
+	JSON401      *ResponsesUserNotAuthorized
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchInvoicesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchInvoicesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r SearchInvoicesResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r SearchInvoicesResponse) Bytes() []byte {
+	return r.Body
+}
+
+type CreateInvoiceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ResponsesInvoiceSuccess
+	JSON400      *ResponsesCreateInvoiceBadRequest
+	JSON401      *ResponsesUserNotAuthorized
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateInvoiceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateInvoiceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r CreateInvoiceResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r CreateInvoiceResponse) Bytes() []byte {
+	return r.Body
+}
+
+type InvoiceByIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesInvoiceSuccess
+	JSON401      *ResponsesUserNotAuthorized
+	JSON404      *ResponsesGetInvoiceNotFound
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r InvoiceByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r InvoiceByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r InvoiceByIdResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r InvoiceByIdResponse) Bytes() []byte {
+	return r.Body
+}
+
+type MerkleRootsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesGetMerklerootsSuccess
+	JSON400      *ResponsesGetMerklerootsBadRequest
+	JSON401      *ResponsesUserNotAuthorized
+	JSON404      *ResponsesGetMerklerootsNotFound
+	JSON409      *ResponsesGetMerklerootsConflict
+	JSON500      *ResponsesGetMerklerootsInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r MerkleRootsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MerkleRootsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r MerkleRootsResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r MerkleRootsResponse) Bytes() []byte {
+	return r.Body
+}
+
+type SearchOperationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesSearchOperationsSuccess
+	JSON400      *ResponsesSearchBadRequest
+	JSON401      *ResponsesUserNotAuthorized
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchOperationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchOperationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r SearchOperationsResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r SearchOperationsResponse) Bytes() []byte {
+	return r.Body
+}
+
+type LookupPaymailDestinationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesLookupPaymailDestinationSuccess
+	JSON400      *ResponsesLookupPaymailDestinationBadRequest
+	JSON401      *ResponsesUserNotAuthorized
+	JSON404      *ResponsesLookupPaymailDestinationNotFound
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r LookupPaymailDestinationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LookupPaymailDestinationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r LookupPaymailDestinationResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r LookupPaymailDestinationResponse) Bytes() []byte {
+	return r.Body
+}
+
+type RecordTransactionOutlineResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ResponsesRecordTransactionSuccess
+	JSON400      *ResponsesRecordTransactionBadRequest
+	JSON401      *ResponsesUserNotAuthorized
+	JSON500      *ResponsesRecordTransactionInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r RecordTransactionOutlineResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RecordTransactionOutlineResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r RecordTransactionOutlineResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r RecordTransactionOutlineResponse) Bytes() []byte {
+	return r.Body
+}
+
+type CreateTransactionOutlineResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesCreateTransactionOutlineSuccess
+	JSON400      *ResponsesCreateTransactionOutlineBadRequest
+	JSON401      *ResponsesUserNotAuthorized
+	JSON422      *ResponsesCreateTransactionOutlineUnprocessable
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTransactionOutlineResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTransactionOutlineResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r CreateTransactionOutlineResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r CreateTransactionOutlineResponse) Bytes() []byte {
+	return r.Body
+}
+
+type GetTransactionStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesTransactionStatusSuccess
+	JSON401      *ResponsesUserNotAuthorized
+	JSON404      *ResponsesGetTransactionNotFound
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTransactionStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTransactionStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r GetTransactionStatusResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r GetTransactionStatusResponse) Bytes() []byte {
+	return r.Body
+}
+
+type CurrentUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesGetCurrentUserSuccess
+	JSON401      *ResponsesUserNotAuthorized
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CurrentUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CurrentUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r CurrentUserResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r CurrentUserResponse) Bytes() []byte {
+	return r.Body
+}
+
+type UpdateCurrentUserPaymailProfileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesUpdatePaymailProfileSuccess
+	JSON400      *ResponsesUpdatePaymailProfileBadRequest
+	JSON401      *ResponsesUserNotAuthorized
+	JSON404      *ResponsesUpdatePaymailProfileNotFound
+	JSON422      *ResponsesUpdatePaymailProfileUnprocessable
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateCurrentUserPaymailProfileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateCurrentUserPaymailProfileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r UpdateCurrentUserPaymailProfileResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r UpdateCurrentUserPaymailProfileResponse) Bytes() []byte {
+	return r.Body
+}
+
+// AdminStatusWithResponse request returning *AdminStatusResponse
+func (c *ClientWithResponses) AdminStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminStatusResponse, error) {
+	rsp, err := c.AdminStatus(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminStatusResponse(rsp)
+}
+
+// CreateUserWithBodyWithResponse request with arbitrary body returning *CreateUserResponse
+func (c *ClientWithResponses) CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
+	rsp, err := c.CreateUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateUserWithResponse(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
+	rsp, err := c.CreateUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserResponse(rsp)
+}
+
+// UserByIdWithResponse request returning *UserByIdResponse
+func (c *ClientWithResponses) UserByIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*UserByIdResponse, error) {
+	rsp, err := c.UserById(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserByIdResponse(rsp)
+}
+
+// AddPaymailToUserWithBodyWithResponse request with arbitrary body returning *AddPaymailToUserResponse
+func (c *ClientWithResponses) AddPaymailToUserWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddPaymailToUserResponse, error) {
+	rsp, err := c.AddPaymailToUserWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddPaymailToUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddPaymailToUserWithResponse(ctx context.Context, id string, body AddPaymailToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AddPaymailToUserResponse, error) {
+	rsp, err := c.AddPaymailToUser(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddPaymailToUserResponse(rsp)
+}
+
+// UpdatePaymailProfileWithBodyWithResponse request with arbitrary body returning *UpdatePaymailProfileResponse
+func (c *ClientWithResponses) UpdatePaymailProfileWithBodyWithResponse(ctx context.Context, id string, paymailId uint, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePaymailProfileResponse, error) {
+	rsp, err := c.UpdatePaymailProfileWithBody(ctx, id, paymailId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePaymailProfileResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdatePaymailProfileWithResponse(ctx context.Context, id string, paymailId uint, body UpdatePaymailProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePaymailProfileResponse, error) {
+	rsp, err := c.UpdatePaymailProfile(ctx, id, paymailId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePaymailProfileResponse(rsp)
+}
+
+// SharedConfigWithResponse request returning *SharedConfigResponse
+func (c *ClientWithResponses) SharedConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SharedConfigResponse, error) {
+	rsp, err := c.SharedConfig(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSharedConfigResponse(rsp)
+}
+
+// SearchContactsWithResponse request returning *SearchContactsResponse
+func (c *ClientWithResponses) SearchContactsWithResponse(ctx context.Context, params *SearchContactsParams, reqEditors ...RequestEditorFn) (*SearchContactsResponse, error) {
+	rsp, err := c.SearchContacts(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchContactsResponse(rsp)
+}
+
+// AddContactWithBodyWithResponse request with arbitrary body returning *AddContactResponse
+func (c *ClientWithResponses) AddContactWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddContactResponse, error) {
+	rsp, err := c.AddContactWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddContactResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddContactWithResponse(ctx context.Context, body AddContactJSONRequestBody, reqEditors ...RequestEditorFn) (*AddContactResponse, error) {
+	rsp, err := c.AddContact(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddContactResponse(rsp)
+}
+
+// RemoveContactWithResponse request returning *RemoveContactResponse
+func (c *ClientWithResponses) RemoveContactWithResponse(ctx context.Context, id RequestsContactID, reqEditors ...RequestEditorFn) (*RemoveContactResponse, error) {
+	rsp, err := c.RemoveContact(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveContactResponse(rsp)
+}
+
+// ContactByIdWithResponse request returning *ContactByIdResponse
+func (c *ClientWithResponses) ContactByIdWithResponse(ctx context.Context, id RequestsContactID, reqEditors ...RequestEditorFn) (*ContactByIdResponse, error) {
+	rsp, err := c.ContactById(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseContactByIdResponse(rsp)
+}
+
+// AcceptContactWithResponse request returning *AcceptContactResponse
+func (c *ClientWithResponses) AcceptContactWithResponse(ctx context.Context, id RequestsContactID, reqEditors ...RequestEditorFn) (*AcceptContactResponse, error) {
+	rsp, err := c.AcceptContact(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAcceptContactResponse(rsp)
+}
+
+// ConfirmContactWithResponse request returning *ConfirmContactResponse
+func (c *ClientWithResponses) ConfirmContactWithResponse(ctx context.Context, id RequestsContactID, reqEditors ...RequestEditorFn) (*ConfirmContactResponse, error) {
+	rsp, err := c.ConfirmContact(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmContactResponse(rsp)
+}
+
+// RejectContactWithResponse request returning *RejectContactResponse
+func (c *ClientWithResponses) RejectContactWithResponse(ctx context.Context, id RequestsContactID, reqEditors ...RequestEditorFn) (*RejectContactResponse, error) {
+	rsp, err := c.RejectContact(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRejectContactResponse(rsp)
+}
+
+// SearchDataWithResponse request returning *SearchDataResponse
+func (c *ClientWithResponses) SearchDataWithResponse(ctx context.Context, params *SearchDataParams, reqEditors ...RequestEditorFn) (*SearchDataResponse, error) {
+	rsp, err := c.SearchData(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchDataResponse(rsp)
+}
+
+// DataByIdWithResponse request returning *DataByIdResponse
+func (c *ClientWithResponses) DataByIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DataByIdResponse, error) {
+	rsp, err := c.DataById(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDataByIdResponse(rsp)
+}
+
+// DataContentByIdWithResponse request returning *DataContentByIdResponse
+func (c *ClientWithResponses) DataContentByIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DataContentByIdResponse, error) {
+	rsp, err := c.DataContentById(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDataContentByIdResponse(rsp)
+}
+
+// SearchInvoicesWithResponse request returning *SearchInvoicesResponse
+func (c *ClientWithResponses) SearchInvoicesWithResponse(ctx context.Context, params *SearchInvoicesParams, reqEditors ...RequestEditorFn) (*SearchInvoicesResponse, error) {
+	rsp, err := c.SearchInvoices(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchInvoicesResponse(rsp)
+}
+
+// CreateInvoiceWithBodyWithResponse request with arbitrary body returning *CreateInvoiceResponse
+func (c *ClientWithResponses) CreateInvoiceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInvoiceResponse, error) {
+	rsp, err := c.CreateInvoiceWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateInvoiceResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateInvoiceWithResponse(ctx context.Context, body CreateInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInvoiceResponse, error) {
+	rsp, err := c.CreateInvoice(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateInvoiceResponse(rsp)
+}
+
+// InvoiceByIdWithResponse request returning *InvoiceByIdResponse
+func (c *ClientWithResponses) InvoiceByIdWithResponse(ctx context.Context, id RequestsInvoiceID, reqEditors ...RequestEditorFn) (*InvoiceByIdResponse, error) {
+	rsp, err := c.InvoiceById(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInvoiceByIdResponse(rsp)
+}
+
+// MerkleRootsWithResponse request returning *MerkleRootsResponse
+func (c *ClientWithResponses) MerkleRootsWithResponse(ctx context.Context, params *MerkleRootsParams, reqEditors ...RequestEditorFn) (*MerkleRootsResponse, error) {
+	rsp, err := c.MerkleRoots(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMerkleRootsResponse(rsp)
+}
+
+// SearchOperationsWithResponse request returning *SearchOperationsResponse
+func (c *ClientWithResponses) SearchOperationsWithResponse(ctx context.Context, params *SearchOperationsParams, reqEditors ...RequestEditorFn) (*SearchOperationsResponse, error) {
+	rsp, err := c.SearchOperations(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchOperationsResponse(rsp)
+}
+
+// LookupPaymailDestinationWithResponse request returning *LookupPaymailDestinationResponse
+func (c *ClientWithResponses) LookupPaymailDestinationWithResponse(ctx context.Context, params *LookupPaymailDestinationParams, reqEditors ...RequestEditorFn) (*LookupPaymailDestinationResponse, error) {
+	rsp, err := c.LookupPaymailDestination(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLookupPaymailDestinationResponse(rsp)
+}
+
+// RecordTransactionOutlineWithBodyWithResponse request with arbitrary body returning *RecordTransactionOutlineResponse
+func (c *ClientWithResponses) RecordTransactionOutlineWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RecordTransactionOutlineResponse, error) {
+	rsp, err := c.RecordTransactionOutlineWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRecordTransactionOutlineResponse(rsp)
+}
+
+func (c *ClientWithResponses) RecordTransactionOutlineWithResponse(ctx context.Context, body RecordTransactionOutlineJSONRequestBody, reqEditors ...RequestEditorFn) (*RecordTransactionOutlineResponse, error) {
+	rsp, err := c.RecordTransactionOutline(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRecordTransactionOutlineResponse(rsp)
+}
+
+// CreateTransactionOutlineWithBodyWithResponse request with arbitrary body returning *CreateTransactionOutlineResponse
+func (c *ClientWithResponses) CreateTransactionOutlineWithBodyWithResponse(ctx context.Context, params *CreateTransactionOutlineParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTransactionOutlineResponse, error) {
+	rsp, err := c.CreateTransactionOutlineWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTransactionOutlineResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTransactionOutlineWithResponse(ctx context.Context, params *CreateTransactionOutlineParams, body CreateTransactionOutlineJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTransactionOutlineResponse, error) {
+	rsp, err := c.CreateTransactionOutline(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTransactionOutlineResponse(rsp)
+}
+
+// GetTransactionStatusWithResponse request returning *GetTransactionStatusResponse
+func (c *ClientWithResponses) GetTransactionStatusWithResponse(ctx context.Context, txid RequestsTxID, reqEditors ...RequestEditorFn) (*GetTransactionStatusResponse, error) {
+	rsp, err := c.GetTransactionStatus(ctx, txid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTransactionStatusResponse(rsp)
+}
+
+// CurrentUserWithResponse request returning *CurrentUserResponse
+func (c *ClientWithResponses) CurrentUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CurrentUserResponse, error) {
+	rsp, err := c.CurrentUser(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCurrentUserResponse(rsp)
+}
+
+// UpdateCurrentUserPaymailProfileWithBodyWithResponse request with arbitrary body returning *UpdateCurrentUserPaymailProfileResponse
+func (c *ClientWithResponses) UpdateCurrentUserPaymailProfileWithBodyWithResponse(ctx context.Context, paymail string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCurrentUserPaymailProfileResponse, error) {
+	rsp, err := c.UpdateCurrentUserPaymailProfileWithBody(ctx, paymail, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCurrentUserPaymailProfileResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateCurrentUserPaymailProfileWithResponse(ctx context.Context, paymail string, body UpdateCurrentUserPaymailProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCurrentUserPaymailProfileResponse, error) {
+	rsp, err := c.UpdateCurrentUserPaymailProfile(ctx, paymail, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCurrentUserPaymailProfileResponse(rsp)
+}
+
+// ParseAdminStatusResponse parses an HTTP response from a AdminStatusWithResponse call
+func ParseAdminStatusResponse(rsp *http.Response) (*AdminStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ResponsesNotAuthorizedToAdminEndpoint
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateUserResponse parses an HTTP response from a CreateUserWithResponse call
+func ParseCreateUserResponse(rsp *http.Response) (*CreateUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ResponsesAdminCreateUserSuccess
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ResponsesAdminUserBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ResponsesNotAuthorizedToAdminEndpoint
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ResponsesAdminInvalidAvatarURL
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponsesAdminCreateUserInternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserByIdResponse parses an HTTP response from a UserByIdWithResponse call
+func ParseUserByIdResponse(rsp *http.Response) (*UserByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResponsesAdminGetUser
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponsesAdminGetUserInternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddPaymailToUserResponse parses an HTTP response from a AddPaymailToUserWithResponse call
+func ParseAddPaymailToUserResponse(rsp *http.Response) (*AddPaymailToUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddPaymailToUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ResponsesAdminAddPaymailSuccess
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ResponsesAdminUserBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ResponsesNotAuthorizedToAdminEndpoint
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ResponsesAdminInvalidAvatarURL
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdatePaymailProfileResponse parses an HTTP response from a UpdatePaymailProfileWithResponse call
+func ParseUpdatePaymailProfileResponse(rsp *http.Response) (*UpdatePaymailProfileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdatePaymailProfileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResponsesUpdatePaymailProfileSuccess
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ResponsesAdminUserBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ResponsesNotAuthorizedToAdminEndpoint
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ResponsesUpdatePaymailProfileNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ResponsesAdminInvalidAvatarURL
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponsesInternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSharedConfigResponse parses an HTTP response from a SharedConfigWithResponse call
+func ParseSharedConfigResponse(rsp *http.Response) (*SharedConfigResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SharedConfigResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResponsesSharedConfig
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ResponsesNotAuthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSearchContactsResponse parses an HTTP response from a SearchContactsWithResponse call
+func ParseSearchContactsResponse(rsp *http.Response) (*SearchContactsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchContactsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResponsesSearchContactsSuccess
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ResponsesSearchBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ResponsesUserNotAuthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponsesInternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddContactResponse parses an HTTP response from a AddContactWithResponse call
+func ParseAddContactResponse(rsp *http.Response) (*AddContactResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddContactResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ResponsesAddContactSuccess
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ResponsesAddContactBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ResponsesUserNotAuthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 424:
+		var dest ResponsesAddContactFailedDependency
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON424 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponsesInternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRemoveContactResponse parses an HTTP response from a RemoveContactWithResponse call
+func ParseRemoveContactResponse(rsp *http.Response) (*RemoveContactResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RemoveContactResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ResponsesUserNotAuthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ResponsesGetContactNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponsesInternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseAdminStatusResponse(rsp)
-}
 
-// CreateUserWithBodyWithResponse request with arbitrary body returning *CreateUserResponse
-func (c *ClientWithResponses) CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
-	rsp, err := c.CreateUserWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateUserResponse(rsp)
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreateUserWithResponse(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
-	rsp, err := c.CreateUser(ctx, body, reqEditors...)
+// ParseContactByIdResponse parses an HTTP response from a ContactByIdWithResponse call
+func ParseContactByIdResponse(rsp *http.Response) (*ContactByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateUserResponse(rsp)
-}
 
-// UserByIdWithResponse request returning *UserByIdResponse
-func (c *ClientWithResponses) UserByIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*UserByIdResponse, error) {
-	rsp, err := c.UserById(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ContactByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUserByIdResponse(rsp)
-}
 
-// AddPaymailToUserWithBodyWithResponse request with arbitrary body returning *AddPaymailToUserResponse
-func (c *ClientWithResponses) AddPaymailToUserWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddPaymailToUserResponse, error) {
-	rsp, err := c.AddPaymailToUserWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAddPaymailToUserResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResponsesContactSuccess
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ResponsesUserNotAuthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ResponsesGetContactNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponsesInternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-func (c *ClientWithResponses) AddPaymailToUserWithResponse(ctx context.Context, id string, body AddPaymailToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AddPaymailToUserResponse, error) {
-	rsp, err := c.AddPaymailToUser(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseAddPaymailToUserResponse(rsp)
+
+	return response, nil
 }
 
-// SharedConfigWithResponse request returning *SharedConfigResponse
-func (c *ClientWithResponses) SharedConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SharedConfigResponse, error) {
-	rsp, err := c.SharedConfig(ctx, reqEditors...)
+// ParseAcceptContactResponse parses an HTTP response from a AcceptContactWithResponse call
+func ParseAcceptContactResponse(rsp *http.Response) (*AcceptContactResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSharedConfigResponse(rsp)
-}
 
-// DataByIdWithResponse request returning *DataByIdResponse
-func (c *ClientWithResponses) DataByIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DataByIdResponse, error) {
-	rsp, err := c.DataById(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AcceptContactResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDataByIdResponse(rsp)
-}
 
-// MerkleRootsWithResponse request returning *MerkleRootsResponse
-func (c *ClientWithResponses) MerkleRootsWithResponse(ctx context.Context, params *MerkleRootsParams, reqEditors ...RequestEditorFn) (*MerkleRootsResponse, error) {
-	rsp, err := c.MerkleRoots(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResponsesContactSuccess
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ResponsesUserNotAuthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ResponsesGetContactNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ResponsesContactUnprocessable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponsesInternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseMerkleRootsResponse(rsp)
+
+	return response, nil
 }
 
-// SearchOperationsWithResponse request returning *SearchOperationsResponse
-func (c *ClientWithResponses) SearchOperationsWithResponse(ctx context.Context, params *SearchOperationsParams, reqEditors ...RequestEditorFn) (*SearchOperationsResponse, error) {
-	rsp, err := c.SearchOperations(ctx, params, reqEditors...)
+// ParseConfirmContactResponse parses an HTTP response from a ConfirmContactWithResponse call
+func ParseConfirmContactResponse(rsp *http.Response) (*ConfirmContactResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSearchOperationsResponse(rsp)
-}
 
-// RecordTransactionOutlineWithBodyWithResponse request with arbitrary body returning *RecordTransactionOutlineResponse
-func (c *ClientWithResponses) RecordTransactionOutlineWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RecordTransactionOutlineResponse, error) {
-	rsp, err := c.RecordTransactionOutlineWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ConfirmContactResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseRecordTransactionOutlineResponse(rsp)
-}
 
-func (c *ClientWithResponses) RecordTransactionOutlineWithResponse(ctx context.Context, body RecordTransactionOutlineJSONRequestBody, reqEditors ...RequestEditorFn) (*RecordTransactionOutlineResponse, error) {
-	rsp, err := c.RecordTransactionOutline(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResponsesContactSuccess
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ResponsesUserNotAuthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ResponsesGetContactNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ResponsesContactUnprocessable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponsesInternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseRecordTransactionOutlineResponse(rsp)
+
+	return response, nil
 }
 
-// CreateTransactionOutlineWithBodyWithResponse request with arbitrary body returning *CreateTransactionOutlineResponse
-func (c *ClientWithResponses) CreateTransactionOutlineWithBodyWithResponse(ctx context.Context, params *CreateTransactionOutlineParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTransactionOutlineResponse, error) {
-	rsp, err := c.CreateTransactionOutlineWithBody(ctx, params, contentType, body, reqEditors...)
+// ParseRejectContactResponse parses an HTTP response from a RejectContactWithResponse call
+func ParseRejectContactResponse(rsp *http.Response) (*RejectContactResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateTransactionOutlineResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateTransactionOutlineWithResponse(ctx context.Context, params *CreateTransactionOutlineParams, body CreateTransactionOutlineJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTransactionOutlineResponse, error) {
-	rsp, err := c.CreateTransactionOutline(ctx, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &RejectContactResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateTransactionOutlineResponse(rsp)
-}
 
-// CurrentUserWithResponse request returning *CurrentUserResponse
-func (c *ClientWithResponses) CurrentUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CurrentUserResponse, error) {
-	rsp, err := c.CurrentUser(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResponsesContactSuccess
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ResponsesUserNotAuthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ResponsesGetContactNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ResponsesContactUnprocessable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponsesInternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseCurrentUserResponse(rsp)
+
+	return response, nil
 }
 
-// ParseAdminStatusResponse parses an HTTP response from a AdminStatusWithResponse call
-func ParseAdminStatusResponse(rsp *http.Response) (*AdminStatusResponse, error) {
+// ParseSearchDataResponse parses an HTTP response from a SearchDataWithResponse call
+func ParseSearchDataResponse(rsp *http.Response) (*SearchDataResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminStatusResponse{
+	response := &SearchDataResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResponsesSearchDataSuccess
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ResponsesSearchDataBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ResponsesNotAuthorizedToAdminEndpoint
+		var dest ResponsesUserNotAuthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponsesInternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseCreateUserResponse parses an HTTP response from a CreateUserWithResponse call
-func ParseCreateUserResponse(rsp *http.Response) (*CreateUserResponse, error) {
+// ParseDataByIdResponse parses an HTTP response from a DataByIdWithResponse call
+func ParseDataByIdResponse(rsp *http.Response) (*DataByIdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateUserResponse{
+	response := &DataByIdResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ResponsesAdminCreateUserSuccess
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResponsesGetDataSuccess
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ResponsesAdminUserBadRequest
+		var dest ResponsesUserBadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ResponsesNotAuthorizedToAdminEndpoint
+		var dest ResponsesUserNotAuthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest ResponsesAdminInvalidAvatarURL
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ResponsesGetDataNotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON422 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ResponsesAdminCreateUserInternalServerError
+		var dest ResponsesInternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3566,29 +8051,43 @@ func ParseCreateUserResponse(rsp *http.Response) (*CreateUserResponse, error) {
 	return response, nil
 }
 
-// ParseUserByIdResponse parses an HTTP response from a UserByIdWithResponse call
-func ParseUserByIdResponse(rsp *http.Response) (*UserByIdResponse, error) {
+// ParseDataContentByIdResponse parses an HTTP response from a DataContentByIdWithResponse call
+func ParseDataContentByIdResponse(rsp *http.Response) (*DataContentByIdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserByIdResponse{
+	response := &DataContentByIdResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ResponsesAdminGetUser
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ResponsesUserBadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ResponsesUserNotAuthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ResponsesGetDataNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ResponsesAdminGetUserInternalServerError
+		var dest ResponsesInternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3599,114 +8098,121 @@ func ParseUserByIdResponse(rsp *http.Response) (*UserByIdResponse, error) {
 	return response, nil
 }
 
-// ParseAddPaymailToUserResponse parses an HTTP response from a AddPaymailToUserWithResponse call
-func ParseAddPaymailToUserResponse(rsp *http.Response) (*AddPaymailToUserResponse, error) {
+// ParseSearchInvoicesResponse parses an HTTP response from a SearchInvoicesWithResponse call
+func ParseSearchInvoicesResponse(rsp *http.Response) (*SearchInvoicesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AddPaymailToUserResponse{
+	response := &SearchInvoicesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ResponsesAdminAddPaymailSuccess
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResponsesSearchInvoicesSuccess
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ResponsesAdminUserBadRequest
+		var dest ResponsesSearchBadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ResponsesNotAuthorizedToAdminEndpoint
+		var dest ResponsesUserNotAuthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest ResponsesAdminInvalidAvatarURL
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponsesInternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON422 = &dest
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseSharedConfigResponse parses an HTTP response from a SharedConfigWithResponse call
-func ParseSharedConfigResponse(rsp *http.Response) (*SharedConfigResponse, error) {
+// ParseCreateInvoiceResponse parses an HTTP response from a CreateInvoiceWithResponse call
+func ParseCreateInvoiceResponse(rsp *http.Response) (*CreateInvoiceResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SharedConfigResponse{
+	response := &CreateInvoiceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ResponsesSharedConfig
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ResponsesInvoiceSuccess
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ResponsesCreateInvoiceBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ResponsesNotAuthorized
+		var dest ResponsesUserNotAuthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponsesInternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDataByIdResponse parses an HTTP response from a DataByIdWithResponse call
-func ParseDataByIdResponse(rsp *http.Response) (*DataByIdResponse, error) {
+// ParseInvoiceByIdResponse parses an HTTP response from a InvoiceByIdWithResponse call
+func ParseInvoiceByIdResponse(rsp *http.Response) (*InvoiceByIdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DataByIdResponse{
+	response := &InvoiceByIdResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ResponsesGetDataSuccess
+		var dest ResponsesInvoiceSuccess
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ResponsesUserBadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest ResponsesUserNotAuthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3715,7 +8221,7 @@ func ParseDataByIdResponse(rsp *http.Response) (*DataByIdResponse, error) {
 		response.JSON401 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ResponsesGetDataNotFound
+		var dest ResponsesGetInvoiceNotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3841,6 +8347,60 @@ func ParseSearchOperationsResponse(rsp *http.Response) (*SearchOperationsRespons
 	return response, nil
 }
 
+// ParseLookupPaymailDestinationResponse parses an HTTP response from a LookupPaymailDestinationWithResponse call
+func ParseLookupPaymailDestinationResponse(rsp *http.Response) (*LookupPaymailDestinationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LookupPaymailDestinationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResponsesLookupPaymailDestinationSuccess
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ResponsesLookupPaymailDestinationBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ResponsesUserNotAuthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ResponsesLookupPaymailDestinationNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponsesInternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseRecordTransactionOutlineResponse parses an HTTP response from a RecordTransactionOutlineWithResponse call
 func ParseRecordTransactionOutlineResponse(rsp *http.Response) (*RecordTransactionOutlineResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -3942,6 +8502,53 @@ func ParseCreateTransactionOutlineResponse(rsp *http.Response) (*CreateTransacti
 	return response, nil
 }
 
+// ParseGetTransactionStatusResponse parses an HTTP response from a GetTransactionStatusWithResponse call
+func ParseGetTransactionStatusResponse(rsp *http.Response) (*GetTransactionStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTransactionStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResponsesTransactionStatusSuccess
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ResponsesUserNotAuthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ResponsesGetTransactionNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponsesInternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseCurrentUserResponse parses an HTTP response from a CurrentUserWithResponse call
 func ParseCurrentUserResponse(rsp *http.Response) (*CurrentUserResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -3981,3 +8588,64 @@ func ParseCurrentUserResponse(rsp *http.Response) (*CurrentUserResponse, error)
 
 	return response, nil
 }
+
+// ParseUpdateCurrentUserPaymailProfileResponse parses an HTTP response from a UpdateCurrentUserPaymailProfileWithResponse call
+func ParseUpdateCurrentUserPaymailProfileResponse(rsp *http.Response) (*UpdateCurrentUserPaymailProfileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateCurrentUserPaymailProfileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResponsesUpdatePaymailProfileSuccess
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ResponsesUpdatePaymailProfileBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ResponsesUserNotAuthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ResponsesUpdatePaymailProfileNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ResponsesUpdatePaymailProfileUnprocessable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponsesInternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
@@ -36,6 +36,8 @@ type AppConfig struct {
 	Server *ServerConfig `json:"server_config" mapstructure:"server_config"`
 	// ARC is a config for Arc.
 	ARC *ARCConfig `json:"arc" mapstructure:"arc"`
+	// Chain selects the chain backend - ARC and BHS or the in-process regtest stand-in.
+	Chain *ChainConfig `json:"chain" mapstructure:"chain"`
 	// Metrics is a configuration for metrics in SPV Wallet.
 	Metrics *MetricsConfig `json:"metrics" mapstructure:"metrics"`
 	// ExperimentalFeatures is a configuration that allows to enable features that are considered experimental/non-production.
@@ -72,6 +74,18 @@ type AuthenticationConfig struct {
 	Scheme string `json:"scheme" mapstructure:"scheme"`
 	// RequireSigning is the flag that decides if the signing is required
 	RequireSigning bool `json:"require_signing" mapstructure:"require_signing"`
+	// MutualAuth is the configuration for BRC-103/104 mutual authentication (API v2 only)
+	MutualAuth *MutualAuthConfig `json:"mutual_auth" mapstructure:"mutual_auth"`
+}
+
+// MutualAuthConfig is the configuration for BRC-103/104 mutual authentication
+type MutualAuthConfig struct {
+	// Enabled is the flag that enables the mutual authentication handshake and identity-key authenticated requests
+	Enabled bool `json:"enabled" mapstructure:"enabled"`
+	// IdentityKey is the server identity private key (hex or WIF); if empty, a random key is generated at startup
+	IdentityKey string `json:"identity_key" mapstructure:"identity_key"`
+	// SessionTTL is the time after which an established session expires; the used nonces are remembered for the same time (min 1m)
+	SessionTTL time.Duration `json:"session_ttl" mapstructure:"session_ttl"`
 }
 
 // CacheConfig is a configuration for cachestore
@@ -92,6 +106,9 @@ type CallbackConfig struct {
 	Token string `json:"token" mapstructure:"token"`
 	// Enabled is the flag that enables callbacks.
 	Enabled bool `json:"enabled" mapstructure:"enabled"`
+	// TokenPerTransaction makes ARC send a token bound to the transaction ID (HMAC keyed with Token) instead of the Token itself.
+	// ARC accepts a single callback token per request, so batch broadcasting is disabled when it's on.
+	TokenPerTransaction bool `json:"token_per_transaction" mapstructure:"token_per_transaction"`
 }
 
 // ClusterConfig is a configuration for the SPV Wallet cluster
@@ -149,6 +166,77 @@ type ARCConfig struct {
 	Token         string          `json:"token" mapstructure:"token"`
 	URL           string          `json:"url" mapstructure:"url"`
 	WaitForStatus string          `json:"wait_for_status" mapstructure:"wait_for_status"`
+	// BroadcastBEEF enables broadcasting transactions in BEEF format when all their ancestors are known.
+	BroadcastBEEF bool `json:"broadcast_beef" mapstructure:"broadcast_beef"`
+	// Endpoints are additional ARC servers used next to the primary one (URL and Token).
+	Endpoints []*ARCEndpointConfig `json:"endpoints" mapstructure:"endpoints"`
+	// BroadcastPolicy is either "failover" (primary first, next ones on failure) or "fan_out" (all at once, first success wins).
+	BroadcastPolicy string `json:"broadcast_policy" mapstructure:"broadcast_policy"`
+	// EndpointCooldown is how long a failing endpoint is skipped (moved to the end of the list).
+	EndpointCooldown time.Duration `json:"endpoint_cooldown" mapstructure:"endpoint_cooldown"`
+	// Batch groups broadcasted transactions and submits them together through ARC's /v1/txs endpoint.
+	Batch *ARCBatchConfig `json:"batch" mapstructure:"batch"`
+	// AsyncBroadcast makes recording of v2 transactions return before broadcasting; transactions are broadcasted by a background task.
+	AsyncBroadcast *ARCAsyncBroadcastConfig `json:"async_broadcast" mapstructure:"async_broadcast"`
+	// TxsProviders are external sources of missing source transactions, queried in the given order.
+	TxsProviders []*TxsProviderConfig `json:"txs_providers" mapstructure:"txs_providers"`
+	// TxsCache keeps transactions fetched from TxsProviders in the cachestore.
+	TxsCache *TxsCacheConfig `json:"txs_cache" mapstructure:"txs_cache"`
+	// FeeUnitRefreshInterval is how often the fee unit is refreshed from the ARC policy (0 disables the refresh).
+	FeeUnitRefreshInterval time.Duration `json:"fee_unit_refresh_interval" mapstructure:"fee_unit_refresh_interval"`
+}
+
+// TxsProviderConfig is the configuration of an external source of transactions.
+type TxsProviderConfig struct {
+	// Type is one of: junglebus, whatsonchain, arc, beef_archive.
+	Type string `json:"type" mapstructure:"type"`
+	// URL is the base url of the API or the directory of the BEEF archive.
+	URL     string        `json:"url" mapstructure:"url"`
+	Token   string        `json:"token" mapstructure:"token"`
+	Timeout time.Duration `json:"timeout" mapstructure:"timeout"`
+}
+
+// TxsCacheConfig is the configuration of the cache of transactions fetched from external providers.
+type TxsCacheConfig struct {
+	Enabled bool          `json:"enabled" mapstructure:"enabled"`
+	TTL     time.Duration `json:"ttl" mapstructure:"ttl"`
+}
+
+// ChainConfig is the configuration of the chain backend
+type ChainConfig struct {
+	// Mode is either "default" (ARC and BHS) or "regtest" (in-process stand-in for ARC and BHS, no network access required).
+	Mode    string         `json:"mode" mapstructure:"mode"`
+	Regtest *RegtestConfig `json:"regtest" mapstructure:"regtest"`
+}
+
+// RegtestConfig is the configuration of the regtest chain mode
+type RegtestConfig struct {
+	// BlockInterval makes the regtest node mine a block periodically; with 0 blocks are mined only on demand.
+	BlockInterval time.Duration `json:"block_interval" mapstructure:"block_interval"`
+}
+
+// ARCAsyncBroadcastConfig is the configuration of asynchronous broadcasting with retries.
+type ARCAsyncBroadcastConfig struct {
+	Enabled bool `json:"enabled" mapstructure:"enabled"`
+	// MaxAttempts is the number of failed broadcast attempts after which the transaction is marked as problematic.
+	MaxAttempts int `json:"max_attempts" mapstructure:"max_attempts"`
+	// RetryBackoff is the delay after the first failed attempt; it doubles after every next failure.
+	RetryBackoff time.Duration `json:"retry_backoff" mapstructure:"retry_backoff"`
+	// MaxRetryBackoff limits the delay between attempts.
+	MaxRetryBackoff time.Duration `json:"max_retry_backoff" mapstructure:"max_retry_backoff"`
+}
+
+// ARCBatchConfig is the configuration of batch broadcasting.
+type ARCBatchConfig struct {
+	Enabled  bool          `json:"enabled" mapstructure:"enabled"`
+	Interval time.Duration `json:"interval" mapstructure:"interval"`
+	MaxSize  int           `json:"max_size" mapstructure:"max_size"`
+}
+
+// ARCEndpointConfig is the configuration of an additional ARC server.
+type ARCEndpointConfig struct {
+	Token string `json:"token" mapstructure:"token"`
+	URL   string `json:"url" mapstructure:"url"`
 }
 
 // FeeUnitConfig reflects the utils.FeeUnit struct with proper annotations for json and mapstructure
@@ -187,6 +275,64 @@ type PaymailConfig struct {
 	DomainValidationEnabled bool `json:"domain_validation_enabled" mapstructure:"domain_validation_enabled"`
 	// SenderValidationEnabled should be turned on for extra security.
 	SenderValidationEnabled bool `json:"sender_validation_enabled" mapstructure:"sender_validation_enabled"`
+	// HostReputation is a config for tracking the reputation of the paymail hosts called by the wallet.
+	HostReputation *PaymailHostReputationConfig `json:"host_reputation" mapstructure:"host_reputation"`
+	// Client is a config for the calls made to the (external) paymail hosts.
+	Client *PaymailClientConfig `json:"client" mapstructure:"client"`
+	// RateLimit is a config for limiting the requests to the public paymail endpoints.
+	RateLimit *PaymailRateLimitConfig `json:"rate_limit" mapstructure:"rate_limit"`
+	// DestinationTTL is how long the addresses derived for paymail destination requests are kept while unpaid (0 - kept forever).
+	// Payments to the address after it was removed are not recognized by the wallet, so the cleanup is opt-in.
+	// Destinations of invoices are kept at least until the invoice expires.
+	DestinationTTL time.Duration `json:"destination_ttl" mapstructure:"destination_ttl"`
+}
+
+// PaymailRateLimitConfig is a config for limiting the requests to the public paymail endpoints per client IP and per paymail.
+// The limits are kept in redis when it's the cache engine, otherwise they are kept in memory (per instance).
+type PaymailRateLimitConfig struct {
+	// Enabled is a flag for enabling the rate limits.
+	Enabled bool `json:"enabled" mapstructure:"enabled"`
+	// PerIP is the number of requests per minute allowed from a single client IP (0 - not limited).
+	PerIP int `json:"per_ip" mapstructure:"per_ip"`
+	// PerAlias is the number of requests per minute allowed to a single paymail (0 - not limited).
+	PerAlias int `json:"per_alias" mapstructure:"per_alias"`
+}
+
+// PaymailClientConfig is a config for caching capabilities and making resilient calls to the paymail hosts.
+// Hosts which failed too many times in a row are not called for a while (see HostReputation).
+type PaymailClientConfig struct {
+	// CapabilitiesTTL is how long the capabilities of the paymail host are cached.
+	CapabilitiesTTL time.Duration `json:"capabilities_ttl" mapstructure:"capabilities_ttl"`
+	// CapabilitiesDomainTTLs overrides CapabilitiesTTL for the given domains.
+	// In env variable, it's a comma separated list of domain=ttl pairs, e.g. "example.com=1h,other.com=30s".
+	CapabilitiesDomainTTLs PaymailDomainTTLs `json:"capabilities_domain_ttls" mapstructure:"capabilities_domain_ttls"`
+	// NegativeCacheTTL is how long a failed capabilities lookup is cached (0 - failed lookups are not cached).
+	NegativeCacheTTL time.Duration `json:"negative_cache_ttl" mapstructure:"negative_cache_ttl"`
+	// Timeout is the maximum time of a single call to the paymail host (0 - no additional timeout).
+	Timeout time.Duration `json:"timeout" mapstructure:"timeout"`
+	// Retries is the number of retries of failed capabilities, PKI and P2P destinations calls.
+	Retries int `json:"retries" mapstructure:"retries"`
+	// RetryDelay is the delay before retrying a failed call.
+	RetryDelay time.Duration `json:"retry_delay" mapstructure:"retry_delay"`
+}
+
+// PaymailDomainTTLs is a list of TTLs for the given paymail domains.
+type PaymailDomainTTLs []PaymailDomainTTLConfig
+
+// PaymailDomainTTLConfig is a TTL for the given paymail domain.
+type PaymailDomainTTLConfig struct {
+	Domain string        `json:"domain" mapstructure:"domain"`
+	TTL    time.Duration `json:"ttl" mapstructure:"ttl"`
+}
+
+// PaymailHostReputationConfig is a config for tracking failures and latency of the paymail hosts called by the wallet.
+type PaymailHostReputationConfig struct {
+	// BlockAfterFailures is the number of consecutive failures after which payments and contact requests to the host are blocked (0 - never blocked).
+	BlockAfterFailures int `json:"block_after_failures" mapstructure:"block_after_failures"`
+	// BlockDuration is how long the host stays blocked after its last failure.
+	BlockDuration time.Duration `json:"block_duration" mapstructure:"block_duration"`
+	// SlowResponse is the average response time above which calls to the host are logged with a warning (0 - no warnings).
+	SlowResponse time.Duration `json:"slow_response" mapstructure:"slow_response"`
 }
 
 // BeefConfig consists of components required to use beef, e.g. Block Headers Service for merkle roots validation
@@ -205,6 +351,35 @@ type BHSConfig struct {
 	AuthToken string `json:"auth_token" mapstructure:"auth_token"`
 	// URL is the URL used to communicate with Block Headers Service (BHS)
 	URL string `json:"url" mapstructure:"url"`
+	// Embedded enables the in-process block headers store instead of the external Block Headers Service.
+	// Headers are imported from ImportBlockHeaders and then kept up to date from Junglebus.
+	Embedded bool `json:"embedded" mapstructure:"embedded"`
+	// MerkleRootsCache is a config for caching merkle roots confirmed by BHS.
+	MerkleRootsCache *BHSMerkleRootsCacheConfig `json:"merkle_roots_cache" mapstructure:"merkle_roots_cache"`
+	// CircuitBreaker is a config for failing fast when BHS is down.
+	CircuitBreaker *BHSCircuitBreakerConfig `json:"circuit_breaker" mapstructure:"circuit_breaker"`
+}
+
+// BHSMerkleRootsCacheConfig is a config for caching (in the cachestore) merkle roots confirmed by BHS.
+type BHSMerkleRootsCacheConfig struct {
+	// Enabled is a flag for enabling the cache.
+	Enabled bool `json:"enabled" mapstructure:"enabled"`
+	// TTL is how long a confirmed merkle root is kept in the cache.
+	TTL time.Duration `json:"ttl" mapstructure:"ttl"`
+}
+
+// BHSCircuitBreakerConfig is a config for the circuit breaker of BHS requests.
+type BHSCircuitBreakerConfig struct {
+	// Enabled is a flag for enabling the circuit breaker.
+	Enabled bool `json:"enabled" mapstructure:"enabled"`
+	// FailureThreshold is the number of consecutive BHS failures which opens the circuit.
+	FailureThreshold int `json:"failure_threshold" mapstructure:"failure_threshold"`
+	// OpenTimeout is how long requests are not sent to BHS after the circuit was opened.
+	OpenTimeout time.Duration `json:"open_timeout" mapstructure:"open_timeout"`
+	// WhenDown is what happens with merkle roots verification while BHS is down: "reject" or "queue".
+	// With "queue", incoming paymail transactions are kept without being recorded and are recorded only after their merkle roots are verified
+	// (supported by the v2 paymail service provider only - with v1, such transactions are rejected).
+	WhenDown string `json:"when_down" mapstructure:"when_down"`
 }
 
 // TaskManagerConfig is a configuration for the taskmanager
@@ -223,6 +398,9 @@ type ServerConfig struct {
 	WriteTimeout time.Duration `json:"write_timeout" mapstructure:"write_timeout"`
 	// Port is the port that the server should use.
 	Port int `json:"port" mapstructure:"port"`
+	// TrustedProxies are IPs or CIDRs of the proxies (e.g. load balancers) allowed to pass the client IP in X-Forwarded-For header.
+	// When empty, the client IP is the IP of the connection, so it cannot be spoofed (used by the paymail rate limits).
+	TrustedProxies []string `json:"trusted_proxies" mapstructure:"trusted_proxies"`
 }
 
 // MetricsConfig represents a metrics config.
@@ -25,6 +25,7 @@ func GetDefaultAppConfig() *AppConfig {
 		ImportBlockHeaders:   "",
 		Logging:              getLoggingDefaults(),
 		ARC:                  getARCDefaults(),
+		Chain:                getChainDefaults(),
 		Notifications:        getNotificationDefaults(),
 		Paymail:              getPaymailDefaults(),
 		BHS:                  getBHSDefaults(),
@@ -42,6 +43,11 @@ func getAuthConfigDefaults() *AuthenticationConfig {
 		AdminKey:       DefaultAdminXpub,
 		RequireSigning: false,
 		Scheme:         "xpub",
+		MutualAuth: &MutualAuthConfig{
+			Enabled:     false,
+			IdentityKey: "",
+			SessionTTL:  1 * time.Hour,
+		},
 	}
 }
 
@@ -109,9 +115,40 @@ func getARCDefaults() *ARCConfig {
 		URL:          "https://arc.taal.com",
 		Token:        "mainnet_06770f425eb00298839a24a49cbdc02c",
 		Callback: &CallbackConfig{
-			Enabled: false,
-			Host:    "https://example.com",
-			Token:   "",
+			Enabled:             false,
+			Host:                "https://example.com",
+			Token:               "",
+			TokenPerTransaction: false,
+		},
+		BroadcastBEEF:    true,
+		Endpoints:        []*ARCEndpointConfig{},
+		BroadcastPolicy:  "failover",
+		EndpointCooldown: 30 * time.Second,
+		Batch: &ARCBatchConfig{
+			Enabled:  false,
+			Interval: 100 * time.Millisecond,
+			MaxSize:  50,
+		},
+		AsyncBroadcast: &ARCAsyncBroadcastConfig{
+			Enabled:         false,
+			MaxAttempts:     10,
+			RetryBackoff:    10 * time.Second,
+			MaxRetryBackoff: 10 * time.Minute,
+		},
+		TxsProviders: []*TxsProviderConfig{},
+		TxsCache: &TxsCacheConfig{
+			Enabled: true,
+			TTL:     24 * time.Hour,
+		},
+		FeeUnitRefreshInterval: 10 * time.Minute,
+	}
+}
+
+func getChainDefaults() *ChainConfig {
+	return &ChainConfig{
+		Mode: ChainModeDefault,
+		Regtest: &RegtestConfig{
+			BlockInterval: 0,
 		},
 	}
 }
@@ -133,6 +170,24 @@ func getPaymailDefaults() *PaymailConfig {
 		Domains:                 []string{"localhost"},
 		DomainValidationEnabled: true,
 		SenderValidationEnabled: false,
+		HostReputation: &PaymailHostReputationConfig{
+			BlockAfterFailures: 5,
+			BlockDuration:      10 * time.Minute,
+			SlowResponse:       5 * time.Second,
+		},
+		Client: &PaymailClientConfig{
+			CapabilitiesTTL:  2 * time.Minute,
+			NegativeCacheTTL: 30 * time.Second,
+			Timeout:          15 * time.Second,
+			Retries:          1,
+			RetryDelay:       200 * time.Millisecond,
+		},
+		RateLimit: &PaymailRateLimitConfig{
+			Enabled:  true,
+			PerIP:    120,
+			PerAlias: 60,
+		},
+		DestinationTTL: 0,
 	}
 }
 
@@ -140,6 +195,17 @@ func getBHSDefaults() *BHSConfig {
 	return &BHSConfig{
 		AuthToken: "mQZQ6WmxURxWz5ch",
 		URL:       "http://localhost:8080",
+		Embedded:  false,
+		MerkleRootsCache: &BHSMerkleRootsCacheConfig{
+			Enabled: true,
+			TTL:     24 * time.Hour,
+		},
+		CircuitBreaker: &BHSCircuitBreakerConfig{
+			Enabled:          true,
+			FailureThreshold: 5,
+			OpenTimeout:      30 * time.Second,
+			WhenDown:         "reject",
+		},
 	}
 }
 
@@ -97,7 +97,13 @@ func loadFromFile(logger zerolog.Logger) error {
 }
 
 func unmarshallToAppConfig(appConfig *AppConfig) error {
-	if err := viper.Unmarshal(appConfig); err != nil {
+	// the default hooks of viper extended with the text unmarshaller, so lists of structs can be provided by env variables (see PaymailDomainTTLs)
+	decodeHook := viper.DecodeHook(mapstructure.ComposeDecodeHookFunc(
+		mapstructure.StringToTimeDurationHookFunc(),
+		mapstructure.StringToSliceHookFunc(","),
+		mapstructure.TextUnmarshallerHookFunc(),
+	))
+	if err := viper.Unmarshal(appConfig, decodeHook); err != nil {
 		err = spverrors.Wrapf(err, "error when unmarshalling config to App Config")
 		return err
 	}
@@ -2,12 +2,15 @@ package config_test
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/bitcoin-sv/spv-wallet/config"
 	"github.com/bitcoin-sv/spv-wallet/engine/tester"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestLoadConfig(t *testing.T) {
@@ -44,3 +47,60 @@ func TestLoadConfig(t *testing.T) {
 		os.Unsetenv("SPVWALLET_CONFIG_FILE")
 	})
 }
+
+func TestLoadCapabilitiesDomainTTLs(t *testing.T) {
+	expected := config.PaymailDomainTTLs{
+		{Domain: "example.com", TTL: time.Hour},
+		{Domain: "other.example.com", TTL: 30 * time.Second},
+	}
+
+	t.Run("from config file", func(t *testing.T) {
+		// given:
+		t.Cleanup(viper.Reset)
+		configFile := filepath.Join(t.TempDir(), "config.yaml")
+		err := os.WriteFile(configFile, []byte(`
+paymail:
+  client:
+    capabilities_domain_ttls:
+      - domain: example.com
+        ttl: 1h
+      - domain: other.example.com
+        ttl: 30s
+`), 0o600)
+		require.NoError(t, err)
+		t.Setenv("SPVWALLET_CONFIG_FILE", configFile)
+
+		// when:
+		cfg, err := config.Load("test", tester.Logger(t))
+
+		// then:
+		require.NoError(t, err)
+		require.Equal(t, expected, cfg.Paymail.Client.CapabilitiesDomainTTLs)
+		require.NoError(t, cfg.Paymail.Client.Validate())
+	})
+
+	t.Run("from env variable", func(t *testing.T) {
+		// given:
+		t.Cleanup(viper.Reset)
+		t.Setenv("SPVWALLET_PAYMAIL_CLIENT_CAPABILITIES_DOMAIN_TTLS", "example.com=1h, other.example.com=30s")
+
+		// when:
+		cfg, err := config.Load("test", tester.Logger(t))
+
+		// then:
+		require.NoError(t, err)
+		require.Equal(t, expected, cfg.Paymail.Client.CapabilitiesDomainTTLs)
+	})
+
+	t.Run("return error for invalid env variable", func(t *testing.T) {
+		// given:
+		t.Cleanup(viper.Reset)
+		t.Setenv("SPVWALLET_PAYMAIL_CLIENT_CAPABILITIES_DOMAIN_TTLS", "example.com")
+
+		// when:
+		_, err := config.Load("test", tester.Logger(t))
+
+		// then:
+		require.Error(t, err)
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"net/url"
 	"slices"
+	"strings"
+	"time"
 
 	configerrors "github.com/bitcoin-sv/spv-wallet/config/errors"
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
@@ -34,6 +36,11 @@ func (c *AppConfig) IsBeefEnabled() bool {
 	return c.Paymail != nil && c.Paymail.Beef.Enabled()
 }
 
+// IsRegtest returns true if the in-process regtest node replaces ARC and BHS
+func (c *AppConfig) IsRegtest() bool {
+	return c.Chain != nil && c.Chain.Mode == ChainModeRegtest
+}
+
 // ARCCallbackEnabled returns true if the ARC callback is enabled
 func (c *AppConfig) ARCCallbackEnabled() bool {
 	return c.ARC != nil && c.ARC.Callback != nil && c.ARC.Callback.Enabled
@@ -53,3 +60,21 @@ func (cc *CallbackConfig) ShouldGetURL() (*url.URL, error) {
 	hostURL := host.JoinPath(BroadcastCallbackRoute)
 	return hostURL, nil
 }
+
+// UnmarshalText parses the comma separated list of domain=ttl pairs (used when the config is provided by env variable).
+func (l *PaymailDomainTTLs) UnmarshalText(text []byte) error {
+	var parsed PaymailDomainTTLs
+	for _, pair := range strings.Split(string(text), ",") {
+		domain, ttl, found := strings.Cut(pair, "=")
+		if !found {
+			return spverrors.Newf("invalid domain ttl %q, expected domain=ttl", pair)
+		}
+		duration, err := time.ParseDuration(strings.TrimSpace(ttl))
+		if err != nil {
+			return spverrors.Wrapf(err, "invalid ttl of domain %s", domain)
+		}
+		parsed = append(parsed, PaymailDomainTTLConfig{Domain: strings.TrimSpace(domain), TTL: duration})
+	}
+	*l = parsed
+	return nil
+}
@@ -32,6 +32,10 @@ func (c *AppConfig) Validate() error {
 		return err
 	}
 
+	if err = c.Chain.Validate(); err != nil {
+		return err
+	}
+
 	if err = c.CustomFeeUnit.Validate(); err != nil {
 		return err
 	}
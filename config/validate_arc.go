@@ -25,6 +25,79 @@ func (n *ARCConfig) Validate() error {
 		return spverrors.Newf("invalid callback host: %s - must be a valid external url - not a localhost", n.Callback.Host)
 	}
 
+	for i, endpoint := range n.Endpoints {
+		if endpoint == nil || endpoint.URL == "" {
+			return spverrors.Newf("arc endpoint %d url is not configured", i)
+		}
+		if !explicitHTTPURLRegex.MatchString(endpoint.URL) {
+			return spverrors.Newf("invalid arc endpoint url: %s - must be a http(s) url", endpoint.URL)
+		}
+	}
+
+	switch n.BroadcastPolicy {
+	case "", "failover", "fan_out":
+	default:
+		return spverrors.Newf("invalid arc broadcast policy: %s - must be failover or fan_out", n.BroadcastPolicy)
+	}
+
+	if n.EndpointCooldown < 0 {
+		return spverrors.Newf("arc endpoint cooldown cannot be negative")
+	}
+
+	if n.AsyncBroadcast != nil && n.AsyncBroadcast.Enabled {
+		if n.AsyncBroadcast.MaxAttempts <= 0 {
+			return spverrors.Newf("arc async broadcast max attempts must be positive")
+		}
+		if n.AsyncBroadcast.RetryBackoff <= 0 || n.AsyncBroadcast.MaxRetryBackoff < n.AsyncBroadcast.RetryBackoff {
+			return spverrors.Newf("arc async broadcast retry backoff must be positive and not greater than max retry backoff")
+		}
+	}
+
+	if n.Batch != nil && n.Batch.Enabled {
+		if n.Batch.MaxSize <= 0 {
+			return spverrors.Newf("arc batch max size must be positive")
+		}
+		if n.Batch.Interval <= 0 {
+			return spverrors.Newf("arc batch interval must be positive")
+		}
+	}
+
+	for i, provider := range n.TxsProviders {
+		if err := provider.validate(i); err != nil {
+			return err
+		}
+	}
+
+	if n.TxsCache != nil && n.TxsCache.Enabled && n.TxsCache.TTL < 0 {
+		return spverrors.Newf("arc txs cache ttl cannot be negative")
+	}
+
+	if n.FeeUnitRefreshInterval < 0 {
+		return spverrors.Newf("arc fee unit refresh interval cannot be negative")
+	}
+
+	return nil
+}
+
+func (p *TxsProviderConfig) validate(i int) error {
+	if p == nil {
+		return spverrors.Newf("arc txs provider %d is not configured", i)
+	}
+	switch p.Type {
+	case "junglebus", "whatsonchain", "arc":
+		if p.URL != "" && !explicitHTTPURLRegex.MatchString(p.URL) {
+			return spverrors.Newf("invalid arc txs provider url: %s - must be a http(s) url", p.URL)
+		}
+	case "beef_archive":
+		if p.URL == "" {
+			return spverrors.Newf("arc txs provider %d (beef_archive) requires a directory in url", i)
+		}
+	default:
+		return spverrors.Newf("unknown arc txs provider type: %s - must be junglebus, whatsonchain, arc or beef_archive", p.Type)
+	}
+	if p.Timeout < 0 {
+		return spverrors.Newf("arc txs provider %d timeout cannot be negative", i)
+	}
 	return nil
 }
 
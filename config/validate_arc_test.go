@@ -3,6 +3,7 @@ package config_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/bitcoin-sv/spv-wallet/config"
 	"github.com/stretchr/testify/require"
@@ -104,4 +105,128 @@ func TestValidateArcConfig(t *testing.T) {
 			require.Error(t, err)
 		})
 	}
+
+	t.Run("additional endpoints with fan out policy", func(t *testing.T) {
+		// given:
+		cfg := config.GetDefaultAppConfig()
+
+		cfg.ARC.Endpoints = []*config.ARCEndpointConfig{{URL: "https://arc.gorillapool.io"}}
+		cfg.ARC.BroadcastPolicy = "fan_out"
+
+		// when:
+		err := cfg.Validate()
+
+		// then:
+		require.NoError(t, err)
+	})
+
+	t.Run("additional endpoint without url", func(t *testing.T) {
+		// given:
+		cfg := config.GetDefaultAppConfig()
+
+		cfg.ARC.Endpoints = []*config.ARCEndpointConfig{{Token: "token"}}
+
+		// when:
+		err := cfg.Validate()
+
+		// then:
+		require.Error(t, err)
+	})
+
+	t.Run("unknown broadcast policy", func(t *testing.T) {
+		// given:
+		cfg := config.GetDefaultAppConfig()
+
+		cfg.ARC.BroadcastPolicy = "random"
+
+		// when:
+		err := cfg.Validate()
+
+		// then:
+		require.Error(t, err)
+	})
+	t.Run("enabled batch without max size", func(t *testing.T) {
+		// given:
+		cfg := config.GetDefaultAppConfig()
+
+		cfg.ARC.Batch.Enabled = true
+		cfg.ARC.Batch.MaxSize = 0
+
+		// when:
+		err := cfg.Validate()
+
+		// then:
+		require.Error(t, err)
+	})
+	t.Run("txs providers in order", func(t *testing.T) {
+		// given:
+		cfg := config.GetDefaultAppConfig()
+
+		cfg.ARC.TxsProviders = []*config.TxsProviderConfig{
+			{Type: "beef_archive", URL: "/var/lib/spv-wallet/beef"},
+			{Type: "whatsonchain", Timeout: time.Second},
+			{Type: "junglebus"},
+		}
+
+		// when:
+		err := cfg.Validate()
+
+		// then:
+		require.NoError(t, err)
+	})
+
+	t.Run("unknown txs provider type", func(t *testing.T) {
+		// given:
+		cfg := config.GetDefaultAppConfig()
+
+		cfg.ARC.TxsProviders = []*config.TxsProviderConfig{{Type: "blockchair"}}
+
+		// when:
+		err := cfg.Validate()
+
+		// then:
+		require.Error(t, err)
+	})
+
+	t.Run("beef archive provider without directory", func(t *testing.T) {
+		// given:
+		cfg := config.GetDefaultAppConfig()
+
+		cfg.ARC.TxsProviders = []*config.TxsProviderConfig{{Type: "beef_archive"}}
+
+		// when:
+		err := cfg.Validate()
+
+		// then:
+		require.Error(t, err)
+	})
+
+	t.Run("negative fee unit refresh interval", func(t *testing.T) {
+		// given:
+		cfg := config.GetDefaultAppConfig()
+
+		cfg.ARC.FeeUnitRefreshInterval = -time.Minute
+
+		// when:
+		err := cfg.Validate()
+
+		// then:
+		require.Error(t, err)
+	})
+
+	t.Run("batch with callback token per transaction", func(t *testing.T) {
+		// given:
+		cfg := config.GetDefaultAppConfig()
+
+		cfg.ARC.Batch.Enabled = true
+		cfg.ARC.Callback.Enabled = true
+		cfg.ARC.Callback.TokenPerTransaction = true
+
+		// when:
+		err := cfg.Validate()
+
+		// then:
+		// batch broadcasting is disabled by the engine in such case
+		require.NoError(t, err)
+	})
 }
@@ -1,12 +1,19 @@
 package config
 
 import (
+	"time"
+
+	primitives "github.com/bitcoin-sv/go-sdk/primitives/ec"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	validation "github.com/go-ozzo/ozzo-validation"
 )
 
 const (
 	// AuthenticationSchemeXpub is the xpub auth scheme (using xPubs as tokens)
 	AuthenticationSchemeXpub = "xpub"
+
+	// MinMutualAuthSessionTTL is the minimal mutual auth session ttl; the used nonces are remembered for that time to prevent replaying the requests
+	MinMutualAuthSessionTTL = time.Minute
 )
 
 // IsAdmin will check if the key is an admin key
@@ -16,8 +23,44 @@ func (a *AuthenticationConfig) IsAdmin(key string) bool {
 
 // Validate checks the configuration for specific rules
 func (a *AuthenticationConfig) Validate() error {
-	return validation.ValidateStruct(a,
+	err := validation.ValidateStruct(a,
 		validation.Field(&a.AdminKey, validation.Required, validation.Length(32, 111)),
 		validation.Field(&a.Scheme, validation.Required, validation.In(AuthenticationSchemeXpub)),
 	)
+	if err != nil {
+		return err
+	}
+
+	return a.MutualAuth.Validate()
+}
+
+// Validate checks the mutual authentication configuration
+func (m *MutualAuthConfig) Validate() error {
+	if m == nil || !m.Enabled {
+		return nil
+	}
+
+	if m.SessionTTL < MinMutualAuthSessionTTL {
+		return spverrors.Newf("mutual auth session ttl must be at least %s", MinMutualAuthSessionTTL)
+	}
+
+	if m.IdentityKey != "" {
+		if _, err := m.ParseIdentityKey(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ParseIdentityKey parses the configured identity key, accepting both hex and WIF formats
+func (m *MutualAuthConfig) ParseIdentityKey() (*primitives.PrivateKey, error) {
+	if key, err := primitives.PrivateKeyFromHex(m.IdentityKey); err == nil {
+		return key, nil
+	}
+	key, err := primitives.PrivateKeyFromWif(m.IdentityKey)
+	if err != nil {
+		return nil, spverrors.Newf("invalid mutual auth identity key, expected hex or WIF encoded private key")
+	}
+	return key, nil
 }
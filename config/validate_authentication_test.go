@@ -2,6 +2,7 @@ package config_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/bitcoin-sv/spv-wallet/config"
 	"github.com/stretchr/testify/require"
@@ -33,6 +34,24 @@ func TestValidateAuthenticationConfig(t *testing.T) {
 				cfg.Authentication.AdminKey = "1234567"
 			},
 		},
+		"invalid mutual auth identity key": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.Authentication.MutualAuth.Enabled = true
+				cfg.Authentication.MutualAuth.IdentityKey = "not-a-key"
+			},
+		},
+		"invalid mutual auth session ttl": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.Authentication.MutualAuth.Enabled = true
+				cfg.Authentication.MutualAuth.SessionTTL = 0
+			},
+		},
+		"too short mutual auth session ttl": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.Authentication.MutualAuth.Enabled = true
+				cfg.Authentication.MutualAuth.SessionTTL = 30 * time.Second
+			},
+		},
 	}
 	for name, test := range invalidConfigTests {
 		t.Run(name, func(t *testing.T) {
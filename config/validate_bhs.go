@@ -8,9 +8,27 @@ func (b *BHSConfig) Validate() error {
 		return spverrors.Newf("bhs config is required")
 	}
 
-	if b.URL == "" {
+	if b.URL == "" && !b.Embedded {
 		return spverrors.Newf("bhs url is required")
 	}
 
+	if b.MerkleRootsCache != nil && b.MerkleRootsCache.Enabled && b.MerkleRootsCache.TTL <= 0 {
+		return spverrors.Newf("bhs merkle roots cache ttl must be positive")
+	}
+
+	if cb := b.CircuitBreaker; cb != nil && cb.Enabled {
+		if cb.FailureThreshold <= 0 {
+			return spverrors.Newf("bhs circuit breaker failure threshold must be positive")
+		}
+		if cb.OpenTimeout <= 0 {
+			return spverrors.Newf("bhs circuit breaker open timeout must be positive")
+		}
+		switch cb.WhenDown {
+		case "", "reject", "queue":
+		default:
+			return spverrors.Newf("invalid bhs circuit breaker when_down: %s - must be reject or queue", cb.WhenDown)
+		}
+	}
+
 	return nil
 }
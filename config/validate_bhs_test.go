@@ -18,6 +18,17 @@ func TestValidateBHSConfig(t *testing.T) {
 				cfg.BHS.AuthToken = ""
 			},
 		},
+		"valid with circuit breaker queueing merkle roots": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.BHS.CircuitBreaker.WhenDown = "queue"
+			},
+		},
+		"valid with no url when embedded store is used": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.BHS.URL = ""
+				cfg.BHS.Embedded = true
+			},
+		},
 	}
 	for name, test := range validConfigTests {
 		t.Run(name, func(t *testing.T) {
@@ -42,6 +53,21 @@ func TestValidateBHSConfig(t *testing.T) {
 				cfg.BHS.URL = ""
 			},
 		},
+		"return error when merkle roots cache ttl is not positive": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.BHS.MerkleRootsCache.TTL = 0
+			},
+		},
+		"return error when circuit breaker threshold is not positive": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.BHS.CircuitBreaker.FailureThreshold = 0
+			},
+		},
+		"return error when circuit breaker policy is unknown": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.BHS.CircuitBreaker.WhenDown = "ignore"
+			},
+		},
 		"return error when config is nil": {
 			scenario: func(cfg *config.AppConfig) {
 				cfg.BHS = nil
@@ -0,0 +1,26 @@
+package config
+
+import "github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+
+// Chain modes
+const (
+	ChainModeDefault = "default"
+	ChainModeRegtest = "regtest"
+)
+
+// Validate checks the configuration of the chain backend
+func (c *ChainConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	if c.Mode != "" && c.Mode != ChainModeDefault && c.Mode != ChainModeRegtest {
+		return spverrors.Newf("invalid chain mode: %s - must be default or regtest", c.Mode)
+	}
+
+	if c.Regtest != nil && c.Regtest.BlockInterval < 0 {
+		return spverrors.Newf("regtest block interval cannot be negative")
+	}
+
+	return nil
+}
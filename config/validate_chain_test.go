@@ -0,0 +1,55 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateChainConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("regtest mode", func(t *testing.T) {
+		// given:
+		cfg := config.GetDefaultAppConfig()
+
+		cfg.Chain.Mode = config.ChainModeRegtest
+		cfg.Chain.Regtest.BlockInterval = 10 * time.Second
+
+		// when:
+		err := cfg.Validate()
+
+		// then:
+		require.NoError(t, err)
+		require.True(t, cfg.IsRegtest())
+	})
+
+	t.Run("unknown mode", func(t *testing.T) {
+		// given:
+		cfg := config.GetDefaultAppConfig()
+
+		cfg.Chain.Mode = "testnet"
+
+		// when:
+		err := cfg.Validate()
+
+		// then:
+		require.Error(t, err)
+	})
+
+	t.Run("negative block interval", func(t *testing.T) {
+		// given:
+		cfg := config.GetDefaultAppConfig()
+
+		cfg.Chain.Mode = config.ChainModeRegtest
+		cfg.Chain.Regtest.BlockInterval = -time.Second
+
+		// when:
+		err := cfg.Validate()
+
+		// then:
+		require.Error(t, err)
+	})
+}
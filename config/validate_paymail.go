@@ -30,7 +30,70 @@ func (p *PaymailConfig) Validate() error {
 		}
 	}
 
+	if err = p.HostReputation.Validate(); err != nil {
+		return err
+	}
+	if err = p.Client.Validate(); err != nil {
+		return err
+	}
+	if err = p.RateLimit.Validate(); err != nil {
+		return err
+	}
+	if p.DestinationTTL < 0 {
+		return spverrors.Newf("paymail destination_ttl cannot be negative")
+	}
+
 	// Todo: validate the default_from_paymail and default_note values
 
 	return nil
 }
+
+// Validate checks the configuration of the paymail hosts reputation
+func (r *PaymailHostReputationConfig) Validate() error {
+	if r == nil {
+		return nil
+	}
+	if r.BlockAfterFailures < 0 {
+		return spverrors.Newf("paymail host_reputation.block_after_failures cannot be negative")
+	}
+	if r.BlockAfterFailures > 0 && r.BlockDuration <= 0 {
+		return spverrors.Newf("paymail host_reputation.block_duration must be positive when blocking is enabled")
+	}
+	if r.SlowResponse < 0 {
+		return spverrors.Newf("paymail host_reputation.slow_response cannot be negative")
+	}
+	return nil
+}
+
+// Validate checks the configuration of the calls to the paymail hosts
+func (c *PaymailClientConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+	if c.CapabilitiesTTL < 0 || c.NegativeCacheTTL < 0 {
+		return spverrors.Newf("paymail client capabilities_ttl and negative_cache_ttl cannot be negative")
+	}
+	for _, domainTTL := range c.CapabilitiesDomainTTLs {
+		if domainTTL.Domain == "" {
+			return spverrors.Newf("paymail client capabilities_domain_ttls must have a domain")
+		}
+		if domainTTL.TTL <= 0 {
+			return spverrors.Newf("paymail client capabilities_domain_ttls for [%s] must be positive", domainTTL.Domain)
+		}
+	}
+	if c.Timeout < 0 || c.Retries < 0 || c.RetryDelay < 0 {
+		return spverrors.Newf("paymail client timeout, retries and retry_delay cannot be negative")
+	}
+	return nil
+}
+
+// Validate checks the configuration of the paymail endpoints rate limits
+func (r *PaymailRateLimitConfig) Validate() error {
+	if r == nil || !r.Enabled {
+		return nil
+	}
+	if r.PerIP < 0 || r.PerAlias < 0 {
+		return spverrors.Newf("paymail rate_limit.per_ip and rate_limit.per_alias cannot be negative")
+	}
+	return nil
+}
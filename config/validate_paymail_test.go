@@ -2,6 +2,7 @@ package config_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/bitcoin-sv/spv-wallet/config"
 	"github.com/stretchr/testify/require"
@@ -24,6 +25,16 @@ func TestValidatePaymailConfig(t *testing.T) {
 				cfg.Paymail.Domains = []string{"test.com", "domain.com"}
 			},
 		},
+		"valid without host reputation": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.Paymail.HostReputation = nil
+			},
+		},
+		"valid without rate limits": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.Paymail.RateLimit = nil
+			},
+		},
 	}
 	for name, test := range validConfigTests {
 		t.Run(name, func(t *testing.T) {
@@ -83,6 +94,41 @@ func TestValidatePaymailConfig(t *testing.T) {
 				cfg.Paymail.Domains = []string{"spaces in domain"}
 			},
 		},
+		"invalid for negative host reputation failures": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.Paymail.HostReputation.BlockAfterFailures = -1
+			},
+		},
+		"invalid for blocking hosts without block duration": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.Paymail.HostReputation.BlockDuration = 0
+			},
+		},
+		"invalid for negative paymail client retries": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.Paymail.Client.Retries = -1
+			},
+		},
+		"invalid for zero capabilities TTL of domain": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.Paymail.Client.CapabilitiesDomainTTLs = config.PaymailDomainTTLs{{Domain: "example.com"}}
+			},
+		},
+		"invalid for capabilities TTL without domain": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.Paymail.Client.CapabilitiesDomainTTLs = config.PaymailDomainTTLs{{TTL: time.Hour}}
+			},
+		},
+		"invalid for negative rate limit per IP": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.Paymail.RateLimit.PerIP = -1
+			},
+		},
+		"invalid for negative destination TTL": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.Paymail.DestinationTTL = -time.Hour
+			},
+		},
 	}
 	for name, test := range invalidConfigTests {
 		t.Run(name, func(t *testing.T) {
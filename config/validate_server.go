@@ -1,6 +1,8 @@
 package config
 
 import (
+	"net"
+
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	validation "github.com/go-ozzo/ozzo-validation"
 )
@@ -22,6 +24,12 @@ func (s *ServerConfig) Validate() error {
 		return spverrors.Newf("server port outside of bounds")
 	}
 
+	for _, proxy := range s.TrustedProxies {
+		if _, _, err := net.ParseCIDR(proxy); err != nil && net.ParseIP(proxy) == nil {
+			return spverrors.Newf("server trusted proxy %q is neither an IP nor a CIDR", proxy)
+		}
+	}
+
 	return validation.ValidateStruct(s,
 		validation.Field(&s.IdleTimeout, validation.Required),
 		validation.Field(&s.ReadTimeout, validation.Required),
@@ -38,6 +38,11 @@ func TestValidateServerConfig(t *testing.T) {
 				cfg.Server.WriteTimeout = 0
 			},
 		},
+		"invalid for malformed trusted proxy": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.Server.TrustedProxies = []string{"10.0.0.0/8", "proxy.example.com"}
+			},
+		},
 	}
 	for name, test := range invalidConfigTests {
 		t.Run(name, func(t *testing.T) {
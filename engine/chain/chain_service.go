@@ -1,20 +1,33 @@
 package chain
 
 import (
+	"context"
+	"slices"
+
+	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	chainerrors "github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
 	"github.com/bitcoin-sv/spv-wallet/engine/chain/internal"
 	"github.com/bitcoin-sv/spv-wallet/engine/chain/internal/arc"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/internal/arcpool"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/internal/beefarchive"
 	"github.com/bitcoin-sv/spv-wallet/engine/chain/internal/bhs"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/internal/bhsguard"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/internal/headers"
 	"github.com/bitcoin-sv/spv-wallet/engine/chain/internal/junglebus"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/internal/txbatch"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/internal/whatsonchain"
 	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
 	"github.com/go-resty/resty/v2"
 	"github.com/rs/zerolog"
 )
 
-type arcService = arc.Service
+type arcPool = arcpool.Pool
 
 type chainService struct {
-	*arcService
+	*arcPool
 	BHSService
+	batcher      *txbatch.Batcher
+	headersStore *headers.Service
 }
 
 // NewChainService creates a new chain service.
@@ -23,21 +36,102 @@ func NewChainService(logger zerolog.Logger, httpClient *resty.Client, arcCfg cha
 		panic("httpClient is required")
 	}
 
-	if arcCfg.UseJunglebus {
-		addJunglebusTxsGetter(logger, httpClient, &arcCfg)
+	if providers := newTxsProviders(logger, httpClient, arcCfg); providers != nil {
+		arcCfg.TxsGetter = internal.CombineTxsGetters(arcCfg.TxsGetter, providers)
+	}
+
+	arcLogger := logger.With().Str("chain", "arc").Logger()
+	service := &chainService{
+		arcPool: arcpool.NewPool(arcLogger, httpClient, arcCfg),
+	}
+
+	if bhsConf.Embedded != nil {
+		service.headersStore = newHeadersStore(logger, httpClient, *bhsConf.Embedded)
+		service.BHSService = service.headersStore
+	} else {
+		bhsLogger := logger.With().Str("chain", "bhs").Logger()
+		service.BHSService = bhsguard.NewGuard(bhsLogger, bhs.NewBHSService(bhsLogger, httpClient, bhsConf), bhsConf)
+	}
+
+	if arcCfg.Batch != nil && arcCfg.Callback != nil && arcCfg.Callback.TokenPerTransaction {
+		arcLogger.Warn().Msg("Batch broadcasting is disabled - ARC cannot carry callback tokens per transaction for a batch")
+	} else if arcCfg.Batch != nil {
+		service.batcher = txbatch.NewBatcher(arcLogger, service.arcPool, arcCfg.Batch.MaxSize, arcCfg.Batch.Interval)
+	}
+
+	return service
+}
+
+// Broadcast submits a transaction to ARC - as a part of a batch when batch broadcasting is enabled.
+func (s *chainService) Broadcast(ctx context.Context, tx *sdk.Transaction) (*chainmodels.TXInfo, error) {
+	if s.batcher != nil {
+		return s.batcher.Broadcast(ctx, tx)
+	}
+	return s.arcPool.Broadcast(ctx, tx)
+}
+
+// SyncBlockHeaders updates the embedded block headers store; it does nothing when the external BHS is used.
+func (s *chainService) SyncBlockHeaders(ctx context.Context) error {
+	if s.headersStore == nil {
+		return nil
 	}
+	return s.headersStore.Sync(ctx)
+}
 
-	return &chainService{
-		arc.NewARCService(logger.With().Str("chain", "arc").Logger(), httpClient, arcCfg),
-		bhs.NewBHSService(logger.With().Str("chain", "bhs").Logger(), httpClient, bhsConf),
+// MineBlocks is available only in the regtest chain mode.
+func (s *chainService) MineBlocks(_ context.Context, _ int) ([]chainmodels.BlockHeader, error) {
+	return nil, chainerrors.ErrMiningNotSupported
+}
+
+func newHeadersStore(logger zerolog.Logger, httpClient *resty.Client, cfg chainmodels.EmbeddedBHSConfig) *headers.Service {
+	if cfg.Source == nil {
+		cfg.Source = junglebus.NewJunglebusService(logger.With().Str("service", "junglebus").Logger(), httpClient)
 	}
+	return headers.NewService(logger.With().Str("chain", "headers").Logger(), httpClient, &cfg)
 }
 
-func addJunglebusTxsGetter(logger zerolog.Logger, httpClient *resty.Client, arcCfg *chainmodels.ARCConfig) {
-	junglebusTxsGetter := junglebus.NewJunglebusService(logger.With().Str("service", "junglebus").Logger(), httpClient)
+// newTxsProviders combines the configured external sources of transactions in the configured order.
+// Every provider is limited by its timeout and the transactions fetched from any of them are cached.
+func newTxsProviders(logger zerolog.Logger, httpClient *resty.Client, arcCfg chainmodels.ARCConfig) chainmodels.TransactionsGetter {
+	providersCfg := arcCfg.TxsProviders
+	if arcCfg.UseJunglebus && !slices.ContainsFunc(providersCfg, func(p chainmodels.TxsProviderConfig) bool {
+		return p.Type == chainmodels.TxsProviderJunglebus
+	}) {
+		providersCfg = append(slices.Clone(providersCfg), chainmodels.TxsProviderConfig{Type: chainmodels.TxsProviderJunglebus})
+	}
+	if len(providersCfg) == 0 {
+		return nil
+	}
+
+	providersLogger := logger.With().Str("chain", "txs-providers").Logger()
+	providers := make([]chainmodels.TransactionsGetter, 0, len(providersCfg))
+	for _, cfg := range providersCfg {
+		provider := newTxsProvider(logger, httpClient, arcCfg, cfg)
+		if provider == nil {
+			providersLogger.Warn().Str("provider", string(cfg.Type)).Msg("Unknown transactions provider, skipping it")
+			continue
+		}
+		providers = append(providers, internal.WithTimeout(providersLogger, string(cfg.Type), provider, cfg.Timeout))
+	}
+
+	return internal.WithCache(providersLogger, internal.CombineTxsGetters(providers...), arcCfg.TxsCache)
+}
 
-	arcCfg.TxsGetter = internal.CombineTxsGetters(
-		arcCfg.TxsGetter,
-		junglebusTxsGetter,
-	)
+func newTxsProvider(logger zerolog.Logger, httpClient *resty.Client, arcCfg chainmodels.ARCConfig, cfg chainmodels.TxsProviderConfig) chainmodels.TransactionsGetter {
+	switch cfg.Type {
+	case chainmodels.TxsProviderJunglebus:
+		return junglebus.NewJunglebusService(logger.With().Str("service", "junglebus").Logger(), httpClient)
+	case chainmodels.TxsProviderWhatsOnChain:
+		return whatsonchain.NewWhatsOnChainService(logger.With().Str("service", "whatsonchain").Logger(), httpClient, cfg.URL, cfg.Token)
+	case chainmodels.TxsProviderARC:
+		if cfg.URL != "" {
+			arcCfg.URL = cfg.URL
+			arcCfg.Token = cfg.Token
+		}
+		return arc.NewTxsGetter(logger.With().Str("service", "arc-txs").Logger(), httpClient, arcCfg)
+	case chainmodels.TxsProviderBEEFArchive:
+		return beefarchive.NewArchive(logger.With().Str("service", "beef-archive").Logger(), cfg.URL)
+	default:
+		return nil
+	}
 }
@@ -31,3 +31,18 @@ var ErrMerkleRootNotFound = models.SPVError{Message: "No block with provided mer
 
 // ErrMerkleRootNotInLongestChain is when Block Header Service finds merkleroot in lastEvaluateKey query param but it is not in longest chain
 var ErrMerkleRootNotInLongestChain = models.SPVError{Message: "Provided merkleroot is not part of the longest chain", StatusCode: 409, Code: "error-merkleroot-not-part-of-longest-chain"}
+
+// ErrBlockHeadersInvalid is when the embedded block headers store gets headers which don't form a valid chain
+var ErrBlockHeadersInvalid = models.SPVError{Message: "received block headers are invalid", StatusCode: 500, Code: "error-block-headers-invalid"}
+
+// ErrBlockHeadersImport is when the embedded block headers store cannot import headers from the configured file
+var ErrBlockHeadersImport = models.SPVError{Message: "cannot import block headers", StatusCode: 500, Code: "error-block-headers-import"}
+
+// ErrBlockHeadersSourceFailure is when the source of block headers for the embedded store fails to return headers
+var ErrBlockHeadersSourceFailure = models.SPVError{Message: "block headers source failed to return headers", StatusCode: 500, Code: "error-block-headers-source-failure"}
+
+// ErrBHSCircuitOpen is when BHS is considered down after consecutive failures and requests are not sent to it
+var ErrBHSCircuitOpen = models.SPVError{Message: "Block Header Service is temporarily unavailable", StatusCode: 503, Code: "error-bhs-circuit-open"}
+
+// ErrMerkleRootsDeferred is when merkle roots cannot be verified now (BHS is down or behind) and the verification should be retried later
+var ErrMerkleRootsDeferred = models.SPVError{Message: "Merkle roots cannot be verified now, verification is deferred", StatusCode: 503, Code: "error-merkle-roots-deferred"}
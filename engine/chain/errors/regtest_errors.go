@@ -0,0 +1,12 @@
+package chainerrors
+
+import "github.com/bitcoin-sv/spv-wallet/models"
+
+// ErrMiningNotSupported is when blocks are requested to be mined while the wallet is connected to a real network
+var ErrMiningNotSupported = models.SPVError{Message: "mining blocks is available only in regtest chain mode", StatusCode: 400, Code: "error-mining-not-supported"}
+
+// ErrRegtestInvalidTx is when the regtest node cannot accept the broadcasted transaction
+var ErrRegtestInvalidTx = models.SPVError{Message: "regtest node rejected the transaction", StatusCode: 500, Code: "error-regtest-invalid-tx"}
+
+// ErrRegtestInvalidBlocksCount is when the number of blocks to mine is out of the allowed range
+var ErrRegtestInvalidBlocksCount = models.SPVError{Message: "invalid number of blocks to mine", StatusCode: 400, Code: "error-regtest-invalid-blocks-count"}
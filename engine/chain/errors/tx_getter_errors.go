@@ -4,3 +4,9 @@ import "github.com/bitcoin-sv/spv-wallet/models"
 
 // ErrGetTransactionsByTxsGetter is when error occurred during getting transactions
 var ErrGetTransactionsByTxsGetter = models.SPVError{Message: "error getting transactions during collecting transactions for Txs getter", StatusCode: 500, Code: "error-get-transactions-txs-getter"}
+
+// ErrWhatsOnChainFailure is when we can't get transactions from WhatsOnChain
+var ErrWhatsOnChainFailure = models.SPVError{Message: "whatsonchain failed to return transactions", StatusCode: 500, Code: "error-whatsonchain-failure"}
+
+// ErrBEEFArchiveFailure is when we can't read transactions from the local BEEF archive
+var ErrBEEFArchiveFailure = models.SPVError{Message: "failed to read transaction from BEEF archive", StatusCode: 500, Code: "error-beef-archive-failure"}
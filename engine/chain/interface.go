@@ -22,11 +22,25 @@ type ARCService interface {
 type BHSService interface {
 	GetMerkleRoots(ctx context.Context, query url.Values) (*models.MerkleRootsBHSResponse, error)
 	VerifyMerkleRoots(ctx context.Context, merkleRoots []*spv.MerkleRootConfirmationRequestItem) (bool, error)
+	// ConfirmMerkleRoots returns the confirmation state of the merkle roots without treating the unverifiable ones as valid.
+	ConfirmMerkleRoots(ctx context.Context, merkleRoots []*spv.MerkleRootConfirmationRequestItem) (chainmodels.MerkleRootConfirmationState, error)
 	HealthcheckBHS(ctx context.Context) error
 }
 
+// BlockHeadersSyncer for updating the embedded block headers store.
+type BlockHeadersSyncer interface {
+	SyncBlockHeaders(ctx context.Context) error
+}
+
+// BlockMiner for mining blocks in the regtest chain mode.
+type BlockMiner interface {
+	MineBlocks(ctx context.Context, count int) ([]chainmodels.BlockHeader, error)
+}
+
 // Service related to the chain.
 type Service interface {
 	ARCService
 	BHSService
+	BlockHeadersSyncer
+	BlockMiner
 }
@@ -20,22 +20,44 @@ const (
 	StatusCumulativeFeeValidationFailed = 473
 )
 
+// Custom ARC defined http status codes related to BEEF
+const (
+	StatusMalformed             = 463
+	StatusMinedAncestorsMissing = 467
+	StatusInvalidBUMPs          = 468
+)
+
 // Broadcast submits a transaction to the ARC server and returns the transaction info.
+// The transaction is sent in the richest format available: BEEF (when enabled), EF, or raw hex as the last resort.
 func (s *Service) Broadcast(ctx context.Context, tx *sdk.Transaction) (*chainmodels.TXInfo, error) {
+	if beefHex, ok := s.prepareBEEFHex(tx); ok {
+		result, status, err := s.broadcast(ctx, tx.TxID().String(), beefHex)
+		if !isBEEFRejection(status) {
+			return result, err
+		}
+		s.rememberBEEFRejection(status)
+		s.logger.Warn().Err(err).Int("status", status).Msg("ARC rejected BEEF transaction. Retrying with EF.")
+	}
+
+	txHex, err := s.prepareTxHex(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, _, err := s.broadcast(ctx, tx.TxID().String(), txHex)
+	return result, err
+}
+
+func (s *Service) broadcast(ctx context.Context, txID, txHex string) (*chainmodels.TXInfo, int, error) {
 	result := &chainmodels.TXInfo{}
 	arcErr := &chainmodels.ArcError{}
 	req := s.prepareARCRequest(ctx).
 		SetResult(result).
 		SetError(arcErr)
 
-	s.setCallbackHeaders(req)
+	s.setCallbackHeaders(req, txID)
 	s.setWaitForHeader(req)
 
-	txHex, err := s.prepareTxHex(ctx, tx)
-	if err != nil {
-		return nil, err
-	}
-
 	req.SetBody(requestBody{
 		RawTx: txHex,
 	})
@@ -43,23 +65,30 @@ func (s *Service) Broadcast(ctx context.Context, tx *sdk.Transaction) (*chainmod
 	response, err := req.Post(fmt.Sprintf("%s/v1/tx", s.arcCfg.URL))
 
 	if err != nil {
-		return nil, s.wrapRequestError(err)
+		return nil, 0, s.wrapRequestError(err)
 	}
 
-	switch response.StatusCode() {
-	case http.StatusOK:
+	status := response.StatusCode()
+	if status == http.StatusOK {
 		if result.TXStatus.IsProblematic() {
-			return nil, chainerrors.ErrARCProblematicStatus.Wrap(spverrors.Newf("ARC Problematic tx status: %s", result.TXStatus))
+			return nil, status, chainerrors.ErrARCProblematicStatus.Wrap(spverrors.Newf("ARC Problematic tx status: %s", result.TXStatus))
 		}
-		return result, nil
+		return result, status, nil
+	}
+	return nil, status, s.statusError(status, arcErr)
+}
+
+// statusError maps a non-OK status code of a broadcast response to the error.
+func (s *Service) statusError(status int, arcErr *chainmodels.ArcError) error {
+	switch status {
 	case http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound:
-		return nil, s.wrapARCError(chainerrors.ErrARCUnauthorized, arcErr)
+		return s.wrapARCError(chainerrors.ErrARCUnauthorized, arcErr)
 	case StatusNotExtendedFormat:
-		return nil, s.wrapARCError(chainerrors.ErrARCNotExtendedFormat, arcErr)
+		return s.wrapARCError(chainerrors.ErrARCNotExtendedFormat, arcErr)
 	case StatusFeeTooLow, StatusCumulativeFeeValidationFailed:
-		return nil, s.wrapARCError(chainerrors.ErrARCWrongFee, arcErr)
+		return s.wrapARCError(chainerrors.ErrARCWrongFee, arcErr)
 	default:
-		return nil, s.wrapARCError(chainerrors.ErrARCUnprocessable, arcErr)
+		return s.wrapARCError(chainerrors.ErrARCUnprocessable, arcErr)
 	}
 }
 
@@ -70,6 +99,52 @@ type requestBody struct {
 	RawTx string `json:"rawTx"`
 }
 
+// prepareBEEFHex returns the BEEF hex of the transaction if BEEF broadcasting is enabled
+// and every input is sourced back to a mined (having a merkle path) ancestor.
+func (s *Service) prepareBEEFHex(tx *sdk.Transaction) (string, bool) {
+	if !s.arcCfg.BroadcastBEEF || s.beefUnsupported.Load() || !isFullySourced(tx) {
+		return "", false
+	}
+	beefHex, err := tx.BEEFHex()
+	if err != nil {
+		s.logger.Info().Err(err).Msg("Could not convert transaction to BEEF. Falling back to EF.")
+		return "", false
+	}
+	return beefHex, true
+}
+
+func isFullySourced(tx *sdk.Transaction) bool {
+	if tx.MerklePath != nil {
+		return true
+	}
+	if len(tx.Inputs) == 0 {
+		return false
+	}
+	for _, input := range tx.Inputs {
+		if input.SourceTransaction == nil || !isFullySourced(input.SourceTransaction) {
+			return false
+		}
+	}
+	return true
+}
+
+func isBEEFRejection(status int) bool {
+	switch status {
+	case http.StatusBadRequest, StatusNotExtendedFormat, StatusMalformed, StatusMinedAncestorsMissing, StatusInvalidBUMPs:
+		return true
+	default:
+		return false
+	}
+}
+
+// rememberBEEFRejection stops sending BEEF when ARC doesn't accept the format at all (not extended format status).
+// Other rejections (e.g. malformed transaction or invalid BUMPs) concern only the given transaction.
+func (s *Service) rememberBEEFRejection(status int) {
+	if status == StatusNotExtendedFormat {
+		s.beefUnsupported.Store(true)
+	}
+}
+
 func (s *Service) prepareTxHex(ctx context.Context, tx *sdk.Transaction) (string, error) {
 	efHex, err := s.efConverter.Convert(ctx, tx)
 	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
@@ -83,15 +158,23 @@ func (s *Service) prepareTxHex(ctx context.Context, tx *sdk.Transaction) (string
 	return efHex, nil
 }
 
-func (s *Service) setCallbackHeaders(req *resty.Request) {
+// setCallbackHeaders sets the callback URL and token; txID is empty when the request contains more than one transaction.
+func (s *Service) setCallbackHeaders(req *resty.Request, txID string) {
 	cb := s.arcCfg.Callback
-	if cb != nil && cb.URL != "" {
-		req.SetHeader("X-CallbackUrl", cb.URL)
+	if cb == nil || cb.URL == "" {
+		return
+	}
+	req.SetHeader("X-CallbackUrl", cb.URL)
 
-		if cb.Token != "" {
-			req.SetHeader("X-CallbackToken", cb.Token)
-		}
+	if cb.Token == "" {
+		return
+	}
+	if cb.TokenPerTransaction && txID == "" {
+		// ARC accepts a single callback token per request, so it cannot carry tokens of several transactions
+		s.logger.Warn().Msg("Callback token per transaction cannot be set for a batch of transactions - callbacks will be rejected")
+		return
 	}
+	req.SetHeader("X-CallbackToken", cb.TokenForTx(txID))
 }
 
 func (s *Service) setWaitForHeader(req *resty.Request) {
@@ -0,0 +1,119 @@
+package arc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+)
+
+// batchItemResponse is a single element of the /v1/txs response.
+// Depending on the status, it describes either the broadcasted transaction or the reason it was rejected.
+type batchItemResponse struct {
+	chainmodels.TXInfo
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail"`
+	Instance string `json:"instance"`
+}
+
+// BroadcastBatch submits transactions to the ARC server in a single request and returns the result for each of them (in the same order).
+// The returned error is set only when the whole batch failed.
+func (s *Service) BroadcastBatch(ctx context.Context, txs []*sdk.Transaction) ([]chainmodels.BroadcastResult, error) {
+	useBEEF := s.arcCfg.BroadcastBEEF && !s.beefUnsupported.Load()
+
+	results, status, err := s.broadcastBatch(ctx, txs, useBEEF)
+	if useBEEF && isBEEFRejection(status) {
+		s.rememberBEEFRejection(status)
+		s.logger.Warn().Err(err).Int("status", status).Msg("ARC rejected batch with BEEF transactions. Retrying with EF.")
+		results, _, err = s.broadcastBatch(ctx, txs, false)
+	}
+	return results, err
+}
+
+func (s *Service) broadcastBatch(ctx context.Context, txs []*sdk.Transaction, useBEEF bool) ([]chainmodels.BroadcastResult, int, error) {
+	body := make([]requestBody, 0, len(txs))
+	for _, tx := range txs {
+		txHex, err := s.prepareBatchTxHex(ctx, tx, useBEEF)
+		if err != nil {
+			return nil, 0, err
+		}
+		body = append(body, requestBody{RawTx: txHex})
+	}
+
+	var items []batchItemResponse
+	arcErr := &chainmodels.ArcError{}
+	req := s.prepareARCRequest(ctx).
+		SetResult(&items).
+		SetError(arcErr).
+		SetBody(body)
+
+	s.setCallbackHeaders(req, batchTxID(txs))
+	s.setWaitForHeader(req)
+
+	response, err := req.Post(fmt.Sprintf("%s/v1/txs", s.arcCfg.URL))
+	if err != nil {
+		return nil, 0, s.wrapRequestError(err)
+	}
+
+	status := response.StatusCode()
+	if status != http.StatusOK {
+		return nil, status, s.statusError(status, arcErr)
+	}
+
+	return s.mapBatchResults(txs, items), status, nil
+}
+
+// batchTxID returns the ID of the only transaction in the batch or an empty string when there are more of them.
+func batchTxID(txs []*sdk.Transaction) string {
+	if len(txs) != 1 {
+		return ""
+	}
+	return txs[0].TxID().String()
+}
+
+func (s *Service) prepareBatchTxHex(ctx context.Context, tx *sdk.Transaction, useBEEF bool) (string, error) {
+	if useBEEF {
+		if beefHex, ok := s.prepareBEEFHex(tx); ok {
+			return beefHex, nil
+		}
+	}
+	return s.prepareTxHex(ctx, tx)
+}
+
+func (s *Service) mapBatchResults(txs []*sdk.Transaction, items []batchItemResponse) []chainmodels.BroadcastResult {
+	byTxID := make(map[string]*batchItemResponse, len(items))
+	for i := range items {
+		byTxID[items[i].TxID] = &items[i]
+	}
+
+	results := make([]chainmodels.BroadcastResult, len(txs))
+	for i, tx := range txs {
+		txID := tx.TxID().String()
+		item, ok := byTxID[txID]
+		switch {
+		case !ok:
+			results[i].Err = chainerrors.ErrARCUnprocessable.Wrap(spverrors.Newf("ARC returned no result for transaction %s", txID))
+		case item.Status != 0 && item.Status != http.StatusOK:
+			results[i].Err = s.statusError(item.Status, &chainmodels.ArcError{
+				Type:      item.Type,
+				Title:     item.Title,
+				Status:    item.Status,
+				Detail:    item.Detail,
+				Instance:  item.Instance,
+				TxID:      item.TxID,
+				ExtraInfo: item.ExtraInfo,
+			})
+		case item.TXStatus.IsProblematic():
+			results[i].Err = chainerrors.ErrARCProblematicStatus.Wrap(spverrors.Newf("ARC Problematic tx status: %s", item.TXStatus))
+		default:
+			results[i].TXInfo = &item.TXInfo
+		}
+	}
+	return results
+}
@@ -0,0 +1,98 @@
+package arc_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/internal/arc"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester"
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBroadcastBatch(t *testing.T) {
+	t.Run("Map per-transaction results", func(t *testing.T) {
+		// given:
+		transport := httpmock.NewMockTransport()
+		httpClient := resty.New()
+		httpClient.GetClient().Transport = transport
+
+		accepted := fromHex(efOfValidRawHex)
+		rejected := fromHex(fallbackRawHex)
+
+		transport.RegisterResponder("POST", arcURL+"/v1/txs", httpmock.NewJsonResponderOrPanic(http.StatusOK, []map[string]any{
+			{
+				"status":   465,
+				"title":    "Fee too low",
+				"detail":   "Fees are too low",
+				"txid":     rejected.TxID().String(),
+				"txStatus": "",
+			},
+			{
+				"status":   200,
+				"txid":     accepted.TxID().String(),
+				"txStatus": "SEEN_ON_NETWORK",
+			},
+		}))
+
+		service := arc.NewARCService(tester.Logger(t), httpClient, arcCfg(arcURL, arcToken))
+
+		// when:
+		results, err := service.BroadcastBatch(context.Background(), []*sdk.Transaction{accepted, rejected})
+
+		// then:
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+
+		require.NoError(t, results[0].Err)
+		require.Equal(t, accepted.TxID().String(), results[0].TXInfo.TxID)
+		require.Equal(t, chainmodels.SeenOnNetwork, results[0].TXInfo.TXStatus)
+
+		require.ErrorIs(t, results[1].Err, chainerrors.ErrARCWrongFee)
+		require.Nil(t, results[1].TXInfo)
+
+		// and:
+		require.Equal(t, 1, transport.GetTotalCallCount())
+	})
+
+	t.Run("Missing result for transaction", func(t *testing.T) {
+		// given:
+		transport := httpmock.NewMockTransport()
+		httpClient := resty.New()
+		httpClient.GetClient().Transport = transport
+
+		transport.RegisterResponder("POST", arcURL+"/v1/txs", httpmock.NewJsonResponderOrPanic(http.StatusOK, []map[string]any{}))
+
+		service := arc.NewARCService(tester.Logger(t), httpClient, arcCfg(arcURL, arcToken))
+
+		// when:
+		results, err := service.BroadcastBatch(context.Background(), []*sdk.Transaction{fromHex(efOfValidRawHex)})
+
+		// then:
+		require.NoError(t, err)
+		require.ErrorIs(t, results[0].Err, chainerrors.ErrARCUnprocessable)
+	})
+
+	t.Run("Whole batch rejected", func(t *testing.T) {
+		// given:
+		transport := httpmock.NewMockTransport()
+		httpClient := resty.New()
+		httpClient.GetClient().Transport = transport
+
+		transport.RegisterResponder("POST", arcURL+"/v1/txs", httpmock.NewStringResponder(http.StatusUnauthorized, ""))
+
+		service := arc.NewARCService(tester.Logger(t), httpClient, arcCfg(arcURL, arcToken))
+
+		// when:
+		results, err := service.BroadcastBatch(context.Background(), []*sdk.Transaction{fromHex(efOfValidRawHex)})
+
+		// then:
+		require.ErrorIs(t, err, chainerrors.ErrARCUnauthorized)
+		require.Nil(t, results)
+	})
+}
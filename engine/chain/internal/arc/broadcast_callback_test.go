@@ -0,0 +1,77 @@
+package arc_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester"
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	callbackURL   = "https://example.com/transaction/broadcast/callback"
+	callbackToken = "callback-token"
+)
+
+func TestBroadcastCallbackToken(t *testing.T) {
+	tests := map[string]struct {
+		tokenPerTransaction bool
+		expectedToken       func(txID string) string
+	}{
+		"shared token": {
+			tokenPerTransaction: false,
+			expectedToken:       func(string) string { return callbackToken },
+		},
+		"token per transaction": {
+			tokenPerTransaction: true,
+			expectedToken: func(txID string) string {
+				cfg := chainmodels.ARCCallbackConfig{Token: callbackToken, TokenPerTransaction: true}
+				return cfg.TokenForTx(txID)
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given:
+			var headers http.Header
+			transport := httpmock.NewMockTransport()
+			transport.RegisterResponder("POST", arcURL+"/v1/tx", func(req *http.Request) (*http.Response, error) {
+				headers = req.Header
+				return httpmock.NewJsonResponse(http.StatusOK, map[string]any{
+					"txStatus": "SEEN_ON_NETWORK",
+					"txid":     "2978f03c8a21bf90b5980113f988c39ef4ae691b9bedd5178c50ebb9c034dabf",
+				})
+			})
+			httpClient := resty.New()
+			httpClient.GetClient().Transport = transport
+
+			cfg := arcCfg(arcURL, arcToken)
+			cfg.Callback = &chainmodels.ARCCallbackConfig{
+				URL:                 callbackURL,
+				Token:               callbackToken,
+				TokenPerTransaction: test.tokenPerTransaction,
+			}
+			service := chain.NewChainService(tester.Logger(t), httpClient, cfg, chainmodels.BHSConfig{})
+
+			tx, err := sdk.NewTransactionFromHex(validRawHex)
+			require.NoError(t, err)
+			txID := tx.TxID().String()
+
+			// when:
+			_, err = service.Broadcast(context.Background(), tx)
+
+			// then:
+			require.NoError(t, err)
+			require.Equal(t, callbackURL, headers.Get("X-CallbackUrl"))
+			require.Equal(t, test.expectedToken(txID), headers.Get("X-CallbackToken"))
+			require.True(t, cfg.Callback.VerifyToken(txID, headers.Get("X-CallbackToken")))
+			require.Equal(t, !test.tokenPerTransaction, cfg.Callback.VerifyToken(minedTxID, headers.Get("X-CallbackToken")))
+		})
+	}
+}
@@ -3,14 +3,19 @@ package arc_test
 import (
 	"context"
 	"iter"
+	"net/http"
 	"testing"
 	"time"
 
 	sdk "github.com/bitcoin-sv/go-sdk/transaction"
 	"github.com/bitcoin-sv/spv-wallet/engine/chain"
 	"github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/internal/arc"
 	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
 	"github.com/bitcoin-sv/spv-wallet/engine/tester"
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 )
 
@@ -162,3 +167,120 @@ func fromHex(hex string) *sdk.Transaction {
 	tx, _ := sdk.NewTransactionFromHex(hex)
 	return tx
 }
+
+func TestBroadcastBEEF(t *testing.T) {
+	t.Run("Broadcast fully sourced tx in BEEF", func(t *testing.T) {
+		httpClient := mockActivate(false)
+
+		cfg := arcCfg(arcURL, arcToken)
+		cfg.BroadcastBEEF = true
+
+		service := chain.NewChainService(tester.Logger(t), httpClient, cfg, chainmodels.BHSConfig{})
+
+		tx := sourcedValidTx(t)
+
+		txInfo, err := service.Broadcast(context.Background(), tx)
+		require.NoError(t, err)
+		require.Equal(t, tx.TxID().String(), txInfo.TxID)
+		require.Equal(t, chainmodels.SeenOnNetwork, txInfo.TXStatus)
+	})
+
+	t.Run("Broadcast in EF when ARC does not support BEEF", func(t *testing.T) {
+		transport := httpmock.NewMockTransport()
+		httpClient := resty.New()
+		httpClient.GetClient().Transport = transport
+
+		transport.RegisterMatcherResponder("POST", arcURL+"/v1/tx",
+			httpmock.BodyContainsString(beefV1HexPrefix),
+			httpmock.NewStringResponder(arc.StatusNotExtendedFormat, `{"status": 460, "title": "Not extended format"}`),
+		)
+		transport.RegisterMatcherResponder("POST", arcURL+"/v1/tx",
+			httpmock.BodyContainsString(efOfValidRawHex),
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, map[string]any{
+				"txStatus": "SEEN_ON_NETWORK",
+				"txid":     "2978f03c8a21bf90b5980113f988c39ef4ae691b9bedd5178c50ebb9c034dabf",
+			}),
+		)
+
+		cfg := arcCfg(arcURL, arcToken)
+		cfg.BroadcastBEEF = true
+
+		service := chain.NewChainService(tester.Logger(t), httpClient, cfg, chainmodels.BHSConfig{})
+
+		for range 2 {
+			tx := sourcedValidTx(t)
+
+			txInfo, err := service.Broadcast(context.Background(), tx)
+			require.NoError(t, err)
+			require.Equal(t, tx.TxID().String(), txInfo.TxID)
+		}
+
+		// BEEF is not sent again after ARC rejected it once
+		require.Equal(t, 3, transport.GetTotalCallCount())
+	})
+
+	t.Run("Keep broadcasting in BEEF after ARC rejected a malformed transaction", func(t *testing.T) {
+		transport := httpmock.NewMockTransport()
+		httpClient := resty.New()
+		httpClient.GetClient().Transport = transport
+
+		transport.RegisterMatcherResponder("POST", arcURL+"/v1/tx",
+			httpmock.BodyContainsString(beefV1HexPrefix),
+			httpmock.NewStringResponder(arc.StatusMalformed, `{"status": 463, "title": "Malformed transaction"}`),
+		)
+		transport.RegisterMatcherResponder("POST", arcURL+"/v1/tx",
+			httpmock.BodyContainsString(efOfValidRawHex),
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, map[string]any{
+				"txStatus": "SEEN_ON_NETWORK",
+				"txid":     "2978f03c8a21bf90b5980113f988c39ef4ae691b9bedd5178c50ebb9c034dabf",
+			}),
+		)
+
+		cfg := arcCfg(arcURL, arcToken)
+		cfg.BroadcastBEEF = true
+
+		service := chain.NewChainService(tester.Logger(t), httpClient, cfg, chainmodels.BHSConfig{})
+
+		for range 2 {
+			tx := sourcedValidTx(t)
+
+			txInfo, err := service.Broadcast(context.Background(), tx)
+			require.NoError(t, err)
+			require.Equal(t, tx.TxID().String(), txInfo.TxID)
+		}
+
+		// BEEF is still sent, because the rejection concerned only the given transaction
+		require.Equal(t, 4, transport.GetTotalCallCount())
+	})
+
+	t.Run("Broadcast unmined ancestors in EF", func(t *testing.T) {
+		httpClient := mockActivate(false)
+
+		cfg := arcCfg(arcURL, arcToken)
+		cfg.BroadcastBEEF = true
+
+		service := chain.NewChainService(tester.Logger(t), httpClient, cfg, chainmodels.BHSConfig{})
+
+		tx := fromHex(validRawHex)
+		tx.Inputs[0].SourceTransaction = fromHex(sourceOfValidRawHex)
+
+		txInfo, err := service.Broadcast(context.Background(), tx)
+		require.NoError(t, err)
+		require.Equal(t, tx.TxID().String(), txInfo.TxID)
+	})
+}
+
+// sourcedValidTx returns the validRawHex transaction with its source transaction marked as mined.
+func sourcedValidTx(t *testing.T) *sdk.Transaction {
+	t.Helper()
+	source := fromHex(sourceOfValidRawHex)
+	sourceTxID := source.TxID()
+	source.MerklePath = sdk.NewMerklePath(862510, [][]*sdk.PathElement{{
+		{Offset: 0, Hash: sourceTxID, Txid: lo.ToPtr(true)},
+		{Offset: 1, Duplicate: lo.ToPtr(true)},
+	}})
+
+	tx := fromHex(validRawHex)
+	tx.Inputs[0].SourceTransaction = source
+	return tx
+}
@@ -17,6 +17,8 @@ const (
 	arcURL            = "https://arc.taal.com"
 	arcToken          = "mainnet_06770f425eb00298839a24a49cbdc02c"
 	invalidTxID       = "invalid"
+	// beefV1HexPrefix is the hex encoded BEEF V1 version marker that starts every BEEF transaction
+	beefV1HexPrefix = "0100beef"
 )
 
 // broadcast transaction cases
@@ -136,6 +138,20 @@ func arcMockResponses(transport *httpmock.MockTransport, applyTimeout bool) {
 		}`),
 	)
 
+	transport.RegisterMatcherResponder("POST", fmt.Sprintf("%s/v1/tx", arcURL),
+		httpmock.BodyContainsString(beefV1HexPrefix+"01"),
+		responder(http.StatusOK, `{
+			"blockHash": "",
+			"blockHeight": 0,
+			"competingTxs": null,
+			"extraInfo": "",
+			"merklePath": "",
+			"timestamp": "2024-09-27T06:11:41.417057192Z",
+			"txStatus": "SEEN_ON_NETWORK",
+			"txid": "2978f03c8a21bf90b5980113f988c39ef4ae691b9bedd5178c50ebb9c034dabf"
+		}`),
+	)
+
 	transport.RegisterMatcherResponder("POST", fmt.Sprintf("%s/v1/tx", arcURL),
 		httpmock.BodyContainsString(efHexOfTxWithMultipleInputs),
 		responder(http.StatusOK, `{
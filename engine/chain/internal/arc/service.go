@@ -2,6 +2,7 @@ package arc
 
 import (
 	"context"
+	"sync/atomic"
 
 	sdk "github.com/bitcoin-sv/go-sdk/transaction"
 	"github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
@@ -19,6 +20,8 @@ type Service struct {
 	efConverter interface {
 		Convert(ctx context.Context, tx *sdk.Transaction) (string, error)
 	}
+	// beefUnsupported is set when ARC reported that it does not support BEEF, so the next transactions are sent as EF straight away.
+	beefUnsupported atomic.Bool
 }
 
 // NewARCService creates a new arc service.
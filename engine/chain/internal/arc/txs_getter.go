@@ -0,0 +1,84 @@
+package arc
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+
+	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/go-resty/resty/v2"
+	"github.com/rs/zerolog"
+)
+
+// rawTxInfo is the part of ARC transaction status response with the raw transaction (not returned by every ARC version)
+type rawTxInfo struct {
+	TxID  string `json:"txid"`
+	RawTx string `json:"rawTx"`
+}
+
+// TxsGetter looks transactions up in ARC.
+type TxsGetter struct {
+	service *Service
+}
+
+// NewTxsGetter creates a transactions getter which fetches raw transactions from ARC's transaction status endpoint.
+// ARC versions which don't return raw transactions are handled as if they didn't know the transactions.
+func NewTxsGetter(logger zerolog.Logger, httpClient *resty.Client, arcCfg chainmodels.ARCConfig) *TxsGetter {
+	return &TxsGetter{
+		service: &Service{
+			logger:     logger,
+			httpClient: httpClient,
+			arcCfg:     arcCfg,
+		},
+	}
+}
+
+// GetTransactions implements chainmodels.TransactionsGetter interface to allow fetching transactions from ARC
+func (g *TxsGetter) GetTransactions(ctx context.Context, ids iter.Seq[string]) ([]*sdk.Transaction, error) {
+	var transactions []*sdk.Transaction
+	for id := range ids {
+		tx, err := g.fetchTransaction(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if tx != nil {
+			transactions = append(transactions, tx)
+		}
+	}
+	return transactions, nil
+}
+
+func (g *TxsGetter) fetchTransaction(ctx context.Context, txID string) (*sdk.Transaction, error) {
+	result := &rawTxInfo{}
+	arcErr := &chainmodels.ArcError{}
+	req := g.service.prepareARCRequest(ctx).
+		SetResult(result).
+		SetError(arcErr)
+
+	response, err := req.Get(fmt.Sprintf("%s/v1/tx/%s", g.service.arcCfg.URL, txID))
+	if err != nil {
+		return nil, g.service.wrapRequestError(err)
+	}
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+		if result.RawTx == "" {
+			return nil, nil
+		}
+		tx, err := sdk.NewTransactionFromHex(result.RawTx)
+		if err != nil {
+			return nil, chainerrors.ErrARCUnprocessable.Wrap(spverrors.Newf("ARC returned invalid raw transaction: %v", err))
+		}
+		return tx, nil
+	case http.StatusNotFound:
+		return nil, nil
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return nil, g.service.wrapARCError(chainerrors.ErrARCUnauthorized, arcErr)
+	default:
+		return nil, g.service.wrapARCError(chainerrors.ErrARCUnsupportedStatusCode, arcErr)
+	}
+}
@@ -0,0 +1,48 @@
+package arcpool
+
+import (
+	"sync"
+	"time"
+)
+
+const defaultCooldown = 30 * time.Second
+
+// health tracks failures of a single ARC endpoint.
+type health struct {
+	mu             sync.Mutex
+	cooldown       time.Duration
+	failures       int
+	unhealthyUntil time.Time
+}
+
+func newHealth(cooldown time.Duration) *health {
+	if cooldown <= 0 {
+		cooldown = defaultCooldown
+	}
+	return &health{cooldown: cooldown}
+}
+
+func (h *health) isHealthy() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.failures == 0 || time.Now().After(h.unhealthyUntil)
+}
+
+// recordSuccess resets the failures and returns the number of failures the endpoint recovered from.
+func (h *health) recordSuccess() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	failures := h.failures
+	h.failures = 0
+	h.unhealthyUntil = time.Time{}
+	return failures
+}
+
+// recordFailure marks the endpoint as unhealthy for the cooldown period and returns the number of consecutive failures.
+func (h *health) recordFailure() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.failures++
+	h.unhealthyUntil = time.Now().Add(h.cooldown)
+	return h.failures
+}
@@ -0,0 +1,248 @@
+package arcpool
+
+import (
+	"context"
+	"errors"
+
+	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/internal/arc"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/go-resty/resty/v2"
+	"github.com/rs/zerolog"
+)
+
+type endpointClient interface {
+	Broadcast(ctx context.Context, tx *sdk.Transaction) (*chainmodels.TXInfo, error)
+	BroadcastBatch(ctx context.Context, txs []*sdk.Transaction) ([]chainmodels.BroadcastResult, error)
+	QueryTransaction(ctx context.Context, txID string) (*chainmodels.TXInfo, error)
+	GetPolicy(ctx context.Context) (*arc.Policy, error)
+}
+
+type endpoint struct {
+	url    string
+	client endpointClient
+	health *health
+}
+
+// Pool of ARC endpoints which broadcasts and queries transactions according to the configured policy.
+type Pool struct {
+	logger    zerolog.Logger
+	policy    chainmodels.ARCBroadcastPolicy
+	endpoints []*endpoint
+}
+
+// NewPool creates a new pool of ARC services - one for the primary ARC server and one for each additional endpoint.
+func NewPool(logger zerolog.Logger, httpClient *resty.Client, arcCfg chainmodels.ARCConfig) *Pool {
+	pool := &Pool{
+		logger: logger,
+		policy: arcCfg.BroadcastPolicy,
+	}
+
+	endpoints := append([]chainmodels.ARCEndpoint{{URL: arcCfg.URL, Token: arcCfg.Token}}, arcCfg.Endpoints...)
+	for _, e := range endpoints {
+		cfg := arcCfg
+		cfg.URL = e.URL
+		cfg.Token = e.Token
+		cfg.Endpoints = nil
+
+		pool.endpoints = append(pool.endpoints, &endpoint{
+			url:    e.URL,
+			client: arc.NewARCService(logger, httpClient, cfg),
+			health: newHealth(arcCfg.EndpointCooldown),
+		})
+	}
+
+	return pool
+}
+
+// Broadcast submits a transaction to the ARC endpoints according to the broadcast policy.
+func (p *Pool) Broadcast(ctx context.Context, tx *sdk.Transaction) (*chainmodels.TXInfo, error) {
+	if p.policy == chainmodels.ARCBroadcastPolicyFanOut && len(p.endpoints) > 1 {
+		return p.fanOutBroadcast(ctx, tx)
+	}
+	return p.failoverBroadcast(ctx, tx)
+}
+
+// QueryTransaction asks the ARC endpoints (healthy first) for a transaction until one of them knows it.
+func (p *Pool) QueryTransaction(ctx context.Context, txID string) (*chainmodels.TXInfo, error) {
+	var lastErr error
+	answered := false
+	for _, e := range p.ordered() {
+		result, err := e.client.QueryTransaction(ctx, txID)
+		if err != nil && p.handleFailure(ctx, e, err) {
+			lastErr = err
+			if ctx.Err() != nil {
+				break
+			}
+			continue
+		}
+		p.handleSuccess(e)
+		if err != nil || result != nil {
+			return result, err
+		}
+		answered = true
+	}
+	if answered {
+		return nil, nil // By convention, nil is returned when transaction is not found
+	}
+	return nil, lastErr
+}
+
+// GetPolicy returns the current policy from the first ARC endpoint able to provide it.
+func (p *Pool) GetPolicy(ctx context.Context) (*arc.Policy, error) {
+	var lastErr error
+	for _, e := range p.ordered() {
+		policy, err := e.client.GetPolicy(ctx)
+		if err != nil && p.handleFailure(ctx, e, err) {
+			lastErr = err
+			if ctx.Err() != nil {
+				break
+			}
+			continue
+		}
+		p.handleSuccess(e)
+		return policy, err
+	}
+	return nil, lastErr
+}
+
+func (p *Pool) failoverBroadcast(ctx context.Context, tx *sdk.Transaction) (*chainmodels.TXInfo, error) {
+	var lastErr error
+	for _, e := range p.ordered() {
+		result, err := e.client.Broadcast(ctx, tx)
+		if err != nil && p.handleFailure(ctx, e, err) {
+			lastErr = err
+			if ctx.Err() != nil {
+				break
+			}
+			continue
+		}
+		p.handleSuccess(e)
+		return result, err
+	}
+	return nil, lastErr
+}
+
+// BroadcastBatch submits transactions to the first healthy ARC endpoint in a single request,
+// falling back to the next ones when the endpoint fails. Batches are never fanned out.
+func (p *Pool) BroadcastBatch(ctx context.Context, txs []*sdk.Transaction) ([]chainmodels.BroadcastResult, error) {
+	var lastErr error
+	for _, e := range p.ordered() {
+		results, err := e.client.BroadcastBatch(ctx, txs)
+		if err != nil && p.handleFailure(ctx, e, err) {
+			lastErr = err
+			if ctx.Err() != nil {
+				break
+			}
+			continue
+		}
+		p.handleSuccess(e)
+		return results, err
+	}
+	return nil, lastErr
+}
+
+type broadcastResult struct {
+	info *chainmodels.TXInfo
+	err  error
+}
+
+func (p *Pool) fanOutBroadcast(ctx context.Context, tx *sdk.Transaction) (*chainmodels.TXInfo, error) {
+	endpoints := p.ordered()
+	results := make(chan broadcastResult, len(endpoints))
+
+	for _, e := range endpoints {
+		// ARC service may hydrate the transaction (e.g. for EF conversion), so each endpoint gets its own copy
+		// and the transaction of the caller is never modified concurrently.
+		endpointTx := cloneWithAncestors(tx)
+		go func() {
+			result, err := e.client.Broadcast(ctx, endpointTx)
+			if err != nil && p.handleFailure(ctx, e, err) {
+				results <- broadcastResult{err: err}
+				return
+			}
+			p.handleSuccess(e)
+			results <- broadcastResult{info: result, err: err}
+		}()
+	}
+
+	var txErr, endpointErr error
+	for range endpoints {
+		result := <-results
+		if result.err == nil {
+			return result.info, nil
+		}
+		if isEndpointFailure(result.err) {
+			endpointErr = result.err
+		} else {
+			txErr = result.err
+		}
+	}
+
+	// error related to the transaction itself is more meaningful than the one about unavailable endpoint
+	if txErr != nil {
+		return nil, txErr
+	}
+	return nil, endpointErr
+}
+
+// cloneWithAncestors copies the transaction together with its source transactions.
+// Unlike sdk.Transaction.Clone, it keeps the merkle paths of the ancestors, so the copy can still be broadcast in BEEF.
+// The scripts and merkle paths are shared with the original - they are only read while broadcasting.
+func cloneWithAncestors(tx *sdk.Transaction) *sdk.Transaction {
+	clone := tx.ShallowClone()
+	clone.MerklePath = tx.MerklePath
+	for i, input := range tx.Inputs {
+		if input.SourceTransaction != nil {
+			clone.Inputs[i].SourceTransaction = cloneWithAncestors(input.SourceTransaction)
+		}
+	}
+	return clone
+}
+
+// ordered returns the endpoints in configured order, with the unhealthy ones moved to the end.
+func (p *Pool) ordered() []*endpoint {
+	healthy := make([]*endpoint, 0, len(p.endpoints))
+	var unhealthy []*endpoint
+	for _, e := range p.endpoints {
+		if e.health.isHealthy() {
+			healthy = append(healthy, e)
+		} else {
+			unhealthy = append(unhealthy, e)
+		}
+	}
+	return append(healthy, unhealthy...)
+}
+
+// handleFailure records the failure of the endpoint and returns true if the error was caused by the endpoint itself
+// (so the next endpoint should be tried), and false if the error is related to the request (e.g. rejected transaction).
+func (p *Pool) handleFailure(ctx context.Context, e *endpoint, err error) bool {
+	if !isEndpointFailure(err) {
+		return false
+	}
+	if ctx.Err() != nil {
+		// the caller gave up - it doesn't tell anything about the endpoint's health
+		return true
+	}
+
+	failures := e.health.recordFailure()
+	if len(p.endpoints) > 1 {
+		p.logger.Warn().Err(err).Str("arcURL", e.url).Int("failures", failures).Msg("ARC endpoint failed")
+	}
+	return true
+}
+
+func (p *Pool) handleSuccess(e *endpoint) {
+	if recoveredFrom := e.health.recordSuccess(); recoveredFrom > 0 && len(p.endpoints) > 1 {
+		p.logger.Info().Str("arcURL", e.url).Int("failures", recoveredFrom).Msg("ARC endpoint recovered")
+	}
+}
+
+func isEndpointFailure(err error) bool {
+	return errors.Is(err, chainerrors.ErrARCUnreachable) ||
+		errors.Is(err, chainerrors.ErrARCUnauthorized) ||
+		errors.Is(err, chainerrors.ErrARCUnsupportedStatusCode) ||
+		errors.Is(err, spverrors.ErrInternal)
+}
@@ -0,0 +1,76 @@
+package arcpool
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/internal/arc"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester"
+	"github.com/stretchr/testify/require"
+)
+
+// Run with -race to detect concurrent modifications of the broadcasted transaction.
+func TestFanOutBroadcastDoesNotModifyTransactionOfCaller(t *testing.T) {
+	// given:
+	var wg sync.WaitGroup
+	pool := &Pool{
+		logger: tester.Logger(t),
+		policy: chainmodels.ARCBroadcastPolicyFanOut,
+	}
+	for range 3 {
+		wg.Add(1)
+		pool.endpoints = append(pool.endpoints, &endpoint{
+			client: &hydratingClient{wg: &wg},
+			health: newHealth(0),
+		})
+	}
+
+	// and:
+	tx := sdk.NewTransaction()
+	tx.AddInput(&sdk.TransactionInput{SourceTXID: sdk.NewTransaction().TxID()})
+
+	// when:
+	txInfo, err := pool.Broadcast(context.Background(), tx)
+
+	// then:
+	require.NoError(t, err)
+	require.NotNil(t, txInfo)
+
+	// and:
+	for _, input := range tx.Inputs {
+		// the caller can still use the transaction while the other endpoints are broadcasting it
+		require.Nil(t, input.SourceTransaction)
+	}
+	wg.Wait()
+	for _, input := range tx.Inputs {
+		require.Nil(t, input.SourceTransaction)
+	}
+}
+
+// hydratingClient sets the source transactions of the inputs like the EF conversion does.
+type hydratingClient struct {
+	wg *sync.WaitGroup
+}
+
+func (c *hydratingClient) Broadcast(_ context.Context, tx *sdk.Transaction) (*chainmodels.TXInfo, error) {
+	defer c.wg.Done()
+	for _, input := range tx.Inputs {
+		input.SourceTransaction = sdk.NewTransaction()
+	}
+	return &chainmodels.TXInfo{TxID: tx.TxID().String(), TXStatus: chainmodels.SeenOnNetwork}, nil
+}
+
+func (c *hydratingClient) BroadcastBatch(_ context.Context, _ []*sdk.Transaction) ([]chainmodels.BroadcastResult, error) {
+	return nil, nil
+}
+
+func (c *hydratingClient) QueryTransaction(_ context.Context, _ string) (*chainmodels.TXInfo, error) {
+	return nil, nil
+}
+
+func (c *hydratingClient) GetPolicy(_ context.Context) (*arc.Policy, error) {
+	return nil, nil
+}
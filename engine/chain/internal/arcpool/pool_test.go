@@ -0,0 +1,252 @@
+package arcpool_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester"
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+	"github.com/rs/zerolog"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	primaryURL   = "https://arc.primary.example.com"
+	secondaryURL = "https://arc.secondary.example.com"
+
+	rawTxHex = "010000000116d60a1563239eac2295b4eecbc6982ff6d007f480e52505c78f803bc8e03a05010000006a473044022024f84674219f2ec2fb78d38bcd19d4ae5b44dd45474d7680d56662a56b127326022025590d4aec95942b0eb6d52e679e4c98939d7a72b5901fae46354552af42cdeb412103ec9a56e27b5b773459c7cef92683a0498da7073346728a724d1878a9d7ce9615ffffffff0201000000000000001976a9149eb8198a2f08551afc193663a0dd80a9ed2f3c1288ac10000000000000001976a914098d21f508a39588d31dd746757c83b7d790cccc88ac00000000"
+	txID     = "305df8d8efdf5a7effe3f91ea766f2ccd3579e61555a2b4c4b9561d8f156aff7"
+
+	// beefV1HexPrefix is the hex encoded BEEF V1 version marker that starts every BEEF transaction
+	beefV1HexPrefix = "0100beef"
+)
+
+var (
+	seenOnNetwork = fmt.Sprintf(`{"txStatus": "SEEN_ON_NETWORK", "txid": "%s"}`, txID)
+	mined         = fmt.Sprintf(`{"txStatus": "MINED", "blockHeight": 862510, "txid": "%s"}`, txID)
+	notFound      = `{"status": 404, "title": "Not found", "extraInfo": "transaction not found"}`
+	feeTooLow     = `{"status": 465, "title": "Fee too low", "extraInfo": "fee too low"}`
+)
+
+func TestFailoverBroadcast(t *testing.T) {
+	t.Run("broadcast to secondary endpoint when primary is unreachable", func(t *testing.T) {
+		// given:
+		transport, httpClient := mockTransport()
+		transport.RegisterResponder("POST", primaryURL+"/v1/tx", httpmock.NewErrorResponder(fmt.Errorf("connection refused")))
+		transport.RegisterResponder("POST", secondaryURL+"/v1/tx", jsonResponder(http.StatusOK, seenOnNetwork))
+
+		// and:
+		service := chain.NewChainService(tester.Logger(t), httpClient, arcCfg(chainmodels.ARCBroadcastPolicyFailover), chainmodels.BHSConfig{})
+
+		// when:
+		txInfo, err := service.Broadcast(context.Background(), fromHex(t, rawTxHex))
+
+		// then:
+		require.NoError(t, err)
+		require.Equal(t, txID, txInfo.TxID)
+		require.Equal(t, chainmodels.SeenOnNetwork, txInfo.TXStatus)
+
+		// when:
+		_, err = service.Broadcast(context.Background(), fromHex(t, rawTxHex))
+
+		// then:
+		require.NoError(t, err)
+
+		// and:
+		calls := transport.GetCallCountInfo()
+		require.Equal(t, 1, calls["POST "+primaryURL+"/v1/tx"], "unhealthy primary endpoint should be tried after the healthy ones")
+		require.Equal(t, 2, calls["POST "+secondaryURL+"/v1/tx"])
+	})
+
+	t.Run("do not fail over when transaction is rejected", func(t *testing.T) {
+		// given:
+		transport, httpClient := mockTransport()
+		transport.RegisterResponder("POST", primaryURL+"/v1/tx", jsonResponder(465, feeTooLow))
+		transport.RegisterResponder("POST", secondaryURL+"/v1/tx", jsonResponder(http.StatusOK, seenOnNetwork))
+
+		// and:
+		service := chain.NewChainService(tester.Logger(t), httpClient, arcCfg(chainmodels.ARCBroadcastPolicyFailover), chainmodels.BHSConfig{})
+
+		// when:
+		txInfo, err := service.Broadcast(context.Background(), fromHex(t, rawTxHex))
+
+		// then:
+		require.ErrorIs(t, err, chainerrors.ErrARCWrongFee)
+		require.Nil(t, txInfo)
+
+		// and:
+		require.Equal(t, 0, transport.GetCallCountInfo()["POST "+secondaryURL+"/v1/tx"])
+	})
+
+	t.Run("return last error when all endpoints are unreachable", func(t *testing.T) {
+		// given:
+		transport, httpClient := mockTransport()
+		transport.RegisterResponder("POST", primaryURL+"/v1/tx", httpmock.NewErrorResponder(fmt.Errorf("connection refused")))
+		transport.RegisterResponder("POST", secondaryURL+"/v1/tx", httpmock.NewErrorResponder(fmt.Errorf("connection refused")))
+
+		// and:
+		service := chain.NewChainService(tester.Logger(t), httpClient, arcCfg(chainmodels.ARCBroadcastPolicyFailover), chainmodels.BHSConfig{})
+
+		// when:
+		txInfo, err := service.Broadcast(context.Background(), fromHex(t, rawTxHex))
+
+		// then:
+		require.ErrorIs(t, err, chainerrors.ErrARCUnreachable)
+		require.Nil(t, txInfo)
+	})
+}
+
+func TestFanOutBroadcast(t *testing.T) {
+	t.Run("return first success", func(t *testing.T) {
+		// given:
+		transport, httpClient := mockTransport()
+		transport.RegisterResponder("POST", primaryURL+"/v1/tx", func(req *http.Request) (*http.Response, error) {
+			time.Sleep(50 * time.Millisecond)
+			return nil, fmt.Errorf("connection refused")
+		})
+		transport.RegisterResponder("POST", secondaryURL+"/v1/tx", jsonResponder(http.StatusOK, seenOnNetwork))
+
+		// and:
+		// NOTE: the slower endpoint finishes after the test, so the test logger cannot be used
+		service := chain.NewChainService(zerolog.Nop(), httpClient, arcCfg(chainmodels.ARCBroadcastPolicyFanOut), chainmodels.BHSConfig{})
+
+		// when:
+		txInfo, err := service.Broadcast(context.Background(), fromHex(t, rawTxHex))
+
+		// then:
+		require.NoError(t, err)
+		require.Equal(t, txID, txInfo.TxID)
+	})
+
+	t.Run("prefer transaction related error over unreachable endpoint", func(t *testing.T) {
+		// given:
+		transport, httpClient := mockTransport()
+		transport.RegisterResponder("POST", primaryURL+"/v1/tx", jsonResponder(465, feeTooLow))
+		transport.RegisterResponder("POST", secondaryURL+"/v1/tx", httpmock.NewErrorResponder(fmt.Errorf("connection refused")))
+
+		// and:
+		service := chain.NewChainService(tester.Logger(t), httpClient, arcCfg(chainmodels.ARCBroadcastPolicyFanOut), chainmodels.BHSConfig{})
+
+		// when:
+		txInfo, err := service.Broadcast(context.Background(), fromHex(t, rawTxHex))
+
+		// then:
+		require.ErrorIs(t, err, chainerrors.ErrARCWrongFee)
+		require.Nil(t, txInfo)
+	})
+
+	t.Run("broadcast fully sourced transaction in BEEF to every endpoint", func(t *testing.T) {
+		// given:
+		transport, httpClient := mockTransport()
+		for _, url := range []string{primaryURL, secondaryURL} {
+			transport.RegisterMatcherResponder("POST", url+"/v1/tx",
+				httpmock.BodyContainsString(beefV1HexPrefix),
+				jsonResponder(http.StatusOK, seenOnNetwork),
+			)
+		}
+
+		// and:
+		cfg := arcCfg(chainmodels.ARCBroadcastPolicyFanOut)
+		cfg.BroadcastBEEF = true
+		service := chain.NewChainService(tester.Logger(t), httpClient, cfg, chainmodels.BHSConfig{})
+
+		// when:
+		txInfo, err := service.Broadcast(context.Background(), fullySourcedTx(t))
+
+		// then:
+		require.NoError(t, err)
+		require.Equal(t, txID, txInfo.TxID)
+	})
+}
+
+func TestQueryTransactionFallback(t *testing.T) {
+	t.Run("query next endpoint when transaction is unknown to the primary one", func(t *testing.T) {
+		// given:
+		transport, httpClient := mockTransport()
+		transport.RegisterResponder("GET", primaryURL+"/v1/tx/"+txID, jsonResponder(http.StatusNotFound, notFound))
+		transport.RegisterResponder("GET", secondaryURL+"/v1/tx/"+txID, jsonResponder(http.StatusOK, mined))
+
+		// and:
+		service := chain.NewChainService(tester.Logger(t), httpClient, arcCfg(chainmodels.ARCBroadcastPolicyFailover), chainmodels.BHSConfig{})
+
+		// when:
+		txInfo, err := service.QueryTransaction(context.Background(), txID)
+
+		// then:
+		require.NoError(t, err)
+		require.Equal(t, chainmodels.Mined, txInfo.TXStatus)
+	})
+
+	t.Run("return nil when no endpoint knows the transaction", func(t *testing.T) {
+		// given:
+		transport, httpClient := mockTransport()
+		transport.RegisterResponder("GET", primaryURL+"/v1/tx/"+txID, httpmock.NewErrorResponder(fmt.Errorf("connection refused")))
+		transport.RegisterResponder("GET", secondaryURL+"/v1/tx/"+txID, jsonResponder(http.StatusNotFound, notFound))
+
+		// and:
+		service := chain.NewChainService(tester.Logger(t), httpClient, arcCfg(chainmodels.ARCBroadcastPolicyFailover), chainmodels.BHSConfig{})
+
+		// when:
+		txInfo, err := service.QueryTransaction(context.Background(), txID)
+
+		// then:
+		require.NoError(t, err)
+		require.Nil(t, txInfo)
+	})
+}
+
+func mockTransport() (*httpmock.MockTransport, *resty.Client) {
+	transport := httpmock.NewMockTransport()
+	client := resty.New()
+	client.GetClient().Transport = transport
+	return transport, client
+}
+
+func jsonResponder(status int, content string) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		res := httpmock.NewStringResponse(status, content)
+		res.Header.Set("Content-Type", "application/json")
+		return res, nil
+	}
+}
+
+func arcCfg(policy chainmodels.ARCBroadcastPolicy) chainmodels.ARCConfig {
+	return chainmodels.ARCConfig{
+		URL:             primaryURL,
+		DeploymentID:    "spv-wallet-test-arc-pool",
+		Endpoints:       []chainmodels.ARCEndpoint{{URL: secondaryURL}},
+		BroadcastPolicy: policy,
+	}
+}
+
+// fullySourcedTx returns a transaction spending the rawTxHex transaction marked as mined, so it can be broadcast in BEEF.
+func fullySourcedTx(t *testing.T) *sdk.Transaction {
+	t.Helper()
+	source := fromHex(t, rawTxHex)
+	source.MerklePath = sdk.NewMerklePath(862510, [][]*sdk.PathElement{{
+		{Offset: 0, Hash: source.TxID(), Txid: lo.ToPtr(true)},
+		{Offset: 1, Duplicate: lo.ToPtr(true)},
+	}})
+
+	tx := sdk.NewTransaction()
+	err := tx.AddInputFrom(source.TxID().String(), 0, source.Outputs[0].LockingScript.String(), source.Outputs[0].Satoshis, nil)
+	require.NoError(t, err)
+	tx.Inputs[0].SourceTransaction = source
+	tx.AddOutput(&sdk.TransactionOutput{Satoshis: 1, LockingScript: source.Outputs[0].LockingScript})
+	return tx
+}
+
+func fromHex(t *testing.T, hex string) *sdk.Transaction {
+	tx, err := sdk.NewTransactionFromHex(hex)
+	require.NoError(t, err)
+	return tx
+}
@@ -0,0 +1,84 @@
+package beefarchive
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"iter"
+	"os"
+	"path/filepath"
+
+	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	chainerrors "github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/rs/zerolog"
+)
+
+const fileExtension = ".beef"
+
+// Archive reads transactions from a local directory of BEEF files.
+// Every file is named by the ID of the transaction it contains (<txid>.beef) and holds BEEF either in binary or hex form.
+type Archive struct {
+	logger zerolog.Logger
+	dir    string
+}
+
+// NewArchive creates a new BEEF archive reading from the given directory.
+func NewArchive(logger zerolog.Logger, dir string) *Archive {
+	return &Archive{
+		logger: logger,
+		dir:    dir,
+	}
+}
+
+// GetTransactions implements chainmodels.TransactionsGetter interface to allow reading transactions from the archive
+func (a *Archive) GetTransactions(ctx context.Context, ids iter.Seq[string]) ([]*sdk.Transaction, error) {
+	var transactions []*sdk.Transaction
+	for id := range ids {
+		if err := ctx.Err(); err != nil {
+			return nil, spverrors.ErrCtxInterrupted.Wrap(err)
+		}
+		tx, err := a.readTransaction(id)
+		if err != nil {
+			return nil, err
+		}
+		if tx != nil {
+			transactions = append(transactions, tx)
+		}
+	}
+	return transactions, nil
+}
+
+func (a *Archive) readTransaction(txID string) (*sdk.Transaction, error) {
+	if _, err := hex.DecodeString(txID); err != nil || len(txID) != 64 {
+		// not a transaction ID, so it can't be a name of a file in the archive
+		return nil, nil
+	}
+
+	content, err := os.ReadFile(filepath.Join(a.dir, txID+fileExtension))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, chainerrors.ErrBEEFArchiveFailure.Wrap(err)
+	}
+
+	beef := bytes.TrimSpace(content)
+	if decoded, err := hex.DecodeString(string(beef)); err == nil {
+		beef = decoded
+	} else {
+		beef = content
+	}
+
+	tx, err := sdk.NewTransactionFromBEEF(beef)
+	if err != nil {
+		return nil, chainerrors.ErrBEEFArchiveFailure.Wrap(spverrors.Wrapf(err, "invalid BEEF in archive for transaction %s", txID))
+	}
+	if tx.TxID().String() != txID {
+		a.logger.Warn().Str("txID", txID).Str("beefTxID", tx.TxID().String()).Msg("BEEF archive file contains other transaction, skipping it")
+		return nil, nil
+	}
+	return tx, nil
+}
@@ -0,0 +1,73 @@
+package beefarchive_test
+
+import (
+	"context"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	chainerrors "github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/internal/beefarchive"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester"
+	"github.com/stretchr/testify/require"
+)
+
+const beefHex = "0100beef02fea6790c000f02fda82c000703145efd05fec7c2edc1827ec685755a2d05208486e9bc2461268e0f5e533bfda92c02cb3553424ffc94b59a60fb358b6cb6dfb694aee894dcd1effc0ed0a9052464e301fd551600b7b53a09331453b5966589dc473b45c87823f109417593a82ff8ffe7ddc6f96e01fd2b0b0080085c6f18f35d5f0a231eda36c1be3f031734bb6cb6987978ef2aad007ea6da01fd940500d26d24b44097ed3f0c2927413dd2f1fb78bba948803abdb7f2fb51d9807a77bc01fdcb0200bad82dfd55455709713ea8390a7c76be5c076da9cd487b75f558728ef8572b7601fd64010022553e159788764f6b3c1e27324f99a13abee9c7069ce34b8a4fcabc45b7aabb01b300debfa516f54f4331ddf74067403d8a70915973e8300c298ff8dbe5bdcc94768101580079a933dbaeaee5dfb6af1ce9bd7a9ef40584e1844a938e398d19f94aba525bed012d005287b1c986e6495d00c103082618d9e0a30c0c620fd2d232eb9c31d59dec510e01170074639f74ebdaaac679e8fed504ae62a6f42c633a549a99e21940aff14e69ab08010a008eb3fde752d9e5c8e67c26daa95f8b9480ec11e608de7afae04eb19775f42342010400eea8e24927e93a0cf9ca50b315e48efca1f0b77643f7110c6efd94220dcedab6010300682c5f78d5f89e5e5d13619f543aacb00d1e4e85043f4d453bd6f6eb14755e790100006df75ca701801279bcb9b91579ac74b2131913ba35a0c3cb313c49062c8f453f0101009fe83bf3febfc2af4641b9bc8c1f76ff9b2ae7de9f4ab5374e672ac70cf8b9b9fec27f0c000b02fd8802000e40280d05fedfd66af3edb59a94f0512e804093f855c025b9d964ca23ca1b12fd890202624fbcb4e68d162361f456b8b4fef6b9e7943013088b32b6bca7f5ced41ff00401fd45010090f9751ef8c4daa8a15d0cc75d1a89e7fad8b8ba258f36da56fbb8faef725b1e01a3002863542b3fe0a1a8fdd6711f7b9af6d9fa2986bf6c67460e5e7fc544a3fa7ec90150003a0b1e497aae08b126ec790f9214517e32cff1a57cf3abe92e9580f634f369500129006d6f372a6b54acb13e4c0ce2b6ff53120685ac23bf8e1953f8358b87ebf90ca4011500c310dbdea5f87b557964e09c67926b6d756c9ea821948e36298544bf761bf9b5010b00a267e36fe2eef4ce3582e359d6b0b93df7d76afd00e19d4abff77d7cf2acf6db01040080c16fd797c5b4f8463e5bffc6ffacd7dae409c9908b4748ff28dabd8bbe8fe0010300b7e09ca039e8b8052f179d5f2c3d1d6e98bb983470784d62f03438621effe80a010000cf5b6da719ca8b752c50b5b9b4d7659cc5aaed7fe429d96d2270617414fb551f010100dc01860ec79aac9c4465b6afb0b0641bbf0b1c8a1c23bf7b920a1b662366ab40030100000001a114c7deb8deba851d87755aa10aa18c97bd77afee4e1bad01d1c50e07a644eb010000006a473044022041abd4f93bd1db1d0097f2d467ae183801d7842d23d0605fa9568040d245167402201be66c96bef4d6d051304f6df2aecbdfe23a8a05af0908ef2117ab5388d8903c412103c08545a40c819f6e50892e31e792d221b6df6da96ebdba9b6fe39305cc6cc768ffffffff0263040000000000001976a91454097d9d921f9a1f55084a943571d868552e924f88acb22a0000000000001976a914c36b3fca5159231033f3fbdca1cde942096d379f88ac0000000001010100000001cfc39e3adcd58ed58cf590079dc61c3eb6ec739abb7d22b592fb969d427f33ee000000006a4730440220253e674e64028459457d55b444f5f3dc15c658425e3184c628016739e4921fd502207c8fe20eb34e55e4115fbd82c23878b4e54f01f6c6ad0811282dd0b1df863b5e41210310a4366fd997127ad972b14c56ca2e18f39ca631ac9e3e4ad3d9827865d0cc70ffffffff0264000000000000001976a914668a92ff9cb5785eb8fc044771837a0818b028b588acdc4e0000000000001976a914b073264927a61cf84327dea77414df6c28b11e5988ac0000000001000100000002cb3553424ffc94b59a60fb358b6cb6dfb694aee894dcd1effc0ed0a9052464e3000000006a4730440220515c3bf93d38fa7cc164746fae4bec8b66c60a82509eb553751afa5971c3e41d0220321517fd5c997ab5f8ef0e59048ce9157de46f92b10d882bf898e62f3ee7343d4121038f1273fcb299405d8d140b4de9a2111ecb39291b2846660ebecd864d13bee575ffffffff624fbcb4e68d162361f456b8b4fef6b9e7943013088b32b6bca7f5ced41ff004010000006a47304402203fb24f6e00a6487cf88a3b39d8454786db63d649142ea76374c2f55990777e6302207fbb903d038cf43e13ffb496a64f36637ec7323e5ac48bb96bdb4a885100abca4121024b003d3cf49a8f48c1fe79b711b1d08e306c42a0ab8da004d97fccc4ced3343affffffff026f000000000000001976a914f232d38cd4c2f87c117af06542b04a7061b6640188aca62a0000000000001976a9146058e52d00e3b94211939f68cc2d9a3fc1e3db0f88ac0000000000"
+
+func TestArchiveGetTransactions(t *testing.T) {
+	expectedTx, err := sdk.NewTransactionFromBEEFHex(beefHex)
+	require.NoError(t, err)
+	txID := expectedTx.TxID().String()
+
+	beefBinary, err := hex.DecodeString(beefHex)
+	require.NoError(t, err)
+
+	tests := map[string][]byte{
+		"BEEF in binary form": beefBinary,
+		"BEEF in hex form":    []byte(beefHex + "\n"),
+	}
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given:
+			dir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(dir, txID+".beef"), content, 0o600))
+			archive := beefarchive.NewArchive(tester.Logger(t), dir)
+
+			// when:
+			txs, err := archive.GetTransactions(context.Background(), slices.Values([]string{txID}))
+
+			// then:
+			require.NoError(t, err)
+			require.Len(t, txs, 1)
+			require.Equal(t, expectedTx.Hex(), txs[0].Hex())
+		})
+	}
+
+	t.Run("skip transactions missing in the archive", func(t *testing.T) {
+		// given:
+		archive := beefarchive.NewArchive(tester.Logger(t), t.TempDir())
+
+		// when:
+		txs, err := archive.GetTransactions(context.Background(), slices.Values([]string{txID, "../not-a-txid"}))
+
+		// then:
+		require.NoError(t, err)
+		require.Empty(t, txs)
+	})
+
+	t.Run("invalid BEEF in the archive", func(t *testing.T) {
+		// given:
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, txID+".beef"), []byte("not a beef"), 0o600))
+		archive := beefarchive.NewArchive(tester.Logger(t), dir)
+
+		// when:
+		_, err := archive.GetTransactions(context.Background(), slices.Values([]string{txID}))
+
+		// then:
+		require.ErrorIs(t, err, chainerrors.ErrBEEFArchiveFailure)
+	})
+}
@@ -14,12 +14,12 @@ import (
 
 // VerifyMerkleRoots verifies the merkle roots of the given transactions using BHS request
 func (s *Service) VerifyMerkleRoots(ctx context.Context, merkleRoots []*spv.MerkleRootConfirmationRequestItem) (valid bool, err error) {
-	confirmations, err := s.makeVerifyMerkleRootsRequest(ctx, merkleRoots)
+	state, err := s.ConfirmMerkleRoots(ctx, merkleRoots)
 	if err != nil {
 		return false, err
 	}
 
-	switch confirmations.ConfirmationState {
+	switch state {
 	case chainmodels.MRConfirmed:
 		return true, nil
 	case chainmodels.MRUnableToVerify:
@@ -32,6 +32,15 @@ func (s *Service) VerifyMerkleRoots(ctx context.Context, merkleRoots []*spv.Merk
 	}
 }
 
+// ConfirmMerkleRoots returns the state of merkle roots confirmation made by BHS
+func (s *Service) ConfirmMerkleRoots(ctx context.Context, merkleRoots []*spv.MerkleRootConfirmationRequestItem) (chainmodels.MerkleRootConfirmationState, error) {
+	confirmations, err := s.makeVerifyMerkleRootsRequest(ctx, merkleRoots)
+	if err != nil {
+		return "", err
+	}
+	return confirmations.ConfirmationState, nil
+}
+
 func (s *Service) makeVerifyMerkleRootsRequest(ctx context.Context, merkleRoots []*spv.MerkleRootConfirmationRequestItem) (*chainmodels.MerkleRootsConfirmations, error) {
 	if len(merkleRoots) == 0 {
 		return nil, chainerrors.ErrBHSBadRequest.Wrap(spverrors.Newf("at least one merkleroot is required"))
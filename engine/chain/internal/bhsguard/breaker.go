@@ -0,0 +1,83 @@
+package bhsguard
+
+import (
+	"sync"
+	"time"
+)
+
+const defaultOpenTimeout = 30 * time.Second
+
+// breaker opens the circuit after a number of consecutive failures of BHS.
+// After the open timeout, a single trial request is let through - its success closes the circuit.
+type breaker struct {
+	mu          sync.Mutex
+	threshold   int
+	openTimeout time.Duration
+	failures    int
+	openUntil   time.Time
+	trial       bool
+}
+
+func newBreaker(threshold int, openTimeout time.Duration) *breaker {
+	if threshold <= 0 {
+		return nil
+	}
+	if openTimeout <= 0 {
+		openTimeout = defaultOpenTimeout
+	}
+	return &breaker{threshold: threshold, openTimeout: openTimeout}
+}
+
+// allow reports if a request can be sent to BHS.
+func (b *breaker) allow() bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < b.threshold {
+		return true
+	}
+	if b.trial || time.Now().Before(b.openUntil) {
+		return false
+	}
+	b.trial = true
+	return true
+}
+
+func (b *breaker) recordSuccess() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures = 0
+	b.trial = false
+}
+
+// recordFailure returns true when the failure opened the circuit.
+func (b *breaker) recordFailure() bool {
+	if b == nil {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures++
+	b.trial = false
+	if b.failures < b.threshold {
+		return false
+	}
+	b.openUntil = time.Now().Add(b.openTimeout)
+	return true
+}
+
+// release ends the trial request without a result (e.g. when it was canceled by the caller).
+func (b *breaker) release() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.trial = false
+}
@@ -0,0 +1,53 @@
+package bhsguard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bitcoin-sv/go-paymail/spv"
+	"github.com/mrz1836/go-cachestore"
+)
+
+const cacheKeyMerkleRootPrefix = "bhs-merkleroot-"
+
+// uncached returns the merkle roots which are not confirmed in the cache.
+func (g *Guard) uncached(ctx context.Context, merkleRoots []*spv.MerkleRootConfirmationRequestItem) []*spv.MerkleRootConfirmationRequestItem {
+	if g.cache == nil {
+		return merkleRoots
+	}
+
+	var result []*spv.MerkleRootConfirmationRequestItem
+	for _, item := range merkleRoots {
+		var cached string
+		err := g.cache.GetModel(ctx, cacheKey(item.BlockHeight), &cached)
+		if err != nil && !errors.Is(err, cachestore.ErrKeyNotFound) {
+			g.logger.Warn().Err(err).Uint64("height", item.BlockHeight).Msg("Failed to get merkle root from cache")
+		}
+		// a different cached merkle root may come from a block which was later reorganized, so BHS is asked again
+		if err != nil || cached != item.MerkleRoot {
+			result = append(result, item)
+		}
+	}
+
+	if g.metrics != nil {
+		g.metrics.IncMerkleRootsCacheHits(len(merkleRoots) - len(result))
+		g.metrics.IncMerkleRootsCacheMisses(len(result))
+	}
+	return result
+}
+
+func (g *Guard) putInCache(ctx context.Context, merkleRoots []*spv.MerkleRootConfirmationRequestItem) {
+	if g.cache == nil {
+		return
+	}
+	for _, item := range merkleRoots {
+		if err := g.cache.SetModel(ctx, cacheKey(item.BlockHeight), item.MerkleRoot, g.cacheTTL); err != nil {
+			g.logger.Warn().Err(err).Uint64("height", item.BlockHeight).Msg("Failed to store merkle root in cache")
+		}
+	}
+}
+
+func cacheKey(height uint64) string {
+	return fmt.Sprintf("%s%d", cacheKeyMerkleRootPrefix, height)
+}
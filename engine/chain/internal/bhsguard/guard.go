@@ -0,0 +1,192 @@
+package bhsguard
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"time"
+
+	"github.com/bitcoin-sv/go-paymail/spv"
+	chainerrors "github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/models"
+	"github.com/rs/zerolog"
+)
+
+const defaultCacheTTL = 24 * time.Hour
+
+// BHSClient is the Block Headers Service client guarded by the Guard.
+type BHSClient interface {
+	GetMerkleRoots(ctx context.Context, query url.Values) (*models.MerkleRootsBHSResponse, error)
+	ConfirmMerkleRoots(ctx context.Context, merkleRoots []*spv.MerkleRootConfirmationRequestItem) (chainmodels.MerkleRootConfirmationState, error)
+	HealthcheckBHS(ctx context.Context) error
+}
+
+// Guard wraps BHS with a cache of confirmed merkle roots and a circuit breaker which fails fast when BHS is down.
+type Guard struct {
+	logger   zerolog.Logger
+	bhs      BHSClient
+	cache    chainmodels.MerkleRootsCache
+	cacheTTL time.Duration
+	breaker  *breaker
+	whenDown chainmodels.BHSDownPolicy
+	metrics  chainmodels.MerkleRootsMetrics
+}
+
+// NewGuard creates a new guard of the BHS client.
+func NewGuard(logger zerolog.Logger, bhs BHSClient, cfg chainmodels.BHSConfig) *Guard {
+	g := &Guard{
+		logger:   logger,
+		bhs:      bhs,
+		whenDown: chainmodels.BHSDownReject,
+		metrics:  cfg.Metrics,
+	}
+
+	if cfg.Cache != nil && cfg.Cache.Store != nil {
+		g.cache = cfg.Cache.Store
+		g.cacheTTL = cfg.Cache.TTL
+		if g.cacheTTL <= 0 {
+			g.cacheTTL = defaultCacheTTL
+		}
+	}
+
+	if cfg.CircuitBreaker != nil {
+		g.breaker = newBreaker(cfg.CircuitBreaker.FailureThreshold, cfg.CircuitBreaker.OpenTimeout)
+		if cfg.CircuitBreaker.WhenDown != "" {
+			g.whenDown = cfg.CircuitBreaker.WhenDown
+		}
+	}
+
+	return g
+}
+
+// GetMerkleRoots returns Merkle Roots from Block Header Service unless the circuit is open
+func (g *Guard) GetMerkleRoots(ctx context.Context, query url.Values) (*models.MerkleRootsBHSResponse, error) {
+	if !g.breaker.allow() {
+		return nil, chainerrors.ErrBHSCircuitOpen
+	}
+	res, err := g.bhs.GetMerkleRoots(ctx, query)
+	g.recordResult(ctx, err)
+	return res, err
+}
+
+// HealthcheckBHS checks the health of the Block Headers Service (BHS) - it's never blocked by the circuit breaker.
+func (g *Guard) HealthcheckBHS(ctx context.Context) error {
+	return g.bhs.HealthcheckBHS(ctx)
+}
+
+// VerifyMerkleRoots verifies the merkle roots which are not confirmed in the cache yet using BHS.
+// Merkle roots which cannot be verified are never reported as valid - with the queue policy, ErrMerkleRootsDeferred is returned instead.
+func (g *Guard) VerifyMerkleRoots(ctx context.Context, merkleRoots []*spv.MerkleRootConfirmationRequestItem) (bool, error) {
+	if len(merkleRoots) == 0 {
+		return g.stateToValid(g.confirm(ctx, merkleRoots))
+	}
+
+	toConfirm := g.uncached(ctx, merkleRoots)
+	if len(toConfirm) == 0 {
+		return true, nil
+	}
+
+	if !g.breaker.allow() {
+		return g.handleBHSDown(toConfirm, chainerrors.ErrBHSCircuitOpen)
+	}
+
+	state, err := g.confirm(ctx, toConfirm)
+	if err != nil && isOutage(ctx, err) {
+		return g.handleBHSDown(toConfirm, err)
+	}
+	if state == chainmodels.MRConfirmed {
+		g.putInCache(ctx, toConfirm)
+	}
+	return g.stateToValid(state, err)
+}
+
+// ConfirmMerkleRoots returns the confirmation state of the merkle roots using the cache and BHS.
+// The outage of BHS is returned as an error, regardless of the policy used while BHS is down.
+func (g *Guard) ConfirmMerkleRoots(ctx context.Context, merkleRoots []*spv.MerkleRootConfirmationRequestItem) (chainmodels.MerkleRootConfirmationState, error) {
+	toConfirm := merkleRoots
+	if len(merkleRoots) > 0 {
+		toConfirm = g.uncached(ctx, merkleRoots)
+		if len(toConfirm) == 0 {
+			return chainmodels.MRConfirmed, nil
+		}
+	}
+
+	if !g.breaker.allow() {
+		return "", chainerrors.ErrBHSCircuitOpen
+	}
+
+	state, err := g.confirm(ctx, toConfirm)
+	if err != nil {
+		return "", err
+	}
+	if state == chainmodels.MRConfirmed {
+		g.putInCache(ctx, toConfirm)
+	}
+	return state, nil
+}
+
+func (g *Guard) confirm(ctx context.Context, merkleRoots []*spv.MerkleRootConfirmationRequestItem) (chainmodels.MerkleRootConfirmationState, error) {
+	start := time.Now()
+	state, err := g.bhs.ConfirmMerkleRoots(ctx, merkleRoots)
+	if g.metrics != nil {
+		g.metrics.ObserveBHSVerifyMerkleRoots(time.Since(start), err == nil)
+	}
+	g.recordResult(ctx, err)
+	return state, err
+}
+
+func (g *Guard) recordResult(ctx context.Context, err error) {
+	if err == nil {
+		g.breaker.recordSuccess()
+		return
+	}
+	switch {
+	case errors.Is(err, chainerrors.ErrBHSBadRequest):
+		// BHS responded, so it's up
+		g.breaker.recordSuccess()
+	case isOutage(ctx, err):
+		if g.breaker.recordFailure() {
+			g.logger.Warn().Err(err).Msg("Block Headers Service is down, requests to it are suspended")
+		}
+	default:
+		g.breaker.release()
+	}
+}
+
+// handleBHSDown never accepts the merkle roots which were not verified.
+// With the queue policy, the returned error is ErrMerkleRootsDeferred, so the caller can keep the transaction and verify it later.
+func (g *Guard) handleBHSDown(merkleRoots []*spv.MerkleRootConfirmationRequestItem, cause error) (bool, error) {
+	if g.whenDown != chainmodels.BHSDownQueue {
+		return false, cause
+	}
+	g.logger.Warn().Err(cause).Int("count", len(merkleRoots)).Msg("Block Headers Service is down, merkle roots verification is deferred")
+	return false, chainerrors.ErrMerkleRootsDeferred.Wrap(cause)
+}
+
+func (g *Guard) stateToValid(state chainmodels.MerkleRootConfirmationState, err error) (bool, error) {
+	if err != nil {
+		return false, err
+	}
+	switch state {
+	case chainmodels.MRConfirmed:
+		return true, nil
+	case chainmodels.MRUnableToVerify:
+		if g.whenDown == chainmodels.BHSDownQueue {
+			g.logger.Warn().Msg("BHS is up but could not verify some merkle root(s), merkle roots verification is deferred")
+			return false, chainerrors.ErrMerkleRootsDeferred
+		}
+		g.logger.Warn().Msg("BHS is up but could not verify some merkle root(s), merkle roots are not accepted")
+		return false, nil
+	default:
+		return false, nil
+	}
+}
+
+// isOutage reports if the error means that BHS is not available (and not that the request was wrong or canceled by the caller).
+func isOutage(ctx context.Context, err error) bool {
+	if errors.Is(err, chainerrors.ErrBHSBadRequest) || errors.Is(err, chainerrors.ErrBHSCircuitOpen) {
+		return false
+	}
+	return !errors.Is(ctx.Err(), context.Canceled)
+}
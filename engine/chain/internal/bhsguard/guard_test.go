@@ -0,0 +1,326 @@
+package bhsguard_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/bitcoin-sv/go-paymail/spv"
+	chainerrors "github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/internal/bhsguard"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester"
+	"github.com/bitcoin-sv/spv-wallet/models"
+	"github.com/mrz1836/go-cachestore"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	firstMerkleRoot = &spv.MerkleRootConfirmationRequestItem{
+		MerkleRoot:  "f67ae53720205a55f4e99c632debabb68b6df0dc0f68affd200a076aee6e80e6",
+		BlockHeight: 864921,
+	}
+	secondMerkleRoot = &spv.MerkleRootConfirmationRequestItem{
+		MerkleRoot:  "0e3e2357e806b6cdb1f70b54c3a3a17b6714ee1f0e68bebb44a74b1efd512098",
+		BlockHeight: 1,
+	}
+)
+
+func TestMerkleRootsCache(t *testing.T) {
+	t.Run("confirmed merkle roots are served from cache", func(t *testing.T) {
+		// given:
+		bhs := &fakeBHS{state: chainmodels.MRConfirmed}
+		metrics := &fakeMetrics{}
+		guard := bhsguard.NewGuard(tester.Logger(t), bhs, chainmodels.BHSConfig{
+			Cache:   &chainmodels.MerkleRootsCacheConfig{Store: newMemoryCache()},
+			Metrics: metrics,
+		})
+
+		// when:
+		valid, err := guard.VerifyMerkleRoots(context.Background(), []*spv.MerkleRootConfirmationRequestItem{firstMerkleRoot})
+
+		// then:
+		require.NoError(t, err)
+		require.True(t, valid)
+		require.Equal(t, 1, bhs.calls)
+
+		// when:
+		valid, err = guard.VerifyMerkleRoots(context.Background(), []*spv.MerkleRootConfirmationRequestItem{firstMerkleRoot})
+
+		// then:
+		require.NoError(t, err)
+		require.True(t, valid)
+		require.Equal(t, 1, bhs.calls)
+		require.Equal(t, 1, metrics.hits)
+		require.Equal(t, 1, metrics.misses)
+		require.Equal(t, 1, metrics.observed)
+	})
+
+	t.Run("only not cached merkle roots are sent to BHS", func(t *testing.T) {
+		// given:
+		bhs := &fakeBHS{state: chainmodels.MRConfirmed}
+		guard := bhsguard.NewGuard(tester.Logger(t), bhs, chainmodels.BHSConfig{
+			Cache: &chainmodels.MerkleRootsCacheConfig{Store: newMemoryCache()},
+		})
+		_, err := guard.VerifyMerkleRoots(context.Background(), []*spv.MerkleRootConfirmationRequestItem{firstMerkleRoot})
+		require.NoError(t, err)
+
+		// when:
+		_, err = guard.VerifyMerkleRoots(context.Background(), []*spv.MerkleRootConfirmationRequestItem{firstMerkleRoot, secondMerkleRoot})
+
+		// then:
+		require.NoError(t, err)
+		require.Equal(t, []*spv.MerkleRootConfirmationRequestItem{secondMerkleRoot}, bhs.lastRequest)
+	})
+
+	t.Run("merkle roots which BHS is unable to verify are not valid", func(t *testing.T) {
+		// given:
+		bhs := &fakeBHS{state: chainmodels.MRUnableToVerify}
+		guard := bhsguard.NewGuard(tester.Logger(t), bhs, chainmodels.BHSConfig{
+			Cache: &chainmodels.MerkleRootsCacheConfig{Store: newMemoryCache()},
+		})
+
+		// when:
+		valid, err := guard.VerifyMerkleRoots(context.Background(), []*spv.MerkleRootConfirmationRequestItem{firstMerkleRoot})
+
+		// then:
+		require.NoError(t, err)
+		require.False(t, valid)
+	})
+
+	t.Run("invalid merkle roots are not cached", func(t *testing.T) {
+		// given:
+		bhs := &fakeBHS{state: chainmodels.MRInvalid}
+		guard := bhsguard.NewGuard(tester.Logger(t), bhs, chainmodels.BHSConfig{
+			Cache: &chainmodels.MerkleRootsCacheConfig{Store: newMemoryCache()},
+		})
+
+		for range 2 {
+			// when:
+			valid, err := guard.VerifyMerkleRoots(context.Background(), []*spv.MerkleRootConfirmationRequestItem{firstMerkleRoot})
+
+			// then:
+			require.NoError(t, err)
+			require.False(t, valid)
+		}
+		require.Equal(t, 2, bhs.calls)
+	})
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Run("reject verification without calling BHS when the circuit is open", func(t *testing.T) {
+		// given:
+		bhs := &fakeBHS{err: chainerrors.ErrBHSUnreachable}
+		guard := bhsguard.NewGuard(tester.Logger(t), bhs, chainmodels.BHSConfig{
+			CircuitBreaker: &chainmodels.BHSCircuitBreakerConfig{FailureThreshold: 2, OpenTimeout: time.Minute},
+		})
+
+		for range 2 {
+			_, err := guard.VerifyMerkleRoots(context.Background(), []*spv.MerkleRootConfirmationRequestItem{firstMerkleRoot})
+			require.ErrorIs(t, err, chainerrors.ErrBHSUnreachable)
+		}
+
+		// when:
+		valid, err := guard.VerifyMerkleRoots(context.Background(), []*spv.MerkleRootConfirmationRequestItem{firstMerkleRoot})
+
+		// then:
+		require.ErrorIs(t, err, chainerrors.ErrBHSCircuitOpen)
+		require.False(t, valid)
+		require.Equal(t, 2, bhs.calls)
+
+		// and when:
+		_, err = guard.GetMerkleRoots(context.Background(), url.Values{})
+
+		// then:
+		require.ErrorIs(t, err, chainerrors.ErrBHSCircuitOpen)
+	})
+
+	t.Run("close the circuit after successful trial request", func(t *testing.T) {
+		// given:
+		bhs := &fakeBHS{err: chainerrors.ErrBHSUnreachable}
+		guard := bhsguard.NewGuard(tester.Logger(t), bhs, chainmodels.BHSConfig{
+			CircuitBreaker: &chainmodels.BHSCircuitBreakerConfig{FailureThreshold: 1, OpenTimeout: time.Millisecond},
+		})
+		_, err := guard.VerifyMerkleRoots(context.Background(), []*spv.MerkleRootConfirmationRequestItem{firstMerkleRoot})
+		require.ErrorIs(t, err, chainerrors.ErrBHSUnreachable)
+
+		// and:
+		time.Sleep(5 * time.Millisecond)
+		bhs.err = nil
+		bhs.state = chainmodels.MRConfirmed
+
+		// when:
+		valid, err := guard.VerifyMerkleRoots(context.Background(), []*spv.MerkleRootConfirmationRequestItem{firstMerkleRoot})
+
+		// then:
+		require.NoError(t, err)
+		require.True(t, valid)
+		require.Equal(t, 2, bhs.calls)
+	})
+
+	t.Run("bad request doesn't open the circuit", func(t *testing.T) {
+		// given:
+		bhs := &fakeBHS{err: chainerrors.ErrBHSBadRequest}
+		guard := bhsguard.NewGuard(tester.Logger(t), bhs, chainmodels.BHSConfig{
+			CircuitBreaker: &chainmodels.BHSCircuitBreakerConfig{FailureThreshold: 1, OpenTimeout: time.Minute},
+		})
+
+		for range 2 {
+			// when:
+			_, err := guard.VerifyMerkleRoots(context.Background(), []*spv.MerkleRootConfirmationRequestItem{firstMerkleRoot})
+
+			// then:
+			require.ErrorIs(t, err, chainerrors.ErrBHSBadRequest)
+		}
+		require.Equal(t, 2, bhs.calls)
+	})
+}
+
+func TestQueueMerkleRootsWhenBHSIsDown(t *testing.T) {
+	t.Run("defer merkle roots verification while BHS is down", func(t *testing.T) {
+		// given:
+		bhs := &fakeBHS{err: chainerrors.ErrBHSUnreachable}
+		cache := newMemoryCache()
+		guard := bhsguard.NewGuard(tester.Logger(t), bhs, chainmodels.BHSConfig{
+			Cache:          &chainmodels.MerkleRootsCacheConfig{Store: cache},
+			CircuitBreaker: &chainmodels.BHSCircuitBreakerConfig{FailureThreshold: 1, OpenTimeout: time.Minute, WhenDown: chainmodels.BHSDownQueue},
+		})
+
+		for range 2 {
+			// when:
+			valid, err := guard.VerifyMerkleRoots(context.Background(), []*spv.MerkleRootConfirmationRequestItem{firstMerkleRoot})
+
+			// then:
+			require.ErrorIs(t, err, chainerrors.ErrMerkleRootsDeferred)
+			require.False(t, valid)
+		}
+		require.Equal(t, 1, bhs.calls)
+		require.Empty(t, cache.values)
+	})
+
+	t.Run("defer merkle roots which BHS is unable to verify", func(t *testing.T) {
+		// given:
+		bhs := &fakeBHS{state: chainmodels.MRUnableToVerify}
+		guard := bhsguard.NewGuard(tester.Logger(t), bhs, chainmodels.BHSConfig{
+			CircuitBreaker: &chainmodels.BHSCircuitBreakerConfig{FailureThreshold: 1, OpenTimeout: time.Minute, WhenDown: chainmodels.BHSDownQueue},
+		})
+
+		// when:
+		valid, err := guard.VerifyMerkleRoots(context.Background(), []*spv.MerkleRootConfirmationRequestItem{firstMerkleRoot})
+
+		// then:
+		require.ErrorIs(t, err, chainerrors.ErrMerkleRootsDeferred)
+		require.False(t, valid)
+	})
+
+	t.Run("verify merkle roots once BHS is back", func(t *testing.T) {
+		// given:
+		bhs := &fakeBHS{err: chainerrors.ErrBHSUnreachable}
+		guard := bhsguard.NewGuard(tester.Logger(t), bhs, chainmodels.BHSConfig{
+			CircuitBreaker: &chainmodels.BHSCircuitBreakerConfig{FailureThreshold: 1, OpenTimeout: time.Millisecond, WhenDown: chainmodels.BHSDownQueue},
+		})
+		_, err := guard.VerifyMerkleRoots(context.Background(), []*spv.MerkleRootConfirmationRequestItem{firstMerkleRoot})
+		require.ErrorIs(t, err, chainerrors.ErrMerkleRootsDeferred)
+
+		// and:
+		time.Sleep(5 * time.Millisecond)
+		bhs.err = nil
+		bhs.state = chainmodels.MRInvalid
+
+		// when:
+		valid, err := guard.VerifyMerkleRoots(context.Background(), []*spv.MerkleRootConfirmationRequestItem{firstMerkleRoot})
+
+		// then:
+		require.NoError(t, err)
+		require.False(t, valid)
+	})
+
+	t.Run("don't confirm merkle roots while BHS is down", func(t *testing.T) {
+		// given:
+		bhs := &fakeBHS{err: chainerrors.ErrBHSUnreachable}
+		guard := bhsguard.NewGuard(tester.Logger(t), bhs, chainmodels.BHSConfig{
+			CircuitBreaker: &chainmodels.BHSCircuitBreakerConfig{FailureThreshold: 1, OpenTimeout: time.Minute, WhenDown: chainmodels.BHSDownQueue},
+		})
+
+		// when:
+		state, err := guard.ConfirmMerkleRoots(context.Background(), []*spv.MerkleRootConfirmationRequestItem{firstMerkleRoot})
+
+		// then:
+		require.ErrorIs(t, err, chainerrors.ErrBHSUnreachable)
+		require.Empty(t, state)
+
+		// and when:
+		state, err = guard.ConfirmMerkleRoots(context.Background(), []*spv.MerkleRootConfirmationRequestItem{firstMerkleRoot})
+
+		// then:
+		require.ErrorIs(t, err, chainerrors.ErrBHSCircuitOpen)
+		require.Empty(t, state)
+		require.Equal(t, 1, bhs.calls)
+	})
+}
+
+type fakeBHS struct {
+	state       chainmodels.MerkleRootConfirmationState
+	err         error
+	calls       int
+	lastRequest []*spv.MerkleRootConfirmationRequestItem
+}
+
+func (f *fakeBHS) GetMerkleRoots(_ context.Context, _ url.Values) (*models.MerkleRootsBHSResponse, error) {
+	f.calls++
+	return &models.MerkleRootsBHSResponse{}, f.err
+}
+
+func (f *fakeBHS) ConfirmMerkleRoots(_ context.Context, merkleRoots []*spv.MerkleRootConfirmationRequestItem) (chainmodels.MerkleRootConfirmationState, error) {
+	f.calls++
+	f.lastRequest = merkleRoots
+	if f.err != nil {
+		return "", f.err
+	}
+	return f.state, nil
+}
+
+func (f *fakeBHS) HealthcheckBHS(_ context.Context) error {
+	return f.err
+}
+
+type memoryCache struct {
+	values map[string]string
+}
+
+func newMemoryCache() *memoryCache {
+	return &memoryCache{values: map[string]string{}}
+}
+
+func (c *memoryCache) GetModel(_ context.Context, key string, model interface{}) error {
+	value, ok := c.values[key]
+	if !ok {
+		return cachestore.ErrKeyNotFound
+	}
+	*(model.(*string)) = value
+	return nil
+}
+
+func (c *memoryCache) SetModel(_ context.Context, key string, model interface{}, _ time.Duration, _ ...string) error {
+	c.values[key] = model.(string)
+	return nil
+}
+
+type fakeMetrics struct {
+	hits     int
+	misses   int
+	observed int
+}
+
+func (m *fakeMetrics) IncMerkleRootsCacheHits(count int) {
+	m.hits += count
+}
+
+func (m *fakeMetrics) IncMerkleRootsCacheMisses(count int) {
+	m.misses += count
+}
+
+func (m *fakeMetrics) ObserveBHSVerifyMerkleRoots(_ time.Duration, _ bool) {
+	m.observed++
+}
@@ -0,0 +1,89 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"slices"
+	"time"
+
+	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/mrz1836/go-cachestore"
+	"github.com/rs/zerolog"
+)
+
+const (
+	cacheKeyTxPrefix = "chain-tx-"
+	defaultTxsTTL    = 24 * time.Hour
+)
+
+// WithCache serves transactions from the cache and caches the transactions returned by the getter.
+// Transactions are immutable, so they can be kept in the cache for a long time.
+func WithCache(logger zerolog.Logger, getter chainmodels.TransactionsGetter, cfg *chainmodels.TxsCacheConfig) chainmodels.TransactionsGetter {
+	if cfg == nil || cfg.Store == nil {
+		return getter
+	}
+	ttl := cfg.TTL
+	if ttl <= 0 {
+		ttl = defaultTxsTTL
+	}
+	return &cachedTxsGetter{
+		logger: logger,
+		getter: getter,
+		cache:  cfg.Store,
+		ttl:    ttl,
+	}
+}
+
+type cachedTxsGetter struct {
+	logger zerolog.Logger
+	getter chainmodels.TransactionsGetter
+	cache  chainmodels.TxsCache
+	ttl    time.Duration
+}
+
+func (g *cachedTxsGetter) GetTransactions(ctx context.Context, ids iter.Seq[string]) ([]*sdk.Transaction, error) {
+	var transactions []*sdk.Transaction
+	var missing []string
+	for id := range ids {
+		if tx := g.fromCache(ctx, id); tx != nil {
+			transactions = append(transactions, tx)
+		} else {
+			missing = append(missing, id)
+		}
+	}
+
+	if len(missing) == 0 {
+		return transactions, nil
+	}
+
+	fetched, err := g.getter.GetTransactions(ctx, slices.Values(missing))
+	if err != nil {
+		return nil, err
+	}
+	for _, tx := range fetched {
+		if err := g.cache.SetModel(ctx, cacheKeyTxPrefix+tx.TxID().String(), tx.Hex(), g.ttl); err != nil {
+			g.logger.Warn().Err(err).Str("txID", tx.TxID().String()).Msg("Failed to store transaction in cache")
+		}
+	}
+	return append(transactions, fetched...), nil
+}
+
+func (g *cachedTxsGetter) fromCache(ctx context.Context, txID string) *sdk.Transaction {
+	var txHex string
+	err := g.cache.GetModel(ctx, cacheKeyTxPrefix+txID, &txHex)
+	if errors.Is(err, cachestore.ErrKeyNotFound) {
+		return nil
+	}
+	if err != nil {
+		g.logger.Warn().Err(err).Str("txID", txID).Msg("Failed to get transaction from cache")
+		return nil
+	}
+	tx, err := sdk.NewTransactionFromHex(txHex)
+	if err != nil {
+		g.logger.Warn().Err(err).Str("txID", txID).Msg("Invalid transaction in cache")
+		return nil
+	}
+	return tx
+}
@@ -0,0 +1,106 @@
+package headers
+
+import (
+	"context"
+	"math/big"
+
+	chainerrors "github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+)
+
+const (
+	// viewDepth is the number of validated headers kept in memory - enough to calculate the expected difficulty
+	viewDepth = retargetInterval + 1
+	// viewLoadSize is the number of stored headers loaded at once when an ancestor is missing in memory
+	viewLoadSize = daaWindow + medianTimeSpan
+)
+
+type viewEntry struct {
+	header *chainmodels.BlockHeader
+	work   *big.Int
+}
+
+// chainView gives access to the chain which is being validated:
+// the headers already validated in this run and the stored headers below them.
+type chainView struct {
+	ctx     context.Context
+	repo    chainmodels.BlockHeadersRepo
+	params  *chainParams
+	entries map[uint32]viewEntry
+}
+
+func newChainView(ctx context.Context, repo chainmodels.BlockHeadersRepo, params *chainParams) *chainView {
+	return &chainView{
+		ctx:     ctx,
+		repo:    repo,
+		params:  params,
+		entries: make(map[uint32]viewEntry),
+	}
+}
+
+// validate checks the header on top of the previous one (see validateHeader)
+// and if it declares the difficulty required by the consensus rules; a valid header becomes part of the view.
+func (v *chainView) validate(header *chainmodels.BlockHeader, prevHash string) error {
+	if err := validateHeader(header, prevHash); err != nil {
+		return err
+	}
+
+	expected, err := v.params.expectedBits(header.Height, v.header, v.work)
+	if err != nil {
+		return err
+	}
+	if header.Bits != expected {
+		return chainerrors.ErrBlockHeadersInvalid.Wrap(spverrors.Newf("header at height %d has difficulty bits %08x, expected %08x", header.Height, header.Bits, expected))
+	}
+
+	v.add(header)
+	return nil
+}
+
+func (v *chainView) add(header *chainmodels.BlockHeader) {
+	v.entries[header.Height] = viewEntry{header: header, work: blockWork(header.Bits)}
+	if header.Height >= viewDepth {
+		delete(v.entries, header.Height-viewDepth)
+	}
+}
+
+func (v *chainView) header(height uint32) (*chainmodels.BlockHeader, error) {
+	entry, err := v.entry(height)
+	if err != nil {
+		return nil, err
+	}
+	return entry.header, nil
+}
+
+func (v *chainView) work(height uint32) (*big.Int, error) {
+	entry, err := v.entry(height)
+	if err != nil {
+		return nil, err
+	}
+	return entry.work, nil
+}
+
+func (v *chainView) entry(height uint32) (viewEntry, error) {
+	if entry, ok := v.entries[height]; ok {
+		return entry, nil
+	}
+
+	// loading the headers up to the requested one, so walking back the chain doesn't query the repository for every header
+	from := height - min(height, viewLoadSize-1)
+	stored, err := v.repo.GetRange(v.ctx, from, int(height-from)+1)
+	if err != nil {
+		return viewEntry{}, spverrors.Wrapf(err, "failed to get block headers from height %d", from)
+	}
+	for i := range stored {
+		if _, ok := v.entries[stored[i].Height]; !ok {
+			v.entries[stored[i].Height] = viewEntry{header: &stored[i], work: blockWork(stored[i].Bits)}
+		}
+	}
+
+	entry, ok := v.entries[height]
+	if !ok {
+		return viewEntry{}, chainerrors.ErrBlockHeadersInvalid.Wrap(spverrors.Newf("missing block header at height %d", height))
+	}
+	return entry, nil
+}
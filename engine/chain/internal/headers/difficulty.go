@@ -0,0 +1,254 @@
+package headers
+
+import (
+	"math/big"
+	"slices"
+
+	chainerrors "github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+)
+
+const (
+	// targetSpacing is the expected time between blocks (in seconds)
+	targetSpacing = 10 * 60
+	// targetTimespan is the expected time of the whole retarget interval (in seconds)
+	targetTimespan = 14 * 24 * 60 * 60
+	// retargetInterval is the number of blocks between difficulty adjustments before DAA
+	retargetInterval = 2016
+	// daaWindow is the number of blocks taken into account by DAA (difficulty adjustment algorithm)
+	daaWindow = 144
+	// medianTimeSpan is the number of blocks used to calculate the median time past
+	medianTimeSpan = 11
+)
+
+// chainParams holds the consensus rules of the network which are needed to calculate the expected difficulty.
+type chainParams struct {
+	// powLimitBits is the lowest difficulty in the compact form
+	powLimitBits uint32
+	// noRetargeting keeps the difficulty of the previous block (regtest)
+	noRetargeting bool
+	// daaHeight is the height of the last block before DAA was activated
+	daaHeight uint32
+}
+
+var mainnetParams = chainParams{
+	powLimitBits: 0x1d00ffff,
+	daaHeight:    504031,
+}
+
+var regtestParams = chainParams{
+	powLimitBits:  RegtestBits,
+	noRetargeting: true,
+}
+
+// headerAt returns the header of the validated chain at the given height.
+type headerAt func(height uint32) (*chainmodels.BlockHeader, error)
+
+// workAt returns the work (proof) of the block of the validated chain at the given height.
+type workAt func(height uint32) (*big.Int, error)
+
+// expectedBits returns the difficulty (in the compact form) which the header at the given height must declare.
+// It follows the consensus rules of BSV: retargeting every 2016 blocks with the emergency difficulty adjustment (EDA)
+// and, since the DAA activation, the adjustment of every block based on the work and time of the last 144 blocks.
+func (p *chainParams) expectedBits(height uint32, at headerAt, work workAt) (uint32, error) {
+	if height == 0 {
+		return p.powLimitBits, nil
+	}
+	prev, err := at(height - 1)
+	if err != nil {
+		return 0, err
+	}
+	if p.noRetargeting {
+		return prev.Bits, nil
+	}
+	if prev.Height >= p.daaHeight {
+		return p.nextDAABits(prev, at, work)
+	}
+	return p.nextEDABits(prev, at)
+}
+
+func (p *chainParams) nextEDABits(prev *chainmodels.BlockHeader, at headerAt) (uint32, error) {
+	height := prev.Height + 1
+	if height%retargetInterval == 0 {
+		first, err := at(height - retargetInterval)
+		if err != nil {
+			return 0, err
+		}
+		return p.retarget(prev, first.Timestamp.Unix()), nil
+	}
+
+	if prev.Bits == p.powLimitBits || height < 7 {
+		return prev.Bits, nil
+	}
+
+	// if producing the last 6 blocks took more than 12 hours, the difficulty is reduced by 20%
+	lastMTP, err := medianTimePast(prev.Height, at)
+	if err != nil {
+		return 0, err
+	}
+	sixthMTP, err := medianTimePast(height-7, at)
+	if err != nil {
+		return 0, err
+	}
+	if lastMTP-sixthMTP < 12*60*60 {
+		return prev.Bits, nil
+	}
+	target := compactToBig(prev.Bits)
+	target.Add(target, new(big.Int).Rsh(target, 2))
+	return p.limited(target), nil
+}
+
+func (p *chainParams) retarget(prev *chainmodels.BlockHeader, firstTimestamp int64) uint32 {
+	timespan := prev.Timestamp.Unix() - firstTimestamp
+	timespan = max(timespan, targetTimespan/4)
+	timespan = min(timespan, targetTimespan*4)
+
+	target := compactToBig(prev.Bits)
+	target.Mul(target, big.NewInt(timespan))
+	target.Div(target, big.NewInt(targetTimespan))
+	return p.limited(target)
+}
+
+func (p *chainParams) nextDAABits(prev *chainmodels.BlockHeader, at headerAt, work workAt) (uint32, error) {
+	last, err := suitableBlock(prev.Height, at)
+	if err != nil {
+		return 0, err
+	}
+	first, err := suitableBlock(prev.Height-daaWindow, at)
+	if err != nil {
+		return 0, err
+	}
+
+	windowWork := new(big.Int)
+	for height := first.Height + 1; height <= last.Height; height++ {
+		blockWork, err := work(height)
+		if err != nil {
+			return 0, err
+		}
+		windowWork.Add(windowWork, blockWork)
+	}
+	windowWork.Mul(windowWork, big.NewInt(targetSpacing))
+
+	// the adjustment is bound to a factor in [0.5, 2]
+	timespan := last.Timestamp.Unix() - first.Timestamp.Unix()
+	timespan = max(timespan, 72*targetSpacing)
+	timespan = min(timespan, 288*targetSpacing)
+	windowWork.Div(windowWork, big.NewInt(timespan))
+	if windowWork.Sign() == 0 {
+		return p.powLimitBits, nil
+	}
+
+	// target = (2^256 - work) / work
+	target := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), windowWork)
+	target.Div(target, windowWork)
+	return p.limited(target), nil
+}
+
+// limited returns the compact form of the target, but not lower difficulty than the limit of the network.
+func (p *chainParams) limited(target *big.Int) uint32 {
+	if target.Cmp(compactToBig(p.powLimitBits)) > 0 {
+		return p.powLimitBits
+	}
+	return bigToCompact(target)
+}
+
+// suitableBlock returns the block with the median timestamp of the block at the given height and its two predecessors.
+func suitableBlock(height uint32, at headerAt) (*chainmodels.BlockHeader, error) {
+	if height < 2 {
+		return nil, chainerrors.ErrBlockHeadersInvalid.Wrap(spverrors.Newf("not enough blocks to adjust difficulty at height %d", height))
+	}
+	blocks := make([]*chainmodels.BlockHeader, 0, 3)
+	for i := range uint32(3) {
+		block, err := at(height - i)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	}
+	// the same sorting network as in the node, so the same block is picked when timestamps are equal
+	blocks[0], blocks[2] = blocks[2], blocks[0]
+	if blocks[0].Timestamp.After(blocks[2].Timestamp) {
+		blocks[0], blocks[2] = blocks[2], blocks[0]
+	}
+	if blocks[0].Timestamp.After(blocks[1].Timestamp) {
+		blocks[0], blocks[1] = blocks[1], blocks[0]
+	}
+	if blocks[1].Timestamp.After(blocks[2].Timestamp) {
+		blocks[1], blocks[2] = blocks[2], blocks[1]
+	}
+	return blocks[1], nil
+}
+
+// medianTimePast returns the median timestamp of the block at the given height and up to 10 blocks before it.
+func medianTimePast(height uint32, at headerAt) (int64, error) {
+	timestamps := make([]int64, 0, medianTimeSpan)
+	for i := uint32(0); i < medianTimeSpan && i <= height; i++ {
+		block, err := at(height - i)
+		if err != nil {
+			return 0, err
+		}
+		timestamps = append(timestamps, block.Timestamp.Unix())
+	}
+	slices.Sort(timestamps)
+	return timestamps[len(timestamps)/2], nil
+}
+
+// blockWork returns the expected number of hashes needed to mine a block with the given difficulty: 2^256 / (target + 1).
+func blockWork(bits uint32) *big.Int {
+	target := compactToBig(bits)
+	if target.Sign() <= 0 {
+		return new(big.Int)
+	}
+	denominator := new(big.Int).Add(target, big.NewInt(1))
+	return new(big.Int).Div(new(big.Int).Lsh(big.NewInt(1), 256), denominator)
+}
+
+// chainWork returns the cumulative work of the headers.
+func chainWork(headers []chainmodels.BlockHeader) *big.Int {
+	work := new(big.Int)
+	for i := range headers {
+		work.Add(work, blockWork(headers[i].Bits))
+	}
+	return work
+}
+
+// compactToBig decodes the target from its compact form (bits).
+func compactToBig(compact uint32) *big.Int {
+	mantissa := compact & 0x007fffff
+	exponent := uint(compact >> 24)
+
+	var target *big.Int
+	if exponent <= 3 {
+		target = big.NewInt(int64(mantissa >> (8 * (3 - exponent))))
+	} else {
+		target = big.NewInt(int64(mantissa))
+		target.Lsh(target, 8*(exponent-3))
+	}
+	if compact&0x00800000 != 0 {
+		target.Neg(target)
+	}
+	return target
+}
+
+// bigToCompact encodes the (non-negative) target into its compact form (bits).
+func bigToCompact(target *big.Int) uint32 {
+	if target.Sign() == 0 {
+		return 0
+	}
+
+	exponent := uint(len(target.Bytes()))
+	var mantissa uint32
+	if exponent <= 3 {
+		mantissa = uint32(target.Uint64()) << (8 * (3 - exponent)) //nolint:gosec // the value has at most 3 bytes
+	} else {
+		mantissa = uint32(new(big.Int).Rsh(target, 8*(exponent-3)).Uint64()) //nolint:gosec // the value has 3 bytes
+	}
+
+	// the sign bit cannot be set, so the mantissa is moved to the next byte
+	if mantissa&0x00800000 != 0 {
+		mantissa >>= 8
+		exponent++
+	}
+	return uint32(exponent<<24) | mantissa //nolint:gosec // exponent of a 256-bit number fits into a byte
+}
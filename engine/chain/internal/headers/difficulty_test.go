@@ -0,0 +1,225 @@
+package headers
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	chainerrors "github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpectedBitsOfRetarget(t *testing.T) {
+	// the same cases as in the node's tests of the difficulty adjustment
+	tests := map[string]struct {
+		lastHeight       uint32
+		lastTimestamp    int64
+		lastBits         uint32
+		lastRetargetTime int64
+		expectedBits     uint32
+	}{
+		"adjust difficulty": {
+			lastHeight:       32255,
+			lastTimestamp:    1262152739,
+			lastBits:         0x1d00ffff,
+			lastRetargetTime: 1261130161,
+			expectedBits:     0x1d00d86a,
+		},
+		"not lower than the limit": {
+			lastHeight:       2015,
+			lastTimestamp:    1233061996,
+			lastBits:         0x1d00ffff,
+			lastRetargetTime: 1231006505,
+			expectedBits:     0x1d00ffff,
+		},
+		"increase difficulty at most 4 times": {
+			lastHeight:       68543,
+			lastTimestamp:    1279297671,
+			lastBits:         0x1c05a3f4,
+			lastRetargetTime: 1279008237,
+			expectedBits:     0x1c0168fd,
+		},
+		"decrease difficulty at most 4 times": {
+			lastHeight:       46367,
+			lastTimestamp:    1269211443,
+			lastBits:         0x1c387f6f,
+			lastRetargetTime: 1263163443,
+			expectedBits:     0x1d00e1fd,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given:
+			chain := testChain{
+				test.lastHeight:                        {Height: test.lastHeight, Bits: test.lastBits, Timestamp: time.Unix(test.lastTimestamp, 0)},
+				test.lastHeight + 1 - retargetInterval: {Height: test.lastHeight + 1 - retargetInterval, Bits: test.lastBits, Timestamp: time.Unix(test.lastRetargetTime, 0)},
+			}
+
+			// when:
+			bits, err := mainnetParams.expectedBits(test.lastHeight+1, chain.header, chain.work)
+
+			// then:
+			require.NoError(t, err)
+			require.Equal(t, test.expectedBits, bits)
+		})
+	}
+}
+
+func TestExpectedBitsOfEmergencyAdjustment(t *testing.T) {
+	const bits = 0x1c05a3f4
+
+	t.Run("keep difficulty when blocks are produced in time", func(t *testing.T) {
+		// given:
+		chain := newTestChain(100000, 20, bits, time.Hour)
+
+		// when:
+		expected, err := mainnetParams.expectedBits(100020, chain.header, chain.work)
+
+		// then:
+		require.NoError(t, err)
+		require.Equal(t, uint32(bits), expected)
+	})
+
+	t.Run("decrease difficulty when last 6 blocks took more than 12 hours", func(t *testing.T) {
+		// given:
+		chain := newTestChain(100000, 20, bits, 3*time.Hour)
+
+		// when:
+		expected, err := mainnetParams.expectedBits(100020, chain.header, chain.work)
+
+		// then:
+		require.NoError(t, err)
+		target := compactToBig(bits)
+		require.Equal(t, bigToCompact(target.Add(target, new(big.Int).Rsh(target, 2))), expected)
+	})
+
+	t.Run("keep the lowest difficulty", func(t *testing.T) {
+		// given:
+		chain := newTestChain(100000, 20, mainnetParams.powLimitBits, 3*time.Hour)
+
+		// when:
+		expected, err := mainnetParams.expectedBits(100020, chain.header, chain.work)
+
+		// then:
+		require.NoError(t, err)
+		require.Equal(t, mainnetParams.powLimitBits, expected)
+	})
+}
+
+func TestExpectedBitsOfDAA(t *testing.T) {
+	const (
+		fromHeight = 600000
+		bits       = 0x18040000
+	)
+
+	t.Run("keep difficulty when blocks are produced every 10 minutes", func(t *testing.T) {
+		// given:
+		chain := newTestChain(fromHeight, daaWindow+3, bits, 10*time.Minute)
+
+		// when:
+		expected, err := mainnetParams.expectedBits(fromHeight+daaWindow+3, chain.header, chain.work)
+
+		// then:
+		require.NoError(t, err)
+		require.Equal(t, uint32(bits), expected)
+	})
+
+	t.Run("double difficulty at most", func(t *testing.T) {
+		// given:
+		chain := newTestChain(fromHeight, daaWindow+3, bits, time.Minute)
+
+		// when:
+		expected, err := mainnetParams.expectedBits(fromHeight+daaWindow+3, chain.header, chain.work)
+
+		// then:
+		require.NoError(t, err)
+		require.Equal(t, uint32(0x18020000), expected)
+	})
+
+	t.Run("halve difficulty at most", func(t *testing.T) {
+		// given:
+		chain := newTestChain(fromHeight, daaWindow+3, bits, time.Hour)
+
+		// when:
+		expected, err := mainnetParams.expectedBits(fromHeight+daaWindow+3, chain.header, chain.work)
+
+		// then:
+		require.NoError(t, err)
+		require.Equal(t, uint32(0x18080000), expected)
+	})
+
+	t.Run("return error when the window is not known", func(t *testing.T) {
+		// given:
+		chain := newTestChain(fromHeight, daaWindow, bits, 10*time.Minute)
+
+		// when:
+		_, err := mainnetParams.expectedBits(fromHeight+daaWindow, chain.header, chain.work)
+
+		// then:
+		require.ErrorIs(t, err, chainerrors.ErrBlockHeadersInvalid)
+	})
+}
+
+func TestExpectedBitsOfRegtest(t *testing.T) {
+	// given:
+	chain := newTestChain(10, 1, RegtestBits, time.Second)
+
+	// when:
+	bits, err := regtestParams.expectedBits(11, chain.header, chain.work)
+
+	// then:
+	require.NoError(t, err)
+	require.Equal(t, uint32(RegtestBits), bits)
+}
+
+func TestChainWork(t *testing.T) {
+	// given:
+	genesis := chainmodels.BlockHeader{Bits: mainnetParams.powLimitBits}
+
+	// when:
+	work := chainWork([]chainmodels.BlockHeader{genesis, genesis})
+
+	// then:
+	require.Equal(t, big.NewInt(2*0x100010001), work)
+}
+
+func TestCompactForm(t *testing.T) {
+	for _, bits := range []uint32{0x1d00ffff, 0x1c05a3f4, 0x207fffff, 0x03123456, 0x01120000} {
+		require.Equal(t, bits, bigToCompact(compactToBig(bits)))
+	}
+}
+
+// testChain keeps headers by height, it's a stand-in for the chain view.
+type testChain map[uint32]chainmodels.BlockHeader
+
+// newTestChain creates count headers starting at the given height with the same difficulty and the time between blocks.
+func newTestChain(fromHeight uint32, count int, bits uint32, spacing time.Duration) testChain {
+	chain := testChain{}
+	start := time.Unix(1600000000, 0)
+	for i := range uint32(count) { //nolint:gosec // small numbers in tests
+		chain[fromHeight+i] = chainmodels.BlockHeader{
+			Height:    fromHeight + i,
+			Bits:      bits,
+			Timestamp: start.Add(time.Duration(i) * spacing),
+		}
+	}
+	return chain
+}
+
+func (c testChain) header(height uint32) (*chainmodels.BlockHeader, error) {
+	header, ok := c[height]
+	if !ok {
+		return nil, chainerrors.ErrBlockHeadersInvalid.Wrap(spverrors.Newf("missing block header at height %d", height))
+	}
+	return &header, nil
+}
+
+func (c testChain) work(height uint32) (*big.Int, error) {
+	header, err := c.header(height)
+	if err != nil {
+		return nil, err
+	}
+	return blockWork(header.Bits), nil
+}
@@ -0,0 +1,80 @@
+package headers
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	chainerrors "github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/models"
+)
+
+// maxMerkleRootsBatchSize is the default and max number of merkle roots returned at once (the same as in BHS)
+const maxMerkleRootsBatchSize = 2000
+
+// GetMerkleRoots returns merkle roots of the stored longest chain, paged in the same way as Block Header Service does it.
+func (s *Service) GetMerkleRoots(ctx context.Context, query url.Values) (*models.MerkleRootsBHSResponse, error) {
+	batchSize, err := parseBatchSize(query.Get("batchSize"))
+	if err != nil {
+		return nil, err
+	}
+
+	fromHeight := uint32(0)
+	if lastEvaluatedKey := query.Get("lastEvaluatedKey"); lastEvaluatedKey != "" {
+		last, err := s.repo.GetByMerkleRoot(ctx, lastEvaluatedKey)
+		if err != nil {
+			return nil, spverrors.Wrapf(err, "failed to get block header by merkle root")
+		}
+		if last == nil {
+			return nil, chainerrors.ErrMerkleRootNotFound
+		}
+		fromHeight = last.Height + 1
+	}
+
+	tip, err := s.repo.GetTip(ctx)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get the tip of block headers")
+	}
+
+	response := &models.MerkleRootsBHSResponse{
+		Content: []models.MerkleRoot{},
+	}
+	if tip == nil {
+		return response, nil
+	}
+
+	headers, err := s.repo.GetRange(ctx, fromHeight, batchSize)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get block headers")
+	}
+
+	for _, header := range headers {
+		response.Content = append(response.Content, models.MerkleRoot{
+			MerkleRoot:  header.MerkleRoot,
+			BlockHeight: int(header.Height),
+		})
+	}
+
+	response.Page.TotalElements = int(tip.Height) + 1
+	response.Page.Size = len(response.Content)
+	if len(headers) > 0 && headers[len(headers)-1].Height < tip.Height {
+		response.Page.LastEvaluatedKey = headers[len(headers)-1].MerkleRoot
+	}
+
+	return response, nil
+}
+
+func parseBatchSize(value string) (int, error) {
+	if value == "" {
+		return maxMerkleRootsBatchSize, nil
+	}
+	batchSize, err := strconv.Atoi(value)
+	if err != nil || batchSize < 0 {
+		return 0, chainerrors.ErrInvalidBatchSize
+	}
+	if batchSize == 0 || batchSize > maxMerkleRootsBatchSize {
+		return maxMerkleRootsBatchSize, nil
+	}
+	return batchSize, nil
+}
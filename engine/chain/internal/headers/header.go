@@ -0,0 +1,98 @@
+package headers
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"math/big"
+	"time"
+
+	crypto "github.com/bitcoin-sv/go-sdk/primitives/hash"
+	"github.com/bitcoin-sv/go-sdk/util"
+	chainerrors "github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+)
+
+// rawHeaderSize is the size of the serialized block header
+const rawHeaderSize = 80
+
+// genesisPrevHash is the previous block hash of the genesis block
+const genesisPrevHash = "0000000000000000000000000000000000000000000000000000000000000000"
+
+// parseRawHeader decodes serialized (80 bytes) block header; the height is not part of the header, so it has to be provided.
+func parseRawHeader(raw []byte, height uint32) chainmodels.BlockHeader {
+	header := chainmodels.BlockHeader{
+		Height:     height,
+		Version:    binary.LittleEndian.Uint32(raw[0:4]),
+		PrevHash:   hex.EncodeToString(util.ReverseBytes(raw[4:36])),
+		MerkleRoot: hex.EncodeToString(util.ReverseBytes(raw[36:68])),
+		Timestamp:  time.Unix(int64(binary.LittleEndian.Uint32(raw[68:72])), 0).UTC(),
+		Bits:       binary.LittleEndian.Uint32(raw[72:76]),
+		Nonce:      binary.LittleEndian.Uint32(raw[76:80]),
+	}
+	header.Hash = hex.EncodeToString(util.ReverseBytes(crypto.Sha256d(raw)))
+	return header
+}
+
+// serializeHeader encodes the block header to its 80-byte form.
+func serializeHeader(header *chainmodels.BlockHeader) ([]byte, error) {
+	prevHash, err := decodeHash(header.PrevHash)
+	if err != nil {
+		return nil, err
+	}
+	merkleRoot, err := decodeHash(header.MerkleRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	raw := make([]byte, rawHeaderSize)
+	binary.LittleEndian.PutUint32(raw[0:4], header.Version)
+	copy(raw[4:36], prevHash)
+	copy(raw[36:68], merkleRoot)
+	binary.LittleEndian.PutUint32(raw[68:72], uint32(header.Timestamp.Unix())) //nolint:gosec // block timestamps fit into uint32
+	binary.LittleEndian.PutUint32(raw[72:76], header.Bits)
+	binary.LittleEndian.PutUint32(raw[76:80], header.Nonce)
+	return raw, nil
+}
+
+// decodeHash decodes hex encoded hash into the internal (reversed) byte order.
+func decodeHash(hash string) ([]byte, error) {
+	decoded, err := hex.DecodeString(hash)
+	if err != nil || len(decoded) != 32 {
+		return nil, chainerrors.ErrBlockHeadersInvalid.Wrap(spverrors.Newf("invalid hash %q", hash))
+	}
+	return util.ReverseBytes(decoded), nil
+}
+
+// validateHeader checks if the header links to the previous one, if its hash matches the content
+// and if the hash satisfies the proof of work declared by the header's bits.
+func validateHeader(header *chainmodels.BlockHeader, prevHash string) error {
+	if header.PrevHash != "" && header.PrevHash != prevHash {
+		return chainerrors.ErrBlockHeadersInvalid.Wrap(spverrors.Newf("header at height %d doesn't link to the previous header", header.Height))
+	}
+	header.PrevHash = prevHash
+
+	raw, err := serializeHeader(header)
+	if err != nil {
+		return err
+	}
+	hash := hex.EncodeToString(util.ReverseBytes(crypto.Sha256d(raw)))
+	if hash != header.Hash {
+		return chainerrors.ErrBlockHeadersInvalid.Wrap(spverrors.Newf("hash of header at height %d doesn't match its content", header.Height))
+	}
+
+	if !hasProofOfWork(hash, header.Bits) {
+		return chainerrors.ErrBlockHeadersInvalid.Wrap(spverrors.Newf("header at height %d doesn't meet its difficulty target", header.Height))
+	}
+	return nil
+}
+
+// hasProofOfWork checks if the hash is not greater than the target encoded in compact bits form.
+func hasProofOfWork(hash string, bits uint32) bool {
+	target := compactToBig(bits)
+	hashValue, ok := new(big.Int).SetString(hash, 16)
+	if !ok {
+		return false
+	}
+	return target.Sign() > 0 && hashValue.Cmp(target) <= 0
+}
@@ -0,0 +1,20 @@
+package headers
+
+import (
+	"context"
+
+	chainerrors "github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+)
+
+// HealthcheckBHS checks if the embedded block headers store is ready to verify merkle roots.
+func (s *Service) HealthcheckBHS(ctx context.Context) error {
+	tip, err := s.repo.GetTip(ctx)
+	if err != nil {
+		return chainerrors.ErrBHSUnhealthy.Wrap(err)
+	}
+	if tip == nil {
+		return chainerrors.ErrBHSUnhealthy.Wrap(spverrors.Newf("block headers store is empty - headers are not synced (yet)"))
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package headers
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strings"
+
+	chainerrors "github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+)
+
+// importBatchSize is the number of imported headers saved at once
+const importBatchSize = 2000
+
+// importHeaders reads raw 80-byte headers, starting from the genesis block, from the configured file and saves them.
+// It returns the last imported header.
+func (s *Service) importHeaders(ctx context.Context) (*chainmodels.BlockHeader, error) {
+	s.logger.Info().Str("url", s.importURL).Msg("Importing block headers")
+
+	file, err := s.openImportFile(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	reader := bufio.NewReader(file)
+	raw := make([]byte, rawHeaderSize)
+	batch := make([]chainmodels.BlockHeader, 0, importBatchSize)
+	prevHash := genesisPrevHash
+	view := newChainView(ctx, s.repo, s.params)
+	var tip *chainmodels.BlockHeader
+
+	for height := uint32(0); ; height++ {
+		_, err = io.ReadFull(reader, raw)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, chainerrors.ErrBlockHeadersImport.Wrap(err)
+		}
+
+		header := parseRawHeader(raw, height)
+		if err = view.validate(&header, prevHash); err != nil {
+			return nil, err
+		}
+		prevHash = header.Hash
+		batch = append(batch, header)
+
+		if len(batch) == importBatchSize {
+			if tip, err = s.saveImported(ctx, batch); err != nil {
+				return nil, err
+			}
+			batch = batch[:0]
+		}
+	}
+
+	if len(batch) > 0 {
+		if tip, err = s.saveImported(ctx, batch); err != nil {
+			return nil, err
+		}
+	}
+
+	if tip != nil {
+		s.logger.Info().Uint32("height", tip.Height).Msg("Block headers imported")
+	}
+	return tip, nil
+}
+
+func (s *Service) saveImported(ctx context.Context, batch []chainmodels.BlockHeader) (*chainmodels.BlockHeader, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, spverrors.ErrCtxInterrupted.Wrap(err)
+	}
+	if err := s.repo.SaveHeaders(ctx, batch); err != nil {
+		return nil, spverrors.Wrapf(err, "failed to save imported block headers")
+	}
+	tip := batch[len(batch)-1]
+	return &tip, nil
+}
+
+func (s *Service) openImportFile(ctx context.Context) (io.ReadCloser, error) {
+	if !strings.HasPrefix(s.importURL, "http://") && !strings.HasPrefix(s.importURL, "https://") {
+		file, err := os.Open(strings.TrimPrefix(s.importURL, "file://"))
+		if err != nil {
+			return nil, chainerrors.ErrBlockHeadersImport.Wrap(err)
+		}
+		return file, nil
+	}
+
+	res, err := s.httpClient.R().
+		SetContext(ctx).
+		SetDoNotParseResponse(true).
+		Get(s.importURL)
+	if err != nil {
+		return nil, chainerrors.ErrBlockHeadersImport.Wrap(err)
+	}
+	if !res.IsSuccess() {
+		_ = res.RawBody().Close()
+		return nil, chainerrors.ErrBlockHeadersImport.Wrap(spverrors.Newf("download of block headers returned status code %d", res.StatusCode()))
+	}
+	return res.RawBody(), nil
+}
@@ -0,0 +1,29 @@
+package headers
+
+import (
+	"math"
+
+	chainerrors "github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+)
+
+// RegtestBits is the lowest difficulty (used by regtest networks), so headers can be mined in no time.
+const RegtestBits = 0x207fffff
+
+// Mine looks for the nonce which makes the header meet its difficulty target and sets the header's hash.
+// It's meant for regtest difficulty (RegtestBits) only - mining at any real difficulty would take forever.
+func Mine(header *chainmodels.BlockHeader) error {
+	for nonce := uint32(0); nonce < math.MaxUint32; nonce++ {
+		header.Nonce = nonce
+		raw, err := serializeHeader(header)
+		if err != nil {
+			return err
+		}
+		header.Hash = parseRawHeader(raw, header.Height).Hash
+		if hasProofOfWork(header.Hash, header.Bits) {
+			return nil
+		}
+	}
+	return chainerrors.ErrBlockHeadersInvalid.Wrap(spverrors.Newf("cannot mine header at height %d", header.Height))
+}
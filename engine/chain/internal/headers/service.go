@@ -0,0 +1,42 @@
+package headers
+
+import (
+	"sync"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/go-resty/resty/v2"
+	"github.com/rs/zerolog"
+)
+
+// Service is an in-process block headers store - an alternative to the external Block Headers Service (BHS).
+// It keeps the chain of headers with the most work in the datastore and answers the same queries as BHS.
+// Headers are validated against the consensus rules of mainnet (or regtest, when configured).
+type Service struct {
+	logger     zerolog.Logger
+	httpClient *resty.Client
+	repo       chainmodels.BlockHeadersRepo
+	source     chainmodels.BlockHeadersSource
+	importURL  string
+	params     *chainParams
+
+	syncLock sync.Mutex
+}
+
+// NewService creates a new instance of the embedded block headers store.
+func NewService(logger zerolog.Logger, httpClient *resty.Client, cfg *chainmodels.EmbeddedBHSConfig) *Service {
+	if cfg.Repo == nil {
+		panic("block headers repository is required")
+	}
+	params := &mainnetParams
+	if cfg.Regtest {
+		params = &regtestParams
+	}
+	return &Service{
+		logger:     logger,
+		httpClient: httpClient,
+		repo:       cfg.Repo,
+		source:     cfg.Source,
+		importURL:  cfg.ImportURL,
+		params:     params,
+	}
+}
@@ -0,0 +1,447 @@
+package headers
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/bitcoin-sv/go-paymail/spv"
+	chainerrors "github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester"
+	"github.com/go-resty/resty/v2"
+	"github.com/stretchr/testify/require"
+)
+
+// first mainnet block headers
+const (
+	genesisRawHeader = "0100000000000000000000000000000000000000000000000000000000000000000000003ba3edfd7a7b12b27ac72c3e67768f617fc81bc3888a51323a9fb8aa4b1e5e4a29ab5f49ffff001d1dac2b7c"
+	block1RawHeader  = "010000006fe28c0ab6f1b372c1a6a246ae63f74f931e8365e15a089c68d6190000000000982051fd1e4ba744bbbe680e1fee14677ba1a3c3540bf7b1cdb606e857233e0e61bc6649ffff001d01e36299"
+	genesisHash      = "000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f"
+	block1Hash       = "00000000839a8e6886ab5951d76f411475428afc90947ee320161bbf18eb6048"
+	block1MerkleRoot = "0e3e2357e806b6cdb1f70b54c3a3a17b6714ee1f0e68bebb44a74b1efd512098"
+)
+
+func TestImportHeaders(t *testing.T) {
+	// given:
+	importFile := filepath.Join(t.TempDir(), "headers.bin")
+	require.NoError(t, os.WriteFile(importFile, rawHeaders(t, genesisRawHeader, block1RawHeader), 0o600))
+
+	repo := newMemoryRepo()
+	service := newMainnetTestService(t, repo, importFile)
+
+	// when:
+	err := service.Sync(context.Background())
+
+	// then:
+	require.NoError(t, err)
+	require.NoError(t, service.HealthcheckBHS(context.Background()))
+
+	tip, err := repo.GetTip(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), tip.Height)
+	require.Equal(t, block1Hash, tip.Hash)
+	require.Equal(t, genesisHash, tip.PrevHash)
+	require.Equal(t, block1MerkleRoot, tip.MerkleRoot)
+}
+
+func TestImportHeadersWithBrokenChain(t *testing.T) {
+	// given:
+	importFile := filepath.Join(t.TempDir(), "headers.bin")
+	require.NoError(t, os.WriteFile(importFile, rawHeaders(t, block1RawHeader), 0o600))
+
+	repo := newMemoryRepo()
+	service := newMainnetTestService(t, repo, importFile)
+
+	// when:
+	err := service.Sync(context.Background())
+
+	// then:
+	require.ErrorIs(t, err, chainerrors.ErrBlockHeadersInvalid)
+	require.Empty(t, repo.headers)
+}
+
+func TestSyncHeadersFromSource(t *testing.T) {
+	t.Run("sync empty store", func(t *testing.T) {
+		// given:
+		chain := mineChain(t, nil, 0, 5)
+		repo := newMemoryRepo()
+		service := newTestService(t, repo, &fakeSource{headers: chain}, "")
+
+		// when:
+		err := service.Sync(context.Background())
+
+		// then:
+		require.NoError(t, err)
+		require.Len(t, repo.headers, 5)
+		require.Equal(t, chain[4].Hash, repo.headers[4].Hash)
+	})
+
+	t.Run("sync only new headers", func(t *testing.T) {
+		// given:
+		chain := mineChain(t, nil, 0, 5)
+		repo := newMemoryRepo(chain[:3]...)
+		source := &fakeSource{headers: chain}
+		service := newTestService(t, repo, source, "")
+
+		// when:
+		err := service.Sync(context.Background())
+
+		// then:
+		require.NoError(t, err)
+		require.Len(t, repo.headers, 5)
+		require.Equal(t, []uint32{3}, source.requestedFrom)
+	})
+
+	t.Run("replace blocks which are no longer in the longest chain", func(t *testing.T) {
+		// given:
+		common := mineChain(t, nil, 0, 2)
+		stale := mineChain(t, &common[1], 2, 2)
+		longest := append(common, mineChain(t, &common[1], 2, 3)...)
+
+		repo := newMemoryRepo(append(common, stale...)...)
+		service := newTestService(t, repo, &fakeSource{headers: longest}, "")
+
+		// when:
+		err := service.Sync(context.Background())
+
+		// then:
+		require.NoError(t, err)
+		require.Len(t, repo.headers, 5)
+		for _, header := range longest {
+			require.Equal(t, header.Hash, repo.headers[header.Height].Hash)
+		}
+	})
+
+	t.Run("keep stored chain when the competing chain of the source is invalid", func(t *testing.T) {
+		// given:
+		common := mineChain(t, nil, 0, 2)
+		stored := mineChain(t, &common[1], 2, 2)
+		competing := append(common, mineChainWithBits(t, &common[1], 2, 3, RegtestBits-1)...)
+
+		repo := newMemoryRepo(append(common, stored...)...)
+		service := newTestService(t, repo, &fakeSource{headers: competing}, "")
+
+		// when:
+		err := service.Sync(context.Background())
+
+		// then:
+		require.ErrorIs(t, err, chainerrors.ErrBlockHeadersInvalid)
+		require.Len(t, repo.headers, 4)
+		for _, header := range append(common, stored...) {
+			require.Equal(t, header.Hash, repo.headers[header.Height].Hash)
+		}
+	})
+
+	t.Run("reject header with unexpected difficulty", func(t *testing.T) {
+		// given:
+		chain := mineChain(t, nil, 0, 2)
+		chain = append(chain, mineChainWithBits(t, &chain[1], 2, 1, RegtestBits-1)...)
+
+		repo := newMemoryRepo(chain[:2]...)
+		service := newTestService(t, repo, &fakeSource{headers: chain}, "")
+
+		// when:
+		err := service.Sync(context.Background())
+
+		// then:
+		require.ErrorIs(t, err, chainerrors.ErrBlockHeadersInvalid)
+		require.Len(t, repo.headers, 2)
+	})
+
+	t.Run("reject header with invalid hash", func(t *testing.T) {
+		// given:
+		chain := mineChain(t, nil, 0, 3)
+		chain[2].Nonce++
+
+		repo := newMemoryRepo(chain[:2]...)
+		service := newTestService(t, repo, &fakeSource{headers: chain}, "")
+
+		// when:
+		err := service.Sync(context.Background())
+
+		// then:
+		require.ErrorIs(t, err, chainerrors.ErrBlockHeadersInvalid)
+		require.Len(t, repo.headers, 2)
+	})
+
+	t.Run("reject header without proof of work", func(t *testing.T) {
+		// given:
+		header := mineChain(t, nil, 0, 1)[0]
+		header.Bits = 0x1d00ffff
+		raw, err := serializeHeader(&header)
+		require.NoError(t, err)
+		header.Hash = parseRawHeader(raw, 0).Hash
+
+		repo := newMemoryRepo()
+		service := newTestService(t, repo, &fakeSource{headers: []chainmodels.BlockHeader{header}}, "")
+
+		// when:
+		err = service.Sync(context.Background())
+
+		// then:
+		require.ErrorIs(t, err, chainerrors.ErrBlockHeadersInvalid)
+		require.Empty(t, repo.headers)
+	})
+}
+
+func TestVerifyMerkleRoots(t *testing.T) {
+	chain := mineChain(t, nil, 0, 3)
+	service := newTestService(t, newMemoryRepo(chain...), nil, "")
+
+	tests := map[string]struct {
+		merkleRoots []*spv.MerkleRootConfirmationRequestItem
+		expectValid bool
+	}{
+		"confirmed merkle roots": {
+			merkleRoots: []*spv.MerkleRootConfirmationRequestItem{
+				{MerkleRoot: chain[1].MerkleRoot, BlockHeight: 1},
+				{MerkleRoot: chain[2].MerkleRoot, BlockHeight: 2},
+			},
+			expectValid: true,
+		},
+		"merkle root at different height": {
+			merkleRoots: []*spv.MerkleRootConfirmationRequestItem{
+				{MerkleRoot: chain[1].MerkleRoot, BlockHeight: 2},
+			},
+			expectValid: false,
+		},
+		"merkle root above the tip is treated as valid": {
+			merkleRoots: []*spv.MerkleRootConfirmationRequestItem{
+				{MerkleRoot: chain[1].MerkleRoot, BlockHeight: 1},
+				{MerkleRoot: chain[2].MerkleRoot, BlockHeight: 10},
+			},
+			expectValid: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// when:
+			valid, err := service.VerifyMerkleRoots(context.Background(), test.merkleRoots)
+
+			// then:
+			require.NoError(t, err)
+			require.Equal(t, test.expectValid, valid)
+		})
+	}
+
+	t.Run("return error when no merkle roots are provided", func(t *testing.T) {
+		// when:
+		_, err := service.VerifyMerkleRoots(context.Background(), nil)
+
+		// then:
+		require.ErrorIs(t, err, chainerrors.ErrBHSBadRequest)
+	})
+}
+
+func TestGetMerkleRoots(t *testing.T) {
+	chain := mineChain(t, nil, 0, 5)
+	service := newTestService(t, newMemoryRepo(chain...), nil, "")
+
+	t.Run("get merkle roots page by page", func(t *testing.T) {
+		// when:
+		first, err := service.GetMerkleRoots(context.Background(), url.Values{"batchSize": {"3"}})
+
+		// then:
+		require.NoError(t, err)
+		require.Len(t, first.Content, 3)
+		require.Equal(t, 5, first.Page.TotalElements)
+		require.Equal(t, chain[2].MerkleRoot, first.Page.LastEvaluatedKey)
+
+		// when:
+		second, err := service.GetMerkleRoots(context.Background(), url.Values{"batchSize": {"3"}, "lastEvaluatedKey": {first.Page.LastEvaluatedKey}})
+
+		// then:
+		require.NoError(t, err)
+		require.Len(t, second.Content, 2)
+		require.Equal(t, chain[3].MerkleRoot, second.Content[0].MerkleRoot)
+		require.Equal(t, 3, second.Content[0].BlockHeight)
+		require.Empty(t, second.Page.LastEvaluatedKey)
+	})
+
+	t.Run("return error for invalid batch size", func(t *testing.T) {
+		// when:
+		_, err := service.GetMerkleRoots(context.Background(), url.Values{"batchSize": {"-1"}})
+
+		// then:
+		require.ErrorIs(t, err, chainerrors.ErrInvalidBatchSize)
+	})
+
+	t.Run("return error for unknown last evaluated key", func(t *testing.T) {
+		// when:
+		_, err := service.GetMerkleRoots(context.Background(), url.Values{"lastEvaluatedKey": {block1MerkleRoot}})
+
+		// then:
+		require.ErrorIs(t, err, chainerrors.ErrMerkleRootNotFound)
+	})
+}
+
+func TestHealthcheckOfEmptyStore(t *testing.T) {
+	// given:
+	service := newTestService(t, newMemoryRepo(), nil, "")
+
+	// when:
+	err := service.HealthcheckBHS(context.Background())
+
+	// then:
+	require.ErrorIs(t, err, chainerrors.ErrBHSUnhealthy)
+}
+
+// newTestService creates the store validating headers with regtest rules, so mineChain can be used.
+func newTestService(t *testing.T, repo chainmodels.BlockHeadersRepo, source chainmodels.BlockHeadersSource, importURL string) *Service {
+	cfg := &chainmodels.EmbeddedBHSConfig{
+		Repo:      repo,
+		ImportURL: importURL,
+		Regtest:   true,
+	}
+	// assigning a nil pointer to the interface would make it non-nil
+	if source != nil {
+		cfg.Source = source
+	}
+	return NewService(tester.Logger(t), resty.New(), cfg)
+}
+
+func newMainnetTestService(t *testing.T, repo chainmodels.BlockHeadersRepo, importURL string) *Service {
+	return NewService(tester.Logger(t), resty.New(), &chainmodels.EmbeddedBHSConfig{
+		Repo:      repo,
+		ImportURL: importURL,
+	})
+}
+
+func rawHeaders(t *testing.T, headers ...string) []byte {
+	var raw []byte
+	for _, header := range headers {
+		decoded, err := hex.DecodeString(header)
+		require.NoError(t, err)
+		raw = append(raw, decoded...)
+	}
+	return raw
+}
+
+var merkleRootSeed uint64
+
+func nextMerkleRootSeed() uint64 {
+	merkleRootSeed++
+	return merkleRootSeed
+}
+
+// mineChain creates count valid headers (with the lowest difficulty) on top of the prev header.
+func mineChain(t *testing.T, prev *chainmodels.BlockHeader, fromHeight uint32, count int) []chainmodels.BlockHeader {
+	return mineChainWithBits(t, prev, fromHeight, count, RegtestBits)
+}
+
+func mineChainWithBits(t *testing.T, prev *chainmodels.BlockHeader, fromHeight uint32, count int, bits uint32) []chainmodels.BlockHeader {
+	prevHash := genesisPrevHash
+	if prev != nil {
+		prevHash = prev.Hash
+	}
+
+	headers := make([]chainmodels.BlockHeader, 0, count)
+	for i := range count {
+		header := chainmodels.BlockHeader{
+			Height:     fromHeight + uint32(i), //nolint:gosec // small numbers in tests
+			PrevHash:   prevHash,
+			MerkleRoot: fmt.Sprintf("%064x", nextMerkleRootSeed()),
+			Version:    1,
+			Bits:       bits,
+			Timestamp:  time.Unix(1700000000+int64(i), 0).UTC(),
+		}
+		require.NoError(t, Mine(&header))
+		headers = append(headers, header)
+		prevHash = header.Hash
+	}
+	return headers
+}
+
+type fakeSource struct {
+	headers       []chainmodels.BlockHeader
+	requestedFrom []uint32
+}
+
+func (s *fakeSource) GetHeaders(_ context.Context, fromHeight uint32, limit int) ([]chainmodels.BlockHeader, error) {
+	if limit > 1 {
+		s.requestedFrom = append(s.requestedFrom, fromHeight)
+	}
+	var result []chainmodels.BlockHeader
+	for _, header := range s.headers {
+		if header.Height >= fromHeight && len(result) < limit {
+			// the source doesn't have to provide the previous hash
+			header.PrevHash = ""
+			result = append(result, header)
+		}
+	}
+	return result, nil
+}
+
+type memoryRepo struct {
+	headers map[uint32]chainmodels.BlockHeader
+}
+
+func newMemoryRepo(headers ...chainmodels.BlockHeader) *memoryRepo {
+	repo := &memoryRepo{headers: map[uint32]chainmodels.BlockHeader{}}
+	_ = repo.SaveHeaders(context.Background(), headers)
+	return repo
+}
+
+func (r *memoryRepo) GetTip(_ context.Context) (*chainmodels.BlockHeader, error) {
+	var tip *chainmodels.BlockHeader
+	for _, header := range r.headers {
+		if tip == nil || header.Height > tip.Height {
+			tip = &header
+		}
+	}
+	return tip, nil
+}
+
+func (r *memoryRepo) GetByHeight(_ context.Context, height uint32) (*chainmodels.BlockHeader, error) {
+	header, ok := r.headers[height]
+	if !ok {
+		return nil, nil
+	}
+	return &header, nil
+}
+
+func (r *memoryRepo) GetByMerkleRoot(_ context.Context, merkleRoot string) (*chainmodels.BlockHeader, error) {
+	for _, header := range r.headers {
+		if header.MerkleRoot == merkleRoot {
+			return &header, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryRepo) GetRange(_ context.Context, fromHeight uint32, limit int) ([]chainmodels.BlockHeader, error) {
+	var result []chainmodels.BlockHeader
+	for _, header := range r.headers {
+		if header.Height >= fromHeight {
+			result = append(result, header)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Height < result[j].Height })
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+func (r *memoryRepo) SaveHeaders(_ context.Context, headers []chainmodels.BlockHeader) error {
+	for _, header := range headers {
+		r.headers[header.Height] = header
+	}
+	return nil
+}
+
+func (r *memoryRepo) DeleteFromHeight(_ context.Context, height uint32) error {
+	for h := range r.headers {
+		if h >= height {
+			delete(r.headers, h)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package headers
+
+import (
+	"context"
+
+	chainerrors "github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+)
+
+const (
+	// syncBatchSize is the number of headers requested from the source at once
+	syncBatchSize = 1000
+	// maxReorgDepth is the max number of blocks which can be replaced during a single sync
+	maxReorgDepth = 100
+)
+
+// Sync brings the store up to date: it imports headers from the configured file when the store is empty,
+// and then fetches new headers from the source, replacing blocks which are no longer part of the chain with most work.
+func (s *Service) Sync(ctx context.Context) error {
+	if !s.syncLock.TryLock() {
+		s.logger.Debug().Msg("Block headers sync is already in progress")
+		return nil
+	}
+	defer s.syncLock.Unlock()
+
+	tip, err := s.repo.GetTip(ctx)
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to get the tip of block headers")
+	}
+
+	if tip == nil && s.importURL != "" {
+		tip, err = s.importHeaders(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	if s.source == nil {
+		return nil
+	}
+	return s.syncFromSource(ctx, tip)
+}
+
+func (s *Service) syncFromSource(ctx context.Context, tip *chainmodels.BlockHeader) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return spverrors.ErrCtxInterrupted.Wrap(err)
+		}
+
+		fromHeight, _ := nextHeight(tip)
+		headers, err := s.source.GetHeaders(ctx, fromHeight, syncBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(headers) == 0 {
+			return nil
+		}
+
+		if err = s.validateHeaders(ctx, headers, tip); err != nil {
+			if tip == nil {
+				return err
+			}
+			newTip, reorgErr := s.reorganize(ctx, tip)
+			if reorgErr != nil {
+				return reorgErr
+			}
+			if newTip == nil {
+				return err
+			}
+			tip = newTip
+			continue
+		}
+
+		if err = s.repo.SaveHeaders(ctx, headers); err != nil {
+			return spverrors.Wrapf(err, "failed to save block headers")
+		}
+		tip = &headers[len(headers)-1]
+		s.logger.Debug().Uint32("height", tip.Height).Msg("Block headers synced")
+
+		if len(headers) < syncBatchSize {
+			return nil
+		}
+	}
+}
+
+// reorganize replaces the stored blocks above the fork point with the branch provided by the source,
+// but only if the branch is valid and has more cumulative work than the stored one.
+// It returns the new tip, or nil when the stored chain is kept.
+func (s *Service) reorganize(ctx context.Context, tip *chainmodels.BlockHeader) (*chainmodels.BlockHeader, error) {
+	fork, err := s.findForkPoint(ctx, tip)
+	if err != nil {
+		return nil, err
+	}
+	if fork.Height == tip.Height {
+		return nil, nil
+	}
+
+	branch, err := s.source.GetHeaders(ctx, fork.Height+1, syncBatchSize)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.validateHeaders(ctx, branch, fork); err != nil {
+		return nil, err
+	}
+	stored, err := s.repo.GetRange(ctx, fork.Height+1, int(tip.Height-fork.Height))
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get block headers from height %d", fork.Height+1)
+	}
+
+	if chainWork(branch).Cmp(chainWork(stored)) <= 0 {
+		s.logger.Warn().Uint32("forkHeight", fork.Height).Msg("Source provides a competing chain without more work, keeping the stored chain")
+		return nil, nil
+	}
+
+	s.logger.Warn().Uint32("forkHeight", fork.Height).Int("replaced", len(stored)).Msg("Chain reorganization, replacing blocks which are no longer part of the chain with most work")
+	if err = s.repo.SaveHeaders(ctx, branch); err != nil {
+		return nil, spverrors.Wrapf(err, "failed to save block headers")
+	}
+	newTip := &branch[len(branch)-1]
+	if newTip.Height < tip.Height {
+		if err = s.repo.DeleteFromHeight(ctx, newTip.Height+1); err != nil {
+			return nil, spverrors.Wrapf(err, "failed to remove block headers from height %d", newTip.Height+1)
+		}
+	}
+	return newTip, nil
+}
+
+// findForkPoint returns the last stored block which is also part of the chain known by the source.
+func (s *Service) findForkPoint(ctx context.Context, tip *chainmodels.BlockHeader) (*chainmodels.BlockHeader, error) {
+	stored := tip
+	for depth := 0; ; depth++ {
+		headers, err := s.source.GetHeaders(ctx, stored.Height, 1)
+		if err != nil {
+			return nil, err
+		}
+		if len(headers) == 0 || headers[0].Height != stored.Height || headers[0].Hash == stored.Hash {
+			return stored, nil
+		}
+
+		if depth >= maxReorgDepth || stored.Height == 0 {
+			return nil, chainerrors.ErrBlockHeadersInvalid.Wrap(spverrors.Newf("chain reorganization is deeper than %d blocks", maxReorgDepth))
+		}
+		height := stored.Height - 1
+		if stored, err = s.repo.GetByHeight(ctx, height); err != nil {
+			return nil, spverrors.Wrapf(err, "failed to get block header at height %d", height)
+		}
+		if stored == nil {
+			return nil, chainerrors.ErrBlockHeadersInvalid.Wrap(spverrors.Newf("missing block header at height %d", height))
+		}
+	}
+}
+
+// validateHeaders checks if the headers form a valid chain on top of the previous header (nil for genesis).
+func (s *Service) validateHeaders(ctx context.Context, headers []chainmodels.BlockHeader, prev *chainmodels.BlockHeader) error {
+	fromHeight, prevHash := nextHeight(prev)
+	view := newChainView(ctx, s.repo, s.params)
+	for i := range headers {
+		header := &headers[i]
+		if header.Height != fromHeight+uint32(i) { //nolint:gosec // i is limited by the batch size
+			return chainerrors.ErrBlockHeadersInvalid.Wrap(spverrors.Newf("expected header at height %d, got %d", fromHeight+uint32(i), header.Height)) //nolint:gosec // i is limited by the batch size
+		}
+		if err := view.validate(header, prevHash); err != nil {
+			return err
+		}
+		prevHash = header.Hash
+	}
+	return nil
+}
+
+func nextHeight(tip *chainmodels.BlockHeader) (uint32, string) {
+	if tip == nil {
+		return 0, genesisPrevHash
+	}
+	return tip.Height + 1, tip.Hash
+}
@@ -0,0 +1,55 @@
+package headers
+
+import (
+	"context"
+	"math"
+
+	"github.com/bitcoin-sv/go-paymail/spv"
+	chainerrors "github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+)
+
+// VerifyMerkleRoots verifies the merkle roots of the given transactions against the stored longest chain.
+func (s *Service) VerifyMerkleRoots(ctx context.Context, merkleRoots []*spv.MerkleRootConfirmationRequestItem) (bool, error) {
+	state, err := s.ConfirmMerkleRoots(ctx, merkleRoots)
+	if err != nil {
+		return false, err
+	}
+
+	switch state {
+	case chainmodels.MRConfirmed:
+		return true, nil
+	case chainmodels.MRUnableToVerify:
+		s.logger.Warn().Msg("Block headers store is behind the longest chain and could not verify some merkle root(s). Defaulting to treat the provided merkle roots as valid.")
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// ConfirmMerkleRoots returns the state of merkle roots confirmation against the stored longest chain.
+func (s *Service) ConfirmMerkleRoots(ctx context.Context, merkleRoots []*spv.MerkleRootConfirmationRequestItem) (chainmodels.MerkleRootConfirmationState, error) {
+	if len(merkleRoots) == 0 {
+		return "", chainerrors.ErrBHSBadRequest.Wrap(spverrors.Newf("at least one merkleroot is required"))
+	}
+
+	state := chainmodels.MRConfirmed
+	for _, item := range merkleRoots {
+		if item.BlockHeight > math.MaxUint32 {
+			return chainmodels.MRInvalid, nil
+		}
+		header, err := s.repo.GetByHeight(ctx, uint32(item.BlockHeight))
+		if err != nil {
+			return "", spverrors.Wrapf(err, "failed to get block header at height %d", item.BlockHeight)
+		}
+		if header == nil {
+			state = chainmodels.MRUnableToVerify
+			continue
+		}
+		if header.MerkleRoot != item.MerkleRoot {
+			return chainmodels.MRInvalid, nil
+		}
+	}
+	return state, nil
+}
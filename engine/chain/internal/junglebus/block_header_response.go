@@ -0,0 +1,12 @@
+package junglebus
+
+// BlockHeaderResponse represents a block header received from the junglebus.gorillapool external service.
+type BlockHeaderResponse struct {
+	Hash       string `json:"hash"`
+	Height     uint32 `json:"height"`
+	Time       uint32 `json:"time"`
+	Nonce      uint32 `json:"nonce"`
+	Version    uint32 `json:"version"`
+	MerkleRoot string `json:"merkleroot"`
+	Bits       string `json:"bits"`
+}
@@ -0,0 +1,49 @@
+package junglebus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	chainerrors "github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+)
+
+// GetHeaders implements chainmodels.BlockHeadersSource interface to allow fetching block headers from Junglebus
+func (s *Service) GetHeaders(ctx context.Context, fromHeight uint32, limit int) ([]chainmodels.BlockHeader, error) {
+	var result []BlockHeaderResponse
+	req := s.httpClient.R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/json").
+		SetQueryParam("limit", strconv.Itoa(limit)).
+		SetResult(&result)
+
+	response, err := req.Get(fmt.Sprintf("https://junglebus.gorillapool.io/v1/block_header/list/%d", fromHeight))
+	if err != nil {
+		return nil, chainerrors.ErrBlockHeadersSourceFailure.Wrap(err)
+	}
+	if response.StatusCode() != http.StatusOK {
+		return nil, chainerrors.ErrBlockHeadersSourceFailure.Wrap(spverrors.Newf("junglebus returned status code %d", response.StatusCode()))
+	}
+
+	headers := make([]chainmodels.BlockHeader, 0, len(result))
+	for _, header := range result {
+		bits, err := strconv.ParseUint(header.Bits, 16, 32)
+		if err != nil {
+			return nil, chainerrors.ErrBlockHeadersSourceFailure.Wrap(spverrors.Newf("junglebus returned invalid bits %q", header.Bits))
+		}
+		headers = append(headers, chainmodels.BlockHeader{
+			Height:     header.Height,
+			Hash:       header.Hash,
+			MerkleRoot: header.MerkleRoot,
+			Version:    header.Version,
+			Bits:       uint32(bits),
+			Nonce:      header.Nonce,
+			Timestamp:  time.Unix(int64(header.Time), 0).UTC(),
+		})
+	}
+	return headers, nil
+}
@@ -0,0 +1,77 @@
+package regtest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	chainerrors "github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+)
+
+// Broadcast accepts the transaction into the mempool of the node.
+// Inputs are not checked against the node's history (so transactions funded from "outside" can be broadcasted),
+// but spending the same output twice is rejected as a double spend.
+func (n *Node) Broadcast(_ context.Context, tx *sdk.Transaction) (*chainmodels.TXInfo, error) {
+	if len(tx.Inputs) == 0 || len(tx.Outputs) == 0 {
+		return nil, chainerrors.ErrRegtestInvalidTx.Wrap(spverrors.Newf("transaction must have inputs and outputs"))
+	}
+	txID := tx.TxID().String()
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if record, ok := n.txs[txID]; ok {
+		info := record.info
+		return &info, nil
+	}
+
+	for _, input := range tx.Inputs {
+		if spentBy, ok := n.spent[outpoint(input)]; ok && spentBy != txID {
+			return nil, chainerrors.ErrARCProblematicStatus.Wrap(spverrors.Newf("ARC Problematic tx status: %s", chainmodels.DoubleSpendAttempted))
+		}
+	}
+	for _, input := range tx.Inputs {
+		n.spent[outpoint(input)] = txID
+	}
+
+	record := &txRecord{
+		tx: tx,
+		info: chainmodels.TXInfo{
+			TxID:      txID,
+			TXStatus:  chainmodels.SeenOnNetwork,
+			Timestamp: time.Now().UTC(),
+		},
+	}
+	n.txs[txID] = record
+	n.mempool = append(n.mempool, txID)
+
+	info := record.info
+	return &info, nil
+}
+
+// QueryTransaction returns the status of the transaction or nil when the node doesn't know it.
+func (n *Node) QueryTransaction(_ context.Context, txID string) (*chainmodels.TXInfo, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	record, ok := n.txs[txID]
+	if !ok {
+		return nil, nil
+	}
+	info := record.info
+	return &info, nil
+}
+
+// GetFeeUnit returns the mining fee of the node.
+func (n *Node) GetFeeUnit(_ context.Context) (*bsv.FeeUnit, error) {
+	feeUnit := n.feeUnit
+	return &feeUnit, nil
+}
+
+func outpoint(input *sdk.TransactionInput) string {
+	return fmt.Sprintf("%s:%d", input.SourceTXID.String(), input.SourceTxOutIndex)
+}
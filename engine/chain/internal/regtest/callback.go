@@ -0,0 +1,32 @@
+package regtest
+
+import (
+	"context"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+)
+
+// sendCallbacks notifies the ARC callback endpoint about transactions' statuses the same way as ARC does.
+func (n *Node) sendCallbacks(ctx context.Context, txs []chainmodels.TXInfo) {
+	if n.callback == nil || n.callback.URL == "" {
+		return
+	}
+	for _, info := range txs {
+		req := n.httpClient.R().
+			SetContext(ctx).
+			SetHeader("Content-Type", "application/json").
+			SetBody(info)
+		if n.callback.Token != "" {
+			req.SetAuthToken(n.callback.TokenForTx(info.TxID))
+		}
+
+		res, err := req.Post(n.callback.URL)
+		if err != nil {
+			n.logger.Warn().Err(err).Str("txID", info.TxID).Msg("Regtest node failed to send ARC callback")
+			continue
+		}
+		if !res.IsSuccess() {
+			n.logger.Warn().Int("status", res.StatusCode()).Str("txID", info.TxID).Msg("ARC callback endpoint rejected regtest callback")
+		}
+	}
+}
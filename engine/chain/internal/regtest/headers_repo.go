@@ -0,0 +1,87 @@
+package regtest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+)
+
+// headersRepo keeps the headers of the regtest chain in memory; heights are consecutive starting from 0.
+type headersRepo struct {
+	mu      sync.RWMutex
+	headers []chainmodels.BlockHeader
+}
+
+func newHeadersRepo() *headersRepo {
+	return &headersRepo{}
+}
+
+// GetTip returns the header with the highest height or nil when there are no headers yet.
+func (r *headersRepo) GetTip(_ context.Context) (*chainmodels.BlockHeader, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if len(r.headers) == 0 {
+		return nil, nil
+	}
+	tip := r.headers[len(r.headers)-1]
+	return &tip, nil
+}
+
+// GetByHeight returns the header at the given height or nil when there is no such header.
+func (r *headersRepo) GetByHeight(_ context.Context, height uint32) (*chainmodels.BlockHeader, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if int(height) >= len(r.headers) {
+		return nil, nil
+	}
+	header := r.headers[height]
+	return &header, nil
+}
+
+// GetByMerkleRoot returns the header with the given merkle root or nil when there is no such header.
+func (r *headersRepo) GetByMerkleRoot(_ context.Context, merkleRoot string) (*chainmodels.BlockHeader, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, header := range r.headers {
+		if header.MerkleRoot == merkleRoot {
+			return &header, nil
+		}
+	}
+	return nil, nil
+}
+
+// GetRange returns up to limit headers starting from the given height, ordered by height.
+func (r *headersRepo) GetRange(_ context.Context, fromHeight uint32, limit int) ([]chainmodels.BlockHeader, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if int(fromHeight) >= len(r.headers) {
+		return nil, nil
+	}
+	end := min(int(fromHeight)+limit, len(r.headers))
+	return append([]chainmodels.BlockHeader(nil), r.headers[fromHeight:end]...), nil
+}
+
+// SaveHeaders stores the headers, replacing the ones at the same heights.
+func (r *headersRepo) SaveHeaders(_ context.Context, headers []chainmodels.BlockHeader) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, header := range headers {
+		if int(header.Height) < len(r.headers) {
+			r.headers[header.Height] = header
+		} else {
+			r.headers = append(r.headers, header)
+		}
+	}
+	return nil
+}
+
+// DeleteFromHeight removes all headers with the height greater or equal to the given one.
+func (r *headersRepo) DeleteFromHeight(_ context.Context, height uint32) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if int(height) < len(r.headers) {
+		r.headers = r.headers[:height]
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package regtest
+
+import (
+	"github.com/bitcoin-sv/go-sdk/chainhash"
+	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+)
+
+// merkleTree keeps all levels of the block's merkle tree - the leaves (transaction IDs) are at the level 0.
+type merkleTree [][]*chainhash.Hash
+
+func buildMerkleTree(leaves []*chainhash.Hash) merkleTree {
+	tree := merkleTree{leaves}
+	for level := leaves; len(level) > 1; level = tree[len(tree)-1] {
+		parents := make([]*chainhash.Hash, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			right := level[i]
+			if i+1 < len(level) {
+				right = level[i+1]
+			}
+			parents = append(parents, sdk.MerkleTreeParent(level[i], right))
+		}
+		tree = append(tree, parents)
+	}
+	return tree
+}
+
+func (t merkleTree) root() *chainhash.Hash {
+	return t[len(t)-1][0]
+}
+
+// merklePath creates the BUMP of the leaf at the given index.
+func (t merkleTree) merklePath(blockHeight uint32, index int) *sdk.MerklePath {
+	isTxID := true
+	duplicate := true
+
+	path := make([][]*sdk.PathElement, len(t)-1)
+	for level := range path {
+		offset := index >> level
+		sibling := &sdk.PathElement{Offset: uint64(offset ^ 1)} //nolint:gosec // offsets are never negative
+		if offset^1 < len(t[level]) {
+			sibling.Hash = t[level][offset^1]
+		} else {
+			sibling.Duplicate = &duplicate
+		}
+
+		if level > 0 {
+			path[level] = []*sdk.PathElement{sibling}
+			continue
+		}
+		leaf := &sdk.PathElement{Offset: uint64(offset), Hash: t[level][offset], Txid: &isTxID} //nolint:gosec // offsets are never negative
+		if leaf.Offset < sibling.Offset {
+			path[level] = []*sdk.PathElement{leaf, sibling}
+		} else {
+			path[level] = []*sdk.PathElement{sibling, leaf}
+		}
+	}
+	return sdk.NewMerklePath(blockHeight, path)
+}
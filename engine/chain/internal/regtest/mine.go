@@ -0,0 +1,102 @@
+package regtest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bitcoin-sv/go-sdk/chainhash"
+	chainerrors "github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/internal/headers"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+)
+
+// maxBlocksPerRequest limits the number of blocks mined at once
+const maxBlocksPerRequest = 1000
+
+// genesisPrevHash is the previous block hash of the genesis block
+const genesisPrevHash = "0000000000000000000000000000000000000000000000000000000000000000"
+
+// MineBlocks mines the given number of blocks; the first one includes all transactions waiting in the mempool.
+// Mined transactions are announced to the ARC callback endpoint (when configured).
+func (n *Node) MineBlocks(ctx context.Context, count int) ([]chainmodels.BlockHeader, error) {
+	if count <= 0 || count > maxBlocksPerRequest {
+		return nil, chainerrors.ErrRegtestInvalidBlocksCount.Wrap(spverrors.Newf("requested %d blocks, allowed 1 to %d", count, maxBlocksPerRequest))
+	}
+
+	n.mu.Lock()
+	mined := make([]chainmodels.BlockHeader, 0, count)
+	var minedTxs []chainmodels.TXInfo
+	for range count {
+		header, txs, err := n.mineBlock(ctx)
+		if err != nil {
+			n.mu.Unlock()
+			return nil, err
+		}
+		mined = append(mined, *header)
+		minedTxs = append(minedTxs, txs...)
+	}
+	n.mu.Unlock()
+
+	n.sendCallbacks(ctx, minedTxs)
+	return mined, nil
+}
+
+// mineBlock mines a block with all the mempool transactions; it must be called with the lock held.
+func (n *Node) mineBlock(ctx context.Context) (*chainmodels.BlockHeader, []chainmodels.TXInfo, error) {
+	tip, err := n.headers.GetTip(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	height, prevHash := uint32(0), genesisPrevHash
+	if tip != nil {
+		height, prevHash = tip.Height+1, tip.Hash
+	}
+
+	leaves := make([]*chainhash.Hash, 0, len(n.mempool)+1)
+	coinbase := coinbaseHash(height)
+	leaves = append(leaves, &coinbase)
+	for _, txID := range n.mempool {
+		hash, err := chainhash.NewHashFromHex(txID)
+		if err != nil {
+			return nil, nil, spverrors.Wrapf(err, "invalid transaction ID in regtest mempool")
+		}
+		leaves = append(leaves, hash)
+	}
+	tree := buildMerkleTree(leaves)
+
+	header := chainmodels.BlockHeader{
+		Height:     height,
+		PrevHash:   prevHash,
+		MerkleRoot: tree.root().String(),
+		Version:    1,
+		Bits:       headers.RegtestBits,
+		Timestamp:  time.Now().UTC().Truncate(time.Second),
+	}
+	if err = headers.Mine(&header); err != nil {
+		return nil, nil, err
+	}
+	if err = n.headers.SaveHeaders(ctx, []chainmodels.BlockHeader{header}); err != nil {
+		return nil, nil, err
+	}
+
+	minedTxs := make([]chainmodels.TXInfo, 0, len(n.mempool))
+	for i, txID := range n.mempool {
+		record := n.txs[txID]
+		record.info.TXStatus = chainmodels.Mined
+		record.info.BlockHash = header.Hash
+		record.info.BlockHeight = int64(height)
+		record.info.MerklePath = tree.merklePath(height, i+1).Hex()
+		record.info.Timestamp = header.Timestamp
+		minedTxs = append(minedTxs, record.info)
+	}
+	n.mempool = nil
+
+	return &header, minedTxs, nil
+}
+
+// coinbaseHash is a stand-in for the coinbase transaction ID - the first leaf of every block's merkle tree.
+func coinbaseHash(height uint32) chainhash.Hash {
+	return chainhash.DoubleHashH([]byte(fmt.Sprintf("regtest coinbase %d", height)))
+}
@@ -0,0 +1,61 @@
+package regtest
+
+import (
+	"context"
+	"sync"
+
+	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/go-resty/resty/v2"
+	"github.com/rs/zerolog"
+)
+
+// defaultFeeUnit is the mining fee of the regtest node when none is configured
+var defaultFeeUnit = bsv.FeeUnit{Satoshis: 1, Bytes: 1000}
+
+// Node is an in-process stand-in for ARC and the Block Headers Service, meant for local development and tests.
+// It accepts broadcasted transactions, mines blocks on demand and calls back the ARC callback endpoint
+// with the merkle paths of mined transactions. Everything is kept in memory.
+type Node struct {
+	logger     zerolog.Logger
+	httpClient *resty.Client
+	callback   *chainmodels.ARCCallbackConfig
+	feeUnit    bsv.FeeUnit
+
+	mu      sync.Mutex
+	headers *headersRepo
+	txs     map[string]*txRecord
+	spent   map[string]string
+	mempool []string
+}
+
+type txRecord struct {
+	tx   *sdk.Transaction
+	info chainmodels.TXInfo
+}
+
+// NewNode creates a new regtest node with the genesis block already mined.
+func NewNode(logger zerolog.Logger, httpClient *resty.Client, callback *chainmodels.ARCCallbackConfig, cfg chainmodels.RegtestConfig) *Node {
+	n := &Node{
+		logger:     logger,
+		httpClient: httpClient,
+		callback:   callback,
+		feeUnit:    defaultFeeUnit,
+		headers:    newHeadersRepo(),
+		txs:        map[string]*txRecord{},
+		spent:      map[string]string{},
+	}
+	if cfg.FeeUnit != nil {
+		n.feeUnit = *cfg.FeeUnit
+	}
+	if _, _, err := n.mineBlock(context.Background()); err != nil {
+		panic("cannot mine regtest genesis block: " + err.Error())
+	}
+	return n
+}
+
+// Headers returns the headers of the blocks mined by the node.
+func (n *Node) Headers() chainmodels.BlockHeadersRepo {
+	return n.headers
+}
@@ -0,0 +1,165 @@
+package regtest_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bitcoin-sv/go-paymail/spv"
+	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	chainerrors "github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/internal/headers"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/internal/regtest"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures/txtestability"
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	callbackURL   = "http://wallet.example.com/transaction/broadcast/callback"
+	callbackToken = "callback-token"
+)
+
+func TestBroadcastAndMine(t *testing.T) {
+	// given:
+	given := txtestability.Given(t)
+	txs := []txtestability.TransactionSpec{
+		given.Tx().WithInput(10).WithP2PKHOutput(1),
+		given.Tx().WithInput(20).WithP2PKHOutput(2),
+		given.Tx().WithInput(30).WithP2PKHOutput(3),
+		given.Tx().WithInput(40).WithP2PKHOutput(4),
+	}
+	node := regtest.NewNode(tester.Logger(t), resty.New(), nil, chainmodels.RegtestConfig{})
+
+	for _, tx := range txs {
+		// when:
+		info, err := node.Broadcast(context.Background(), tx.TX())
+
+		// then:
+		require.NoError(t, err)
+		require.Equal(t, chainmodels.SeenOnNetwork, info.TXStatus)
+	}
+
+	// when:
+	mined, err := node.MineBlocks(context.Background(), 2)
+
+	// then:
+	require.NoError(t, err)
+	require.Len(t, mined, 2)
+	require.EqualValues(t, 1, mined[0].Height)
+	require.EqualValues(t, 2, mined[1].Height)
+	require.Equal(t, mined[0].Hash, mined[1].PrevHash)
+
+	for _, tx := range txs {
+		// when:
+		info, err := node.QueryTransaction(context.Background(), tx.ID())
+
+		// then:
+		require.NoError(t, err)
+		require.Equal(t, chainmodels.Mined, info.TXStatus)
+		require.EqualValues(t, 1, info.BlockHeight)
+		require.Equal(t, mined[0].Hash, info.BlockHash)
+
+		// and:
+		merklePath, err := sdk.NewMerklePathFromHex(info.MerklePath)
+		require.NoError(t, err)
+		txID := tx.ID()
+		root, err := merklePath.ComputeRootHex(&txID)
+		require.NoError(t, err)
+		require.Equal(t, mined[0].MerkleRoot, root)
+	}
+}
+
+func TestMinedBlocksAreKnownToBlockHeadersService(t *testing.T) {
+	// given:
+	tx := txtestability.Given(t).Tx().WithInput(10).WithP2PKHOutput(1)
+	node := regtest.NewNode(tester.Logger(t), resty.New(), nil, chainmodels.RegtestConfig{})
+	bhs := headers.NewService(tester.Logger(t), resty.New(), &chainmodels.EmbeddedBHSConfig{Repo: node.Headers()})
+
+	_, err := node.Broadcast(context.Background(), tx.TX())
+	require.NoError(t, err)
+	mined, err := node.MineBlocks(context.Background(), 1)
+	require.NoError(t, err)
+
+	// when:
+	valid, err := bhs.VerifyMerkleRoots(context.Background(), []*spv.MerkleRootConfirmationRequestItem{{
+		MerkleRoot:  mined[0].MerkleRoot,
+		BlockHeight: uint64(mined[0].Height),
+	}})
+
+	// then:
+	require.NoError(t, err)
+	require.True(t, valid)
+}
+
+func TestDoubleSpend(t *testing.T) {
+	// given:
+	given := txtestability.Given(t)
+	tx := given.Tx().WithInput(10).WithP2PKHOutput(1)
+	doubleSpend := given.Tx().WithInputFromUTXO(tx.InputSourceTX(0), tx.InputUTXO(0).Vout).WithP2PKHOutput(2)
+	node := regtest.NewNode(tester.Logger(t), resty.New(), nil, chainmodels.RegtestConfig{})
+
+	_, err := node.Broadcast(context.Background(), tx.TX())
+	require.NoError(t, err)
+
+	// when:
+	_, err = node.Broadcast(context.Background(), doubleSpend.TX())
+
+	// then:
+	require.ErrorIs(t, err, chainerrors.ErrARCProblematicStatus)
+
+	// and when:
+	info, err := node.Broadcast(context.Background(), tx.TX())
+
+	// then:
+	require.NoError(t, err)
+	require.Equal(t, tx.ID(), info.TxID)
+}
+
+func TestCallbackOnMinedTransaction(t *testing.T) {
+	// given:
+	tx := txtestability.Given(t).Tx().WithInput(10).WithP2PKHOutput(1)
+
+	transport := httpmock.NewMockTransport()
+	client := resty.New()
+	client.GetClient().Transport = transport
+
+	var callbacks []chainmodels.TXInfo
+	transport.RegisterResponder(http.MethodPost, callbackURL, func(req *http.Request) (*http.Response, error) {
+		require.Equal(t, "Bearer "+callbackToken, req.Header.Get("Authorization"))
+		var info chainmodels.TXInfo
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&info))
+		callbacks = append(callbacks, info)
+		return httpmock.NewStringResponse(http.StatusOK, ""), nil
+	})
+
+	node := regtest.NewNode(tester.Logger(t), client, &chainmodels.ARCCallbackConfig{URL: callbackURL, Token: callbackToken}, chainmodels.RegtestConfig{})
+	_, err := node.Broadcast(context.Background(), tx.TX())
+	require.NoError(t, err)
+
+	// when:
+	mined, err := node.MineBlocks(context.Background(), 1)
+
+	// then:
+	require.NoError(t, err)
+	require.Len(t, callbacks, 1)
+	require.Equal(t, tx.ID(), callbacks[0].TxID)
+	require.Equal(t, chainmodels.Mined, callbacks[0].TXStatus)
+	require.Equal(t, mined[0].Hash, callbacks[0].BlockHash)
+	require.NotEmpty(t, callbacks[0].MerklePath)
+}
+
+func TestMineInvalidNumberOfBlocks(t *testing.T) {
+	// given:
+	node := regtest.NewNode(tester.Logger(t), resty.New(), nil, chainmodels.RegtestConfig{})
+
+	// when:
+	_, err := node.MineBlocks(context.Background(), 0)
+
+	// then:
+	require.ErrorIs(t, err, chainerrors.ErrRegtestInvalidBlocksCount)
+}
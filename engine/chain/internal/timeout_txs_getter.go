@@ -0,0 +1,50 @@
+package internal
+
+import (
+	"context"
+	"iter"
+	"time"
+
+	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/rs/zerolog"
+)
+
+// WithTimeout limits the time of getting transactions from the provider.
+// When the provider fails or doesn't answer in time, the failure is logged and no transactions are returned,
+// so the next TransactionsGetter combined by CombineTxsGetters is asked instead.
+func WithTimeout(logger zerolog.Logger, name string, getter chainmodels.TransactionsGetter, timeout time.Duration) chainmodels.TransactionsGetter {
+	return &timeoutTxsGetter{
+		logger:  logger,
+		name:    name,
+		getter:  getter,
+		timeout: timeout,
+	}
+}
+
+type timeoutTxsGetter struct {
+	logger  zerolog.Logger
+	name    string
+	getter  chainmodels.TransactionsGetter
+	timeout time.Duration
+}
+
+func (g *timeoutTxsGetter) GetTransactions(ctx context.Context, ids iter.Seq[string]) ([]*sdk.Transaction, error) {
+	providerCtx := ctx
+	if g.timeout > 0 {
+		var cancel context.CancelFunc
+		providerCtx, cancel = context.WithTimeout(ctx, g.timeout)
+		defer cancel()
+	}
+
+	txs, err := g.getter.GetTransactions(providerCtx, ids)
+	if err != nil {
+		if ctx.Err() != nil {
+			// the caller gave up, so there is no point in asking other providers
+			return nil, ctx.Err()
+		}
+		g.logger.Warn().Err(err).Str("provider", g.name).Msg("Transactions provider failed, skipping it")
+		return nil, nil
+	}
+	return txs, nil
+}
@@ -0,0 +1,136 @@
+package txbatch
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/rs/zerolog"
+)
+
+const (
+	defaultMaxSize  = 50
+	defaultInterval = 100 * time.Millisecond
+)
+
+// BatchBroadcaster submits multiple transactions in a single request.
+type BatchBroadcaster interface {
+	BroadcastBatch(ctx context.Context, txs []*sdk.Transaction) ([]chainmodels.BroadcastResult, error)
+}
+
+type pending struct {
+	ctx    context.Context
+	tx     *sdk.Transaction
+	result chan chainmodels.BroadcastResult
+}
+
+// Batcher groups transactions broadcasted within a short interval and submits them together.
+// Transactions are submitted in the order they were broadcasted, so a child never precedes its parent in a batch.
+type Batcher struct {
+	logger      zerolog.Logger
+	broadcaster BatchBroadcaster
+	maxSize     int
+	interval    time.Duration
+
+	mu      sync.Mutex
+	pending []*pending
+	timer   *time.Timer
+}
+
+// NewBatcher creates a new batcher which flushes after the interval passes since the first queued transaction
+// or when maxSize transactions are queued, whichever comes first.
+func NewBatcher(logger zerolog.Logger, broadcaster BatchBroadcaster, maxSize int, interval time.Duration) *Batcher {
+	if maxSize <= 0 {
+		maxSize = defaultMaxSize
+	}
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+	return &Batcher{
+		logger:      logger,
+		broadcaster: broadcaster,
+		maxSize:     maxSize,
+		interval:    interval,
+	}
+}
+
+// Broadcast queues the transaction for the next batch and waits for its result.
+func (b *Batcher) Broadcast(ctx context.Context, tx *sdk.Transaction) (*chainmodels.TXInfo, error) {
+	p := &pending{
+		ctx:    ctx,
+		tx:     tx,
+		result: make(chan chainmodels.BroadcastResult, 1),
+	}
+	b.enqueue(p)
+
+	select {
+	case result := <-p.result:
+		return result.TXInfo, result.Err
+	case <-ctx.Done():
+		return nil, chainerrors.ErrARCUnreachable.Wrap(ctx.Err())
+	}
+}
+
+func (b *Batcher) enqueue(p *pending) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.pending = append(b.pending, p)
+	if len(b.pending) >= b.maxSize {
+		go b.flush(b.take())
+		return
+	}
+	if b.timer == nil {
+		b.timer = time.AfterFunc(b.interval, func() {
+			b.mu.Lock()
+			batch := b.take()
+			b.mu.Unlock()
+			b.flush(batch)
+		})
+	}
+}
+
+// take returns the queued transactions and resets the queue; it must be called with the lock held.
+func (b *Batcher) take() []*pending {
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+	batch := b.pending
+	b.pending = nil
+	return batch
+}
+
+func (b *Batcher) flush(batch []*pending) {
+	if len(batch) == 0 {
+		return
+	}
+
+	txs := make([]*sdk.Transaction, 0, len(batch))
+	for _, p := range batch {
+		txs = append(txs, p.tx)
+	}
+
+	// The batch outlives a single caller, so it shouldn't be interrupted when one of them gives up.
+	ctx := context.WithoutCancel(batch[0].ctx)
+
+	results, err := b.broadcaster.BroadcastBatch(ctx, txs)
+	if err == nil && len(results) != len(batch) {
+		err = spverrors.Newf("expected %d broadcast results, got %d", len(batch), len(results))
+	}
+	if err != nil {
+		b.logger.Warn().Err(err).Int("size", len(batch)).Msg("Failed to broadcast batch of transactions")
+		for _, p := range batch {
+			p.result <- chainmodels.BroadcastResult{Err: err}
+		}
+		return
+	}
+
+	for i, p := range batch {
+		p.result <- results[i]
+	}
+}
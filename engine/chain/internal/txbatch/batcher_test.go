@@ -0,0 +1,140 @@
+package txbatch_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/internal/txbatch"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type mockBatchBroadcaster struct {
+	mu      sync.Mutex
+	batches [][]*sdk.Transaction
+	err     error
+}
+
+func (m *mockBatchBroadcaster) BroadcastBatch(_ context.Context, txs []*sdk.Transaction) ([]chainmodels.BroadcastResult, error) {
+	m.mu.Lock()
+	m.batches = append(m.batches, txs)
+	m.mu.Unlock()
+
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	results := make([]chainmodels.BroadcastResult, 0, len(txs))
+	for _, tx := range txs {
+		results = append(results, chainmodels.BroadcastResult{TXInfo: &chainmodels.TXInfo{
+			TxID:     tx.TxID().String(),
+			TXStatus: chainmodels.SeenOnNetwork,
+		}})
+	}
+	return results, nil
+}
+
+func (m *mockBatchBroadcaster) batchSizes() []int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	sizes := make([]int, 0, len(m.batches))
+	for _, batch := range m.batches {
+		sizes = append(sizes, len(batch))
+	}
+	return sizes
+}
+
+func TestBatcher(t *testing.T) {
+	t.Run("group transactions broadcasted within interval", func(t *testing.T) {
+		// given:
+		broadcaster := &mockBatchBroadcaster{}
+		batcher := txbatch.NewBatcher(zerolog.Nop(), broadcaster, 10, 50*time.Millisecond)
+
+		// when:
+		results := broadcastConcurrently(t, batcher, 3)
+
+		// then:
+		require.Equal(t, []int{3}, broadcaster.batchSizes())
+		for txID, info := range results {
+			require.Equal(t, txID, info.TxID)
+		}
+	})
+
+	t.Run("submit batch immediately when max size is reached", func(t *testing.T) {
+		// given:
+		broadcaster := &mockBatchBroadcaster{}
+		batcher := txbatch.NewBatcher(zerolog.Nop(), broadcaster, 2, time.Hour)
+
+		// when:
+		broadcastConcurrently(t, batcher, 4)
+
+		// then:
+		require.Equal(t, []int{2, 2}, broadcaster.batchSizes())
+	})
+
+	t.Run("return batch error to every caller", func(t *testing.T) {
+		// given:
+		broadcaster := &mockBatchBroadcaster{err: chainerrors.ErrARCUnreachable}
+		batcher := txbatch.NewBatcher(zerolog.Nop(), broadcaster, 10, time.Millisecond)
+
+		// when:
+		info, err := batcher.Broadcast(context.Background(), txWithLockTime(1))
+
+		// then:
+		require.ErrorIs(t, err, chainerrors.ErrARCUnreachable)
+		require.Nil(t, info)
+	})
+
+	t.Run("stop waiting when context is canceled", func(t *testing.T) {
+		// given:
+		broadcaster := &mockBatchBroadcaster{}
+		batcher := txbatch.NewBatcher(zerolog.Nop(), broadcaster, 10, time.Hour)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancel()
+
+		// when:
+		info, err := batcher.Broadcast(ctx, txWithLockTime(1))
+
+		// then:
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Nil(t, info)
+	})
+}
+
+func broadcastConcurrently(t *testing.T, batcher *txbatch.Batcher, count int) map[string]*chainmodels.TXInfo {
+	t.Helper()
+
+	var mu sync.Mutex
+	results := make(map[string]*chainmodels.TXInfo, count)
+
+	var wg sync.WaitGroup
+	for i := range count {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tx := txWithLockTime(uint32(i))
+			info, err := batcher.Broadcast(context.Background(), tx)
+			require.NoError(t, err)
+
+			mu.Lock()
+			results[tx.TxID().String()] = info
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
+
+// txWithLockTime returns a transaction with a unique ID for each lock time.
+func txWithLockTime(lockTime uint32) *sdk.Transaction {
+	tx := sdk.NewTransaction()
+	tx.LockTime = lockTime
+	return tx
+}
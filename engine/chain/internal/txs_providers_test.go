@@ -0,0 +1,146 @@
+package internal_test
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"testing"
+	"time"
+
+	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/internal"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester"
+	"github.com/mrz1836/go-cachestore"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxsGetterWithTimeout(t *testing.T) {
+	tx1 := fromHex(tx1Hex)
+	tx2 := fromHex(tx2Hex)
+
+	t.Run("slow provider is skipped in favor of the next one", func(t *testing.T) {
+		// given:
+		getter := internal.CombineTxsGetters(
+			internal.WithTimeout(tester.Logger(t), "slow", &mockTxsGetter{applyTimeout: true}, time.Millisecond),
+			&mockTxsGetter{transactions: []*sdk.Transaction{tx1}},
+		)
+
+		// when:
+		transactions, err := getter.GetTransactions(context.Background(), ids(tx1))
+
+		// then:
+		require.NoError(t, err)
+		require.Len(t, transactions, 1)
+		shouldAllContain(t, transactions, ids(tx1))
+	})
+
+	t.Run("failing provider is skipped in favor of the next one", func(t *testing.T) {
+		// given:
+		getter := internal.CombineTxsGetters(
+			internal.WithTimeout(tester.Logger(t), "failing", &mockTxsGetter{returnError: errors.New("provider down")}, time.Second),
+			&mockTxsGetter{transactions: []*sdk.Transaction{tx2}},
+		)
+
+		// when:
+		transactions, err := getter.GetTransactions(context.Background(), ids(tx2))
+
+		// then:
+		require.NoError(t, err)
+		shouldAllContain(t, transactions, ids(tx2))
+	})
+
+	t.Run("interrupted caller is not hidden", func(t *testing.T) {
+		// given:
+		getter := internal.WithTimeout(tester.Logger(t), "slow", &mockTxsGetter{applyTimeout: true}, time.Minute)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancel()
+
+		// when:
+		_, err := getter.GetTransactions(ctx, ids(tx1))
+
+		// then:
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestTxsGetterWithCache(t *testing.T) {
+	tx1 := fromHex(tx1Hex)
+	tx2 := fromHex(tx2Hex)
+
+	t.Run("transactions are fetched from the provider only once", func(t *testing.T) {
+		// given:
+		provider := &countingTxsGetter{transactions: []*sdk.Transaction{tx1, tx2}}
+		getter := internal.WithCache(tester.Logger(t), provider, &chainmodels.TxsCacheConfig{Store: newMemoryTxsCache()})
+
+		// when:
+		transactions, err := getter.GetTransactions(context.Background(), ids(tx1))
+
+		// then:
+		require.NoError(t, err)
+		shouldAllContain(t, transactions, ids(tx1))
+		require.Equal(t, [][]string{{id(tx1)}}, provider.requested)
+
+		// when:
+		transactions, err = getter.GetTransactions(context.Background(), ids(tx1, tx2))
+
+		// then:
+		require.NoError(t, err)
+		require.Len(t, transactions, 2)
+		shouldAllContain(t, transactions, ids(tx1, tx2))
+		require.Equal(t, [][]string{{id(tx1)}, {id(tx2)}}, provider.requested)
+	})
+
+	t.Run("no cache store", func(t *testing.T) {
+		// given:
+		provider := &countingTxsGetter{transactions: []*sdk.Transaction{tx1}}
+
+		// when:
+		getter := internal.WithCache(tester.Logger(t), provider, &chainmodels.TxsCacheConfig{})
+
+		// then:
+		require.Same(t, provider, getter)
+	})
+}
+
+type countingTxsGetter struct {
+	transactions []*sdk.Transaction
+	requested    [][]string
+}
+
+func (g *countingTxsGetter) GetTransactions(_ context.Context, txIDs iter.Seq[string]) ([]*sdk.Transaction, error) {
+	var requested []string
+	var result []*sdk.Transaction
+	for txID := range txIDs {
+		requested = append(requested, txID)
+		for _, tx := range g.transactions {
+			if id(tx) == txID {
+				result = append(result, tx)
+			}
+		}
+	}
+	g.requested = append(g.requested, requested)
+	return result, nil
+}
+
+type memoryTxsCache struct {
+	values map[string]string
+}
+
+func newMemoryTxsCache() *memoryTxsCache {
+	return &memoryTxsCache{values: map[string]string{}}
+}
+
+func (c *memoryTxsCache) GetModel(_ context.Context, key string, model interface{}) error {
+	value, ok := c.values[key]
+	if !ok {
+		return cachestore.ErrKeyNotFound
+	}
+	*(model.(*string)) = value
+	return nil
+}
+
+func (c *memoryTxsCache) SetModel(_ context.Context, key string, model interface{}, _ time.Duration, _ ...string) error {
+	c.values[key] = model.(string)
+	return nil
+}
@@ -0,0 +1,32 @@
+package whatsonchain
+
+import (
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/rs/zerolog"
+)
+
+// DefaultURL is the base url of WhatsOnChain API for mainnet.
+const DefaultURL = "https://api.whatsonchain.com/v1/bsv/main"
+
+// Service for WhatsOnChain-style REST API requests.
+type Service struct {
+	logger     zerolog.Logger
+	httpClient *resty.Client
+	url        string
+	token      string
+}
+
+// NewWhatsOnChainService creates a new WhatsOnChain service.
+func NewWhatsOnChainService(logger zerolog.Logger, httpClient *resty.Client, url, token string) *Service {
+	if url == "" {
+		url = DefaultURL
+	}
+	return &Service{
+		logger:     logger,
+		httpClient: httpClient,
+		url:        strings.TrimSuffix(url, "/"),
+		token:      token,
+	}
+}
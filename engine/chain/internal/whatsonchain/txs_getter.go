@@ -0,0 +1,73 @@
+package whatsonchain
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"slices"
+
+	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	chainerrors "github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+)
+
+// maxTxsPerRequest is the limit of transactions in a single bulk request of WhatsOnChain API
+const maxTxsPerRequest = 20
+
+type txsHexRequest struct {
+	TxIDs []string `json:"txids"`
+}
+
+type txHexResponse struct {
+	TxID  string `json:"txid"`
+	Hex   string `json:"hex"`
+	Error string `json:"error,omitempty"`
+}
+
+// GetTransactions implements chainmodels.TransactionsGetter interface to allow fetching transactions from WhatsOnChain
+func (s *Service) GetTransactions(ctx context.Context, ids iter.Seq[string]) ([]*sdk.Transaction, error) {
+	var transactions []*sdk.Transaction
+	for chunk := range slices.Chunk(slices.Collect(ids), maxTxsPerRequest) {
+		txs, err := s.fetchTransactions(ctx, chunk)
+		if err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, txs...)
+	}
+	return transactions, nil
+}
+
+func (s *Service) fetchTransactions(ctx context.Context, txIDs []string) ([]*sdk.Transaction, error) {
+	var result []txHexResponse
+	req := s.httpClient.R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/json").
+		SetBody(txsHexRequest{TxIDs: txIDs}).
+		SetResult(&result)
+
+	if s.token != "" {
+		req.SetHeader("Authorization", s.token)
+	}
+
+	response, err := req.Post(s.url + "/txs/hex")
+	if err != nil {
+		return nil, chainerrors.ErrWhatsOnChainFailure.Wrap(err)
+	}
+	if response.StatusCode() != http.StatusOK {
+		return nil, chainerrors.ErrWhatsOnChainFailure.Wrap(spverrors.Newf("whatsonchain returned status code %d", response.StatusCode()))
+	}
+
+	transactions := make([]*sdk.Transaction, 0, len(result))
+	for _, item := range result {
+		if item.Error != "" || item.Hex == "" {
+			// transaction is not known by WhatsOnChain
+			continue
+		}
+		tx, err := sdk.NewTransactionFromHex(item.Hex)
+		if err != nil {
+			return nil, chainerrors.ErrWhatsOnChainFailure.Wrap(err)
+		}
+		transactions = append(transactions, tx)
+	}
+	return transactions, nil
+}
@@ -0,0 +1,28 @@
+package chainmodels
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+)
+
+// TokenForTx returns the callback token which ARC should send back with callbacks about the transaction.
+// With TokenPerTransaction, it is an HMAC of the transaction ID keyed with the configured token,
+// so a token leaked with one callback cannot be used to forge callbacks about other transactions.
+func (c *ARCCallbackConfig) TokenForTx(txID string) string {
+	if !c.TokenPerTransaction {
+		return c.Token
+	}
+	mac := hmac.New(sha256.New, []byte(c.Token))
+	mac.Write([]byte(txID))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyToken checks (in constant time) if the token received with the callback about the transaction is valid.
+func (c *ARCCallbackConfig) VerifyToken(txID, token string) bool {
+	if c.Token == "" || (c.TokenPerTransaction && txID == "") {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(c.TokenForTx(txID)), []byte(token)) == 1
+}
@@ -3,6 +3,7 @@ package chainmodels
 import (
 	"context"
 	"iter"
+	"time"
 
 	sdk "github.com/bitcoin-sv/go-sdk/transaction"
 )
@@ -16,6 +17,32 @@ type TransactionsGetter interface {
 type ARCCallbackConfig struct {
 	URL   string
 	Token string
+	// TokenPerTransaction makes every broadcast carry its own callback token bound to the transaction ID (see TokenForTx).
+	TokenPerTransaction bool
+}
+
+// ARCBroadcastPolicy defines how transactions are broadcast when more than one ARC endpoint is configured.
+type ARCBroadcastPolicy string
+
+const (
+	// ARCBroadcastPolicyFailover broadcasts to the first healthy endpoint and falls back to the next ones when the endpoint fails.
+	ARCBroadcastPolicyFailover ARCBroadcastPolicy = "failover"
+	// ARCBroadcastPolicyFanOut broadcasts to all endpoints at once and returns the first successful result.
+	ARCBroadcastPolicyFanOut ARCBroadcastPolicy = "fan_out"
+)
+
+// ARCEndpoint is an additional ARC server used next to the primary one.
+type ARCEndpoint struct {
+	URL   string
+	Token string
+}
+
+// ARCBatchConfig enables grouping of broadcasted transactions into batches submitted through ARC's /v1/txs endpoint.
+type ARCBatchConfig struct {
+	// MaxSize is the number of transactions which triggers submitting the batch immediately.
+	MaxSize int
+	// Interval is how long the first transaction in a batch waits for others before the batch is submitted.
+	Interval time.Duration
 }
 
 // ARCConfig is the configuration for the ARC API.
@@ -27,4 +54,19 @@ type ARCConfig struct {
 	Callback     *ARCCallbackConfig
 	UseJunglebus bool
 	TxsGetter    TransactionsGetter
+	// TxsProviders are external sources of transactions queried, in order, for transactions not found by the TxsGetter.
+	TxsProviders []TxsProviderConfig
+	// TxsCache (optional) caches transactions fetched from the TxsProviders.
+	TxsCache *TxsCacheConfig
+	// BroadcastBEEF enables broadcasting fully sourced transactions in BEEF format (ARC must support it).
+	BroadcastBEEF bool
+
+	// Endpoints are additional ARC servers; the primary one (URL and Token) is always the first endpoint.
+	Endpoints []ARCEndpoint
+	// BroadcastPolicy defaults to ARCBroadcastPolicyFailover.
+	BroadcastPolicy ARCBroadcastPolicy
+	// EndpointCooldown is how long an endpoint is considered unhealthy after it failed.
+	EndpointCooldown time.Duration
+	// Batch enables batch broadcasting when set.
+	Batch *ARCBatchConfig
 }
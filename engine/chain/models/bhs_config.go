@@ -1,7 +1,72 @@
 package chainmodels
 
+import (
+	"context"
+	"time"
+)
+
 // BHSConfig consists of AuthToken and URL used to communicate with BlockHeadersService (BHS)
 type BHSConfig struct {
 	AuthToken string
 	URL       string
+	// Embedded enables the in-process block headers store which is used instead of the external BHS.
+	Embedded *EmbeddedBHSConfig
+
+	// Cache enables caching of merkle roots confirmed by BHS.
+	Cache *MerkleRootsCacheConfig
+	// CircuitBreaker enables failing fast when BHS is down.
+	CircuitBreaker *BHSCircuitBreakerConfig
+	// Metrics (optional) collects cache hits and misses and the latency of merkle roots verification.
+	Metrics MerkleRootsMetrics
+}
+
+// EmbeddedBHSConfig is the configuration of the in-process block headers store.
+type EmbeddedBHSConfig struct {
+	Repo BlockHeadersRepo
+	// Source provides new headers; defaults to Junglebus when not set.
+	Source BlockHeadersSource
+	// ImportURL points to a file (local path or http url) with raw 80-byte headers starting from the genesis block.
+	// It is imported when the store is empty.
+	ImportURL string
+	// Regtest validates headers with the rules of regtest networks (no difficulty adjustment) instead of the mainnet ones.
+	Regtest bool
+}
+
+// MerkleRootsCache is the subset of the cachestore used to keep confirmed merkle roots.
+type MerkleRootsCache interface {
+	GetModel(ctx context.Context, key string, model interface{}) error
+	SetModel(ctx context.Context, key string, model interface{}, ttl time.Duration, dependencies ...string) error
+}
+
+// MerkleRootsCacheConfig is the configuration of the confirmed merkle roots cache.
+type MerkleRootsCacheConfig struct {
+	Store MerkleRootsCache
+	TTL   time.Duration
+}
+
+// BHSDownPolicy defines what happens with merkle roots verification while BHS is down.
+type BHSDownPolicy string
+
+const (
+	// BHSDownReject fails the verification, so the incoming transaction is rejected.
+	BHSDownReject BHSDownPolicy = "reject"
+	// BHSDownQueue fails the verification with ErrMerkleRootsDeferred, so the incoming transaction can be kept and verified later, when BHS is back.
+	BHSDownQueue BHSDownPolicy = "queue"
+)
+
+// BHSCircuitBreakerConfig is the configuration of the circuit breaker for BHS requests.
+type BHSCircuitBreakerConfig struct {
+	// FailureThreshold is the number of consecutive failures which opens the circuit.
+	FailureThreshold int
+	// OpenTimeout is how long the circuit stays open before a trial request is let through.
+	OpenTimeout time.Duration
+	// WhenDown defaults to BHSDownReject.
+	WhenDown BHSDownPolicy
+}
+
+// MerkleRootsMetrics collects metrics of merkle roots verification.
+type MerkleRootsMetrics interface {
+	IncMerkleRootsCacheHits(count int)
+	IncMerkleRootsCacheMisses(count int)
+	ObserveBHSVerifyMerkleRoots(duration time.Duration, success bool)
 }
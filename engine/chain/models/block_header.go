@@ -0,0 +1,40 @@
+package chainmodels
+
+import (
+	"context"
+	"time"
+)
+
+// BlockHeader is a header of a block in the longest chain.
+type BlockHeader struct {
+	Height     uint32
+	Hash       string
+	PrevHash   string
+	MerkleRoot string
+	Version    uint32
+	Bits       uint32
+	Nonce      uint32
+	Timestamp  time.Time
+}
+
+// BlockHeadersRepo persists the longest chain of block headers for the embedded block headers store.
+type BlockHeadersRepo interface {
+	// GetTip returns the header with the highest height or nil when there are no headers yet.
+	GetTip(ctx context.Context) (*BlockHeader, error)
+	// GetByHeight returns the header at the given height or nil when there is no such header.
+	GetByHeight(ctx context.Context, height uint32) (*BlockHeader, error)
+	// GetByMerkleRoot returns the header with the given merkle root or nil when there is no such header.
+	GetByMerkleRoot(ctx context.Context, merkleRoot string) (*BlockHeader, error)
+	// GetRange returns up to limit headers starting from the given height, ordered by height.
+	GetRange(ctx context.Context, fromHeight uint32, limit int) ([]BlockHeader, error)
+	// SaveHeaders stores the headers, replacing the ones at the same heights.
+	SaveHeaders(ctx context.Context, headers []BlockHeader) error
+	// DeleteFromHeight removes all headers with the height greater or equal to the given one.
+	DeleteFromHeight(ctx context.Context, height uint32) error
+}
+
+// BlockHeadersSource provides headers of the longest chain to the embedded block headers store.
+// The PrevHash of returned headers can be left empty - it is filled and checked by the store.
+type BlockHeadersSource interface {
+	GetHeaders(ctx context.Context, fromHeight uint32, limit int) ([]BlockHeader, error)
+}
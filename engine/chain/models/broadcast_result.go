@@ -0,0 +1,8 @@
+package chainmodels
+
+// BroadcastResult is the outcome of broadcasting a single transaction as a part of a batch.
+// Exactly one of TXInfo and Err is set.
+type BroadcastResult struct {
+	TXInfo *TXInfo
+	Err    error
+}
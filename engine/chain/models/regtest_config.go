@@ -0,0 +1,17 @@
+package chainmodels
+
+import (
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+)
+
+// RegtestConfig is the configuration of the regtest chain mode, where ARC and BHS are replaced by an in-process stand-in.
+// Broadcasted transactions are kept in memory until a block is mined, then they get valid BUMPs
+// and ARC callbacks are sent to the configured callback URL.
+type RegtestConfig struct {
+	// BlockInterval (optional) makes the node mine a block periodically; otherwise blocks are mined only on demand.
+	BlockInterval time.Duration
+	// FeeUnit (optional) is the mining fee returned as the policy; defaults to 1 satoshi per 1000 bytes.
+	FeeUnit *bsv.FeeUnit
+}
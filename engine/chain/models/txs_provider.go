@@ -0,0 +1,43 @@
+package chainmodels
+
+import (
+	"context"
+	"time"
+)
+
+// TxsProviderType is the kind of external source of transactions.
+type TxsProviderType string
+
+const (
+	// TxsProviderJunglebus fetches transactions from Junglebus (GorillaPool).
+	TxsProviderJunglebus TxsProviderType = "junglebus"
+	// TxsProviderWhatsOnChain fetches transactions from WhatsOnChain-style REST API.
+	TxsProviderWhatsOnChain TxsProviderType = "whatsonchain"
+	// TxsProviderARC looks transactions up in ARC (requires ARC version which returns raw transactions).
+	TxsProviderARC TxsProviderType = "arc"
+	// TxsProviderBEEFArchive reads transactions from a local directory of BEEF files named by the transaction ID.
+	TxsProviderBEEFArchive TxsProviderType = "beef_archive"
+)
+
+// TxsProviderConfig is the configuration of a single external source of transactions.
+type TxsProviderConfig struct {
+	Type TxsProviderType
+	// URL is the base url of the API (whatsonchain, arc) or the directory of the archive (beef_archive).
+	URL string
+	// Token is sent in the Authorization header (whatsonchain, arc).
+	Token string
+	// Timeout limits the time of a single request to the provider, so a slow provider doesn't block the next ones.
+	Timeout time.Duration
+}
+
+// TxsCache is the subset of the cachestore used to keep transactions fetched from external providers.
+type TxsCache interface {
+	GetModel(ctx context.Context, key string, model interface{}) error
+	SetModel(ctx context.Context, key string, model interface{}, ttl time.Duration, dependencies ...string) error
+}
+
+// TxsCacheConfig is the configuration of the cache of transactions fetched from external providers.
+type TxsCacheConfig struct {
+	Store TxsCache
+	TTL   time.Duration
+}
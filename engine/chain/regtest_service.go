@@ -0,0 +1,39 @@
+package chain
+
+import (
+	"context"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/internal/headers"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/internal/regtest"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/go-resty/resty/v2"
+	"github.com/rs/zerolog"
+)
+
+type regtestService struct {
+	*regtest.Node
+	*headers.Service
+}
+
+// NewRegtestChainService creates a chain service which doesn't connect to any network.
+// ARC and BHS are replaced by an in-process node which mines blocks on demand,
+// and calls back the ARC callback endpoint (when configured) with the merkle paths of mined transactions.
+func NewRegtestChainService(logger zerolog.Logger, httpClient *resty.Client, callback *chainmodels.ARCCallbackConfig, cfg chainmodels.RegtestConfig) Service {
+	if httpClient == nil {
+		panic("httpClient is required")
+	}
+
+	node := regtest.NewNode(logger.With().Str("chain", "regtest").Logger(), httpClient, callback, cfg)
+	return &regtestService{
+		Node: node,
+		Service: headers.NewService(logger.With().Str("chain", "headers").Logger(), httpClient, &chainmodels.EmbeddedBHSConfig{
+			Repo:    node.Headers(),
+			Regtest: true,
+		}),
+	}
+}
+
+// SyncBlockHeaders does nothing - the headers are created by the regtest node when mining.
+func (s *regtestService) SyncBlockHeaders(_ context.Context) error {
+	return nil
+}
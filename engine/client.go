@@ -11,17 +11,25 @@ import (
 	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
 	"github.com/bitcoin-sv/spv-wallet/engine/cluster"
 	"github.com/bitcoin-sv/spv-wallet/engine/datastore"
+	"github.com/bitcoin-sv/spv-wallet/engine/feeunit"
 	"github.com/bitcoin-sv/spv-wallet/engine/logging"
 	"github.com/bitcoin-sv/spv-wallet/engine/metrics"
 	"github.com/bitcoin-sv/spv-wallet/engine/notifications"
 	paymailclient "github.com/bitcoin-sv/spv-wallet/engine/paymail"
+	"github.com/bitcoin-sv/spv-wallet/engine/paymaildomains"
+	"github.com/bitcoin-sv/spv-wallet/engine/paymailhosts"
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	"github.com/bitcoin-sv/spv-wallet/engine/taskmanager"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/addresses"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/contacts"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/data"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/database/repository"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/invoices"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/mutualauth"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/operations"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymaildestinations"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymails"
+	paymailprovider "github.com/bitcoin-sv/spv-wallet/engine/v2/paymailserver"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/record"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/txsync"
@@ -41,35 +49,44 @@ type (
 
 	// clientOptions holds all the configuration for the client
 	clientOptions struct {
-		cacheStore                 *cacheStoreOptions    // Configuration options for Cachestore (ristretto, redis, etc.)
-		cluster                    *clusterOptions       // Configuration options for the cluster coordinator
-		dataStore                  *dataStoreOptions     // Configuration options for the DataStore (PostgreSQL, etc.)
-		debug                      bool                  // If the client is in debug mode
-		encryptionKey              string                // Encryption key for encrypting sensitive information (IE: paymail xPub) (hex encoded key)
-		httpClient                 *resty.Client         // HTTP client to use for http calls
-		iuc                        bool                  // (Input UTXO Check) True will check input utxos when saving transactions
-		logger                     *zerolog.Logger       // Internal logging
-		metrics                    *metrics.Metrics      // Metrics with a collector interface
-		notifications              *notificationsOptions // Configuration options for Notifications
-		paymail                    *paymailOptions       // Paymail options & client
-		transactionOutlinesService outlines.Service      // Service for transaction outlines
-		transactionRecordService   *record.Service       // Service for recording transactions
-		taskManager                *taskManagerOptions   // Configuration options for the TaskManager (TaskQ, etc.)
-		userAgent                  string                // User agent for all outgoing requests
-		chainService               chain.Service         // Chain service
-		arcConfig                  chainmodels.ARCConfig // Configuration for ARC
-		bhsConfig                  chainmodels.BHSConfig // Configuration for BHS
-		feeUnit                    *bsv.FeeUnit          // Fee unit for transactions
+		cacheStore                 *cacheStoreOptions         // Configuration options for Cachestore (ristretto, redis, etc.)
+		cluster                    *clusterOptions            // Configuration options for the cluster coordinator
+		dataStore                  *dataStoreOptions          // Configuration options for the DataStore (PostgreSQL, etc.)
+		debug                      bool                       // If the client is in debug mode
+		encryptionKey              string                     // Encryption key for encrypting sensitive information (IE: paymail xPub) (hex encoded key)
+		httpClient                 *resty.Client              // HTTP client to use for http calls
+		iuc                        bool                       // (Input UTXO Check) True will check input utxos when saving transactions
+		logger                     *zerolog.Logger            // Internal logging
+		metrics                    *metrics.Metrics           // Metrics with a collector interface
+		notifications              *notificationsOptions      // Configuration options for Notifications
+		paymail                    *paymailOptions            // Paymail options & client
+		transactionOutlinesService outlines.Service           // Service for transaction outlines
+		transactionRecordService   *record.Service            // Service for recording transactions
+		taskManager                *taskManagerOptions        // Configuration options for the TaskManager (TaskQ, etc.)
+		userAgent                  string                     // User agent for all outgoing requests
+		chainService               chain.Service              // Chain service
+		arcConfig                  chainmodels.ARCConfig      // Configuration for ARC
+		bhsConfig                  chainmodels.BHSConfig      // Configuration for BHS
+		regtestConfig              *chainmodels.RegtestConfig // Configuration of the regtest chain mode (replaces ARC and BHS)
+		feeUnit                    *bsv.FeeUnit               // Custom fee unit for transactions (otherwise taken from ARC policy)
+		feeUnitService             *feeunit.Service           // Service keeping the fee unit up to date
+		feeUnitRefreshInterval     time.Duration              // How often the fee unit is refreshed from the ARC policy (0 - never)
+		paymailDomains             *paymaildomains.Service    // Service keeping the paymail domains served by the wallet
+		paymailHosts               *paymailhosts.Service      // Service guarding the calls to the paymail hosts (allow/deny lists and reputation)
 
 		// v2
-		repositories *repository.All   // Repositories for all db models
-		users        *users.Service    // User domain service
-		paymails     *paymails.Service // Paymail domain service
-		addresses    *addresses.Service
-		operations   *operations.Service
-		txSync       *txsync.Service
-		data         *data.Service
-		config       *config.AppConfig
+		repositories        *repository.All   // Repositories for all db models
+		users               *users.Service    // User domain service
+		paymails            *paymails.Service // Paymail domain service
+		addresses           *addresses.Service
+		paymailDestinations *paymaildestinations.Service
+		invoices            *invoices.Service
+		operations          *operations.Service
+		txSync              *txsync.Service
+		data                *data.Service
+		mutualAuth          *mutualauth.Service
+		contacts            *contacts.Service
+		config              *config.AppConfig
 	}
 
 	// cacheStoreOptions holds the cache configuration and client
@@ -100,9 +117,10 @@ type (
 
 	// paymailOptions holds the configuration for Paymail
 	paymailOptions struct {
-		client       paymail.ClientInterface     // Paymail client for communicating with Paymail providers
-		service      paymailclient.ServiceClient // Paymail service for handling Paymail requests
-		serverConfig *PaymailServerOptions       // Server configuration if Paymail is enabled
+		client       paymail.ClientInterface         // Paymail client for communicating with Paymail providers
+		service      paymailclient.ServiceClient     // Paymail service for handling Paymail requests
+		serverConfig *PaymailServerOptions           // Server configuration if Paymail is enabled
+		provider     paymailprovider.ServiceProvider // The v2 paymail service provider (if the experimental provider is enabled)
 	}
 
 	// PaymailServerOptions is the options for the Paymail server
@@ -110,6 +128,9 @@ type (
 		*server.Configuration                    // Server configuration if Paymail is enabled
 		options               []server.ConfigOps // Options for the paymail server
 		DefaultFromPaymail    string             // IE: from@domain.com
+		Domains               []string           // Static paymail domains (from the config)
+		DomainValidation      bool               // Serve only known paymail domains
+		SenderValidation      bool               // Require signatures of senders of incoming P2P transactions
 		ExperimentalProvider  bool
 	}
 
@@ -160,17 +181,26 @@ func NewClient(ctx context.Context, opts ...ClientOps) (ClientInterface, error)
 
 	client.loadRepositories()
 
+	client.loadPaymailDomainsService()
 	client.loadUsersService()
 	client.loadPaymailsService()
 	client.loadAddressesService()
+	client.loadPaymailDestinationsService()
+	client.loadInvoicesService()
 	client.loadDataService()
 	client.loadOperationsService()
 
+	if err = client.loadMutualAuthService(); err != nil {
+		return nil, err
+	}
+
 	// Load the Paymail client and service (if does not exist)
 	if err = client.loadPaymailComponents(); err != nil {
 		return nil, err
 	}
 
+	client.loadContactsService()
+
 	// Load the Notification client (if client does not exist)
 	if err = client.loadNotificationClient(ctx); err != nil {
 		return nil, err
@@ -197,10 +227,8 @@ func NewClient(ctx context.Context, opts ...ClientOps) (ClientInterface, error)
 		return nil, err
 	}
 
-	if client.options.feeUnit == nil {
-		if err = client.askForFeeUnit(ctx); err != nil {
-			return nil, err
-		}
+	if err = client.loadFeeUnitService(ctx); err != nil {
+		return nil, err
 	}
 
 	if err = client.loadTransactionOutlinesService(); err != nil {
@@ -349,7 +377,22 @@ func (c *Client) LogBHSReadiness(ctx context.Context) {
 
 // FeeUnit will return the fee unit used for transactions
 func (c *Client) FeeUnit() bsv.FeeUnit {
-	return *c.options.feeUnit
+	return c.options.feeUnitService.FeeUnit()
+}
+
+// FeeUnitService will return the service keeping the fee unit up to date
+func (c *Client) FeeUnitService() *feeunit.Service {
+	return c.options.feeUnitService
+}
+
+// PaymailDomainsService will return the service keeping the paymail domains served by the wallet
+func (c *Client) PaymailDomainsService() *paymaildomains.Service {
+	return c.options.paymailDomains
+}
+
+// PaymailHostsService will return the service guarding the calls to the paymail hosts
+func (c *Client) PaymailHostsService() *paymailhosts.Service {
+	return c.options.paymailHosts
 }
 
 // Repositories will return all the repositories
@@ -372,6 +415,21 @@ func (c *Client) AddressesService() *addresses.Service {
 	return c.options.addresses
 }
 
+// PaymailDestinationsService will return the service keeping the origin of the addresses derived for paymails
+func (c *Client) PaymailDestinationsService() *paymaildestinations.Service {
+	return c.options.paymailDestinations
+}
+
+// DeferredTransactionsProcessor will return the processor of the incoming paymail transactions deferred while BHS was down
+func (c *Client) DeferredTransactionsProcessor() paymailprovider.DeferredTransactionsProcessor {
+	return c.options.paymail.provider
+}
+
+// InvoicesService will return the invoices domain service
+func (c *Client) InvoicesService() *invoices.Service {
+	return c.options.invoices
+}
+
 // DataService will return the data domain service
 func (c *Client) DataService() *data.Service {
 	return c.options.data
@@ -386,3 +444,13 @@ func (c *Client) OperationsService() *operations.Service {
 func (c *Client) TxSyncService() *txsync.Service {
 	return c.options.txSync
 }
+
+// MutualAuthService will return the BRC-103/104 mutual authentication service
+func (c *Client) MutualAuthService() *mutualauth.Service {
+	return c.options.mutualAuth
+}
+
+// ContactsService will return the contacts domain service
+func (c *Client) ContactsService() *contacts.Service {
+	return c.options.contacts
+}
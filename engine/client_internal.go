@@ -2,20 +2,31 @@ package engine
 
 import (
 	"context"
+	"net"
+	"time"
 
 	paymailclient "github.com/bitcoin-sv/go-paymail"
 	paymailserver "github.com/bitcoin-sv/go-paymail/server"
+	"github.com/bitcoin-sv/spv-wallet/config"
 	"github.com/bitcoin-sv/spv-wallet/engine/chain"
+	chainmodels "github.com/bitcoin-sv/spv-wallet/engine/chain/models"
 	"github.com/bitcoin-sv/spv-wallet/engine/cluster"
 	"github.com/bitcoin-sv/spv-wallet/engine/datastore"
+	"github.com/bitcoin-sv/spv-wallet/engine/feeunit"
 	"github.com/bitcoin-sv/spv-wallet/engine/notifications"
 	"github.com/bitcoin-sv/spv-wallet/engine/paymail"
+	"github.com/bitcoin-sv/spv-wallet/engine/paymaildomains"
+	"github.com/bitcoin-sv/spv-wallet/engine/paymailhosts"
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	"github.com/bitcoin-sv/spv-wallet/engine/taskmanager"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/addresses"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/contacts"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/data"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/database/repository"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/invoices"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/mutualauth"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/operations"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymaildestinations"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymails"
 	paymailprovider "github.com/bitcoin-sv/spv-wallet/engine/v2/paymailserver"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/beef"
@@ -163,24 +174,64 @@ func (c *Client) loadPaymailComponents() (err error) {
 		c.options.paymail.client.WithCustomHTTPClient(c.options.httpClient)
 	}
 
+	if c.options.paymailHosts == nil {
+		logger := c.Logger().With().Str("subservice", "paymailHosts").Logger()
+		c.options.paymailHosts = paymailhosts.NewService(logger, c.Repositories().PaymailHosts, c.paymailHostReputationConfig())
+	}
+
 	if c.options.paymail.service == nil {
 		logger := c.Logger().With().Str("subservice", "paymail").Logger()
-		c.options.paymail.service = paymail.NewServiceClient(c.Cachestore(), c.options.paymail.client, logger)
+		opts := []paymail.ServiceClientOption{
+			paymail.WithHostGuard(c.PaymailHostsService()),
+			paymail.WithClientConfig(c.paymailClientConfig()),
+		}
+		if metrics, enabled := c.Metrics(); enabled {
+			opts = append(opts, paymail.WithMetrics(metrics))
+		}
+		c.options.paymail.service = paymail.NewServiceClient(c.Cachestore(), c.options.paymail.client, logger, opts...)
 	}
 	return
 }
 
+func (c *Client) paymailClientConfig() *config.PaymailClientConfig {
+	if c.options.config == nil || c.options.config.Paymail == nil {
+		return nil
+	}
+	return c.options.config.Paymail.Client
+}
+
+func (c *Client) paymailHostReputationConfig() *config.PaymailHostReputationConfig {
+	if c.options.config == nil || c.options.config.Paymail == nil {
+		return nil
+	}
+	return c.options.config.Paymail.HostReputation
+}
+
+func (c *Client) paymailDestinationTTL() time.Duration {
+	if c.options.config == nil || c.options.config.Paymail == nil {
+		return 0
+	}
+	return c.options.config.Paymail.DestinationTTL
+}
+
 func (c *Client) loadTransactionOutlinesService() error {
 	if c.options.transactionOutlinesService == nil {
 		logger := c.Logger().With().Str("subservice", "transactionOutlines").Logger()
-		utxoSelector := utxo.NewSelector(c.Datastore().DB(), c.FeeUnit())
+		utxoSelector := utxo.NewSelector(c.Datastore().DB(), c.FeeUnitService())
 		beefService := beef.NewService(c.Repositories().Transactions)
 
-		c.options.transactionOutlinesService = outlines.NewService(c.PaymailService(), c.options.paymails, beefService, utxoSelector, c.FeeUnit(), logger, c.UsersService())
+		c.options.transactionOutlinesService = outlines.NewService(c.PaymailService(), c.options.paymails, beefService, utxoSelector, c.FeeUnitService(), logger, c.UsersService(), c.ContactsService())
 	}
 	return nil
 }
 
+func (c *Client) asyncBroadcastConfig() *config.ARCAsyncBroadcastConfig {
+	if c.options.config == nil || c.options.config.ARC == nil {
+		return nil
+	}
+	return c.options.config.ARC.AsyncBroadcast
+}
+
 func (c *Client) loadTransactionRecordService() error {
 	if c.options.transactionRecordService == nil {
 		logger := c.Logger().With().Str("subservice", "transactionRecord").Logger()
@@ -193,6 +244,9 @@ func (c *Client) loadTransactionRecordService() error {
 			c.Repositories().Transactions,
 			c.Chain(),
 			c.PaymailService(),
+			c.InvoicesService(),
+			c.PaymailDestinationsService(),
+			c.asyncBroadcastConfig(),
 		)
 	}
 	return nil
@@ -204,15 +258,30 @@ func (c *Client) loadRepositories() {
 	}
 }
 
+func (c *Client) loadPaymailDomainsService() {
+	if c.options.paymailDomains == nil {
+		logger := c.Logger().With().Str("subservice", "paymailDomains").Logger()
+		serverConfig := c.options.paymail.serverConfig
+		cfg := &config.PaymailConfig{
+			Domains:                 serverConfig.Domains,
+			DefaultFromPaymail:      serverConfig.DefaultFromPaymail,
+			DomainValidationEnabled: serverConfig.DomainValidation,
+			SenderValidationEnabled: serverConfig.SenderValidation,
+		}
+		c.options.paymailDomains = paymaildomains.NewService(logger, c.Repositories().PaymailDomains, c.options.httpClient, net.DefaultResolver, cfg)
+	}
+}
+
 func (c *Client) loadUsersService() {
 	if c.options.users == nil {
-		c.options.users = users.NewService(c.Repositories().Users, c.options.config)
+		c.options.users = users.NewService(c.Repositories().Users, c.PaymailDomainsService())
 	}
 }
 
 func (c *Client) loadPaymailsService() {
 	if c.options.paymails == nil {
-		c.options.paymails = paymails.NewService(c.Repositories().Paymails, c.UsersService(), c.options.config)
+		logger := c.Logger().With().Str("subservice", "paymails").Logger()
+		c.options.paymails = paymails.NewService(c.Repositories().Paymails, c.UsersService(), c.Cachestore(), c.PaymailDomainsService(), &logger)
 	}
 }
 
@@ -222,6 +291,20 @@ func (c *Client) loadAddressesService() {
 	}
 }
 
+func (c *Client) loadPaymailDestinationsService() {
+	if c.options.paymailDestinations == nil {
+		logger := c.Logger().With().Str("subservice", "paymailDestinations").Logger()
+		c.options.paymailDestinations = paymaildestinations.NewService(logger, c.Repositories().PaymailDestinations)
+	}
+}
+
+func (c *Client) loadInvoicesService() {
+	if c.options.invoices == nil {
+		logger := c.Logger().With().Str("subservice", "invoices").Logger()
+		c.options.invoices = invoices.NewService(&logger, c.Repositories().Invoices, c.PaymailsService(), c.PaymailDestinationsService())
+	}
+}
+
 func (c *Client) loadDataService() {
 	if c.options.data == nil {
 		c.options.data = data.NewService(c.Repositories().Data)
@@ -234,10 +317,40 @@ func (c *Client) loadOperationsService() {
 	}
 }
 
+func (c *Client) loadContactsService() {
+	if c.options.contacts == nil {
+		logger := c.Logger().With().Str("subservice", "contacts").Logger()
+		c.options.contacts = contacts.NewService(&logger, c.Repositories().Contacts, c.PaymailService(), c.PaymailsService())
+	}
+}
+
+func (c *Client) loadMutualAuthService() (err error) {
+	if c.options.mutualAuth == nil {
+		c.options.mutualAuth, err = mutualauth.NewService(c.options.config, c.Cachestore())
+	}
+	return
+}
+
 func (c *Client) loadChainService() {
 	if c.options.chainService == nil {
 		logger := c.Logger().With().Str("subservice", "chain").Logger()
+		if c.options.regtestConfig != nil {
+			c.options.chainService = chain.NewRegtestChainService(logger, c.options.httpClient, c.options.arcConfig.Callback, *c.options.regtestConfig)
+			return
+		}
 		c.options.arcConfig.TxsGetter = newSDKTxGetter(c)
+		if c.options.arcConfig.TxsCache != nil {
+			c.options.arcConfig.TxsCache.Store = c.Cachestore()
+		}
+		if c.options.bhsConfig.Embedded != nil {
+			c.options.bhsConfig.Embedded.Repo = c.Repositories().BlockHeaders
+		}
+		if c.options.bhsConfig.Cache != nil {
+			c.options.bhsConfig.Cache.Store = c.Cachestore()
+		}
+		if metrics, enabled := c.Metrics(); enabled {
+			c.options.bhsConfig.Metrics = metrics
+		}
 		c.options.chainService = chain.NewChainService(logger, c.options.httpClient, c.options.arcConfig, c.options.bhsConfig)
 	}
 }
@@ -245,7 +358,7 @@ func (c *Client) loadChainService() {
 func (c *Client) loadTxSyncService() {
 	if c.options.txSync == nil {
 		logger := c.Logger().With().Str("subservice", "tx_sync").Logger()
-		c.options.txSync = txsync.NewService(logger, c.Repositories().Transactions)
+		c.options.txSync = txsync.NewService(logger, c.Repositories().Transactions, c.Chain())
 	}
 }
 
@@ -292,6 +405,9 @@ func (c *Client) loadPaymailServer() (err error) {
 		)
 	}
 
+	// Domains are checked by the service providers (they can be managed at runtime, see paymaildomains.Service)
+	c.options.paymail.serverConfig.options = append(c.options.paymail.serverConfig.options, paymailserver.WithDomainValidationDisabled())
+
 	paymailLogger := c.Logger().With().Str("subservice", "go-paymail").Logger()
 	c.options.paymail.serverConfig.options = append(c.options.paymail.serverConfig.options, paymailserver.WithLogger(&paymailLogger))
 
@@ -301,20 +417,53 @@ func (c *Client) loadPaymailServer() (err error) {
 	var serviceProvider paymailserver.PaymailServiceProvider
 	if c.options.paymail.serverConfig.ExperimentalProvider {
 		paymailServiceLogger := c.Logger().With().Str("subservice", "paymail-service-provider").Logger()
-		serviceProvider = paymailprovider.NewServiceProvider(
+
+		// incoming transactions are deferred (instead of rejected) only with the queue policy for BHS outages
+		var deferred paymail.DeferredTransactionsRepo
+		if c.deferringIncomingTransactions() {
+			deferred = c.Repositories().DeferredTxs
+		}
+
+		provider := paymailprovider.NewServiceProvider(
 			&paymailServiceLogger,
+			c.PaymailDomainsService(),
 			c.PaymailsService(),
 			c.UsersService(),
 			c.AddressesService(),
+			c.PaymailDestinationsService(),
+			c.InvoicesService(),
 			c.Chain(),
 			c.TransactionRecordService(),
+			c.PaymailService(),
+			deferred,
 		)
+		serviceProvider = provider
+		c.options.paymail.provider = provider
+
+		// the wallet's own paymail extensions
+		c.options.paymail.serverConfig.options = append(c.options.paymail.serverConfig.options, paymailserver.WithCapabilities(map[string]any{
+			paymailprovider.InvoiceDestinationCapability: paymailprovider.NewInvoiceDestinationCapability(&paymailServiceLogger, provider),
+		}))
 	} else {
 		serviceProvider = &PaymailDefaultServiceProvider{client: c}
+
+		// the v2 service provider applies the sender validation policy of the domain by itself
+		if c.options.paymail.serverConfig.SenderValidation {
+			c.options.paymail.serverConfig.options = append(c.options.paymail.serverConfig.options, paymailserver.WithSenderValidation())
+		}
 	}
 
 	paymailLocator.RegisterPaymailService(serviceProvider)
-	paymailLocator.RegisterPikeContactService(&PikeContactServiceProvider{client: c})
+	if c.options.paymail.serverConfig.ExperimentalProvider {
+		pikeContactLogger := c.Logger().With().Str("subservice", "pike-contact-service-provider").Logger()
+		paymailLocator.RegisterPikeContactService(paymailprovider.NewPikeContactServiceProvider(
+			&pikeContactLogger,
+			c.PaymailsService(),
+			c.ContactsService(),
+		))
+	} else {
+		paymailLocator.RegisterPikeContactService(&PikeContactServiceProvider{client: c})
+	}
 	paymailLocator.RegisterPikePaymentService(&PikePaymentServiceProvider{client: c})
 
 	c.options.paymail.serverConfig.Configuration, err = paymailserver.NewConfig(
@@ -324,12 +473,17 @@ func (c *Client) loadPaymailServer() (err error) {
 	return
 }
 
-func (c *Client) askForFeeUnit(ctx context.Context) error {
-	feeUnit, err := c.Chain().GetFeeUnit(ctx)
-	if err != nil {
-		return spverrors.ErrAskingForFeeUnit.Wrap(err)
+// deferringIncomingTransactions reports if the incoming paymail transactions are kept until BHS verifies their merkle roots.
+func (c *Client) deferringIncomingTransactions() bool {
+	cb := c.options.bhsConfig.CircuitBreaker
+	return cb != nil && cb.WhenDown == chainmodels.BHSDownQueue &&
+		c.options.paymail.serverConfig != nil && c.options.paymail.serverConfig.ExperimentalProvider
+}
+
+func (c *Client) loadFeeUnitService(ctx context.Context) error {
+	if c.options.feeUnitService == nil {
+		logger := c.Logger().With().Str("subservice", "feeUnit").Logger()
+		c.options.feeUnitService = feeunit.NewService(logger, c.Repositories().FeeUnits, c.Chain(), c.Cluster(), c.options.feeUnit)
 	}
-	c.options.feeUnit = feeUnit
-	c.Logger().Info().Msgf("Fee unit set by ARC policy: %d satoshis per %d bytes", feeUnit.Satoshis, feeUnit.Bytes)
-	return nil
+	return c.options.feeUnitService.Init(ctx)
 }
@@ -306,11 +306,9 @@ func WithPaymailSupport(domains []string, defaultFromPaymail string, domainValid
 		for _, domain := range domains {
 			c.paymail.serverConfig.options = append(c.paymail.serverConfig.options, server.WithDomain(domain))
 		}
-
-		// Set the sender validation
-		if senderValidation {
-			c.paymail.serverConfig.options = append(c.paymail.serverConfig.options, server.WithSenderValidation())
-		}
+		c.paymail.serverConfig.Domains = domains
+		c.paymail.serverConfig.DomainValidation = domainValidation
+		c.paymail.serverConfig.SenderValidation = senderValidation
 
 		// Domain validation
 		if !domainValidation {
@@ -436,6 +434,13 @@ func WithCustomFeeUnit(feeUnit bsv.FeeUnit) ClientOps {
 	}
 }
 
+// WithFeeUnitRefresh will periodically refresh the fee unit from the ARC policy
+func WithFeeUnitRefresh(interval time.Duration) ClientOps {
+	return func(c *clientOptions) {
+		c.feeUnitRefreshInterval = interval
+	}
+}
+
 // WithARC sets all the ARC options needed for broadcasting, querying transactions etc.
 func WithARC(arcCfg chainmodels.ARCConfig) ClientOps {
 	return func(c *clientOptions) {
@@ -446,13 +451,59 @@ func WithARC(arcCfg chainmodels.ARCConfig) ClientOps {
 // WithBHS set BHS url params
 func WithBHS(url, token string) ClientOps {
 	return func(c *clientOptions) {
-		c.bhsConfig = chainmodels.BHSConfig{
-			URL:       url,
-			AuthToken: token,
+		c.bhsConfig.URL = url
+		c.bhsConfig.AuthToken = token
+	}
+}
+
+// WithBHSMerkleRootsCache enables caching (in the cachestore) of merkle roots confirmed by BHS
+func WithBHSMerkleRootsCache(ttl time.Duration) ClientOps {
+	return func(c *clientOptions) {
+		c.bhsConfig.Cache = &chainmodels.MerkleRootsCacheConfig{TTL: ttl}
+	}
+}
+
+// WithBHSCircuitBreaker enables failing fast when BHS is down - after failureThreshold consecutive failures
+// requests are not sent to BHS for openTimeout and merkle roots verification is handled according to whenDown policy
+func WithBHSCircuitBreaker(failureThreshold int, openTimeout time.Duration, whenDown chainmodels.BHSDownPolicy) ClientOps {
+	return func(c *clientOptions) {
+		c.bhsConfig.CircuitBreaker = &chainmodels.BHSCircuitBreakerConfig{
+			FailureThreshold: failureThreshold,
+			OpenTimeout:      openTimeout,
+			WhenDown:         whenDown,
 		}
 	}
 }
 
+// WithEmbeddedBHS replaces the Block Headers Service with the in-process block headers store.
+// The importURL (optional) points to a file with raw block headers imported when the store is empty.
+func WithEmbeddedBHS(importURL string) ClientOps {
+	return func(c *clientOptions) {
+		if c.bhsConfig.Embedded == nil {
+			c.bhsConfig.Embedded = &chainmodels.EmbeddedBHSConfig{}
+		}
+		c.bhsConfig.Embedded.ImportURL = importURL
+	}
+}
+
+// WithBlockHeadersSource sets the source of new block headers for the embedded block headers store (Junglebus by default).
+func WithBlockHeadersSource(source chainmodels.BlockHeadersSource) ClientOps {
+	return func(c *clientOptions) {
+		if c.bhsConfig.Embedded == nil {
+			c.bhsConfig.Embedded = &chainmodels.EmbeddedBHSConfig{}
+		}
+		c.bhsConfig.Embedded.Source = source
+	}
+}
+
+// WithRegtestChain replaces ARC and BHS with an in-process regtest node, so the wallet works without network access.
+// Blocks are mined on demand (see chain.BlockMiner) or periodically when cfg.BlockInterval is set.
+func WithRegtestChain(cfg chainmodels.RegtestConfig) ClientOps {
+	return func(c *clientOptions) {
+		c.regtestConfig = &cfg
+	}
+}
+
 // WithAppConfig passes the config struct into engine
 func WithAppConfig(config *config.AppConfig) ClientOps {
 	return func(c *clientOptions) {
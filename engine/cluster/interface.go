@@ -19,6 +19,9 @@ type Channel string
 var (
 	// DestinationNew is a message sent when a new destination is created
 	DestinationNew Channel = "new-destination"
+
+	// FeeUnitChanged is a message sent when the fee unit used for transactions changes
+	FeeUnitChanged Channel = "fee-unit-changed"
 )
 
 // ClientInterface interface for the internal pub/sub functionality for clusters
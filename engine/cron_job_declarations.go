@@ -12,6 +12,12 @@ const (
 	CronJobNameDraftTransactionCleanUp = "draft_transaction_clean_up"
 	CronJobNameSyncTransaction         = "sync_transaction"
 	CronJobNameCalculateMetrics        = "calculate_metrics"
+	CronJobNameBroadcastTransactionsV2 = "broadcast_transactions_v2"
+	CronJobNameSyncBlockHeaders        = "sync_block_headers"
+	CronJobNameProcessDeferredTxs      = "process_deferred_transactions"
+	CronJobNameRegtestMineBlock        = "regtest_mine_block"
+	CronJobNameRefreshFeeUnit          = "refresh_fee_unit"
+	CronJobNameDestinationsCleanUp     = "paymail_destinations_clean_up"
 )
 
 type cronJobHandler func(ctx context.Context, client *Client) error
@@ -51,6 +57,55 @@ func (c *Client) cronJobs() taskmanager.CronJobs {
 		taskSyncTransactions,
 	)
 
+	if cfg := c.asyncBroadcastConfig(); cfg != nil && cfg.Enabled {
+		addJob(
+			CronJobNameBroadcastTransactionsV2,
+			5*time.Second,
+			taskBroadcastTransactionsV2,
+		)
+	}
+
+	if c.options.bhsConfig.Embedded != nil {
+		addJob(
+			CronJobNameSyncBlockHeaders,
+			1*time.Minute,
+			taskSyncBlockHeaders,
+		)
+	}
+
+	if c.deferringIncomingTransactions() {
+		addJob(
+			CronJobNameProcessDeferredTxs,
+			1*time.Minute,
+			taskProcessDeferredTransactions,
+		)
+	}
+
+	if cfg := c.options.regtestConfig; cfg != nil && cfg.BlockInterval > 0 {
+		addJob(
+			CronJobNameRegtestMineBlock,
+			cfg.BlockInterval,
+			taskRegtestMineBlock,
+		)
+	}
+
+	if c.options.feeUnitRefreshInterval > 0 && c.options.feeUnit == nil {
+		addJob(
+			CronJobNameRefreshFeeUnit,
+			c.options.feeUnitRefreshInterval,
+			taskRefreshFeeUnit,
+		)
+	}
+
+	// paymail destinations are stored only by the v2 paymail service provider
+	if c.options.paymail.serverConfig.ExperimentalProvider && c.paymailDestinationTTL() > 0 {
+		addJob(
+			CronJobNameDestinationsCleanUp,
+			10*time.Minute,
+			taskCleanupPaymailDestinations,
+		)
+	}
+
 	if _, enabled := c.Metrics(); enabled {
 		addJob(
 			CronJobNameCalculateMetrics,
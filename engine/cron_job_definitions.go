@@ -64,6 +64,40 @@ func taskSyncTransactions(ctx context.Context, client *Client) error {
 	return nil
 }
 
+// taskBroadcastTransactionsV2 will broadcast transactions queued by asynchronous recording
+func taskBroadcastTransactionsV2(ctx context.Context, client *Client) error {
+	return client.TransactionRecordService().BroadcastPending(ctx)
+}
+
+// taskSyncBlockHeaders will update the embedded block headers store
+func taskSyncBlockHeaders(ctx context.Context, client *Client) error {
+	return client.Chain().SyncBlockHeaders(ctx)
+}
+
+// taskProcessDeferredTransactions will record the incoming transactions received while BHS was down, once their merkle roots are verified
+func taskProcessDeferredTransactions(ctx context.Context, client *Client) error {
+	if client.options.paymail.provider == nil {
+		return nil
+	}
+	return client.options.paymail.provider.ProcessDeferredTransactions(ctx)
+}
+
+// taskRegtestMineBlock will mine a block in the regtest chain mode
+func taskRegtestMineBlock(ctx context.Context, client *Client) error {
+	_, err := client.Chain().MineBlocks(ctx, 1)
+	return err
+}
+
+// taskRefreshFeeUnit will refresh the fee unit from the ARC policy
+func taskRefreshFeeUnit(ctx context.Context, client *Client) error {
+	return client.FeeUnitService().Refresh(ctx)
+}
+
+// taskCleanupPaymailDestinations will remove the paymail destinations which were not paid within the TTL
+func taskCleanupPaymailDestinations(ctx context.Context, client *Client) error {
+	return client.PaymailDestinationsService().DeleteUnpaid(ctx, client.paymailDestinationTTL())
+}
+
 func taskCalculateMetrics(ctx context.Context, client *Client) error {
 	m, enabled := client.Metrics()
 	if !enabled {
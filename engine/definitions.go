@@ -125,6 +125,12 @@ func AllDBModels(v2 bool) []any {
 		&Contact{},
 		&Webhook{},
 		&PaymailAddress{},
+		// block headers of the embedded block headers store are used by both engine versions
+		&database.BlockHeader{},
+		// so is the history of fee units
+		&database.FeeUnit{},
+		&database.PaymailDomain{},
+		&database.PaymailHostRule{},
 	}
 
 	if !v2 {
@@ -0,0 +1,42 @@
+package feeunit
+
+import (
+	"context"
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+)
+
+// Source tells where the fee unit comes from.
+type Source string
+
+const (
+	// SourceARCPolicy is the mining fee from the ARC policy.
+	SourceARCPolicy Source = "arc_policy"
+	// SourceConfig is the custom fee unit from the configuration - it's never refreshed from ARC.
+	SourceConfig Source = "config"
+	// SourceAdmin is the fee unit set by the admin at runtime - it's used until the override is cleared.
+	SourceAdmin Source = "admin"
+)
+
+// Change is an entry of the fee unit history.
+type Change struct {
+	FeeUnit   bsv.FeeUnit
+	Source    Source
+	CreatedAt time.Time
+}
+
+// Repo persists the history of fee units.
+type Repo interface {
+	// Add appends the change to the history.
+	Add(ctx context.Context, change Change) error
+	// GetLatest returns the most recent change or nil when the history is empty.
+	GetLatest(ctx context.Context) (*Change, error)
+	// List returns up to limit most recent changes, the newest first.
+	List(ctx context.Context, limit int) ([]Change, error)
+}
+
+// PolicyProvider provides the fee unit from the mining policy (ARC).
+type PolicyProvider interface {
+	GetFeeUnit(ctx context.Context) (*bsv.FeeUnit, error)
+}
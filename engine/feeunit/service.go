@@ -0,0 +1,212 @@
+package feeunit
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/cluster"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/rs/zerolog"
+)
+
+// MaxHistoryLimit is the maximum number of the fee unit changes returned at once (the max page size of the listings).
+const MaxHistoryLimit = 100
+
+// Service keeps the fee unit used for transactions up to date.
+// The fee unit is taken from the configuration (when set), from the admin's override or from the ARC policy,
+// which is refreshed periodically. Every change is recorded in the history and propagated to other cluster nodes.
+type Service struct {
+	logger     zerolog.Logger
+	repo       Repo
+	policy     PolicyProvider
+	cluster    cluster.ClientInterface
+	configured *bsv.FeeUnit
+
+	// mu serializes changes of the fee unit; reads use the current pointer without locking
+	mu      sync.Mutex
+	current atomic.Pointer[Change]
+}
+
+// clusterMessage is published to other cluster nodes when the fee unit changes.
+type clusterMessage struct {
+	Satoshis bsv.Satoshis `json:"satoshis"`
+	Bytes    int          `json:"bytes"`
+	Source   Source       `json:"source"`
+}
+
+// NewService creates a new fee unit service; configured (optional) is the custom fee unit from the configuration.
+// The cluster (optional) is used to propagate changes to other nodes.
+func NewService(logger zerolog.Logger, repo Repo, policy PolicyProvider, clusterClient cluster.ClientInterface, configured *bsv.FeeUnit) *Service {
+	return &Service{
+		logger:     logger,
+		repo:       repo,
+		policy:     policy,
+		cluster:    clusterClient,
+		configured: configured,
+	}
+}
+
+// Init sets the initial fee unit and subscribes to changes made by other cluster nodes.
+// The admin's override from the history has the precedence (so it survives a restart), then the configured fee unit, then the ARC policy.
+// When ARC cannot be asked, the last known fee unit from the history is used.
+func (s *Service) Init(ctx context.Context) error {
+	if s.cluster != nil {
+		if _, err := s.cluster.Subscribe(cluster.FeeUnitChanged, s.onClusterMessage); err != nil {
+			return spverrors.Wrapf(err, "failed to subscribe to fee unit changes")
+		}
+	}
+
+	latest, err := s.repo.GetLatest(ctx)
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to get the latest fee unit")
+	}
+	if latest != nil && latest.Source == SourceAdmin {
+		s.current.Store(latest)
+		s.logger.Info().Msgf("Fee unit overridden by admin: %d satoshis per %d bytes", latest.FeeUnit.Satoshis, latest.FeeUnit.Bytes)
+		return nil
+	}
+
+	if s.configured != nil {
+		return s.apply(ctx, *s.configured, SourceConfig)
+	}
+
+	feeUnit, err := s.policy.GetFeeUnit(ctx)
+	if err != nil {
+		if latest == nil {
+			return spverrors.ErrAskingForFeeUnit.Wrap(err)
+		}
+		s.logger.Warn().Err(err).Msgf("Cannot ask ARC for fee unit, using the last known one: %d satoshis per %d bytes", latest.FeeUnit.Satoshis, latest.FeeUnit.Bytes)
+		s.current.Store(latest)
+		return nil
+	}
+	return s.apply(ctx, *feeUnit, SourceARCPolicy)
+}
+
+// FeeUnit returns the fee unit currently used for transactions.
+func (s *Service) FeeUnit() bsv.FeeUnit {
+	return s.Current().FeeUnit
+}
+
+// Current returns the fee unit currently used for transactions together with its source.
+func (s *Service) Current() Change {
+	current := s.current.Load()
+	if current == nil {
+		return Change{}
+	}
+	return *current
+}
+
+// Refresh updates the fee unit from the ARC policy; it does nothing when the fee unit is configured or overridden.
+func (s *Service) Refresh(ctx context.Context) error {
+	if source := s.Current().Source; source == SourceConfig || source == SourceAdmin {
+		return nil
+	}
+	feeUnit, err := s.policy.GetFeeUnit(ctx)
+	if err != nil {
+		return spverrors.ErrAskingForFeeUnit.Wrap(err)
+	}
+	return s.apply(ctx, *feeUnit, SourceARCPolicy)
+}
+
+// Override sets the fee unit at runtime; the ARC policy and the configured fee unit are not used until the override is cleared.
+func (s *Service) Override(ctx context.Context, feeUnit bsv.FeeUnit) error {
+	if !feeUnit.IsValid() {
+		return spverrors.ErrInvalidFeeUnit
+	}
+	return s.apply(ctx, feeUnit, SourceAdmin)
+}
+
+// ClearOverride goes back to the configured fee unit or to the ARC policy.
+func (s *Service) ClearOverride(ctx context.Context) error {
+	if s.Current().Source != SourceAdmin {
+		return nil
+	}
+	if s.configured != nil {
+		return s.apply(ctx, *s.configured, SourceConfig)
+	}
+	feeUnit, err := s.policy.GetFeeUnit(ctx)
+	if err != nil {
+		return spverrors.ErrAskingForFeeUnit.Wrap(err)
+	}
+	return s.apply(ctx, *feeUnit, SourceARCPolicy)
+}
+
+// History returns up to limit (at most MaxHistoryLimit) most recent changes of the fee unit, the newest first.
+func (s *Service) History(ctx context.Context, limit int) ([]Change, error) {
+	changes, err := s.repo.List(ctx, min(limit, MaxHistoryLimit))
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get fee unit history")
+	}
+	return changes, nil
+}
+
+func (s *Service) apply(ctx context.Context, feeUnit bsv.FeeUnit, source Source) error {
+	s.mu.Lock()
+	changed, err := s.store(ctx, feeUnit, source)
+	s.mu.Unlock()
+	if err != nil || !changed {
+		return err
+	}
+
+	s.logger.Info().Str("source", string(source)).Msgf("Fee unit set: %d satoshis per %d bytes", feeUnit.Satoshis, feeUnit.Bytes)
+	s.publish(feeUnit, source)
+	return nil
+}
+
+// store sets the current fee unit and records it in the history (unless another cluster node already did it).
+func (s *Service) store(ctx context.Context, feeUnit bsv.FeeUnit, source Source) (bool, error) {
+	if current := s.current.Load(); current != nil && current.FeeUnit == feeUnit && current.Source == source {
+		return false, nil
+	}
+	change := &Change{FeeUnit: feeUnit, Source: source, CreatedAt: time.Now().UTC()}
+
+	latest, err := s.repo.GetLatest(ctx)
+	if err != nil {
+		return false, spverrors.Wrapf(err, "failed to get the latest fee unit")
+	}
+	if latest == nil || latest.FeeUnit != feeUnit || latest.Source != source {
+		if err = s.repo.Add(ctx, *change); err != nil {
+			return false, spverrors.Wrapf(err, "failed to record fee unit change")
+		}
+	}
+
+	s.current.Store(change)
+	return true, nil
+}
+
+func (s *Service) publish(feeUnit bsv.FeeUnit, source Source) {
+	if s.cluster == nil {
+		return
+	}
+	data, err := json.Marshal(clusterMessage{Satoshis: feeUnit.Satoshis, Bytes: feeUnit.Bytes, Source: source})
+	if err != nil {
+		s.logger.Error().Err(err).Msg("Failed to encode fee unit change for the cluster")
+		return
+	}
+	if err = s.cluster.Publish(cluster.FeeUnitChanged, string(data)); err != nil {
+		s.logger.Warn().Err(err).Msg("Failed to publish fee unit change to the cluster")
+	}
+}
+
+// onClusterMessage applies the fee unit changed by another cluster node (it's already recorded in the history).
+func (s *Service) onClusterMessage(data string) {
+	var msg clusterMessage
+	if err := json.Unmarshal([]byte(data), &msg); err != nil {
+		s.logger.Warn().Err(err).Msg("Invalid fee unit change received from the cluster")
+		return
+	}
+	feeUnit := bsv.FeeUnit{Satoshis: msg.Satoshis, Bytes: msg.Bytes}
+	if !feeUnit.IsValid() {
+		s.logger.Warn().Msg("Invalid fee unit received from the cluster")
+		return
+	}
+	if current := s.current.Load(); current != nil && current.FeeUnit == feeUnit && current.Source == msg.Source {
+		return
+	}
+	s.current.Store(&Change{FeeUnit: feeUnit, Source: msg.Source, CreatedAt: time.Now().UTC()})
+	s.logger.Info().Str("source", string(msg.Source)).Msgf("Fee unit changed by another cluster node: %d satoshis per %d bytes", feeUnit.Satoshis, feeUnit.Bytes)
+}
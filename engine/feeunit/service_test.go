@@ -0,0 +1,272 @@
+package feeunit_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/cluster"
+	"github.com/bitcoin-sv/spv-wallet/engine/feeunit"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	arcFeeUnit    = bsv.FeeUnit{Satoshis: 1, Bytes: 1000}
+	customFeeUnit = bsv.FeeUnit{Satoshis: 5, Bytes: 1000}
+	adminFeeUnit  = bsv.FeeUnit{Satoshis: 50, Bytes: 1000}
+)
+
+func TestInitFromARCPolicy(t *testing.T) {
+	// given:
+	repo := &memoryRepo{}
+	service := feeunit.NewService(tester.Logger(t), repo, &fakePolicy{feeUnit: arcFeeUnit}, nil, nil)
+
+	// when:
+	err := service.Init(context.Background())
+
+	// then:
+	require.NoError(t, err)
+	require.Equal(t, arcFeeUnit, service.FeeUnit())
+	require.Equal(t, feeunit.SourceARCPolicy, service.Current().Source)
+	require.Len(t, repo.changes, 1)
+}
+
+func TestInitFromConfig(t *testing.T) {
+	// given:
+	policy := &fakePolicy{feeUnit: arcFeeUnit}
+	service := feeunit.NewService(tester.Logger(t), &memoryRepo{}, policy, nil, &customFeeUnit)
+
+	// when:
+	err := service.Init(context.Background())
+	require.NoError(t, err)
+
+	policy.feeUnit = adminFeeUnit
+	err = service.Refresh(context.Background())
+
+	// then:
+	require.NoError(t, err)
+	require.Equal(t, customFeeUnit, service.FeeUnit())
+	require.Equal(t, feeunit.SourceConfig, service.Current().Source)
+}
+
+func TestInitWhenARCIsDown(t *testing.T) {
+	t.Run("use the last known fee unit", func(t *testing.T) {
+		// given:
+		repo := &memoryRepo{changes: []feeunit.Change{{FeeUnit: arcFeeUnit, Source: feeunit.SourceARCPolicy}}}
+		service := feeunit.NewService(tester.Logger(t), repo, &fakePolicy{err: errors.New("arc is down")}, nil, nil)
+
+		// when:
+		err := service.Init(context.Background())
+
+		// then:
+		require.NoError(t, err)
+		require.Equal(t, arcFeeUnit, service.FeeUnit())
+	})
+
+	t.Run("fail without history", func(t *testing.T) {
+		// given:
+		service := feeunit.NewService(tester.Logger(t), &memoryRepo{}, &fakePolicy{err: errors.New("arc is down")}, nil, nil)
+
+		// when:
+		err := service.Init(context.Background())
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrAskingForFeeUnit)
+	})
+}
+
+func TestRefresh(t *testing.T) {
+	// given:
+	repo := &memoryRepo{}
+	policy := &fakePolicy{feeUnit: arcFeeUnit}
+	service := feeunit.NewService(tester.Logger(t), repo, policy, nil, nil)
+	require.NoError(t, service.Init(context.Background()))
+
+	// when:
+	err := service.Refresh(context.Background())
+
+	// then:
+	require.NoError(t, err)
+	require.Len(t, repo.changes, 1, "unchanged fee unit shouldn't be recorded")
+
+	// when:
+	newFeeUnit := bsv.FeeUnit{Satoshis: 2, Bytes: 1000}
+	policy.feeUnit = newFeeUnit
+	err = service.Refresh(context.Background())
+
+	// then:
+	require.NoError(t, err)
+	require.Equal(t, newFeeUnit, service.FeeUnit())
+
+	history, err := service.History(context.Background(), 10)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	require.Equal(t, newFeeUnit, history[0].FeeUnit)
+	require.Equal(t, arcFeeUnit, history[1].FeeUnit)
+}
+
+func TestHistoryLimit(t *testing.T) {
+	// given:
+	repo := &memoryRepo{}
+	for range feeunit.MaxHistoryLimit + 1 {
+		repo.changes = append(repo.changes, feeunit.Change{FeeUnit: arcFeeUnit, Source: feeunit.SourceARCPolicy})
+	}
+	service := feeunit.NewService(tester.Logger(t), repo, &fakePolicy{feeUnit: arcFeeUnit}, nil, nil)
+
+	// when:
+	history, err := service.History(context.Background(), 1_000_000)
+
+	// then:
+	require.NoError(t, err)
+	require.Len(t, history, feeunit.MaxHistoryLimit)
+}
+
+func TestOverride(t *testing.T) {
+	// given:
+	repo := &memoryRepo{}
+	policy := &fakePolicy{feeUnit: arcFeeUnit}
+	service := feeunit.NewService(tester.Logger(t), repo, policy, nil, nil)
+	require.NoError(t, service.Init(context.Background()))
+
+	// when:
+	err := service.Override(context.Background(), adminFeeUnit)
+
+	// then:
+	require.NoError(t, err)
+	require.Equal(t, adminFeeUnit, service.FeeUnit())
+	require.Equal(t, feeunit.SourceAdmin, service.Current().Source)
+
+	// when:
+	err = service.Refresh(context.Background())
+
+	// then:
+	require.NoError(t, err)
+	require.Equal(t, adminFeeUnit, service.FeeUnit(), "override shouldn't be replaced by the ARC policy")
+
+	// when:
+	restarted := feeunit.NewService(tester.Logger(t), repo, policy, nil, nil)
+	err = restarted.Init(context.Background())
+
+	// then:
+	require.NoError(t, err)
+	require.Equal(t, adminFeeUnit, restarted.FeeUnit(), "override should survive a restart")
+
+	// when:
+	err = service.ClearOverride(context.Background())
+
+	// then:
+	require.NoError(t, err)
+	require.Equal(t, arcFeeUnit, service.FeeUnit())
+	require.Equal(t, feeunit.SourceARCPolicy, service.Current().Source)
+}
+
+func TestOverrideWithConfiguredFeeUnit(t *testing.T) {
+	// given:
+	repo := &memoryRepo{}
+	policy := &fakePolicy{feeUnit: arcFeeUnit}
+	service := feeunit.NewService(tester.Logger(t), repo, policy, nil, &customFeeUnit)
+	require.NoError(t, service.Init(context.Background()))
+	require.NoError(t, service.Override(context.Background(), adminFeeUnit))
+
+	// when:
+	restarted := feeunit.NewService(tester.Logger(t), repo, policy, nil, &customFeeUnit)
+	err := restarted.Init(context.Background())
+
+	// then:
+	require.NoError(t, err)
+	require.Equal(t, adminFeeUnit, restarted.FeeUnit(), "override should survive a restart")
+	require.Equal(t, feeunit.SourceAdmin, restarted.Current().Source)
+
+	// when:
+	err = restarted.ClearOverride(context.Background())
+
+	// then:
+	require.NoError(t, err)
+	require.Equal(t, customFeeUnit, restarted.FeeUnit())
+	require.Equal(t, feeunit.SourceConfig, restarted.Current().Source)
+}
+
+func TestOverrideInvalidFeeUnit(t *testing.T) {
+	// given:
+	service := feeunit.NewService(tester.Logger(t), &memoryRepo{}, &fakePolicy{feeUnit: arcFeeUnit}, nil, nil)
+	require.NoError(t, service.Init(context.Background()))
+
+	// when:
+	err := service.Override(context.Background(), bsv.FeeUnit{Satoshis: 1, Bytes: 0})
+
+	// then:
+	require.ErrorIs(t, err, spverrors.ErrInvalidFeeUnit)
+	require.Equal(t, arcFeeUnit, service.FeeUnit())
+}
+
+func TestPropagationThroughCluster(t *testing.T) {
+	// given:
+	pubSub, err := cluster.NewClient(context.Background())
+	require.NoError(t, err)
+
+	repo := &memoryRepo{}
+	policy := &fakePolicy{feeUnit: arcFeeUnit}
+	node1 := feeunit.NewService(tester.Logger(t), repo, policy, pubSub, nil)
+	node2 := feeunit.NewService(tester.Logger(t), repo, policy, pubSub, nil)
+	require.NoError(t, node1.Init(context.Background()))
+	// memory pub/sub keeps a single subscriber, so messages published by node1 are delivered to node2
+	require.NoError(t, node2.Init(context.Background()))
+
+	// when:
+	err = node1.Override(context.Background(), adminFeeUnit)
+
+	// then:
+	require.NoError(t, err)
+	require.Equal(t, adminFeeUnit, node2.FeeUnit())
+	require.Equal(t, feeunit.SourceAdmin, node2.Current().Source)
+	require.Len(t, repo.changes, 2, "change should be recorded once")
+}
+
+type fakePolicy struct {
+	feeUnit bsv.FeeUnit
+	err     error
+}
+
+func (p *fakePolicy) GetFeeUnit(_ context.Context) (*bsv.FeeUnit, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	feeUnit := p.feeUnit
+	return &feeUnit, nil
+}
+
+type memoryRepo struct {
+	mu      sync.Mutex
+	changes []feeunit.Change
+}
+
+func (r *memoryRepo) Add(_ context.Context, change feeunit.Change) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.changes = append(r.changes, change)
+	return nil
+}
+
+func (r *memoryRepo) GetLatest(_ context.Context) (*feeunit.Change, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.changes) == 0 {
+		return nil, nil
+	}
+	latest := r.changes[len(r.changes)-1]
+	return &latest, nil
+}
+
+func (r *memoryRepo) List(_ context.Context, limit int) ([]feeunit.Change, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	changes := make([]feeunit.Change, 0, limit)
+	for i := len(r.changes) - 1; i >= 0 && len(changes) < limit; i-- {
+		changes = append(changes, r.changes[i])
+	}
+	return changes, nil
+}
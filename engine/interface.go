@@ -9,15 +9,23 @@ import (
 	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
 	"github.com/bitcoin-sv/spv-wallet/engine/cluster"
 	"github.com/bitcoin-sv/spv-wallet/engine/datastore"
+	"github.com/bitcoin-sv/spv-wallet/engine/feeunit"
 	"github.com/bitcoin-sv/spv-wallet/engine/metrics"
 	"github.com/bitcoin-sv/spv-wallet/engine/notifications"
 	paymailclient "github.com/bitcoin-sv/spv-wallet/engine/paymail"
+	"github.com/bitcoin-sv/spv-wallet/engine/paymaildomains"
+	"github.com/bitcoin-sv/spv-wallet/engine/paymailhosts"
 	"github.com/bitcoin-sv/spv-wallet/engine/taskmanager"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/addresses"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/contacts"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/data"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/database/repository"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/invoices"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/mutualauth"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/operations"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymaildestinations"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymails"
+	paymailprovider "github.com/bitcoin-sv/spv-wallet/engine/v2/paymailserver"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/record"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/txsync"
@@ -166,9 +174,14 @@ type V2 interface {
 	UsersService() *users.Service
 	PaymailsService() *paymails.Service
 	AddressesService() *addresses.Service
+	PaymailDestinationsService() *paymaildestinations.Service
+	DeferredTransactionsProcessor() paymailprovider.DeferredTransactionsProcessor
+	InvoicesService() *invoices.Service
 	DataService() *data.Service
 	OperationsService() *operations.Service
 	TxSyncService() *txsync.Service
+	MutualAuthService() *mutualauth.Service
+	ContactsService() *contacts.Service
 }
 
 // ClientInterface is the client (spv wallet engine) interface comprised of all services/actions
@@ -197,5 +210,8 @@ type ClientInterface interface {
 	Chain() chain.Service
 	LogBHSReadiness(ctx context.Context)
 	FeeUnit() bsv.FeeUnit
+	FeeUnitService() *feeunit.Service
+	PaymailDomainsService() *paymaildomains.Service
+	PaymailHostsService() *paymailhosts.Service
 	V2
 }
@@ -22,6 +22,13 @@ type Metrics struct {
 	recordTransaction *prometheus.HistogramVec
 	queryTransaction  *prometheus.HistogramVec
 	addContact        *prometheus.HistogramVec
+	bhsVerifyMR       *prometheus.HistogramVec
+	paymailCall       *prometheus.HistogramVec
+
+	// merkleRootsCache counts hits and misses of the confirmed merkle roots cache
+	merkleRootsCache *prometheus.CounterVec
+	// paymailCapabilitiesCache counts hits, negative hits and misses of the paymail capabilities cache
+	paymailCapabilitiesCache *prometheus.CounterVec
 
 	// each cronJob is observed by the duration it takes to execute and the last time it was executed
 	cronHistogram     *prometheus.HistogramVec
@@ -31,14 +38,18 @@ type Metrics struct {
 // NewMetrics is a constructor for the Metrics struct
 func NewMetrics(collector Collector) *Metrics {
 	return &Metrics{
-		collector:         collector,
-		stats:             collector.RegisterGaugeVec(statsGaugeName, "name"),
-		verifyMerkleRoots: collector.RegisterHistogramVec(verifyMerkleRootsHistogramName, "classification"),
-		recordTransaction: collector.RegisterHistogramVec(recordTransactionHistogramName, "classification", "strategy"),
-		queryTransaction:  collector.RegisterHistogramVec(queryTransactionHistogramName, "classification"),
-		addContact:        collector.RegisterHistogramVec(addContactHistogramName, "classification"),
-		cronHistogram:     collector.RegisterHistogramVec(cronHistogramName, "name", "classification"),
-		cronLastExecution: collector.RegisterGaugeVec(cronLastExecutionGaugeName, "name"),
+		collector:                collector,
+		stats:                    collector.RegisterGaugeVec(statsGaugeName, "name"),
+		verifyMerkleRoots:        collector.RegisterHistogramVec(verifyMerkleRootsHistogramName, "classification"),
+		recordTransaction:        collector.RegisterHistogramVec(recordTransactionHistogramName, "classification", "strategy"),
+		queryTransaction:         collector.RegisterHistogramVec(queryTransactionHistogramName, "classification"),
+		addContact:               collector.RegisterHistogramVec(addContactHistogramName, "classification"),
+		bhsVerifyMR:              collector.RegisterHistogramVec(bhsVerifyMerkleRootsHistogramName, "classification"),
+		paymailCall:              collector.RegisterHistogramVec(paymailCallHistogramName, "operation", "classification"),
+		merkleRootsCache:         collector.RegisterCounterVec(merkleRootsCacheCounterName, "result"),
+		paymailCapabilitiesCache: collector.RegisterCounterVec(paymailCapabilitiesCacheCounterName, "result"),
+		cronHistogram:            collector.RegisterHistogramVec(cronHistogramName, "name", "classification"),
+		cronLastExecution:        collector.RegisterGaugeVec(cronLastExecutionGaugeName, "name"),
 	}
 }
 
@@ -86,6 +97,31 @@ func (m *Metrics) TrackAddContact() EndWithClassification {
 	}
 }
 
+// ObserveBHSVerifyMerkleRoots is used to track the time of a merkle roots verification request sent to BHS
+func (m *Metrics) ObserveBHSVerifyMerkleRoots(duration time.Duration, success bool) {
+	m.bhsVerifyMR.WithLabelValues(classify(success)).Observe(duration.Seconds())
+}
+
+// IncMerkleRootsCacheHits is used to count merkle roots found in the cache
+func (m *Metrics) IncMerkleRootsCacheHits(count int) {
+	m.merkleRootsCache.WithLabelValues("hit").Add(float64(count))
+}
+
+// IncMerkleRootsCacheMisses is used to count merkle roots which were not found in the cache
+func (m *Metrics) IncMerkleRootsCacheMisses(count int) {
+	m.merkleRootsCache.WithLabelValues("miss").Add(float64(count))
+}
+
+// ObservePaymailCall is used to track the time of a call to the (external) paymail host
+func (m *Metrics) ObservePaymailCall(operation string, duration time.Duration, success bool) {
+	m.paymailCall.WithLabelValues(operation, classify(success)).Observe(duration.Seconds())
+}
+
+// IncPaymailCapabilitiesCache is used to count the lookups of the paymail capabilities cache by their result (hit, negative-hit, miss)
+func (m *Metrics) IncPaymailCapabilitiesCache(result string) {
+	m.paymailCapabilitiesCache.WithLabelValues(result).Inc()
+}
+
 func classify(success bool) string {
 	if success {
 		return "success"
@@ -3,10 +3,17 @@ package metrics
 const domainPrefix = "bsv_"
 
 const (
-	verifyMerkleRootsHistogramName = domainPrefix + "verify_merkle_roots_histogram"
-	recordTransactionHistogramName = domainPrefix + "record_transaction_histogram"
-	queryTransactionHistogramName  = domainPrefix + "query_transaction_histogram"
-	addContactHistogramName        = domainPrefix + "add_contact_histogram"
+	verifyMerkleRootsHistogramName    = domainPrefix + "verify_merkle_roots_histogram"
+	recordTransactionHistogramName    = domainPrefix + "record_transaction_histogram"
+	queryTransactionHistogramName     = domainPrefix + "query_transaction_histogram"
+	addContactHistogramName           = domainPrefix + "add_contact_histogram"
+	bhsVerifyMerkleRootsHistogramName = domainPrefix + "bhs_verify_merkle_roots_histogram"
+	paymailCallHistogramName          = domainPrefix + "paymail_call_histogram"
+)
+
+const (
+	merkleRootsCacheCounterName         = domainPrefix + "merkle_roots_cache_total"
+	paymailCapabilitiesCacheCounterName = domainPrefix + "paymail_capabilities_cache_total"
 )
 
 const (
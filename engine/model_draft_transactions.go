@@ -154,9 +154,13 @@ func (m *DraftTransaction) processConfigOutputs(ctx context.Context) error {
 	// Get the sender's paymail from the metadata, this help when sender has multiple paymails
 	senderPaymail, ok := m.Metadata["sender"].(string)
 	if ok {
-		alias, _, address := paymail.SanitizePaymail(senderPaymail)
+		alias, domain, address := paymail.SanitizePaymail(senderPaymail)
 		if address != "" {
 			conditions["alias"] = alias
+			// the domain may have its own default sender (used when the sender has no paymail)
+			if domainPaymailFrom := c.PaymailDomainsService().DefaultFromPaymail(ctx, domain); domainPaymailFrom != "" {
+				paymailFrom = domainPaymailFrom
+			}
 		}
 	}
 
@@ -0,0 +1,93 @@
+package paymail
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/bitcoin-sv/go-paymail"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/mrz1836/go-cachestore"
+)
+
+const (
+	cacheKeyCapabilities   = "paymail-capabilities-"
+	defaultCapabilitiesTTL = 2 * time.Minute
+)
+
+// Results of the capabilities cache lookups (used as the metrics label).
+const (
+	cacheHit         = "hit"
+	cacheNegativeHit = "negative-hit"
+	cacheMiss        = "miss"
+)
+
+// CachedCapabilities is the entry of the capabilities cache:
+// the capabilities of the paymail host or the error of the failed lookup (negative caching).
+type CachedCapabilities struct {
+	Domain       string                       `json:"domain"`
+	Capabilities *paymail.CapabilitiesPayload `json:"capabilities,omitempty"`
+	Error        string                       `json:"error,omitempty"`
+	CachedAt     time.Time                    `json:"cachedAt"`
+	ExpiresAt    time.Time                    `json:"expiresAt"`
+}
+
+// GetCachedCapabilities returns the cache entry for the domain or nil when the domain is not cached.
+func (s *service) GetCachedCapabilities(ctx context.Context, domain string) (*CachedCapabilities, error) {
+	cached := new(CachedCapabilities)
+	err := s.cache.GetModel(ctx, cacheKeyCapabilities+domain, cached)
+	if errors.Is(err, cachestore.ErrKeyNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get capabilities from cachestore")
+	}
+
+	if cached.Capabilities != nil && len(cached.Capabilities.Capabilities) > 0 || cached.Error != "" {
+		return cached, nil
+	}
+
+	return nil, nil
+}
+
+// PurgeCachedCapabilities removes the cached capabilities (or the failed lookup) of the domain.
+func (s *service) PurgeCachedCapabilities(ctx context.Context, domain string) error {
+	if err := s.cache.Delete(ctx, cacheKeyCapabilities+domain); err != nil {
+		return spverrors.Wrapf(err, "failed to remove capabilities from cachestore")
+	}
+	return nil
+}
+
+func (s *service) putCapabilitiesInCache(ctx context.Context, domain string, capabilities *paymail.CapabilitiesPayload) {
+	s.putInCache(ctx, &CachedCapabilities{Domain: domain, Capabilities: capabilities}, s.capabilitiesTTL(domain))
+}
+
+func (s *service) putFailedLookupInCache(ctx context.Context, domain string, lookupErr error) {
+	if s.cfg.NegativeCacheTTL <= 0 || ctx.Err() != nil {
+		return
+	}
+	s.putInCache(ctx, &CachedCapabilities{Domain: domain, Error: lookupErr.Error()}, s.cfg.NegativeCacheTTL)
+}
+
+func (s *service) putInCache(ctx context.Context, cached *CachedCapabilities, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+	cached.CachedAt = time.Now().UTC()
+	cached.ExpiresAt = cached.CachedAt.Add(ttl)
+
+	err := s.cache.SetModel(ctx, cacheKeyCapabilities+cached.Domain, cached, ttl)
+	if err != nil {
+		s.log.Warn().Err(err).Msgf("failed to store capabilities for domain %s in cache", cached.Domain)
+	}
+}
+
+func (s *service) capabilitiesTTL(domain string) time.Duration {
+	for _, domainTTL := range s.cfg.CapabilitiesDomainTTLs {
+		if strings.EqualFold(domainTTL.Domain, domain) {
+			return domainTTL.TTL
+		}
+	}
+	return s.cfg.CapabilitiesTTL
+}
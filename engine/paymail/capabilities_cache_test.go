@@ -0,0 +1,185 @@
+package paymail_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bitcoin-sv/go-paymail"
+	"github.com/bitcoin-sv/spv-wallet/config"
+	paymailclient "github.com/bitcoin-sv/spv-wallet/engine/paymail"
+	pmerrors "github.com/bitcoin-sv/spv-wallet/engine/paymail/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/paymail/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/paymailmock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CapabilitiesCache(t *testing.T) {
+	t.Run("cache capabilities and purge them", func(t *testing.T) {
+		given := testabilities.Given(t)
+
+		// given:
+		guard := &hostGuardMock{}
+		paymailClient := given.NewPaymailClientService(
+			paymailclient.WithHostGuard(guard),
+			paymailclient.WithClientConfig(&config.PaymailClientConfig{CapabilitiesTTL: time.Minute}),
+		)
+		given.ExternalPaymailHost().WillRespondWithP2PCapabilities()
+
+		// when:
+		_, err := paymailClient.GetCapabilities(context.Background(), testDomain)
+		require.NoError(t, err)
+		_, err = paymailClient.GetCapabilities(context.Background(), testDomain)
+		require.NoError(t, err)
+
+		// then:
+		require.Len(t, guard.calls, 1, "capabilities should be taken from the cache")
+
+		cached, err := paymailClient.GetCachedCapabilities(context.Background(), testDomain)
+		require.NoError(t, err)
+		require.NotNil(t, cached)
+		require.NotNil(t, cached.Capabilities)
+		require.Empty(t, cached.Error)
+		require.WithinDuration(t, cached.CachedAt.Add(time.Minute), cached.ExpiresAt, time.Millisecond)
+
+		// when:
+		err = paymailClient.PurgeCachedCapabilities(context.Background(), testDomain)
+
+		// then:
+		require.NoError(t, err)
+		cached, err = paymailClient.GetCachedCapabilities(context.Background(), testDomain)
+		require.NoError(t, err)
+		require.Nil(t, cached)
+	})
+
+	t.Run("cache failed lookup", func(t *testing.T) {
+		given := testabilities.Given(t)
+
+		// given:
+		guard := &hostGuardMock{}
+		paymailClient := given.NewPaymailClientService(
+			paymailclient.WithHostGuard(guard),
+			paymailclient.WithClientConfig(&config.PaymailClientConfig{CapabilitiesTTL: time.Minute, NegativeCacheTTL: time.Minute}),
+		)
+		given.ExternalPaymailHost().WillRespondWithErrorOnCapabilities()
+
+		// when:
+		_, firstErr := paymailClient.GetCapabilities(context.Background(), testDomain)
+		_, secondErr := paymailClient.GetCapabilities(context.Background(), testDomain)
+
+		// then:
+		require.Error(t, firstErr)
+		require.ErrorContains(t, secondErr, "failed recently")
+		require.Len(t, guard.calls, 1, "failed lookup should be taken from the cache")
+
+		cached, err := paymailClient.GetCachedCapabilities(context.Background(), testDomain)
+		require.NoError(t, err)
+		require.NotNil(t, cached)
+		require.Nil(t, cached.Capabilities)
+		require.NotEmpty(t, cached.Error)
+	})
+
+	t.Run("don't cache failed lookup without negative cache TTL", func(t *testing.T) {
+		given := testabilities.Given(t)
+
+		// given:
+		guard := &hostGuardMock{}
+		paymailClient := given.NewPaymailClientService(
+			paymailclient.WithHostGuard(guard),
+			paymailclient.WithClientConfig(&config.PaymailClientConfig{CapabilitiesTTL: time.Minute}),
+		)
+		given.ExternalPaymailHost().WillRespondWithErrorOnCapabilities()
+
+		// when:
+		_, _ = paymailClient.GetCapabilities(context.Background(), testDomain)
+		_, err := paymailClient.GetCapabilities(context.Background(), testDomain)
+
+		// then:
+		require.Error(t, err)
+		require.Len(t, guard.calls, 2)
+	})
+}
+
+func Test_PaymailCallResilience(t *testing.T) {
+	t.Run("retry failed lookup", func(t *testing.T) {
+		given := testabilities.Given(t)
+
+		// given:
+		guard := &hostGuardMock{}
+		paymailClient := given.NewPaymailClientService(
+			paymailclient.WithHostGuard(guard),
+			paymailclient.WithClientConfig(&config.PaymailClientConfig{CapabilitiesTTL: time.Minute, Retries: 2}),
+		)
+		given.ExternalPaymailHost().WillRespondWithErrorOnCapabilities()
+
+		// when:
+		_, err := paymailClient.GetCapabilities(context.Background(), testDomain)
+
+		// then:
+		require.Error(t, err)
+		require.Len(t, guard.calls, 3)
+		for _, call := range guard.calls {
+			require.Error(t, call.err)
+		}
+	})
+
+	t.Run("don't retry and don't count as host failure the not found response", func(t *testing.T) {
+		given := testabilities.Given(t)
+
+		// given:
+		guard := &hostGuardMock{}
+		paymailClient := given.NewPaymailClientService(
+			paymailclient.WithHostGuard(guard),
+			paymailclient.WithClientConfig(&config.PaymailClientConfig{CapabilitiesTTL: time.Minute, Retries: 2}),
+		)
+		given.ExternalPaymailHost().WillRespondWithNotFoundOnCapabilities()
+
+		// when:
+		_, err := paymailClient.GetCapabilities(context.Background(), testDomain)
+
+		// then:
+		require.Error(t, err)
+		require.Equal(t, []hostCall{{domain: testDomain}}, guard.calls)
+	})
+
+	t.Run("don't retry and don't count as host failure the invalid response", func(t *testing.T) {
+		given := testabilities.Given(t)
+
+		// given:
+		guard := &hostGuardMock{}
+		paymailClient := given.NewPaymailClientService(
+			paymailclient.WithHostGuard(guard),
+			paymailclient.WithClientConfig(&config.PaymailClientConfig{CapabilitiesTTL: time.Minute, Retries: 2}),
+		)
+		given.ExternalPaymailHost().WillRespondWithP2PCapabilities()
+		given.ExternalPaymailHost().WillRespondOnCapability(paymail.BRFCP2PPaymentDestination).
+			With(paymailmock.P2PDestinationsForSats(2))
+
+		// when:
+		_, err := paymailClient.GetP2PDestinations(context.Background(), &paymail.SanitisedPaymail{
+			Alias:   "tester",
+			Domain:  testDomain,
+			Address: "tester@" + testDomain,
+		}, 1)
+
+		// then:
+		require.ErrorIs(t, err, pmerrors.ErrPaymailHostInvalidResponse)
+		require.Equal(t, []hostCall{{domain: testDomain}, {domain: testDomain}}, guard.calls, "capabilities and single p2p destinations call should be reported as successful")
+	})
+
+	t.Run("don't call blocked host", func(t *testing.T) {
+		given := testabilities.Given(t)
+
+		// given:
+		guard := &hostGuardMock{unavailable: pmerrors.ErrPaymailHostBlocked}
+		paymailClient := given.NewPaymailClientService(paymailclient.WithHostGuard(guard))
+		given.ExternalPaymailHost().WillRespondWithP2PCapabilities()
+
+		// when:
+		_, err := paymailClient.GetCapabilities(context.Background(), testDomain)
+
+		// then:
+		require.ErrorIs(t, err, pmerrors.ErrPaymailHostBlocked)
+		require.Empty(t, guard.calls)
+	})
+}
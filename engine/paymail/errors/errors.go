@@ -37,3 +37,21 @@ var ErrParseIncomingTransaction = models.SPVError{Message: "incoming hex transac
 
 // ErrRecordTransaction is when the transaction could not be recorded
 var ErrRecordTransaction = models.SPVError{Message: "transaction could not be recorded", StatusCode: 500, Code: "error-record-transaction"}
+
+// ErrSenderValidationFailed is when the sender of the incoming transaction cannot be verified against the sender's PKI
+var ErrSenderValidationFailed = models.SPVError{Message: "sender of the transaction could not be verified", StatusCode: 400, Code: "error-paymail-sender-validation-failed"}
+
+// ErrPaymailHostDenied is when the paymail host is on the deny list
+var ErrPaymailHostDenied = models.SPVError{Message: "paymail host is on the deny list", StatusCode: 400, Code: "error-paymail-host-denied"}
+
+// ErrPaymailHostNotAllowed is when the allow list is used and the paymail host is not on it
+var ErrPaymailHostNotAllowed = models.SPVError{Message: "paymail host is not on the allow list", StatusCode: 400, Code: "error-paymail-host-not-allowed"}
+
+// ErrPaymailHostBlocked is when the paymail host failed too many times in a row and calls to it are blocked for a while
+var ErrPaymailHostBlocked = models.SPVError{Message: "paymail host is blocked due to repeated failures", StatusCode: 500, Code: "error-paymail-host-blocked"}
+
+// ErrMissingInvoiceReference is when the destination is requested without the reference of the invoice
+var ErrMissingInvoiceReference = models.SPVError{Message: "missing invoice reference", StatusCode: 400, Code: "error-paymail-missing-invoice-reference"}
+
+// ErrPaymailRateLimitExceeded is when the client or the paymail exceeded the rate limit of the paymail endpoints
+var ErrPaymailRateLimitExceeded = models.SPVError{Message: "too many paymail requests", StatusCode: 429, Code: "error-paymail-rate-limit-exceeded"}
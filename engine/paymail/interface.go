@@ -3,16 +3,35 @@ package paymail
 import (
 	"context"
 
+	"github.com/bitcoin-sv/go-paymail"
 	"github.com/bitcoin-sv/go-paymail/spv"
 	primitives "github.com/bitcoin-sv/go-sdk/primitives/ec"
 	trx "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/spv-wallet/engine/paymaildomains"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/addresses/addressesmodels"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/contacts/contactsmodels"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/invoices/invoicesmodels"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymaildestinations/destinationmodels"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymails/paymailsmodels"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymailserver/deferredmodels"
 )
 
 // PaymailsService is an interface for paymails service
 type PaymailsService interface {
 	Find(ctx context.Context, alias, domain string) (*paymailsmodels.Paymail, error)
+	// FindPublicProfile returns the paymail served publicly (it can be cached for a short time).
+	FindPublicProfile(ctx context.Context, alias, domain string) (*paymailsmodels.Paymail, error)
+}
+
+// DomainsService is an interface for paymail domains service
+type DomainsService interface {
+	// Find returns the served domain or nil when the domain is not served.
+	Find(ctx context.Context, name string) (*paymaildomains.Domain, error)
+}
+
+// PKIProvider is an interface for getting the PKI of (external) paymails
+type PKIProvider interface {
+	GetPkiForPaymail(ctx context.Context, sPaymail *paymail.SanitisedPaymail) (*paymail.PKIResponse, error)
 }
 
 // UsersService is an interface for users service
@@ -25,12 +44,37 @@ type AddressesService interface {
 	Create(ctx context.Context, newAddress *addressesmodels.NewAddress) error
 }
 
+// DestinationsService is an interface for the service keeping the origin of the addresses derived for paymails
+type DestinationsService interface {
+	Create(ctx context.Context, destination *destinationmodels.NewDestination) error
+	RecordPayment(ctx context.Context, referenceID string, tx *trx.Transaction, payment destinationmodels.Payment) error
+}
+
+// InvoicesService is an interface for invoices service
+type InvoicesService interface {
+	// FindPayable returns the invoice served through the given paymail, which still awaits the payment.
+	FindPayable(ctx context.Context, paymail, reference string) (*invoicesmodels.Invoice, error)
+}
+
 // MerkleRootsVerifier is an interface for verifying merkle roots
 type MerkleRootsVerifier interface {
 	VerifyMerkleRoots(ctx context.Context, merkleRoots []*spv.MerkleRootConfirmationRequestItem) (bool, error)
 }
 
+// DeferredTransactionsRepo is an interface for storing incoming transactions until their merkle roots are verified
+type DeferredTransactionsRepo interface {
+	Create(ctx context.Context, tx *deferredmodels.NewDeferredTransaction) error
+	FindPending(ctx context.Context, limit int) ([]*deferredmodels.DeferredTransaction, error)
+	MarkRejected(ctx context.Context, txID, reason string) error
+	Delete(ctx context.Context, txID string) error
+}
+
 // TxRecorder is an interface for recording transactions
 type TxRecorder interface {
-	RecordPaymailTransaction(ctx context.Context, tx *trx.Transaction, senderPaymail, receiverPaymail string) error
+	RecordPaymailTransaction(ctx context.Context, tx *trx.Transaction, senderPaymail, senderPubKey, receiverPaymail, reference string) error
+}
+
+// ContactsService is an interface for contacts service
+type ContactsService interface {
+	AddContactRequest(ctx context.Context, userID, fullName, paymailAddress string) (*contactsmodels.Contact, error)
 }
@@ -0,0 +1,164 @@
+package paymail
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/bitcoin-sv/go-paymail"
+	pmerrors "github.com/bitcoin-sv/spv-wallet/engine/paymail/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+)
+
+// Operations of the calls to the paymail hosts (used as the metrics label).
+const (
+	operationCapabilities   = "capabilities"
+	operationPKI            = "pki"
+	operationP2PDestination = "p2p-destination"
+	operationP2PSend        = "p2p-send"
+	operationPikeInvite     = "pike-invite"
+)
+
+// call makes the call to the paymail host of the domain, unless the host is blocked due to repeated failures.
+// The call is limited by the configured timeout and - if it's retryable - repeated on failure of the host.
+// Every attempt is reported to the host guard (reputation) and to the metrics.
+// Only the failures of the host itself (transport errors, timeouts and 5xx responses) are counted by the host guard and retried,
+// so e.g. requests for unknown paymails (4xx) or responses rejected by validation cannot get the host blocked.
+func call[T any](ctx context.Context, s *service, domain, operation string, retryable bool, fn func() (T, error)) (T, error) {
+	var result T
+	if err := s.hosts.CheckAvailability(domain); err != nil {
+		return result, err //nolint:wrapcheck // the guard returns SPVErrors
+	}
+
+	attempts := 1
+	if retryable {
+		attempts += s.cfg.Retries
+	}
+
+	var err error
+	for attempt := range attempts {
+		if attempt > 0 {
+			if waitErr := sleep(ctx, s.cfg.RetryDelay); waitErr != nil {
+				return result, err
+			}
+			// the failures could get the host blocked (or it was the trial call to the blocked host)
+			if s.hosts.CheckAvailability(domain) != nil {
+				return result, err
+			}
+			s.log.Debug().Err(err).Str("domain", domain).Str("operation", operation).Int("attempt", attempt+1).Msg("Retrying paymail call")
+		}
+
+		start := time.Now()
+		result, err = withTimeout(ctx, s.cfg.Timeout, fn)
+		latency := time.Since(start)
+
+		hostErr := hostFailure(err, result)
+		s.hosts.RecordCall(domain, latency, hostErr)
+		s.metrics.ObservePaymailCall(operation, latency, err == nil)
+
+		if hostErr == nil || ctx.Err() != nil {
+			return result, err
+		}
+	}
+	return result, err
+}
+
+// withTimeout returns when the call finishes or the timeout passes;
+// go-paymail client doesn't accept a context, so the call itself is finished by the timeouts of the HTTP client.
+func withTimeout[T any](ctx context.Context, timeout time.Duration, fn func() (T, error)) (T, error) {
+	if timeout <= 0 {
+		return fn()
+	}
+
+	type outcome struct {
+		result T
+		err    error
+	}
+	done := make(chan outcome, 1)
+	go func() {
+		result, err := fn()
+		done <- outcome{result: result, err: err}
+	}()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	select {
+	case out := <-done:
+		return out.result, out.err
+	case <-ctx.Done():
+		var result T
+		return result, spverrors.Wrapf(ctx.Err(), "paymail host didn't respond in %s", timeout)
+	}
+}
+
+// hostFailure returns the error if it's caused by the paymail host being unavailable or failing,
+// and nil if the call succeeded or the host responded properly but the request or the response was not accepted.
+func hostFailure(err error, response any) error {
+	if err == nil || errors.Is(err, pmerrors.ErrPaymailHostInvalidResponse) {
+		return nil
+	}
+	statusCode := responseStatusCode(response)
+	if statusCode == 0 || statusCode >= http.StatusInternalServerError {
+		return err
+	}
+	return nil
+}
+
+// responseStatusCode returns the HTTP status code of the paymail host response or 0 if there is no response (e.g. transport error or timeout).
+func responseStatusCode(response any) int {
+	switch r := response.(type) {
+	case *paymail.CapabilitiesResponse:
+		if r != nil {
+			return r.StatusCode
+		}
+	case *paymail.PaymentDestinationResponse:
+		if r != nil {
+			return r.StatusCode
+		}
+	case *paymail.PKIResponse:
+		if r != nil {
+			return r.StatusCode
+		}
+	case *paymail.PikeContactRequestResponse:
+		if r != nil {
+			return r.StatusCode
+		}
+	case *paymail.P2PTransactionResponse:
+		if r != nil {
+			return r.StatusCode
+		}
+	}
+	return 0
+}
+
+func sleep(ctx context.Context, delay time.Duration) error {
+	if delay <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// anyHost is the HostGuard used when the paymail hosts are not guarded: every host can be called.
+type anyHost struct{}
+
+func (anyHost) CheckHost(context.Context, string) error { return nil }
+
+func (anyHost) CheckAvailability(string) error { return nil }
+
+func (anyHost) RecordCall(string, time.Duration, error) {}
+
+// noMetrics is used when the metrics are disabled.
+type noMetrics struct{}
+
+func (noMetrics) ObservePaymailCall(string, time.Duration, bool) {}
+
+func (noMetrics) IncPaymailCapabilitiesCache(string) {}
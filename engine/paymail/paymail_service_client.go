@@ -2,11 +2,10 @@ package paymail
 
 import (
 	"context"
-	"errors"
-	"time"
 
 	"github.com/bitcoin-sv/go-paymail"
 	trx "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/spv-wallet/config"
 	pmerrors "github.com/bitcoin-sv/spv-wallet/engine/paymail/errors"
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	"github.com/bitcoin-sv/spv-wallet/models/bsv"
@@ -14,17 +13,44 @@ import (
 	"github.com/rs/zerolog"
 )
 
-const cacheKeyCapabilities = "paymail-capabilities-"
-const cacheTTLCapabilities = 2 * time.Minute
-
 type service struct {
 	cache         cachestore.ClientInterface
 	paymailClient paymail.ClientInterface
 	log           zerolog.Logger
+	hosts         HostGuard
+	metrics       Metrics
+	cfg           config.PaymailClientConfig
+}
+
+// ServiceClientOption is an optional setting of the paymail service client.
+type ServiceClientOption func(*service)
+
+// WithHostGuard makes the paymail service client check the paymail hosts before payments and contact requests
+// and report the result of every call to the host.
+func WithHostGuard(guard HostGuard) ServiceClientOption {
+	return func(s *service) {
+		s.hosts = guard
+	}
+}
+
+// WithClientConfig sets the capabilities cache TTLs and the timeout and retries of the calls to the paymail hosts.
+func WithClientConfig(cfg *config.PaymailClientConfig) ServiceClientOption {
+	return func(s *service) {
+		if cfg != nil {
+			s.cfg = *cfg
+		}
+	}
+}
+
+// WithMetrics makes the paymail service client collect metrics of the calls to the paymail hosts and of the capabilities cache.
+func WithMetrics(metrics Metrics) ServiceClientOption {
+	return func(s *service) {
+		s.metrics = metrics
+	}
 }
 
 // NewServiceClient creates a new paymail service client
-func NewServiceClient(cache cachestore.ClientInterface, paymailClient paymail.ClientInterface, log zerolog.Logger) ServiceClient {
+func NewServiceClient(cache cachestore.ClientInterface, paymailClient paymail.ClientInterface, log zerolog.Logger, opts ...ServiceClientOption) ServiceClient {
 	if paymailClient == nil {
 		panic(spverrors.Newf("paymail client is required to create a new paymail service"))
 	}
@@ -33,11 +59,18 @@ func NewServiceClient(cache cachestore.ClientInterface, paymailClient paymail.Cl
 		panic(spverrors.Newf("cache is required to create a new paymail service"))
 	}
 
-	return &service{
+	s := &service{
 		cache:         cache,
 		paymailClient: paymailClient,
 		log:           log,
+		hosts:         anyHost{},
+		metrics:       noMetrics{},
+		cfg:           config.PaymailClientConfig{CapabilitiesTTL: defaultCapabilitiesTTL},
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
 }
 
 // GetSanitizedPaymail validates and returns the sanitized version of paymail address (alias@domain.tld)
@@ -53,31 +86,42 @@ func (s *service) GetSanitizedPaymail(addr string) (*paymail.SanitisedPaymail, e
 }
 
 // GetCapabilities is a utility function to retrieve capabilities for a Paymail provider
+// Capabilities are cached (see config.PaymailClientConfig); failed lookups are cached for NegativeCacheTTL.
 func (s *service) GetCapabilities(ctx context.Context, domain string) (*paymail.CapabilitiesPayload, error) {
-	// todo: allow user to configure the time that they want to cache the capabilities (if they want to cache or not)
-
-	cacheKey := cacheKeyCapabilities + domain
-
-	capabilities, err := s.loadCapabilitiesFromCache(ctx, cacheKey)
+	cached, err := s.GetCachedCapabilities(ctx, domain)
 	if err != nil {
 		return nil, err
 	}
-	if capabilities != nil {
-		return capabilities, nil
+	switch {
+	case cached == nil:
+		s.metrics.IncPaymailCapabilitiesCache(cacheMiss)
+	case cached.Capabilities != nil:
+		s.metrics.IncPaymailCapabilitiesCache(cacheHit)
+		return cached.Capabilities, nil
+	default:
+		s.metrics.IncPaymailCapabilitiesCache(cacheNegativeHit)
+		return nil, spverrors.Newf("capabilities lookup of %s failed recently: %s", domain, cached.Error)
 	}
 
-	response, err := s.loadCapabilities(domain)
+	response, err := call(ctx, s, domain, operationCapabilities, true, func() (*paymail.CapabilitiesResponse, error) {
+		return s.loadCapabilities(domain)
+	})
 	if err != nil {
+		s.putFailedLookupInCache(ctx, domain, err)
 		return nil, err
 	}
 
-	s.putCapabilitiesInCache(ctx, cacheKey, response.CapabilitiesPayload)
+	s.putCapabilitiesInCache(ctx, domain, &response.CapabilitiesPayload)
 
 	return &response.CapabilitiesPayload, nil
 }
 
 // GetP2PDestinations will ask a paymail host on given address for P2P destinations.
 func (s *service) GetP2PDestinations(ctx context.Context, address *paymail.SanitisedPaymail, satoshis bsv.Satoshis) (*paymail.PaymentDestinationPayload, error) {
+	if err := s.hosts.CheckHost(ctx, address.Domain); err != nil {
+		return nil, err //nolint:wrapcheck // the guard returns SPVErrors
+	}
+
 	capabilities, err := s.GetCapabilities(ctx, address.Domain)
 	if err != nil {
 		return nil, pmerrors.ErrPaymailHostResponseError.Wrap(err)
@@ -88,16 +132,22 @@ func (s *service) GetP2PDestinations(ctx context.Context, address *paymail.Sanit
 		return nil, pmerrors.ErrPaymailHostNotSupportingP2P
 	}
 
-	response, err := s.paymailClient.GetP2PPaymentDestination(
-		p2pDestinationURL,
-		address.Alias, address.Domain,
-		&paymail.PaymentRequest{Satoshis: uint64(satoshis)},
-	)
-	if err != nil {
-		return nil, pmerrors.ErrPaymailHostResponseError.Wrap(err)
-	}
-
-	err = s.validatePaymentDestinationResponse(response, satoshis)
+	// an invalid response is treated as a failure of the host (and it's not retried)
+	response, err := call(ctx, s, address.Domain, operationP2PDestination, true, func() (*paymail.PaymentDestinationResponse, error) {
+		response, err := s.paymailClient.GetP2PPaymentDestination(
+			p2pDestinationURL,
+			address.Alias, address.Domain,
+			&paymail.PaymentRequest{Satoshis: uint64(satoshis)},
+		)
+		if err != nil {
+			// the response is returned to find out if the host failed (see hostFailure)
+			return response, pmerrors.ErrPaymailHostResponseError.Wrap(err)
+		}
+		if err = s.validatePaymentDestinationResponse(response, satoshis); err != nil {
+			return nil, err
+		}
+		return response, nil
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -105,30 +155,6 @@ func (s *service) GetP2PDestinations(ctx context.Context, address *paymail.Sanit
 	return &response.PaymentDestinationPayload, nil
 }
 
-func (s *service) loadCapabilitiesFromCache(ctx context.Context, key string) (*paymail.CapabilitiesPayload, error) {
-	capabilities := new(paymail.CapabilitiesPayload)
-	err := s.cache.GetModel(ctx, key, capabilities)
-	if errors.Is(err, cachestore.ErrKeyNotFound) {
-		return nil, nil
-	}
-	if err != nil {
-		return nil, spverrors.Wrapf(err, "failed to get capabilities from cachestore")
-	}
-
-	if len(capabilities.Capabilities) > 0 {
-		return capabilities, nil
-	}
-
-	return nil, nil
-}
-
-func (s *service) putCapabilitiesInCache(ctx context.Context, key string, capabilities paymail.CapabilitiesPayload) {
-	err := s.cache.SetModel(ctx, key, capabilities, cacheTTLCapabilities)
-	if err != nil {
-		s.log.Warn().Err(err).Msgf("failed to store capabilities for key %s in cache", key)
-	}
-}
-
 func (s *service) loadCapabilities(domain string) (*paymail.CapabilitiesResponse, error) {
 	// Get SRV record (domain can be different!)
 	srv, err := s.paymailClient.GetSRVRecord(
@@ -152,11 +178,13 @@ func (s *service) GetP2P(ctx context.Context, domain string) (success bool, p2pD
 // StartP2PTransaction will start the P2P transaction, returning the reference ID and outputs
 func (s *service) StartP2PTransaction(alias, domain, p2pDestinationURL string, satoshis uint64) (*paymail.PaymentDestinationPayload, error) {
 	// Start the P2P transaction request
-	response, err := s.paymailClient.GetP2PPaymentDestination(
-		p2pDestinationURL,
-		alias, domain,
-		&paymail.PaymentRequest{Satoshis: satoshis},
-	)
+	response, err := call(context.Background(), s, domain, operationP2PDestination, true, func() (*paymail.PaymentDestinationResponse, error) {
+		return s.paymailClient.GetP2PPaymentDestination(
+			p2pDestinationURL,
+			alias, domain,
+			&paymail.PaymentRequest{Satoshis: satoshis},
+		)
+	})
 	if err != nil {
 		return nil, err //nolint:wrapcheck // we have handler for paymail errors
 	}
@@ -176,7 +204,9 @@ func (s *service) GetPkiForPaymail(ctx context.Context, sPaymail *paymail.Saniti
 	}
 
 	url := capabilities.GetString(paymail.BRFCPki, paymail.BRFCPkiAlternate)
-	pki, err := s.paymailClient.GetPKI(url, sPaymail.Alias, sPaymail.Domain)
+	pki, err := call(ctx, s, sPaymail.Domain, operationPKI, true, func() (*paymail.PKIResponse, error) {
+		return s.paymailClient.GetPKI(url, sPaymail.Alias, sPaymail.Domain)
+	})
 	if err != nil {
 		return nil, err //nolint:wrapcheck // we have handler for paymail errors
 	}
@@ -186,6 +216,10 @@ func (s *service) GetPkiForPaymail(ctx context.Context, sPaymail *paymail.Saniti
 
 // AddContactRequest sends a contact invitation via PIKE capability
 func (s *service) AddContactRequest(ctx context.Context, receiverPaymail *paymail.SanitisedPaymail, contactData *paymail.PikeContactRequestPayload) (*paymail.PikeContactRequestResponse, error) {
+	if err := s.hosts.CheckHost(ctx, receiverPaymail.Domain); err != nil {
+		return nil, err //nolint:wrapcheck // the guard returns SPVErrors
+	}
+
 	capabilities, err := s.GetCapabilities(ctx, receiverPaymail.Domain)
 	if err != nil {
 		return nil, spverrors.ErrGetCapabilities
@@ -196,7 +230,9 @@ func (s *service) AddContactRequest(ctx context.Context, receiverPaymail *paymai
 	}
 
 	url := capabilities.ExtractPikeInviteURL()
-	response, err := s.paymailClient.AddContactRequest(url, receiverPaymail.Alias, receiverPaymail.Domain, contactData)
+	response, err := call(ctx, s, receiverPaymail.Domain, operationPikeInvite, false, func() (*paymail.PikeContactRequestResponse, error) {
+		return s.paymailClient.AddContactRequest(url, receiverPaymail.Alias, receiverPaymail.Domain, contactData)
+	})
 	if err != nil {
 		return nil, spverrors.Wrapf(err, "failed to send contact request")
 	}
@@ -260,7 +296,9 @@ func (s *service) Notify(ctx context.Context, address string, p2pMetadata *payma
 		return spverrors.Newf("%s is unknown format", format)
 	}
 
-	res, err := s.paymailClient.SendP2PTransaction(p2pSubmitTxURL, sanitizedPm.Alias, sanitizedPm.Domain, p2pTransaction)
+	res, err := call(ctx, s, sanitizedPm.Domain, operationP2PSend, false, func() (*paymail.P2PTransactionResponse, error) {
+		return s.paymailClient.SendP2PTransaction(p2pSubmitTxURL, sanitizedPm.Alias, sanitizedPm.Domain, p2pTransaction)
+	})
 	if err != nil {
 		return spverrors.Wrapf(err, "failed to send transaction via paymail")
 	}
@@ -2,6 +2,7 @@ package paymail
 
 import (
 	"context"
+	"time"
 
 	"github.com/bitcoin-sv/go-paymail"
 	trx "github.com/bitcoin-sv/go-sdk/transaction"
@@ -18,4 +19,22 @@ type ServiceClient interface {
 	GetPkiForPaymail(ctx context.Context, sPaymail *paymail.SanitisedPaymail) (*paymail.PKIResponse, error)
 	AddContactRequest(ctx context.Context, receiverPaymail *paymail.SanitisedPaymail, contactData *paymail.PikeContactRequestPayload) (*paymail.PikeContactRequestResponse, error)
 	Notify(ctx context.Context, address string, p2pMetadata *paymail.P2PMetaData, reference string, tx *trx.Transaction) error
+	GetCachedCapabilities(ctx context.Context, domain string) (*CachedCapabilities, error)
+	PurgeCachedCapabilities(ctx context.Context, domain string) error
+}
+
+// HostGuard decides if the paymail host can be called and keeps its reputation.
+type HostGuard interface {
+	// CheckHost returns an error if payments and contact requests cannot be sent to the paymail host of the domain.
+	CheckHost(ctx context.Context, domain string) error
+	// CheckAvailability returns an error if the paymail host of the domain cannot be called at all, because it failed too many times in a row.
+	CheckAvailability(domain string) error
+	// RecordCall updates the reputation of the paymail host of the domain with the result of the call.
+	RecordCall(domain string, latency time.Duration, err error)
+}
+
+// Metrics collects metrics of the calls to the paymail hosts and of the capabilities cache.
+type Metrics interface {
+	ObservePaymailCall(operation string, duration time.Duration, success bool)
+	IncPaymailCapabilitiesCache(result string)
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/bitcoin-sv/go-paymail"
 	"github.com/bitcoin-sv/spv-wallet/engine"
 	paymailclient "github.com/bitcoin-sv/spv-wallet/engine/paymail"
+	pmerrors "github.com/bitcoin-sv/spv-wallet/engine/paymail/errors"
 	"github.com/bitcoin-sv/spv-wallet/engine/paymail/testabilities"
 	"github.com/bitcoin-sv/spv-wallet/engine/taskmanager"
 	xtester "github.com/bitcoin-sv/spv-wallet/engine/tester"
@@ -191,6 +192,66 @@ func Test_GetP2PDestinations(t *testing.T) {
 		assert.Equal(t, paymailHostResponse.Outputs[0].Script, destinations.Outputs[0].Script)
 		assert.EqualValues(t, satoshis, destinations.Outputs[0].Satoshis)
 	})
+
+	t.Run("return error when paymail host is denied", func(t *testing.T) {
+		given := testabilities.Given(t)
+
+		// given:
+		guard := &hostGuardMock{err: pmerrors.ErrPaymailHostDenied}
+		paymailClient := given.NewPaymailClientService(paymailclient.WithHostGuard(guard))
+
+		// and:
+		given.ExternalPaymailHost().WillRespondWithP2PCapabilities()
+
+		// when:
+		destinations, err := paymailClient.GetP2PDestinations(context.Background(), paymailAddress, satoshis)
+
+		// then:
+		require.ErrorIs(t, err, pmerrors.ErrPaymailHostDenied)
+		require.Nil(t, destinations)
+		require.Empty(t, guard.calls)
+	})
+
+	t.Run("report results of calls to the host guard", func(t *testing.T) {
+		given := testabilities.Given(t)
+
+		// given:
+		guard := &hostGuardMock{}
+		paymailClient := given.NewPaymailClientService(paymailclient.WithHostGuard(guard))
+
+		// and:
+		given.ExternalPaymailHost().WillRespondWithP2PCapabilities()
+
+		// when:
+		_, err := paymailClient.GetP2PDestinations(context.Background(), paymailAddress, satoshis)
+
+		// then:
+		require.NoError(t, err)
+		require.Equal(t, []hostCall{{domain: testDomain}, {domain: testDomain}}, guard.calls, "capabilities and p2p destinations calls should be reported")
+	})
+}
+
+type hostCall struct {
+	domain string
+	err    error
+}
+
+type hostGuardMock struct {
+	err         error
+	unavailable error
+	calls       []hostCall
+}
+
+func (m *hostGuardMock) CheckHost(_ context.Context, _ string) error {
+	return m.err
+}
+
+func (m *hostGuardMock) CheckAvailability(_ string) error {
+	return m.unavailable
+}
+
+func (m *hostGuardMock) RecordCall(domain string, _ time.Duration, err error) {
+	m.calls = append(m.calls, hostCall{domain: domain, err: err})
 }
 
 func Test_StartP2PTransaction(t *testing.T) {
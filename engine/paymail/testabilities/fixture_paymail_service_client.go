@@ -14,7 +14,7 @@ import (
 
 // PaymailClientFixture is a test fixture - used for establishing environment for test.
 type PaymailClientFixture interface {
-	NewPaymailClientService() paymailclient.ServiceClient
+	NewPaymailClientService(opts ...paymailclient.ServiceClientOption) paymailclient.ServiceClient
 	MockedPaymailClient() *paymailmock.PaymailClientMock
 	ExternalPaymailHost() PaymailHostFixture
 }
@@ -55,8 +55,8 @@ func GivenWithMockClient(t testing.TB, mockClient *paymailmock.PaymailClientMock
 	return ability
 }
 
-func (a *paymailServiceClientAbility) NewPaymailClientService() paymailclient.ServiceClient {
-	return paymailclient.NewServiceClient(tester.CacheStore(), a.PaymailClientMock, tester.Logger(a.t))
+func (a *paymailServiceClientAbility) NewPaymailClientService(opts ...paymailclient.ServiceClientOption) paymailclient.ServiceClient {
+	return paymailclient.NewServiceClient(tester.CacheStore(), a.PaymailClientMock, tester.Logger(a.t), opts...)
 }
 
 func (a *paymailServiceClientAbility) MockedPaymailClient() *paymailmock.PaymailClientMock {
@@ -14,6 +14,7 @@ import (
 	trx "github.com/bitcoin-sv/go-sdk/transaction"
 	"github.com/bitcoin-sv/go-sdk/transaction/template/p2pkh"
 	"github.com/bitcoin-sv/spv-wallet/conv"
+	"github.com/bitcoin-sv/spv-wallet/engine/paymaildomains"
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	"github.com/bitcoin-sv/spv-wallet/engine/utils"
 )
@@ -53,6 +54,10 @@ func (p *PaymailDefaultServiceProvider) GetPaymailByAlias(
 	alias, domain string,
 	_ *server.RequestMetadata,
 ) (*paymail.AddressInformation, error) {
+	if _, err := p.servedDomain(ctx, domain); err != nil {
+		return nil, err
+	}
+
 	pm, err := getPaymailAddress(ctx, alias+"@"+domain, p.client.DefaultModelOptions()...)
 	if err != nil {
 		return nil, err
@@ -143,6 +148,14 @@ func (p *PaymailDefaultServiceProvider) RecordTransaction(ctx context.Context,
 	metadata[p2pMetadataField] = p2pTx.MetaData
 	metadata[ReferenceIDField] = p2pTx.Reference
 
+	domain, err := p.servedDomain(ctx, requestMetadata.Domain)
+	if err != nil {
+		return nil, err
+	}
+	if domain.RequireBEEF && p2pTx.DecodedBeef == nil {
+		return nil, spverrors.ErrPaymailDomainRequiresBEEF
+	}
+
 	// Record the transaction
 	sdkTx, err := buildSDKTx(p2pTx)
 	if err != nil {
@@ -232,7 +245,23 @@ func (p *PaymailDefaultServiceProvider) AddContact(
 	return
 }
 
+// servedDomain returns the paymail domain if it's served by the wallet (domains can be managed at runtime)
+func (p *PaymailDefaultServiceProvider) servedDomain(ctx context.Context, name string) (*paymaildomains.Domain, error) {
+	domain, err := p.client.PaymailDomainsService().Find(ctx, name)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // returns our internal errors
+	}
+	if domain == nil {
+		return nil, spverrors.ErrCouldNotFindPaymail
+	}
+	return domain, nil
+}
+
 func (p *PaymailDefaultServiceProvider) getDestinationForPaymail(ctx context.Context, alias, domain string, metadata Metadata) (*Destination, error) {
+	if _, err := p.servedDomain(ctx, domain); err != nil {
+		return nil, err
+	}
+
 	pm, err := getPaymailAddress(ctx, alias+"@"+domain, p.client.DefaultModelOptions()...)
 	if err != nil {
 		return nil, err
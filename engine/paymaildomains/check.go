@@ -0,0 +1,104 @@
+package paymaildomains
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/bitcoin-sv/go-paymail"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+)
+
+// wellKnownDocument is the part of the .well-known/bsvalias document inspected by the self-check.
+type wellKnownDocument struct {
+	BsvAlias     string         `json:"bsvalias"`
+	Capabilities map[string]any `json:"capabilities"`
+}
+
+// Check runs the DNS/.well-known self-check of the domain and stores its result:
+// a domain which passes the check is served (also when domain validation is enabled for it).
+// The check is not stored for static domains.
+func (s *Service) Check(ctx context.Context, name string) (*CheckResult, error) {
+	domain, err := s.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	result := s.check(ctx, domain.Name)
+
+	if domain.Static {
+		return result, nil
+	}
+	if result.Valid {
+		domain.ValidatedAt = &result.CheckedAt
+	} else {
+		domain.ValidatedAt = nil
+	}
+	if err = s.repo.Update(ctx, domain); err != nil {
+		return nil, spverrors.Wrapf(err, "failed to save paymail domain check result")
+	}
+	s.logger.Info().Str("domain", domain.Name).Bool("valid", result.Valid).Msg("Paymail domain checked")
+	return result, nil
+}
+
+func (s *Service) check(ctx context.Context, domain string) *CheckResult {
+	result := &CheckResult{Domain: domain, CheckedAt: time.Now().UTC()}
+
+	target, port := s.lookupTarget(ctx, result)
+	result.WellKnownURL = wellKnownURL(target, port)
+
+	var document wellKnownDocument
+	res, err := s.httpClient.R().
+		SetContext(ctx).
+		Get(result.WellKnownURL)
+	switch {
+	case err != nil:
+		result.Errors = append(result.Errors, fmt.Sprintf("cannot fetch %s: %s", result.WellKnownURL, err.Error()))
+	case res.IsError():
+		result.Errors = append(result.Errors, fmt.Sprintf("%s responded with status %d", result.WellKnownURL, res.StatusCode()))
+	// the document is parsed regardless of the content type - some paymail hosts don't set it
+	case json.Unmarshal(res.Body(), &document) != nil:
+		result.Errors = append(result.Errors, fmt.Sprintf("%s is not a valid JSON document", result.WellKnownURL))
+	case document.BsvAlias == "":
+		result.Errors = append(result.Errors, fmt.Sprintf("%s is missing the %s version", result.WellKnownURL, paymail.DefaultServiceName))
+	case len(document.Capabilities) == 0:
+		result.Errors = append(result.Errors, fmt.Sprintf("%s has no capabilities", result.WellKnownURL))
+	default:
+		result.Capabilities = len(document.Capabilities)
+	}
+
+	result.Valid = len(result.Errors) == 0
+	return result
+}
+
+// lookupTarget returns the host serving the paymail capabilities of the domain:
+// the first SRV target or the domain itself when there are no SRV records.
+func (s *Service) lookupTarget(ctx context.Context, result *CheckResult) (string, uint16) {
+	_, records, err := s.resolver.LookupSRV(ctx, paymail.DefaultServiceName, paymail.DefaultProtocol, result.Domain)
+	if err != nil {
+		var dnsErr *net.DNSError
+		if !errors.As(err, &dnsErr) || !dnsErr.IsNotFound {
+			result.Errors = append(result.Errors, fmt.Sprintf("SRV lookup failed: %s", err.Error()))
+		}
+	}
+
+	for _, record := range records {
+		result.SRVTargets = append(result.SRVTargets, strings.TrimSuffix(record.Target, "."))
+	}
+	if len(records) == 0 {
+		return result.Domain, paymail.DefaultPort
+	}
+	return result.SRVTargets[0], records[0].Port
+}
+
+func wellKnownURL(target string, port uint16) string {
+	host := target
+	if port != paymail.DefaultPort {
+		host = net.JoinHostPort(target, fmt.Sprint(port))
+	}
+	return fmt.Sprintf("https://%s/.well-known/%s", host, paymail.DefaultServiceName)
+}
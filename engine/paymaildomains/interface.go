@@ -0,0 +1,82 @@
+package paymaildomains
+
+import (
+	"context"
+	"net"
+	"time"
+)
+
+// SenderValidationPolicy tells how the signatures of senders of incoming P2P transactions are verified.
+type SenderValidationPolicy string
+
+const (
+	// SenderValidationRequire rejects transactions without a signature made with the key from the sender's PKI.
+	SenderValidationRequire SenderValidationPolicy = "require"
+	// SenderValidationWarn verifies the sender, but only logs a warning when the verification fails.
+	SenderValidationWarn SenderValidationPolicy = "warn"
+	// SenderValidationIgnore doesn't verify the sender against the sender's PKI.
+	SenderValidationIgnore SenderValidationPolicy = "ignore"
+)
+
+// Settings are the per-domain settings of the paymail server.
+type Settings struct {
+	// DomainValidationEnabled makes the domain served only after it passes the DNS/.well-known self-check.
+	DomainValidationEnabled bool
+	// DefaultFromPaymail is the sender paymail used for transactions sent from this domain when the sender has no paymail.
+	DefaultFromPaymail string
+	// RequireBEEF makes the paymail server accept incoming P2P transactions only in BEEF format.
+	RequireBEEF bool
+	// SenderValidation is the policy of verifying senders of incoming P2P transactions;
+	// when empty, "require" is used if paymail.sender_validation_enabled is set, otherwise "ignore".
+	SenderValidation SenderValidationPolicy
+}
+
+// Domain is a paymail domain served by the wallet.
+type Domain struct {
+	Name string
+	Settings
+	// ValidatedAt is the time of the last successful self-check (nil if it has never passed).
+	ValidatedAt *time.Time
+	// Static is true for domains from the configuration (paymail.domains) - they cannot be changed at runtime.
+	Static bool
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// IsServed returns true if paymail requests for the domain can be handled.
+func (d *Domain) IsServed() bool {
+	return !d.DomainValidationEnabled || d.ValidatedAt != nil
+}
+
+// CheckResult holds the diagnostics of the DNS/.well-known self-check of the domain.
+type CheckResult struct {
+	Domain string
+	// SRVTargets are the hosts from the _bsvalias._tcp SRV records; the domain itself is used when there are none.
+	SRVTargets []string
+	// WellKnownURL is the URL of the paymail capabilities document which was checked.
+	WellKnownURL string
+	// Capabilities is the number of capabilities announced by the .well-known/bsvalias document.
+	Capabilities int
+	// Errors are the problems found during the check.
+	Errors    []string
+	Valid     bool
+	CheckedAt time.Time
+}
+
+// Repo persists paymail domains managed at runtime.
+type Repo interface {
+	// Get returns the domain or nil when it doesn't exist.
+	Get(ctx context.Context, name string) (*Domain, error)
+	List(ctx context.Context) ([]*Domain, error)
+	Create(ctx context.Context, domain *Domain) error
+	// Update saves the settings and the validation time of the domain.
+	Update(ctx context.Context, domain *Domain) error
+	// Delete returns false when the domain doesn't exist.
+	Delete(ctx context.Context, name string) (bool, error)
+}
+
+// Resolver looks up the SRV records of the paymail domain; net.DefaultResolver is used by default.
+type Resolver interface {
+	LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+}
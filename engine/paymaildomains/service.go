@@ -0,0 +1,261 @@
+package paymaildomains
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/bitcoin-sv/go-paymail"
+	"github.com/bitcoin-sv/spv-wallet/config"
+	configerrors "github.com/bitcoin-sv/spv-wallet/config/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/go-resty/resty/v2"
+	"github.com/rs/zerolog"
+)
+
+// Service keeps the paymail domains served by the wallet.
+// Domains from the configuration (paymail.domains) are static; other domains are stored in the database
+// and can be added, changed and removed at runtime - they are consulted on every paymail request.
+type Service struct {
+	logger     zerolog.Logger
+	repo       Repo
+	httpClient *resty.Client
+	resolver   Resolver
+	cfg        *config.PaymailConfig
+}
+
+// NewService creates a new paymail domains service.
+func NewService(logger zerolog.Logger, repo Repo, httpClient *resty.Client, resolver Resolver, cfg *config.PaymailConfig) *Service {
+	return &Service{
+		logger:     logger,
+		repo:       repo,
+		httpClient: httpClient,
+		resolver:   resolver,
+		cfg:        cfg,
+	}
+}
+
+// Find returns the served domain or nil when the domain is not served.
+// When domain validation is disabled in the configuration, any domain is served with the default settings.
+func (s *Service) Find(ctx context.Context, name string) (*Domain, error) {
+	name, err := paymail.SanitizeDomain(name)
+	if err != nil {
+		return nil, nil //nolint:nilerr // malformed domain is just not served
+	}
+
+	domain, err := s.get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if domain != nil {
+		if !domain.IsServed() {
+			return nil, nil
+		}
+		if domain.SenderValidation == "" {
+			domain.SenderValidation = s.defaultSenderValidation()
+		}
+		return domain, nil
+	}
+
+	if !s.cfg.DomainValidationEnabled {
+		return &Domain{Name: name, Settings: s.defaultSettings()}, nil
+	}
+	return nil, nil
+}
+
+// CheckDomain returns an error if paymails cannot be created in the domain.
+func (s *Service) CheckDomain(ctx context.Context, name string) error {
+	domain, err := s.Find(ctx, name)
+	if err != nil {
+		return err
+	}
+	if domain == nil {
+		return configerrors.ErrUnsupportedDomain
+	}
+	return nil
+}
+
+// DefaultFromPaymail returns the default sender paymail of the domain or an empty string when it's not set.
+func (s *Service) DefaultFromPaymail(ctx context.Context, name string) string {
+	domain, err := s.Find(ctx, name)
+	if err != nil {
+		s.logger.Warn().Err(err).Str("domain", name).Msg("Cannot get paymail domain settings")
+	}
+	if domain == nil {
+		return ""
+	}
+	return domain.DefaultFromPaymail
+}
+
+// Get returns the domain (static or managed at runtime), also when it's not served yet.
+func (s *Service) Get(ctx context.Context, name string) (*Domain, error) {
+	name, err := sanitize(name)
+	if err != nil {
+		return nil, err
+	}
+	domain, err := s.get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if domain == nil {
+		return nil, spverrors.ErrPaymailDomainNotFound
+	}
+	return domain, nil
+}
+
+// List returns all the domains: static ones first, then the ones managed at runtime.
+func (s *Service) List(ctx context.Context) ([]*Domain, error) {
+	managed, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to list paymail domains")
+	}
+	domains := make([]*Domain, 0, len(s.cfg.Domains)+len(managed))
+	for _, name := range s.cfg.Domains {
+		domains = append(domains, s.staticDomain(name))
+	}
+	return append(domains, managed...), nil
+}
+
+// Names returns the names of all served domains.
+func (s *Service) Names(ctx context.Context) ([]string, error) {
+	domains, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(domains))
+	for _, domain := range domains {
+		if domain.IsServed() {
+			names = append(names, domain.Name)
+		}
+	}
+	return names, nil
+}
+
+// Create adds a new domain; it's served immediately unless domain validation is enabled for it.
+func (s *Service) Create(ctx context.Context, name string, settings Settings) (*Domain, error) {
+	name, err := sanitize(name)
+	if err != nil {
+		return nil, err
+	}
+	if err = validateSettings(settings); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, spverrors.ErrPaymailDomainAlreadyExists
+	}
+
+	now := time.Now().UTC()
+	domain := &Domain{Name: name, Settings: settings, CreatedAt: now, UpdatedAt: now}
+	if err = s.repo.Create(ctx, domain); err != nil {
+		return nil, spverrors.Wrapf(err, "failed to create paymail domain")
+	}
+	s.logger.Info().Str("domain", name).Msg("Paymail domain added")
+	return domain, nil
+}
+
+// Update changes the settings of the domain managed at runtime.
+func (s *Service) Update(ctx context.Context, name string, settings Settings) (*Domain, error) {
+	domain, err := s.getManaged(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if err = validateSettings(settings); err != nil {
+		return nil, err
+	}
+
+	domain.Settings = settings
+	domain.UpdatedAt = time.Now().UTC()
+	if err = s.repo.Update(ctx, domain); err != nil {
+		return nil, spverrors.Wrapf(err, "failed to update paymail domain")
+	}
+	return domain, nil
+}
+
+// Delete removes the domain managed at runtime; paymails in the domain are no longer served.
+func (s *Service) Delete(ctx context.Context, name string) error {
+	domain, err := s.getManaged(ctx, name)
+	if err != nil {
+		return err
+	}
+	deleted, err := s.repo.Delete(ctx, domain.Name)
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to delete paymail domain")
+	}
+	if !deleted {
+		return spverrors.ErrPaymailDomainNotFound
+	}
+	s.logger.Info().Str("domain", domain.Name).Msg("Paymail domain removed")
+	return nil
+}
+
+func (s *Service) get(ctx context.Context, name string) (*Domain, error) {
+	if slices.Contains(s.cfg.Domains, name) {
+		return s.staticDomain(name), nil
+	}
+	domain, err := s.repo.Get(ctx, name)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get paymail domain")
+	}
+	return domain, nil
+}
+
+func (s *Service) getManaged(ctx context.Context, name string) (*Domain, error) {
+	domain, err := s.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if domain.Static {
+		return nil, spverrors.ErrPaymailDomainStatic
+	}
+	return domain, nil
+}
+
+func (s *Service) staticDomain(name string) *Domain {
+	return &Domain{
+		Name:     name,
+		Settings: s.defaultSettings(),
+		Static:   true,
+	}
+}
+
+func (s *Service) defaultSettings() Settings {
+	return Settings{DefaultFromPaymail: s.cfg.DefaultFromPaymail, SenderValidation: s.defaultSenderValidation()}
+}
+
+func (s *Service) defaultSenderValidation() SenderValidationPolicy {
+	if s.cfg.SenderValidationEnabled {
+		return SenderValidationRequire
+	}
+	return SenderValidationIgnore
+}
+
+func sanitize(name string) (string, error) {
+	sanitized, err := paymail.SanitizeDomain(name)
+	if err != nil {
+		return "", spverrors.ErrInvalidDomain.Wrap(err)
+	}
+	if err = paymail.ValidateDomain(sanitized); err != nil {
+		return "", spverrors.ErrInvalidDomain.Wrap(err)
+	}
+	return sanitized, nil
+}
+
+func validateSettings(settings Settings) error {
+	switch settings.SenderValidation {
+	case "", SenderValidationRequire, SenderValidationWarn, SenderValidationIgnore:
+	default:
+		return spverrors.ErrInvalidSenderValidationPolicy
+	}
+	if settings.DefaultFromPaymail == "" {
+		return nil
+	}
+	if err := paymail.ValidatePaymail(settings.DefaultFromPaymail); err != nil {
+		return spverrors.ErrPaymailAddressIsInvalid.Wrap(err)
+	}
+	return nil
+}
@@ -0,0 +1,319 @@
+package paymaildomains_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/config"
+	configerrors "github.com/bitcoin-sv/spv-wallet/config/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/paymaildomains"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester"
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	staticDomain  = "static.example.com"
+	runtimeDomain = "runtime.example.com"
+)
+
+const wellKnownResponse = `{
+	"bsvalias": "1.0",
+	"capabilities": {
+		"pki": "https://paymail.example.com/v1/bsvalias/id/{alias}@{domain.tld}",
+		"paymentDestination": "https://paymail.example.com/v1/bsvalias/address/{alias}@{domain.tld}"
+	}
+}`
+
+func TestFind(t *testing.T) {
+	t.Run("static domain", func(t *testing.T) {
+		// given:
+		service, _ := givenService(t, true)
+
+		// when:
+		domain, err := service.Find(context.Background(), staticDomain)
+
+		// then:
+		require.NoError(t, err)
+		require.NotNil(t, domain)
+		require.True(t, domain.Static)
+	})
+
+	t.Run("domain added at runtime", func(t *testing.T) {
+		// given:
+		service, _ := givenService(t, true)
+		_, err := service.Create(context.Background(), runtimeDomain, paymaildomains.Settings{RequireBEEF: true})
+		require.NoError(t, err)
+
+		// when:
+		domain, err := service.Find(context.Background(), runtimeDomain)
+
+		// then:
+		require.NoError(t, err)
+		require.NotNil(t, domain)
+		require.True(t, domain.RequireBEEF)
+	})
+
+	t.Run("unknown domain", func(t *testing.T) {
+		// given:
+		service, _ := givenService(t, true)
+
+		// when:
+		domain, err := service.Find(context.Background(), "unknown.example.com")
+
+		// then:
+		require.NoError(t, err)
+		require.Nil(t, domain)
+		require.ErrorIs(t, service.CheckDomain(context.Background(), "unknown.example.com"), configerrors.ErrUnsupportedDomain)
+	})
+
+	t.Run("unknown domain with domain validation disabled", func(t *testing.T) {
+		// given:
+		service, _ := givenService(t, false)
+
+		// when:
+		domain, err := service.Find(context.Background(), "unknown.example.com")
+
+		// then:
+		require.NoError(t, err)
+		require.NotNil(t, domain)
+	})
+
+	t.Run("deleted domain", func(t *testing.T) {
+		// given:
+		service, _ := givenService(t, true)
+		_, err := service.Create(context.Background(), runtimeDomain, paymaildomains.Settings{})
+		require.NoError(t, err)
+		require.NoError(t, service.Delete(context.Background(), runtimeDomain))
+
+		// when:
+		domain, err := service.Find(context.Background(), runtimeDomain)
+
+		// then:
+		require.NoError(t, err)
+		require.Nil(t, domain)
+	})
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("already existing domain", func(t *testing.T) {
+		// given:
+		service, _ := givenService(t, true)
+
+		// when:
+		_, err := service.Create(context.Background(), staticDomain, paymaildomains.Settings{})
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrPaymailDomainAlreadyExists)
+	})
+
+	t.Run("invalid domain", func(t *testing.T) {
+		// given:
+		service, _ := givenService(t, true)
+
+		// when:
+		_, err := service.Create(context.Background(), "not a domain", paymaildomains.Settings{})
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrInvalidDomain)
+	})
+
+	t.Run("invalid default sender", func(t *testing.T) {
+		// given:
+		service, _ := givenService(t, true)
+
+		// when:
+		_, err := service.Create(context.Background(), runtimeDomain, paymaildomains.Settings{DefaultFromPaymail: "invalid"})
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrPaymailAddressIsInvalid)
+	})
+
+	t.Run("unknown sender validation policy", func(t *testing.T) {
+		// given:
+		service, _ := givenService(t, true)
+
+		// when:
+		_, err := service.Create(context.Background(), runtimeDomain, paymaildomains.Settings{SenderValidation: "strict"})
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrInvalidSenderValidationPolicy)
+	})
+}
+
+func TestSenderValidationDefault(t *testing.T) {
+	// given:
+	service, _ := givenService(t, true)
+	_, err := service.Create(context.Background(), runtimeDomain, paymaildomains.Settings{})
+	require.NoError(t, err)
+
+	// when:
+	domain, err := service.Find(context.Background(), runtimeDomain)
+
+	// then:
+	require.NoError(t, err)
+	require.Equal(t, paymaildomains.SenderValidationIgnore, domain.SenderValidation)
+}
+
+func TestStaticDomainCannotBeChanged(t *testing.T) {
+	// given:
+	service, _ := givenService(t, true)
+
+	// when:
+	_, updateErr := service.Update(context.Background(), staticDomain, paymaildomains.Settings{RequireBEEF: true})
+	deleteErr := service.Delete(context.Background(), staticDomain)
+
+	// then:
+	require.ErrorIs(t, updateErr, spverrors.ErrPaymailDomainStatic)
+	require.ErrorIs(t, deleteErr, spverrors.ErrPaymailDomainStatic)
+}
+
+func TestDefaultFromPaymail(t *testing.T) {
+	// given:
+	service, _ := givenService(t, true)
+	_, err := service.Create(context.Background(), runtimeDomain, paymaildomains.Settings{DefaultFromPaymail: "sender@runtime.example.com"})
+	require.NoError(t, err)
+
+	// then:
+	require.Equal(t, "sender@runtime.example.com", service.DefaultFromPaymail(context.Background(), runtimeDomain))
+	require.Equal(t, "from@static.example.com", service.DefaultFromPaymail(context.Background(), staticDomain))
+	require.Empty(t, service.DefaultFromPaymail(context.Background(), "unknown.example.com"))
+}
+
+func TestCheck(t *testing.T) {
+	t.Run("domain with validation is served after passing the check", func(t *testing.T) {
+		// given:
+		service, transport := givenService(t, true)
+		transport.RegisterResponder(http.MethodGet, "https://paymail.example.com:8443/.well-known/bsvalias",
+			httpmock.NewStringResponder(http.StatusOK, wellKnownResponse))
+
+		_, err := service.Create(context.Background(), runtimeDomain, paymaildomains.Settings{DomainValidationEnabled: true})
+		require.NoError(t, err)
+
+		domain, err := service.Find(context.Background(), runtimeDomain)
+		require.NoError(t, err)
+		require.Nil(t, domain, "domain shouldn't be served before the check")
+
+		// when:
+		result, err := service.Check(context.Background(), runtimeDomain)
+
+		// then:
+		require.NoError(t, err)
+		require.True(t, result.Valid)
+		require.Empty(t, result.Errors)
+		require.Equal(t, []string{"paymail.example.com"}, result.SRVTargets)
+		require.Equal(t, 2, result.Capabilities)
+
+		domain, err = service.Find(context.Background(), runtimeDomain)
+		require.NoError(t, err)
+		require.NotNil(t, domain)
+		require.NotNil(t, domain.ValidatedAt)
+	})
+
+	t.Run("domain without SRV records and with invalid .well-known", func(t *testing.T) {
+		// given:
+		service, transport := givenService(t, true)
+		transport.RegisterResponder(http.MethodGet, "https://"+staticDomain+"/.well-known/bsvalias",
+			httpmock.NewStringResponder(http.StatusNotFound, ""))
+
+		// when:
+		result, err := service.Check(context.Background(), staticDomain)
+
+		// then:
+		require.NoError(t, err)
+		require.False(t, result.Valid)
+		require.Empty(t, result.SRVTargets)
+		require.Len(t, result.Errors, 1)
+	})
+
+	t.Run("unknown domain", func(t *testing.T) {
+		// given:
+		service, _ := givenService(t, true)
+
+		// when:
+		_, err := service.Check(context.Background(), "unknown.example.com")
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrPaymailDomainNotFound)
+	})
+}
+
+func givenService(t *testing.T, domainValidation bool) (*paymaildomains.Service, *httpmock.MockTransport) {
+	transport := httpmock.NewMockTransport()
+	client := resty.New()
+	client.SetTransport(transport)
+
+	cfg := &config.PaymailConfig{
+		Domains:                 []string{staticDomain},
+		DefaultFromPaymail:      "from@static.example.com",
+		DomainValidationEnabled: domainValidation,
+	}
+
+	resolver := fakeResolver{
+		runtimeDomain: {{Target: "paymail.example.com.", Port: 8443}},
+	}
+
+	return paymaildomains.NewService(tester.Logger(t), &memoryRepo{domains: map[string]paymaildomains.Domain{}}, client, resolver, cfg), transport
+}
+
+type fakeResolver map[string][]*net.SRV
+
+func (r fakeResolver) LookupSRV(_ context.Context, _, _, name string) (string, []*net.SRV, error) {
+	records, ok := r[name]
+	if !ok {
+		return "", nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+	}
+	return "", records, nil
+}
+
+type memoryRepo struct {
+	mu      sync.Mutex
+	domains map[string]paymaildomains.Domain
+}
+
+func (r *memoryRepo) Get(_ context.Context, name string) (*paymaildomains.Domain, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	domain, ok := r.domains[name]
+	if !ok {
+		return nil, nil
+	}
+	return &domain, nil
+}
+
+func (r *memoryRepo) List(_ context.Context) ([]*paymaildomains.Domain, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	domains := make([]*paymaildomains.Domain, 0, len(r.domains))
+	for _, domain := range r.domains {
+		domains = append(domains, &domain)
+	}
+	return domains, nil
+}
+
+func (r *memoryRepo) Create(_ context.Context, domain *paymaildomains.Domain) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.domains[domain.Name] = *domain
+	return nil
+}
+
+func (r *memoryRepo) Update(_ context.Context, domain *paymaildomains.Domain) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.domains[domain.Name] = *domain
+	return nil
+}
+
+func (r *memoryRepo) Delete(_ context.Context, name string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, ok := r.domains[name]
+	delete(r.domains, name)
+	return ok, nil
+}
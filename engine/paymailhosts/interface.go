@@ -0,0 +1,63 @@
+package paymailhosts
+
+import (
+	"context"
+	"time"
+)
+
+// Policy tells if payments and contact requests can be sent to the paymail host.
+type Policy string
+
+const (
+	// PolicyAllow puts the paymail host on the allow list; when the list is not empty, only hosts on it can be called.
+	PolicyAllow Policy = "allow"
+	// PolicyDeny puts the paymail host on the deny list; such hosts are never called.
+	PolicyDeny Policy = "deny"
+)
+
+// Rule is an admin-managed entry of the allow or deny list.
+// The rule applies to the domain and all its subdomains.
+type Rule struct {
+	Domain    string
+	Policy    Policy
+	Note      string
+	CreatedAt time.Time
+}
+
+// Status is the reputation status of the paymail host.
+type Status string
+
+const (
+	// StatusGood is for hosts which respond fast and without errors.
+	StatusGood Status = "good"
+	// StatusDegraded is for hosts which recently failed or respond slowly; calls to them are logged with a warning.
+	StatusDegraded Status = "degraded"
+	// StatusBlocked is for hosts which failed too many times in a row; they are not called until the block expires.
+	StatusBlocked Status = "blocked"
+)
+
+// Reputation holds the failure/latency statistics of the paymail host collected from the calls made by the wallet.
+type Reputation struct {
+	Domain              string
+	Calls               int
+	Failures            int
+	ConsecutiveFailures int
+	// AverageLatency is the moving average of the response time, weighted towards the recent calls.
+	AverageLatency time.Duration
+	LastError      string
+	LastCallAt     time.Time
+	LastFailureAt  *time.Time
+	Status         Status
+
+	// trial is set while the single call let through to the blocked host after BlockDuration awaits its result.
+	trial bool
+}
+
+// Repo persists the allow/deny rules.
+type Repo interface {
+	List(ctx context.Context) ([]*Rule, error)
+	// Save creates the rule or replaces the existing rule for the domain.
+	Save(ctx context.Context, rule *Rule) error
+	// Delete returns false when the rule doesn't exist.
+	Delete(ctx context.Context, domain string) (bool, error)
+}
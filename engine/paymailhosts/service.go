@@ -0,0 +1,272 @@
+package paymailhosts
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bitcoin-sv/go-paymail"
+	"github.com/bitcoin-sv/spv-wallet/config"
+	pmerrors "github.com/bitcoin-sv/spv-wallet/engine/paymail/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/rs/zerolog"
+)
+
+// Service guards the outgoing calls to paymail hosts:
+// it enforces the admin-managed allow/deny lists and keeps the (in-memory) reputation of every called host.
+type Service struct {
+	logger zerolog.Logger
+	repo   Repo
+	cfg    config.PaymailHostReputationConfig
+
+	mu          sync.Mutex
+	reputations map[string]*Reputation
+}
+
+// NewService creates a new paymail hosts service; nil cfg disables blocking and latency warnings.
+func NewService(logger zerolog.Logger, repo Repo, cfg *config.PaymailHostReputationConfig) *Service {
+	s := &Service{
+		logger:      logger,
+		repo:        repo,
+		reputations: make(map[string]*Reputation),
+	}
+	if cfg != nil {
+		s.cfg = *cfg
+	}
+	return s
+}
+
+// CheckHost returns an error if payments and contact requests cannot be sent to the paymail host of the domain.
+// A host with degraded reputation is allowed, but a warning is logged.
+func (s *Service) CheckHost(ctx context.Context, domain string) error {
+	domain = strings.ToLower(domain)
+
+	rules, err := s.repo.List(ctx)
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to get paymail host rules")
+	}
+	if err = checkRules(rules, domain); err != nil {
+		return err
+	}
+
+	reputation := s.Reputation(domain)
+	switch reputation.Status {
+	case StatusBlocked:
+		return pmerrors.ErrPaymailHostBlocked
+	case StatusDegraded:
+		s.logger.Warn().
+			Str("domain", domain).
+			Int("consecutiveFailures", reputation.ConsecutiveFailures).
+			Dur("averageLatency", reputation.AverageLatency).
+			Msg("Calling paymail host with degraded reputation")
+	case StatusGood:
+	}
+	return nil
+}
+
+// CheckAvailability returns an error if the paymail host of the domain failed too many times in a row
+// and it must not be called until the block expires (it works as a circuit breaker for every call to the host).
+// After the block expires, a single trial call is let through - its result (see RecordCall) unblocks the host or renews the block.
+func (s *Service) CheckAvailability(domain string) error {
+	domain = strings.ToLower(domain)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	reputation, ok := s.reputations[domain]
+	if !ok {
+		return nil
+	}
+	if s.isBlocked(reputation) {
+		return pmerrors.ErrPaymailHostBlocked
+	}
+	if s.failedTooManyTimes(reputation) {
+		reputation.trial = true
+	}
+	return nil
+}
+
+// RecordCall updates the reputation of the paymail host of the domain with the result of the call.
+func (s *Service) RecordCall(domain string, latency time.Duration, err error) {
+	domain = strings.ToLower(domain)
+	now := time.Now().UTC()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	reputation, ok := s.reputations[domain]
+	if !ok {
+		reputation = &Reputation{Domain: domain, AverageLatency: latency}
+		s.reputations[domain] = reputation
+	}
+
+	reputation.trial = false
+	reputation.Calls++
+	reputation.LastCallAt = now
+	reputation.AverageLatency = movingAverage(reputation.AverageLatency, latency)
+	if err == nil {
+		reputation.ConsecutiveFailures = 0
+		return
+	}
+
+	reputation.Failures++
+	reputation.ConsecutiveFailures++
+	reputation.LastError = err.Error()
+	reputation.LastFailureAt = &now
+	if s.cfg.BlockAfterFailures > 0 && reputation.ConsecutiveFailures == s.cfg.BlockAfterFailures {
+		s.logger.Warn().Err(err).Str("domain", domain).Msg("Paymail host failed too many times, calls to it are blocked")
+	}
+}
+
+// Reputation returns the reputation of the paymail host of the domain (a good one if the host was never called).
+func (s *Service) Reputation(domain string) *Reputation {
+	domain = strings.ToLower(domain)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	reputation, ok := s.reputations[domain]
+	if !ok {
+		return &Reputation{Domain: domain, Status: StatusGood}
+	}
+	return s.withStatus(reputation)
+}
+
+// Reputations returns the reputations of all the called paymail hosts.
+func (s *Service) Reputations() []*Reputation {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	reputations := make([]*Reputation, 0, len(s.reputations))
+	for _, reputation := range s.reputations {
+		reputations = append(reputations, s.withStatus(reputation))
+	}
+	slices.SortFunc(reputations, func(a, b *Reputation) int {
+		return strings.Compare(a.Domain, b.Domain)
+	})
+	return reputations
+}
+
+// ResetReputation forgets the reputation of the paymail host, which unblocks it.
+func (s *Service) ResetReputation(domain string) {
+	domain = strings.ToLower(domain)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.reputations, domain)
+}
+
+// Rules returns the allow/deny rules.
+func (s *Service) Rules(ctx context.Context) ([]*Rule, error) {
+	rules, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get paymail host rules")
+	}
+	return rules, nil
+}
+
+// SaveRule puts the domain on the allow or deny list, replacing the existing rule for the domain.
+func (s *Service) SaveRule(ctx context.Context, domain string, policy Policy, note string) (*Rule, error) {
+	domain, err := sanitize(domain)
+	if err != nil {
+		return nil, err
+	}
+	if policy != PolicyAllow && policy != PolicyDeny {
+		return nil, spverrors.ErrInvalidPaymailHostPolicy
+	}
+
+	rule := &Rule{Domain: domain, Policy: policy, Note: note, CreatedAt: time.Now().UTC()}
+	if err = s.repo.Save(ctx, rule); err != nil {
+		return nil, spverrors.Wrapf(err, "failed to save paymail host rule")
+	}
+	s.logger.Info().Str("domain", domain).Str("policy", string(policy)).Msg("Paymail host rule saved")
+	return rule, nil
+}
+
+// DeleteRule removes the domain from the allow or deny list.
+func (s *Service) DeleteRule(ctx context.Context, domain string) error {
+	domain, err := sanitize(domain)
+	if err != nil {
+		return err
+	}
+	deleted, err := s.repo.Delete(ctx, domain)
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to delete paymail host rule")
+	}
+	if !deleted {
+		return spverrors.ErrPaymailHostRuleNotFound
+	}
+	s.logger.Info().Str("domain", domain).Msg("Paymail host rule removed")
+	return nil
+}
+
+func (s *Service) withStatus(reputation *Reputation) *Reputation {
+	result := *reputation
+	switch {
+	case s.isBlocked(reputation):
+		result.Status = StatusBlocked
+	case reputation.ConsecutiveFailures > 0,
+		s.cfg.SlowResponse > 0 && reputation.AverageLatency > s.cfg.SlowResponse:
+		result.Status = StatusDegraded
+	default:
+		result.Status = StatusGood
+	}
+	return &result
+}
+
+// isBlocked returns true when the host failed too many times in a row and the last failure is recent
+// or the trial call let through after BlockDuration still awaits its result.
+func (s *Service) isBlocked(reputation *Reputation) bool {
+	if !s.failedTooManyTimes(reputation) {
+		return false
+	}
+	return reputation.trial || reputation.LastFailureAt != nil && time.Since(*reputation.LastFailureAt) < s.cfg.BlockDuration
+}
+
+func (s *Service) failedTooManyTimes(reputation *Reputation) bool {
+	return s.cfg.BlockAfterFailures > 0 && reputation.ConsecutiveFailures >= s.cfg.BlockAfterFailures
+}
+
+func checkRules(rules []*Rule, domain string) error {
+	allowList := false
+	allowed := false
+	for _, rule := range rules {
+		matches := rule.matches(domain)
+		switch rule.Policy {
+		case PolicyDeny:
+			if matches {
+				return pmerrors.ErrPaymailHostDenied
+			}
+		case PolicyAllow:
+			allowList = true
+			allowed = allowed || matches
+		}
+	}
+	if allowList && !allowed {
+		return pmerrors.ErrPaymailHostNotAllowed
+	}
+	return nil
+}
+
+func (r *Rule) matches(domain string) bool {
+	return domain == r.Domain || strings.HasSuffix(domain, "."+r.Domain)
+}
+
+// movingAverage weights the latest latency by 1/5, so the average follows the recent behaviour of the host.
+func movingAverage(average, latency time.Duration) time.Duration {
+	return (4*average + latency) / 5
+}
+
+func sanitize(domain string) (string, error) {
+	sanitized, err := paymail.SanitizeDomain(domain)
+	if err != nil {
+		return "", spverrors.ErrInvalidDomain.Wrap(err)
+	}
+	if err = paymail.ValidateDomain(sanitized); err != nil {
+		return "", spverrors.ErrInvalidDomain.Wrap(err)
+	}
+	return sanitized, nil
+}
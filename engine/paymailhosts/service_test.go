@@ -0,0 +1,287 @@
+package paymailhosts_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/config"
+	pmerrors "github.com/bitcoin-sv/spv-wallet/engine/paymail/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/paymailhosts"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester"
+	"github.com/stretchr/testify/require"
+)
+
+var errHostDown = errors.New("host is down")
+
+func TestCheckHostRules(t *testing.T) {
+	tests := map[string]struct {
+		rules       map[string]paymailhosts.Policy
+		domain      string
+		expectedErr error
+	}{
+		"no rules": {
+			domain: "example.com",
+		},
+		"denied domain": {
+			rules:       map[string]paymailhosts.Policy{"example.com": paymailhosts.PolicyDeny},
+			domain:      "example.com",
+			expectedErr: pmerrors.ErrPaymailHostDenied,
+		},
+		"subdomain of denied domain": {
+			rules:       map[string]paymailhosts.Policy{"example.com": paymailhosts.PolicyDeny},
+			domain:      "pay.example.com",
+			expectedErr: pmerrors.ErrPaymailHostDenied,
+		},
+		"domain with denied domain as suffix": {
+			rules:  map[string]paymailhosts.Policy{"example.com": paymailhosts.PolicyDeny},
+			domain: "myexample.com",
+		},
+		"allowed domain": {
+			rules:  map[string]paymailhosts.Policy{"example.com": paymailhosts.PolicyAllow},
+			domain: "example.com",
+		},
+		"domain not on the allow list": {
+			rules:       map[string]paymailhosts.Policy{"example.com": paymailhosts.PolicyAllow},
+			domain:      "other.com",
+			expectedErr: pmerrors.ErrPaymailHostNotAllowed,
+		},
+		"denied subdomain of allowed domain": {
+			rules: map[string]paymailhosts.Policy{
+				"example.com":     paymailhosts.PolicyAllow,
+				"pay.example.com": paymailhosts.PolicyDeny,
+			},
+			domain:      "pay.example.com",
+			expectedErr: pmerrors.ErrPaymailHostDenied,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given:
+			service := givenService(t, nil)
+			for domain, policy := range test.rules {
+				_, err := service.SaveRule(context.Background(), domain, policy, "")
+				require.NoError(t, err)
+			}
+
+			// when:
+			err := service.CheckHost(context.Background(), test.domain)
+
+			// then:
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSaveRule(t *testing.T) {
+	t.Run("invalid policy", func(t *testing.T) {
+		// given:
+		service := givenService(t, nil)
+
+		// when:
+		_, err := service.SaveRule(context.Background(), "example.com", "block", "")
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrInvalidPaymailHostPolicy)
+	})
+
+	t.Run("replace existing rule", func(t *testing.T) {
+		// given:
+		service := givenService(t, nil)
+		_, err := service.SaveRule(context.Background(), "example.com", paymailhosts.PolicyDeny, "")
+		require.NoError(t, err)
+
+		// when:
+		_, err = service.SaveRule(context.Background(), "Example.com", paymailhosts.PolicyAllow, "trusted")
+
+		// then:
+		require.NoError(t, err)
+		rules, err := service.Rules(context.Background())
+		require.NoError(t, err)
+		require.Len(t, rules, 1)
+		require.Equal(t, paymailhosts.PolicyAllow, rules[0].Policy)
+	})
+
+	t.Run("delete not existing rule", func(t *testing.T) {
+		// given:
+		service := givenService(t, nil)
+
+		// when:
+		err := service.DeleteRule(context.Background(), "example.com")
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrPaymailHostRuleNotFound)
+	})
+}
+
+func TestReputation(t *testing.T) {
+	cfg := &config.PaymailHostReputationConfig{
+		BlockAfterFailures: 3,
+		BlockDuration:      time.Minute,
+		SlowResponse:       time.Second,
+	}
+
+	t.Run("host blocked after consecutive failures", func(t *testing.T) {
+		// given:
+		service := givenService(t, cfg)
+		for range cfg.BlockAfterFailures {
+			service.RecordCall("example.com", time.Millisecond, errHostDown)
+		}
+
+		// when:
+		err := service.CheckHost(context.Background(), "example.com")
+
+		// then:
+		require.ErrorIs(t, err, pmerrors.ErrPaymailHostBlocked)
+
+		reputation := service.Reputation("example.com")
+		require.Equal(t, paymailhosts.StatusBlocked, reputation.Status)
+		require.Equal(t, cfg.BlockAfterFailures, reputation.Failures)
+		require.Equal(t, errHostDown.Error(), reputation.LastError)
+	})
+
+	t.Run("successful call resets consecutive failures", func(t *testing.T) {
+		// given:
+		service := givenService(t, cfg)
+		for range cfg.BlockAfterFailures - 1 {
+			service.RecordCall("example.com", time.Millisecond, errHostDown)
+		}
+		service.RecordCall("example.com", time.Millisecond, nil)
+		service.RecordCall("example.com", time.Millisecond, errHostDown)
+
+		// when:
+		err := service.CheckHost(context.Background(), "example.com")
+
+		// then:
+		require.NoError(t, err)
+
+		reputation := service.Reputation("example.com")
+		require.Equal(t, paymailhosts.StatusDegraded, reputation.Status)
+		require.Equal(t, 1, reputation.ConsecutiveFailures)
+		require.Equal(t, cfg.BlockAfterFailures+1, reputation.Calls)
+	})
+
+	t.Run("slow host is degraded", func(t *testing.T) {
+		// given:
+		service := givenService(t, cfg)
+		service.RecordCall("example.com", 2*cfg.SlowResponse, nil)
+
+		// when:
+		err := service.CheckHost(context.Background(), "example.com")
+
+		// then:
+		require.NoError(t, err)
+		require.Equal(t, paymailhosts.StatusDegraded, service.Reputation("example.com").Status)
+	})
+
+	t.Run("reset unblocks the host", func(t *testing.T) {
+		// given:
+		service := givenService(t, cfg)
+		for range cfg.BlockAfterFailures {
+			service.RecordCall("example.com", time.Millisecond, errHostDown)
+		}
+
+		// when:
+		service.ResetReputation("example.com")
+
+		// then:
+		require.NoError(t, service.CheckHost(context.Background(), "example.com"))
+		require.Empty(t, service.Reputations())
+	})
+
+	t.Run("single trial call is let through after the block expires", func(t *testing.T) {
+		// given:
+		service := givenService(t, &config.PaymailHostReputationConfig{BlockAfterFailures: 3, BlockDuration: time.Millisecond})
+		for range 3 {
+			service.RecordCall("example.com", time.Millisecond, errHostDown)
+		}
+		time.Sleep(2 * time.Millisecond)
+
+		// when:
+		trialErr := service.CheckAvailability("example.com")
+		concurrentErr := service.CheckAvailability("example.com")
+
+		// then:
+		require.NoError(t, trialErr)
+		require.ErrorIs(t, concurrentErr, pmerrors.ErrPaymailHostBlocked)
+
+		// when:
+		service.RecordCall("example.com", time.Millisecond, nil)
+
+		// then:
+		require.NoError(t, service.CheckAvailability("example.com"))
+		require.Equal(t, paymailhosts.StatusGood, service.Reputation("example.com").Status)
+	})
+
+	t.Run("failed trial call renews the block", func(t *testing.T) {
+		// given:
+		blockDuration := 50 * time.Millisecond
+		service := givenService(t, &config.PaymailHostReputationConfig{BlockAfterFailures: 3, BlockDuration: blockDuration})
+		for range 3 {
+			service.RecordCall("example.com", time.Millisecond, errHostDown)
+		}
+		time.Sleep(blockDuration)
+		require.NoError(t, service.CheckAvailability("example.com"))
+
+		// when:
+		service.RecordCall("example.com", time.Millisecond, errHostDown)
+
+		// then:
+		require.ErrorIs(t, service.CheckAvailability("example.com"), pmerrors.ErrPaymailHostBlocked)
+	})
+
+	t.Run("never blocked without config", func(t *testing.T) {
+		// given:
+		service := givenService(t, nil)
+		for range 10 {
+			service.RecordCall("example.com", time.Millisecond, errHostDown)
+		}
+
+		// when:
+		err := service.CheckHost(context.Background(), "example.com")
+
+		// then:
+		require.NoError(t, err)
+	})
+}
+
+func givenService(t *testing.T, cfg *config.PaymailHostReputationConfig) *paymailhosts.Service {
+	return paymailhosts.NewService(tester.Logger(t), &memoryRepo{rules: map[string]paymailhosts.Rule{}}, cfg)
+}
+
+type memoryRepo struct {
+	mu    sync.Mutex
+	rules map[string]paymailhosts.Rule
+}
+
+func (r *memoryRepo) List(_ context.Context) ([]*paymailhosts.Rule, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rules := make([]*paymailhosts.Rule, 0, len(r.rules))
+	for _, rule := range r.rules {
+		rules = append(rules, &rule)
+	}
+	return rules, nil
+}
+
+func (r *memoryRepo) Save(_ context.Context, rule *paymailhosts.Rule) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.rules[rule.Domain] = *rule
+	return nil
+}
+
+func (r *memoryRepo) Delete(_ context.Context, domain string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, ok := r.rules[domain]
+	delete(r.rules, domain)
+	return ok, nil
+}
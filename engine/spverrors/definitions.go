@@ -208,6 +208,30 @@ var ErrMissingPaymailXPubID = models.SPVError{Message: "missing xpub_id in payma
 // ErrPaymailAlreadyExists is when paymail with given data already exists in db
 var ErrPaymailAlreadyExists = models.SPVError{Message: "paymail already exists", StatusCode: 409, Code: "error-paymail-already-exists"}
 
+// ErrPaymailDomainNotFound is when the paymail domain is not managed at runtime
+var ErrPaymailDomainNotFound = models.SPVError{Message: "paymail domain not found", StatusCode: 404, Code: "error-paymail-domain-not-found"}
+
+// ErrPaymailDomainAlreadyExists is when the paymail domain is already served
+var ErrPaymailDomainAlreadyExists = models.SPVError{Message: "paymail domain already exists", StatusCode: 409, Code: "error-paymail-domain-already-exists"}
+
+// ErrPaymailDomainStatic is when the paymail domain comes from the configuration and cannot be changed at runtime
+var ErrPaymailDomainStatic = models.SPVError{Message: "paymail domain from the configuration cannot be changed", StatusCode: 400, Code: "error-paymail-domain-static"}
+
+// ErrPaymailDomainRequiresBEEF is when the transaction sent to the paymail domain is not in BEEF format
+var ErrPaymailDomainRequiresBEEF = models.SPVError{Message: "paymail domain accepts only BEEF transactions", StatusCode: 400, Code: "error-paymail-domain-requires-beef"}
+
+// ErrInvalidSenderValidationPolicy is when the sender validation policy of the paymail domain is unknown
+var ErrInvalidSenderValidationPolicy = models.SPVError{Message: "invalid sender validation policy, expected one of: require, warn, ignore", StatusCode: 400, Code: "error-paymail-domain-invalid-sender-validation"}
+
+// ErrInvalidPaymailHostPolicy is when the policy of the paymail host rule is unknown
+var ErrInvalidPaymailHostPolicy = models.SPVError{Message: "invalid paymail host policy, expected one of: allow, deny", StatusCode: 400, Code: "error-paymail-host-invalid-policy"}
+
+// ErrPaymailHostRuleNotFound is when there is no allow/deny rule for the paymail host
+var ErrPaymailHostRuleNotFound = models.SPVError{Message: "paymail host rule not found", StatusCode: 404, Code: "error-paymail-host-rule-not-found"}
+
+// ErrPaymailCapabilitiesNotCached is when the capabilities of the paymail host are not in the cache
+var ErrPaymailCapabilitiesNotCached = models.SPVError{Message: "paymail capabilities are not cached", StatusCode: 404, Code: "error-paymail-capabilities-not-cached"}
+
 // ErrPaymailMerkleRootVerificationFailed is when merkle root verification could not be processed
 var ErrPaymailMerkleRootVerificationFailed = models.SPVError{Message: "merkle root verification could not be processed", StatusCode: 400, Code: "error-paymail-merkle-root-verification-failed"}
 
@@ -445,6 +469,9 @@ var ErrRouteMethodNotAllowed = models.SPVError{Message: "method not allowed", St
 // ErrAskingForFeeUnit is when error occurred during asking for fee unit
 var ErrAskingForFeeUnit = models.SPVError{Message: "error during asking for fee unit", StatusCode: 500, Code: "error-asking-for-fee-unit"}
 
+// ErrInvalidFeeUnit is when the provided fee unit is not valid
+var ErrInvalidFeeUnit = models.SPVError{Message: "invalid fee unit - bytes must be positive", StatusCode: 400, Code: "error-fee-unit-invalid"}
+
 // ErrBroadcast is when broadcast error occurred
 var ErrBroadcast = models.SPVError{Message: "broadcast error", StatusCode: 500, Code: "error-broadcast"}
 
@@ -476,3 +503,12 @@ var ErrDataNotFound = models.SPVError{Message: "data not found", StatusCode: 404
 
 // ErrInvalidDataID is when data id is invalid
 var ErrInvalidDataID = models.SPVError{Message: "invalid data id", StatusCode: 400, Code: "error-invalid-data-id"}
+
+// ErrInvalidDataSearchFilter is when the filter of the data search is invalid
+var ErrInvalidDataSearchFilter = models.SPVError{Message: "invalid data search filter", StatusCode: 400, Code: "error-invalid-data-search-filter"}
+
+// ErrPaymailDestinationNotFound is when the address or the output has not been derived for a paymail of the user
+var ErrPaymailDestinationNotFound = models.SPVError{Message: "paymail destination not found", StatusCode: 404, Code: "error-paymail-destination-not-found"}
+
+// ErrInvalidPaymailDestinationLookup is when neither an address nor an outpoint is provided to look up a paymail destination
+var ErrInvalidPaymailDestinationLookup = models.SPVError{Message: "either address or txId with vout must be provided", StatusCode: 400, Code: "error-paymail-destination-invalid-lookup"}
@@ -1,6 +1,7 @@
 package testabilities
 
 import (
+	"strings"
 	"testing"
 
 	sdk "github.com/bitcoin-sv/go-sdk/transaction"
@@ -12,6 +13,9 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// beefV1HexPrefix is the hex encoded BEEF V1 version marker, used to recognize transactions broadcast in BEEF format.
+const beefV1HexPrefix = "0100beef"
+
 type EngineAssertions interface {
 	ExternalPaymailHost() testpaymail.PaymailExternalAssertions
 	ARC() ARCAssertions
@@ -78,7 +82,13 @@ type arcBroadcastAssertions struct {
 func (a *arcBroadcastAssertions) WithTxID(txID string) ARCBroadcastAssertions {
 	rawTx := jsonrequire.NewGetterWithJSON(a.t, string(a.details.RequestBody)).GetString("rawTx")
 
-	tx, err := sdk.NewTransactionFromHex(rawTx)
+	var tx *sdk.Transaction
+	var err error
+	if strings.HasPrefix(rawTx, beefV1HexPrefix) {
+		tx, err = sdk.NewTransactionFromBEEFHex(rawTx)
+	} else {
+		tx, err = sdk.NewTransactionFromHex(rawTx)
+	}
 	a.require.NoError(err)
 	a.require.NotNil(tx)
 	a.require.Equal(txID, tx.TxID().String())
@@ -1,6 +1,10 @@
 package testabilities
 
-import "github.com/bitcoin-sv/spv-wallet/config"
+import (
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/config"
+)
 
 type ConfigOpts func(*config.AppConfig)
 
@@ -21,3 +25,42 @@ func WithNotificationsEnabled() ConfigOpts {
 		c.Notifications.Enabled = true
 	}
 }
+
+func WithAsyncBroadcast() ConfigOpts {
+	return func(c *config.AppConfig) {
+		c.ARC.AsyncBroadcast.Enabled = true
+	}
+}
+
+func WithPaymailRateLimit(perIP, perAlias int) ConfigOpts {
+	return func(c *config.AppConfig) {
+		c.Paymail.RateLimit = &config.PaymailRateLimitConfig{
+			Enabled:  true,
+			PerIP:    perIP,
+			PerAlias: perAlias,
+		}
+	}
+}
+
+func WithTrustedProxies(proxies ...string) ConfigOpts {
+	return func(c *config.AppConfig) {
+		c.Server.TrustedProxies = proxies
+	}
+}
+
+func WithSenderValidationEnabled() ConfigOpts {
+	return func(c *config.AppConfig) {
+		c.Paymail.SenderValidationEnabled = true
+	}
+}
+
+func WithBHSDownQueue() ConfigOpts {
+	return func(c *config.AppConfig) {
+		c.BHS.CircuitBreaker = &config.BHSCircuitBreakerConfig{
+			Enabled:          true,
+			FailureThreshold: 1,
+			OpenTimeout:      time.Millisecond,
+			WhenDown:         "queue",
+		}
+	}
+}
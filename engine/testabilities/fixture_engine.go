@@ -7,6 +7,7 @@ import (
 
 	"github.com/bitcoin-sv/spv-wallet/config"
 	"github.com/bitcoin-sv/spv-wallet/engine"
+	chainmodels "github.com/bitcoin-sv/spv-wallet/engine/chain/models"
 	"github.com/bitcoin-sv/spv-wallet/engine/datastore"
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	"github.com/bitcoin-sv/spv-wallet/engine/testabilities/testmode"
@@ -30,6 +31,12 @@ const fileDbConnectionString = "file:spv-wallet-test.db"
 
 const CallbackTestToken = "arc-test-token"
 
+// CallbackTestTokenForTx returns the callback token sent to ARC with the broadcast of the transaction (token per transaction is enabled in tests).
+func CallbackTestTokenForTx(txID string) string {
+	cfg := chainmodels.ARCCallbackConfig{Token: CallbackTestToken, TokenPerTransaction: true}
+	return cfg.TokenForTx(txID)
+}
+
 type EngineFixture interface {
 	Engine() (walletEngine EngineWithConfig, cleanup func())
 	EngineWithConfiguration(opts ...ConfigOpts) (walletEngine EngineWithConfig, cleanup func())
@@ -268,6 +275,7 @@ func getConfigForTests() *config.AppConfig {
 	cfg.ARC.Callback.Enabled = true
 	cfg.ARC.Callback.Host = "https://" + fixtures.PaymailDomain
 	cfg.ARC.Callback.Token = CallbackTestToken
+	cfg.ARC.Callback.TokenPerTransaction = true
 
 	cfg.Paymail.Domains = []string{fixtures.PaymailDomain}
 
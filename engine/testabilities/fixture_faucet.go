@@ -104,6 +104,7 @@ func (f *faucetFixture) StoreData(data string) (txtestability.TransactionSpec, s
 					outpoint,
 					f.user.ID(),
 					[]byte(data),
+					nil,
 				),
 			},
 		},
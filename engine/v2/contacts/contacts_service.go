@@ -0,0 +1,242 @@
+package contacts
+
+import (
+	"context"
+
+	"github.com/bitcoin-sv/go-paymail"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/contacts/contactserrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/contacts/contactsmodels"
+	"github.com/bitcoin-sv/spv-wallet/models"
+	"github.com/bitcoin-sv/spv-wallet/models/filter"
+	"github.com/rs/zerolog"
+)
+
+// Service is the domain service for user contacts.
+type Service struct {
+	logger        *zerolog.Logger
+	contactsRepo  Repo
+	paymailClient PaymailClient
+	paymails      PaymailsService
+}
+
+// NewService creates a new contacts service.
+func NewService(logger *zerolog.Logger, contactsRepo Repo, paymailClient PaymailClient, paymails PaymailsService) *Service {
+	return &Service{
+		logger:        logger,
+		contactsRepo:  contactsRepo,
+		paymailClient: paymailClient,
+		paymails:      paymails,
+	}
+}
+
+// Create adds (or updates) a contact in the user's contact book and sends the PIKE invitation to the contact.
+// NOTE: If the invitation cannot be delivered, the saved contact is returned together with ErrAddingContactRequest.
+func (s *Service) Create(ctx context.Context, newContact *contactsmodels.NewContact) (*contactsmodels.Contact, error) {
+	contactPaymail, err := s.paymailClient.GetSanitizedPaymail(newContact.Paymail)
+	if err != nil {
+		return nil, contactserrors.ErrInvalidContactPaymail.Wrap(err)
+	}
+
+	requesterPaymail, err := s.requesterPaymail(ctx, newContact)
+	if err != nil {
+		return nil, err
+	}
+
+	if ownsPaymail, err := s.paymails.HasPaymailAddress(ctx, newContact.UserID, contactPaymail.Address); err != nil {
+		return nil, spverrors.Wrapf(err, "failed to check if contact paymail belongs to user")
+	} else if ownsPaymail {
+		return nil, contactserrors.ErrContactToSelf
+	}
+
+	pki, err := s.paymailClient.GetPkiForPaymail(ctx, contactPaymail)
+	if err != nil {
+		return nil, contactserrors.ErrGettingPKI.Wrap(err)
+	}
+
+	contact, err := s.contactsRepo.FindByPaymail(ctx, contactPaymail.Address, newContact.UserID)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to find contact")
+	}
+	if contact == nil {
+		contact = &contactsmodels.Contact{
+			Paymail: contactPaymail.Address,
+			UserID:  newContact.UserID,
+		}
+	}
+	contact.FullName = newContact.FullName
+	if contact.Status != contactsmodels.StatusConfirmed || contact.PubKey != pki.PubKey {
+		contact.Status = contactsmodels.StatusUnconfirmed
+	}
+	contact.PubKey = pki.PubKey
+
+	contact, err = s.contactsRepo.Save(ctx, contact)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to save contact")
+	}
+
+	if err = s.sendContactRequest(ctx, contactPaymail, requesterPaymail); err != nil {
+		s.logger.Warn().Err(err).
+			Str("requesterPaymail", requesterPaymail.Address).
+			Str("requestedContact", contactPaymail.Address).
+			Msg("adding contact request failed")
+		return contact, contactserrors.ErrAddingContactRequest.Wrap(err)
+	}
+
+	return contact, nil
+}
+
+// AddContactRequest handles the PIKE invitation sent to the user by other paymail.
+func (s *Service) AddContactRequest(ctx context.Context, userID, fullName, paymailAddress string) (*contactsmodels.Contact, error) {
+	contactPaymail, err := s.paymailClient.GetSanitizedPaymail(paymailAddress)
+	if err != nil {
+		return nil, contactserrors.ErrInvalidContactPaymail.Wrap(err)
+	}
+
+	pki, err := s.paymailClient.GetPkiForPaymail(ctx, contactPaymail)
+	if err != nil {
+		return nil, contactserrors.ErrGettingPKI.Wrap(err)
+	}
+
+	contact, err := s.contactsRepo.FindByPaymail(ctx, contactPaymail.Address, userID)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to find contact")
+	}
+
+	if contact == nil {
+		contact = &contactsmodels.Contact{
+			FullName: fullName,
+			Paymail:  contactPaymail.Address,
+			Status:   contactsmodels.StatusAwaiting,
+			UserID:   userID,
+		}
+	} else if contact.PubKey == pki.PubKey {
+		// contact already exists and its identity didn't change
+		return contact, nil
+	} else if contact.Status == contactsmodels.StatusConfirmed {
+		contact.Status = contactsmodels.StatusUnconfirmed
+	}
+	contact.PubKey = pki.PubKey
+
+	contact, err = s.contactsRepo.Save(ctx, contact)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to save contact")
+	}
+	return contact, nil
+}
+
+// Accept accepts the invitation from the contact (awaiting -> unconfirmed).
+func (s *Service) Accept(ctx context.Context, userID string, contactID uint) (*contactsmodels.Contact, error) {
+	return s.changeStatus(ctx, userID, contactID, contactsmodels.StatusAwaiting, contactsmodels.StatusUnconfirmed)
+}
+
+// Reject rejects the invitation from the contact (awaiting -> rejected).
+func (s *Service) Reject(ctx context.Context, userID string, contactID uint) (*contactsmodels.Contact, error) {
+	return s.changeStatus(ctx, userID, contactID, contactsmodels.StatusAwaiting, contactsmodels.StatusRejected)
+}
+
+// Confirm confirms the identity of the contact (unconfirmed -> confirmed).
+func (s *Service) Confirm(ctx context.Context, userID string, contactID uint) (*contactsmodels.Contact, error) {
+	return s.changeStatus(ctx, userID, contactID, contactsmodels.StatusUnconfirmed, contactsmodels.StatusConfirmed)
+}
+
+// Remove deletes the contact from the user's contact book.
+func (s *Service) Remove(ctx context.Context, userID string, contactID uint) error {
+	if _, err := s.FindForUser(ctx, userID, contactID); err != nil {
+		return err
+	}
+	if err := s.contactsRepo.Delete(ctx, contactID, userID); err != nil {
+		return spverrors.Wrapf(err, "failed to delete contact")
+	}
+	return nil
+}
+
+// FindForUser returns the contact of the user by its ID.
+func (s *Service) FindForUser(ctx context.Context, userID string, contactID uint) (*contactsmodels.Contact, error) {
+	contact, err := s.contactsRepo.FindForUser(ctx, contactID, userID)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to find contact")
+	}
+	if contact == nil {
+		return nil, contactserrors.ErrContactNotFound
+	}
+	return contact, nil
+}
+
+// FindForPayment returns the contact of the user which can be used as a recipient of a payment.
+func (s *Service) FindForPayment(ctx context.Context, userID string, contactID uint) (*contactsmodels.Contact, error) {
+	contact, err := s.FindForUser(ctx, userID, contactID)
+	if err != nil {
+		return nil, err
+	}
+	if contact.Status == contactsmodels.StatusRejected {
+		return nil, contactserrors.ErrContactRejected
+	}
+	return contact, nil
+}
+
+// PaginatedForUser returns contacts of the user based on the provided paging options.
+func (s *Service) PaginatedForUser(ctx context.Context, userID string, page filter.Page) (*models.PagedResult[contactsmodels.Contact], error) {
+	result, err := s.contactsRepo.PaginatedForUser(ctx, userID, page)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get contacts for user")
+	}
+	return result, nil
+}
+
+func (s *Service) changeStatus(ctx context.Context, userID string, contactID uint, from, to contactsmodels.Status) (*contactsmodels.Contact, error) {
+	contact, err := s.FindForUser(ctx, userID, contactID)
+	if err != nil {
+		return nil, err
+	}
+	if contact.Status != from {
+		return nil, contactserrors.ErrInvalidStatusTransition
+	}
+
+	contact.Status = to
+	contact, err = s.contactsRepo.Save(ctx, contact)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to save contact")
+	}
+	return contact, nil
+}
+
+func (s *Service) requesterPaymail(ctx context.Context, newContact *contactsmodels.NewContact) (*paymail.SanitisedPaymail, error) {
+	address := newContact.RequesterPaymail
+	if address == "" {
+		var err error
+		address, err = s.paymails.GetDefaultPaymailAddress(ctx, newContact.UserID)
+		if err != nil {
+			return nil, contactserrors.ErrInvalidRequesterPaymail.Wrap(err)
+		}
+	} else if ownsPaymail, err := s.paymails.HasPaymailAddress(ctx, newContact.UserID, address); err != nil || !ownsPaymail {
+		return nil, contactserrors.ErrInvalidRequesterPaymail
+	}
+
+	requester, err := s.paymailClient.GetSanitizedPaymail(address)
+	if err != nil {
+		return nil, contactserrors.ErrInvalidRequesterPaymail.Wrap(err)
+	}
+	return requester, nil
+}
+
+func (s *Service) sendContactRequest(ctx context.Context, contactPaymail, requesterPaymail *paymail.SanitisedPaymail) error {
+	requester, err := s.paymails.Find(ctx, requesterPaymail.Alias, requesterPaymail.Domain)
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to find requester paymail")
+	}
+
+	fullName := requesterPaymail.Alias
+	if requester != nil && requester.PublicName != "" {
+		fullName = requester.PublicName
+	}
+
+	_, err = s.paymailClient.AddContactRequest(ctx, contactPaymail, &paymail.PikeContactRequestPayload{
+		FullName: fullName,
+		Paymail:  requesterPaymail.Address,
+	})
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to send PIKE contact request")
+	}
+	return nil
+}
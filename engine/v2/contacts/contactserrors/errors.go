@@ -0,0 +1,27 @@
+package contactserrors
+
+import "github.com/bitcoin-sv/spv-wallet/models"
+
+// ErrContactNotFound is when the contact does not exist or does not belong to the user.
+var ErrContactNotFound = models.SPVError{Message: "contact not found", StatusCode: 404, Code: "error-contact-not-found"}
+
+// ErrInvalidContactPaymail is when the paymail of the contact is invalid.
+var ErrInvalidContactPaymail = models.SPVError{Message: "invalid contact paymail", StatusCode: 400, Code: "error-contact-invalid-paymail"}
+
+// ErrInvalidRequesterPaymail is when the paymail of the requester is invalid or does not belong to the user.
+var ErrInvalidRequesterPaymail = models.SPVError{Message: "invalid requester paymail", StatusCode: 400, Code: "error-contact-invalid-requester-paymail"}
+
+// ErrContactToSelf is when the user tries to add own paymail as a contact.
+var ErrContactToSelf = models.SPVError{Message: "cannot add own paymail as a contact", StatusCode: 400, Code: "error-contact-to-self"}
+
+// ErrGettingPKI is when the PKI of the contact cannot be obtained.
+var ErrGettingPKI = models.SPVError{Message: "cannot get PKI of the contact paymail", StatusCode: 424, Code: "error-contact-getting-pki"}
+
+// ErrAddingContactRequest is when the PIKE invitation cannot be delivered to the contact's paymail host.
+var ErrAddingContactRequest = models.SPVError{Message: "cannot send contact request to the contact paymail host", StatusCode: 424, Code: "error-contact-adding-request"}
+
+// ErrInvalidStatusTransition is when the requested action is not allowed in the current contact status.
+var ErrInvalidStatusTransition = models.SPVError{Message: "action not allowed for the current contact status", StatusCode: 422, Code: "error-contact-invalid-status"}
+
+// ErrContactRejected is when the user tries to pay to a rejected contact.
+var ErrContactRejected = models.SPVError{Message: "contact is rejected", StatusCode: 422, Code: "error-contact-rejected"}
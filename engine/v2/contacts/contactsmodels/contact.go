@@ -0,0 +1,40 @@
+package contactsmodels
+
+import "time"
+
+// Status represents the status of the contact.
+type Status string
+
+const (
+	// StatusUnconfirmed is a status of the contact added by the user, whose identity (public key) is not confirmed yet.
+	StatusUnconfirmed Status = "unconfirmed"
+	// StatusAwaiting is a status of the contact which sent an invitation to the user (via PIKE) and awaits acceptance.
+	StatusAwaiting Status = "awaiting"
+	// StatusConfirmed is a status of the contact whose identity (public key) was confirmed by the user.
+	StatusConfirmed Status = "confirmed"
+	// StatusRejected is a status of the contact whose invitation was rejected by the user.
+	StatusRejected Status = "rejected"
+)
+
+// Contact is a domain model of the user's contact.
+type Contact struct {
+	ID        uint
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	FullName string
+	Paymail  string
+	PubKey   string
+	Status   Status
+
+	UserID string
+}
+
+// NewContact represents data for adding a new contact by the user.
+type NewContact struct {
+	UserID   string
+	FullName string
+	Paymail  string
+	// RequesterPaymail is the user's paymail which is sent to the contact in the PIKE invitation; if empty, the default paymail is used.
+	RequesterPaymail string
+}
@@ -0,0 +1,34 @@
+package contacts
+
+import (
+	"context"
+
+	"github.com/bitcoin-sv/go-paymail"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/contacts/contactsmodels"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymails/paymailsmodels"
+	"github.com/bitcoin-sv/spv-wallet/models"
+	"github.com/bitcoin-sv/spv-wallet/models/filter"
+)
+
+// Repo is an interface for contacts repository.
+type Repo interface {
+	FindForUser(ctx context.Context, id uint, userID string) (*contactsmodels.Contact, error)
+	FindByPaymail(ctx context.Context, paymail, userID string) (*contactsmodels.Contact, error)
+	PaginatedForUser(ctx context.Context, userID string, page filter.Page) (*models.PagedResult[contactsmodels.Contact], error)
+	Save(ctx context.Context, contact *contactsmodels.Contact) (*contactsmodels.Contact, error)
+	Delete(ctx context.Context, id uint, userID string) error
+}
+
+// PaymailClient is the subset of the paymail service client used to communicate with the contact's paymail host.
+type PaymailClient interface {
+	GetSanitizedPaymail(addr string) (*paymail.SanitisedPaymail, error)
+	GetPkiForPaymail(ctx context.Context, sPaymail *paymail.SanitisedPaymail) (*paymail.PKIResponse, error)
+	AddContactRequest(ctx context.Context, receiverPaymail *paymail.SanitisedPaymail, contactData *paymail.PikeContactRequestPayload) (*paymail.PikeContactRequestResponse, error)
+}
+
+// PaymailsService is the paymails domain service.
+type PaymailsService interface {
+	Find(ctx context.Context, alias, domain string) (*paymailsmodels.Paymail, error)
+	HasPaymailAddress(ctx context.Context, userID string, address string) (bool, error)
+	GetDefaultPaymailAddress(ctx context.Context, userID string) (string, error)
+}
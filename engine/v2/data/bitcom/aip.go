@@ -0,0 +1,30 @@
+package bitcom
+
+import (
+	"encoding/base64"
+
+	bsm "github.com/bitcoin-sv/go-sdk/compat/bsm"
+	"github.com/bitcoin-sv/go-sdk/script"
+)
+
+// SignedMessage returns the message signed by the AIP signature:
+// OP_RETURN followed by all the pushes preceding the AIP prefix (including separators).
+func SignedMessage(pushesBefore [][]byte) []byte {
+	message := []byte{script.OpRETURN}
+	for _, push := range pushesBefore {
+		message = append(message, push...)
+	}
+	return message
+}
+
+// Verify checks if the signature of AIP is a valid signature of the message made by the AIP address.
+func (a *AIP) Verify(message []byte) bool {
+	if a.Algorithm != AIPAlgorithm {
+		return false
+	}
+	signature, err := base64.StdEncoding.DecodeString(a.Signature)
+	if err != nil {
+		return false
+	}
+	return bsm.VerifyMessage(a.Address, signature, message) == nil
+}
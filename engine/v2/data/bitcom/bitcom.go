@@ -0,0 +1,81 @@
+// Package bitcom contains the Bitcom protocols (B://, MAP, AIP) which can be stored in the data outputs.
+// Protocols in a single OP_RETURN output are separated by the pipe ("|") push.
+package bitcom
+
+import (
+	magic "github.com/bitcoinschema/go-map"
+)
+
+// Bitcom prefixes of the supported protocols.
+const (
+	// BPrefix is the prefix of the B:// protocol (files).
+	BPrefix = "19HxigV4QyBv3tHpQVcUEQyq1pzZVdoAut"
+	// AIPPrefix is the prefix of the Author Identity Protocol (signatures).
+	AIPPrefix = "15PciHG22SNLQJXMoSUaWVi7WSqc7hCfva"
+)
+
+// MAPPrefix is the prefix of the Magic Attribute Protocol (key/value).
+var MAPPrefix = magic.Prefix
+
+// Separator is the push separating protocols in the OP_RETURN output.
+const Separator = "|"
+
+// Names of the supported protocols.
+const (
+	ProtocolB   = "B"
+	ProtocolMAP = "MAP"
+	ProtocolAIP = "AIP"
+)
+
+// AIPAlgorithm is the only supported AIP signing algorithm (Bitcoin Signed Message).
+const AIPAlgorithm = "BITCOIN_ECDSA"
+
+// DefaultBEncoding is used when the encoding of the B:// file is not provided.
+const DefaultBEncoding = "binary"
+
+// Protocol is a single Bitcom protocol part of the data output; exactly one of B, MAP and AIP is set.
+type Protocol struct {
+	Name string `json:"protocol"`
+	B    *B     `json:"b,omitempty"`
+	MAP  *MAP   `json:"map,omitempty"`
+	AIP  *AIP   `json:"aip,omitempty"`
+}
+
+// B is a file stored with the B:// protocol.
+type B struct {
+	Content   []byte `json:"content"`
+	MediaType string `json:"mediaType"`
+	Encoding  string `json:"encoding"`
+	Filename  string `json:"filename,omitempty"`
+}
+
+// MAP is a Magic Attribute Protocol command with its key/value pairs.
+type MAP struct {
+	Command string            `json:"command"`
+	Keys    map[string]string `json:"keys"`
+}
+
+// AIP is an Author Identity Protocol signature of the data preceding it in the output.
+type AIP struct {
+	Algorithm string `json:"algorithm"`
+	Address   string `json:"address"`
+	// Signature is the base64 encoded Bitcoin Signed Message signature.
+	Signature string `json:"signature"`
+	// Valid is set by Parse, it says if the signature matches the address and the signed data.
+	Valid bool `json:"valid"`
+}
+
+// NewB creates a B:// protocol part.
+func NewB(b *B) Protocol {
+	return Protocol{Name: ProtocolB, B: b}
+}
+
+// NewMAP creates a MAP protocol part.
+func NewMAP(m *MAP) Protocol {
+	return Protocol{Name: ProtocolMAP, MAP: m}
+}
+
+// NewAIP creates an AIP protocol part.
+func NewAIP(aip *AIP) Protocol {
+	return Protocol{Name: ProtocolAIP, AIP: aip}
+}
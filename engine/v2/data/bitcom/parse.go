@@ -0,0 +1,101 @@
+package bitcom
+
+import (
+	"bytes"
+
+	"github.com/bitcoinschema/go-bpu"
+	magic "github.com/bitcoinschema/go-map"
+)
+
+// Parse recognises the supported protocols in the pushes of the OP_RETURN output.
+// Parts which are not supported or malformed are skipped (they are still part of the data blob).
+func Parse(pushes [][]byte) []Protocol {
+	var protocols []Protocol
+	start := 0
+	for i := 0; i <= len(pushes); i++ {
+		if i < len(pushes) && !isSeparator(pushes[i]) {
+			continue
+		}
+		if protocol, ok := parsePart(pushes[:start], pushes[start:i]); ok {
+			protocols = append(protocols, protocol)
+		}
+		start = i + 1
+	}
+	return protocols
+}
+
+func isSeparator(push []byte) bool {
+	return bytes.Equal(push, []byte(Separator))
+}
+
+func parsePart(before, part [][]byte) (Protocol, bool) {
+	if len(part) == 0 {
+		return Protocol{}, false
+	}
+	switch string(part[0]) {
+	case BPrefix:
+		return parseB(part[1:])
+	case MAPPrefix:
+		return parseMAP(part)
+	case AIPPrefix:
+		return parseAIP(before, part[1:])
+	default:
+		return Protocol{}, false
+	}
+}
+
+func parseB(fields [][]byte) (Protocol, bool) {
+	if len(fields) < 3 {
+		return Protocol{}, false
+	}
+	b := &B{
+		Content:   fields[0],
+		MediaType: string(fields[1]),
+		Encoding:  string(fields[2]),
+	}
+	if len(fields) > 3 {
+		b.Filename = string(fields[3])
+	}
+	return NewB(b), true
+}
+
+func parseMAP(part [][]byte) (Protocol, bool) {
+	if len(part) < 3 {
+		return Protocol{}, false
+	}
+	cells := make([]bpu.Cell, len(part))
+	for i, push := range part {
+		value := string(push)
+		cells[i] = bpu.Cell{S: &value}
+	}
+
+	parsed, err := magic.NewFromTape(&bpu.Tape{Cell: cells})
+	if err != nil {
+		return Protocol{}, false
+	}
+
+	command, _ := parsed[magic.Cmd].(string)
+	keys := make(map[string]string, len(parsed))
+	for key, value := range parsed {
+		if key == magic.Cmd {
+			continue
+		}
+		if str, ok := value.(string); ok {
+			keys[key] = str
+		}
+	}
+	return NewMAP(&MAP{Command: command, Keys: keys}), true
+}
+
+func parseAIP(before, fields [][]byte) (Protocol, bool) {
+	if len(fields) < 3 {
+		return Protocol{}, false
+	}
+	aip := &AIP{
+		Algorithm: string(fields[0]),
+		Address:   string(fields[1]),
+		Signature: string(fields[2]),
+	}
+	aip.Valid = aip.Verify(SignedMessage(before))
+	return NewAIP(aip), true
+}
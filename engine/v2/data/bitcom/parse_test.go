@@ -0,0 +1,102 @@
+package bitcom_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	bsm "github.com/bitcoin-sv/go-sdk/compat/bsm"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/data/bitcom"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("B, MAP and AIP", func(t *testing.T) {
+		// given:
+		signed := pushes(
+			bitcom.BPrefix, "hello world", "text/plain", "utf-8", "hello.txt",
+			bitcom.Separator,
+			bitcom.MAPPrefix, "SET", "app", "spv-wallet", "type", "post",
+			bitcom.Separator,
+		)
+		signature := sign(t, bitcom.SignedMessage(signed))
+		data := append(signed, pushes(bitcom.AIPPrefix, bitcom.AIPAlgorithm, fixtures.Sender.ID(), signature)...)
+
+		// when:
+		protocols := bitcom.Parse(data)
+
+		// then:
+		require.Equal(t, []bitcom.Protocol{
+			bitcom.NewB(&bitcom.B{
+				Content:   []byte("hello world"),
+				MediaType: "text/plain",
+				Encoding:  "utf-8",
+				Filename:  "hello.txt",
+			}),
+			bitcom.NewMAP(&bitcom.MAP{
+				Command: "SET",
+				Keys:    map[string]string{"app": "spv-wallet", "type": "post"},
+			}),
+			bitcom.NewAIP(&bitcom.AIP{
+				Algorithm: bitcom.AIPAlgorithm,
+				Address:   fixtures.Sender.ID(),
+				Signature: signature,
+				Valid:     true,
+			}),
+		}, protocols)
+	})
+
+	t.Run("AIP signature of other data", func(t *testing.T) {
+		// given:
+		signature := sign(t, []byte("other data"))
+		data := pushes(
+			bitcom.BPrefix, "hello world", "text/plain", "utf-8",
+			bitcom.Separator,
+			bitcom.AIPPrefix, bitcom.AIPAlgorithm, fixtures.Sender.ID(), signature,
+		)
+
+		// when:
+		protocols := bitcom.Parse(data)
+
+		// then:
+		require.Len(t, protocols, 2)
+		require.NotNil(t, protocols[1].AIP)
+		require.False(t, protocols[1].AIP.Valid)
+	})
+
+	t.Run("unknown and malformed protocols are skipped", func(t *testing.T) {
+		// given:
+		data := pushes(
+			"hello world",
+			bitcom.Separator,
+			bitcom.BPrefix, "only content",
+			bitcom.Separator,
+			bitcom.MAPPrefix, "SET", "app", "spv-wallet",
+		)
+
+		// when:
+		protocols := bitcom.Parse(data)
+
+		// then:
+		require.Equal(t, []bitcom.Protocol{
+			bitcom.NewMAP(&bitcom.MAP{
+				Command: "SET",
+				Keys:    map[string]string{"app": "spv-wallet"},
+			}),
+		}, protocols)
+	})
+}
+
+func pushes(values ...string) [][]byte {
+	result := make([][]byte, len(values))
+	for i, value := range values {
+		result[i] = []byte(value)
+	}
+	return result
+}
+
+func sign(t *testing.T, message []byte) string {
+	signature, err := bsm.SignMessage(fixtures.Sender.PrivateKey(), message)
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(signature)
+}
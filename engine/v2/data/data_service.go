@@ -5,6 +5,8 @@ import (
 
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/data/datamodels"
+	"github.com/bitcoin-sv/spv-wallet/models"
+	"github.com/bitcoin-sv/spv-wallet/models/filter"
 )
 
 // Service is the domain service for data.
@@ -27,3 +29,12 @@ func (s *Service) FindForUser(ctx context.Context, id string, userID string) (*d
 	}
 	return item, nil
 }
+
+// PaginatedForUser returns the data of a user matching the filter, based on the provided paging options.
+func (s *Service) PaginatedForUser(ctx context.Context, userID string, searchFilter datamodels.SearchFilter, page filter.Page) (*models.PagedResult[datamodels.Data], error) {
+	result, err := s.dataRepo.PaginatedForUser(ctx, userID, searchFilter, page)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to search data for user %s", userID)
+	}
+	return result, nil
+}
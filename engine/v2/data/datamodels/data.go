@@ -1,18 +1,63 @@
 package datamodels
 
-import "github.com/bitcoin-sv/spv-wallet/models/bsv"
+import (
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/data/bitcom"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+)
+
+// DefaultMediaType is the media type of the data which is not a B:// file.
+const DefaultMediaType = "application/octet-stream"
 
 // Data is a domain model for data stored in outputs (e.g. OP_RETURN).
 type Data struct {
 	TxID string
 	Vout uint32
 
+	CreatedAt time.Time
+
 	UserID string
 
 	Blob []byte
+
+	Protocols []bitcom.Protocol
 }
 
 // ID returns the unique identifier of the data (outpoint string)
 func (d *Data) ID() string {
 	return bsv.Outpoint{TxID: d.TxID, Vout: d.Vout}.String()
 }
+
+// Content returns the content of the data with its media type.
+// For the B:// file it is the file itself, otherwise it is the whole blob.
+func (d *Data) Content() (content []byte, mediaType string, filename string) {
+	for _, protocol := range d.Protocols {
+		if protocol.B != nil && protocol.B.MediaType != "" {
+			return protocol.B.Content, protocol.B.MediaType, protocol.B.Filename
+		}
+	}
+	return d.Blob, DefaultMediaType, ""
+}
+
+// SearchFilter holds the optional conditions for searching the data of a user.
+type SearchFilter struct {
+	TxID *string
+
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+
+	// Prefix is the beginning of the blob.
+	Prefix []byte
+
+	// MinSize and MaxSize are the bounds (inclusive) of the blob size in bytes.
+	MinSize *uint
+	MaxSize *uint
+
+	// Protocol is the name of the Bitcom protocol (B, MAP or AIP) recognised in the data.
+	Protocol   *string
+	MediaType  *string
+	MapApp     *string
+	MapType    *string
+	AIPAddress *string
+}
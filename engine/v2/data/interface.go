@@ -4,9 +4,12 @@ import (
 	"context"
 
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/data/datamodels"
+	"github.com/bitcoin-sv/spv-wallet/models"
+	"github.com/bitcoin-sv/spv-wallet/models/filter"
 )
 
 // Repo is the interface that wraps the basic operations with data.
 type Repo interface {
 	FindForUser(ctx context.Context, id string, userID string) (*datamodels.Data, error)
+	PaginatedForUser(ctx context.Context, userID string, searchFilter datamodels.SearchFilter, page filter.Page) (*models.PagedResult[datamodels.Data], error)
 }
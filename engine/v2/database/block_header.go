@@ -0,0 +1,17 @@
+package database
+
+import "time"
+
+// BlockHeader represents a header of a block in the longest chain kept by the embedded block headers store.
+type BlockHeader struct {
+	Height     uint32 `gorm:"primaryKey;autoIncrement:false"`
+	Hash       string `gorm:"type:char(64);uniqueIndex"`
+	PrevHash   string `gorm:"type:char(64)"`
+	MerkleRoot string `gorm:"type:char(64);index"`
+	Version    uint32
+	Bits       uint32
+	Nonce      uint32
+	Timestamp  time.Time
+
+	CreatedAt time.Time
+}
@@ -1,15 +1,36 @@
 package database
 
-import "github.com/bitcoin-sv/spv-wallet/models/bsv"
+import (
+	"strings"
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/data/bitcom"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
 
 // Data holds the data stored in outputs.
 type Data struct {
 	TxID string `gorm:"primaryKey"`
 	Vout uint32 `gorm:"primaryKey"`
 
+	CreatedAt time.Time
+
 	UserID string
-	
+
 	Blob []byte
+
+	// Protocols are the Bitcom protocols recognised in the data (stored as JSON).
+	Protocols datatypes.JSONSlice[bitcom.Protocol]
+
+	// ProtocolNames, MediaType, MapApp, MapType and AIPAddress are filled from the protocols to search the data.
+	// ProtocolNames has the form "|B|MAP|".
+	ProtocolNames string
+	MediaType     string `gorm:"index"`
+	MapApp        string `gorm:"index"`
+	MapType       string `gorm:"index"`
+	AIPAddress    string `gorm:"index"`
 }
 
 // Outpoint returns bsv.Outpoint object which identifies the data-output.
@@ -19,3 +40,24 @@ func (o *Data) Outpoint() *bsv.Outpoint {
 		Vout: o.Vout,
 	}
 }
+
+// BeforeCreate is a gorm hook that fills the search columns from the protocols.
+func (o *Data) BeforeCreate(_ *gorm.DB) error {
+	names := make([]string, 0, len(o.Protocols))
+	for _, protocol := range o.Protocols {
+		names = append(names, protocol.Name)
+		switch {
+		case protocol.B != nil && o.MediaType == "":
+			o.MediaType = protocol.B.MediaType
+		case protocol.MAP != nil && o.MapApp == "" && o.MapType == "":
+			o.MapApp = protocol.MAP.Keys["app"]
+			o.MapType = protocol.MAP.Keys["type"]
+		case protocol.AIP != nil && protocol.AIP.Valid && o.AIPAddress == "":
+			o.AIPAddress = protocol.AIP.Address
+		}
+	}
+	if len(names) > 0 {
+		o.ProtocolNames = "|" + strings.Join(names, "|") + "|"
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package database
+
+import "time"
+
+// DeferredTransaction keeps an incoming paymail transaction (as BEEF) which was received while its merkle roots couldn't be verified.
+// The transaction is recorded (and the row removed) only after the merkle roots are verified.
+type DeferredTransaction struct {
+	TxID string `gorm:"type:char(64);primaryKey"`
+	BEEF string
+
+	SenderPaymail   string
+	SenderPubKey    string
+	ReceiverPaymail string
+	Reference       string
+	Note            string
+
+	Status string `gorm:"type:varchar(16);index"`
+	Reason string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,13 @@
+package database
+
+import "time"
+
+// FeeUnit is an entry of the history of fee units used for transactions.
+type FeeUnit struct {
+	ID       uint   `gorm:"primaryKey"`
+	Satoshis uint64 `gorm:"type:bigint"`
+	Bytes    int
+	Source   string `gorm:"type:varchar(20)"`
+
+	CreatedAt time.Time `gorm:"index"`
+}
@@ -0,0 +1,28 @@
+package database
+
+import (
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+)
+
+// Invoice represents a payment request of the user served through the paymail invoice extension
+type Invoice struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	Reference string `gorm:"type:char(32);uniqueIndex"`
+	Paymail   string
+	Satoshis  bsv.Satoshis
+	Memo      string
+	ExpiresAt time.Time
+	Status    string `gorm:"index"`
+
+	PaidSatoshis bsv.Satoshis
+	TxID         *string `gorm:"type:char(64)"`
+	PaidAt       *time.Time
+
+	UserID string `gorm:"index"`
+	User   *User  `gorm:"foreignKey:UserID"`
+}
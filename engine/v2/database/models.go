@@ -12,5 +12,10 @@ func Models() []any {
 		Address{},
 		UserUTXO{},
 		Operation{},
+		UserContact{},
+		PaymailDestination{},
+		Invoice{},
+		DeferredTransaction{},
+		QueuedSpentUTXO{},
 	}
 }
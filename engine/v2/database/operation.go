@@ -13,6 +13,9 @@ type Operation struct {
 	Type         string
 	Value        int64
 
+	// SenderPubKey is the public key of the sender verified against its paymail PKI (nil if not verified).
+	SenderPubKey *string
+
 	User        *User               `gorm:"foreignKey:UserID"`
 	Transaction *TrackedTransaction `gorm:"foreignKey:TxID"`
 }
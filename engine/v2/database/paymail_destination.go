@@ -0,0 +1,34 @@
+package database
+
+import (
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+)
+
+// PaymailDestination keeps the origin of an address derived for a paymail (p2p-payment-destination or address resolution request)
+// and, once it's paid, the outpoint and the sender of the payment.
+type PaymailDestination struct {
+	Address     string `gorm:"type:char(34);primaryKey"`
+	ReferenceID string `gorm:"index"`
+
+	UserID  string `gorm:"index"`
+	Paymail string
+
+	Request            string `gorm:"type:varchar(32)"`
+	Satoshis           bsv.Satoshis
+	RequesterIP        string
+	RequesterUserAgent string
+
+	SenderPaymail string
+	SenderName    string
+	SenderPubKey  string
+	Purpose       string
+	Note          string
+
+	TxID *string `gorm:"type:char(64);index:idx_paymail_destinations_outpoint"`
+	Vout *uint32 `gorm:"index:idx_paymail_destinations_outpoint"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,18 @@
+package database
+
+import "time"
+
+// PaymailDomain is a paymail domain served by the wallet, managed at runtime (in addition to the ones from the config).
+type PaymailDomain struct {
+	Name string `gorm:"primaryKey;type:varchar(255)"`
+
+	DomainValidationEnabled bool
+	DefaultFromPaymail      string
+	RequireBEEF             bool   `gorm:"column:require_beef"`
+	SenderValidation        string `gorm:"type:varchar(10)"`
+
+	ValidatedAt *time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,12 @@
+package database
+
+import "time"
+
+// PaymailHostRule is an entry of the allow or deny list of paymail hosts the wallet can send payments and contact requests to.
+type PaymailHostRule struct {
+	Domain string `gorm:"primaryKey;type:varchar(255)"`
+	Policy string `gorm:"type:varchar(10)"`
+	Note   string
+
+	CreatedAt time.Time
+}
@@ -0,0 +1,62 @@
+package database
+
+import (
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"gorm.io/datatypes"
+)
+
+// QueuedSpentUTXO keeps the user's UTXO spent by a transaction which is queued for asynchronous broadcasting.
+// When the transaction cannot be broadcasted (it becomes PROBLEMATIC), the UTXO is restored;
+// otherwise the row is removed once the transaction is broadcasted.
+type QueuedSpentUTXO struct {
+	SpendingTX string `gorm:"type:char(64);primaryKey"`
+	UserID     string `gorm:"primaryKey"`
+	TxID       string `gorm:"primaryKey"`
+	Vout       uint32 `gorm:"primaryKey"`
+
+	Satoshis           uint64
+	EstimatedInputSize uint64
+	Bucket             string
+	CustomInstructions datatypes.JSONSlice[bsv.CustomInstruction]
+	TokenID            *string
+	TokenAmount        *uint64
+
+	// CreatedAt is the creation time of the spent UTXO.
+	CreatedAt time.Time
+}
+
+// NewQueuedSpentUTXO creates a QueuedSpentUTXO keeping the UTXO spent by the queued transaction.
+func NewQueuedSpentUTXO(spendingTX string, utxo *UserUTXO) *QueuedSpentUTXO {
+	return &QueuedSpentUTXO{
+		SpendingTX:         spendingTX,
+		UserID:             utxo.UserID,
+		TxID:               utxo.TxID,
+		Vout:               utxo.Vout,
+		Satoshis:           utxo.Satoshis,
+		EstimatedInputSize: utxo.EstimatedInputSize,
+		Bucket:             utxo.Bucket,
+		CustomInstructions: utxo.CustomInstructions,
+		TokenID:            utxo.TokenID,
+		TokenAmount:        utxo.TokenAmount,
+		CreatedAt:          utxo.CreatedAt,
+	}
+}
+
+// ToUserUTXO restores the spent UTXO.
+func (q *QueuedSpentUTXO) ToUserUTXO() *UserUTXO {
+	return &UserUTXO{
+		UserID:             q.UserID,
+		TxID:               q.TxID,
+		Vout:               q.Vout,
+		Satoshis:           q.Satoshis,
+		EstimatedInputSize: q.EstimatedInputSize,
+		Bucket:             q.Bucket,
+		CreatedAt:          q.CreatedAt,
+		TouchedAt:          time.Now(),
+		CustomInstructions: q.CustomInstructions,
+		TokenID:            q.TokenID,
+		TokenAmount:        q.TokenAmount,
+	}
+}
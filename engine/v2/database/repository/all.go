@@ -4,24 +4,40 @@ import "gorm.io/gorm"
 
 // All holds all repositories.
 type All struct {
-	Addresses    *Addresses
-	Paymails     *Paymails
-	Operations   *Operations
-	Transactions *Transactions
-	Users        *Users
-	Outputs      *Outputs
-	Data         *Data
+	Addresses           *Addresses
+	Paymails            *Paymails
+	Operations          *Operations
+	Transactions        *Transactions
+	Users               *Users
+	Outputs             *Outputs
+	Data                *Data
+	Contacts            *Contacts
+	BlockHeaders        *BlockHeaders
+	FeeUnits            *FeeUnits
+	PaymailDomains      *PaymailDomains
+	PaymailHosts        *PaymailHostRules
+	PaymailDestinations *PaymailDestinations
+	Invoices            *Invoices
+	DeferredTxs         *DeferredTransactions
 }
 
 // NewRepositories creates a new holder for all repositories.
 func NewRepositories(db *gorm.DB) *All {
 	return &All{
-		Addresses:    NewAddressesRepo(db),
-		Paymails:     NewPaymailsRepo(db),
-		Operations:   NewOperationsRepo(db),
-		Transactions: NewTransactions(db),
-		Users:        NewUsersRepo(db),
-		Outputs:      NewOutputsRepo(db),
-		Data:         NewDataRepo(db),
+		Addresses:           NewAddressesRepo(db),
+		Paymails:            NewPaymailsRepo(db),
+		Operations:          NewOperationsRepo(db),
+		Transactions:        NewTransactions(db),
+		Users:               NewUsersRepo(db),
+		Outputs:             NewOutputsRepo(db),
+		Data:                NewDataRepo(db),
+		Contacts:            NewContactsRepo(db),
+		BlockHeaders:        NewBlockHeadersRepo(db),
+		FeeUnits:            NewFeeUnitsRepo(db),
+		PaymailDomains:      NewPaymailDomainsRepo(db),
+		PaymailHosts:        NewPaymailHostRulesRepo(db),
+		PaymailDestinations: NewPaymailDestinationsRepo(db),
+		Invoices:            NewInvoicesRepo(db),
+		DeferredTxs:         NewDeferredTransactionsRepo(db),
 	}
 }
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/database"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+// BlockHeaders is a repository for block headers of the embedded block headers store.
+type BlockHeaders struct {
+	db *gorm.DB
+}
+
+// NewBlockHeadersRepo creates a new repository for block headers.
+func NewBlockHeadersRepo(db *gorm.DB) *BlockHeaders {
+	return &BlockHeaders{db: db}
+}
+
+// GetTip returns the header with the highest height or nil when there are no headers.
+func (r *BlockHeaders) GetTip(ctx context.Context) (*chainmodels.BlockHeader, error) {
+	return r.first(r.db.WithContext(ctx).Order("height DESC"))
+}
+
+// GetByHeight returns the header at the given height or nil when there is no such header.
+func (r *BlockHeaders) GetByHeight(ctx context.Context, height uint32) (*chainmodels.BlockHeader, error) {
+	return r.first(r.db.WithContext(ctx).Where("height = ?", height))
+}
+
+// GetByMerkleRoot returns the header with the given merkle root or nil when there is no such header.
+func (r *BlockHeaders) GetByMerkleRoot(ctx context.Context, merkleRoot string) (*chainmodels.BlockHeader, error) {
+	return r.first(r.db.WithContext(ctx).Where("merkle_root = ?", merkleRoot))
+}
+
+// GetRange returns up to limit headers starting from the given height, ordered by height.
+func (r *BlockHeaders) GetRange(ctx context.Context, fromHeight uint32, limit int) ([]chainmodels.BlockHeader, error) {
+	var rows []*database.BlockHeader
+	if err := r.db.
+		WithContext(ctx).
+		Where("height >= ?", fromHeight).
+		Order("height ASC").
+		Limit(limit).
+		Find(&rows).Error; err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get block headers")
+	}
+
+	return lo.Map(rows, func(row *database.BlockHeader, _ int) chainmodels.BlockHeader {
+		return mapToBlockHeader(row)
+	}), nil
+}
+
+// SaveHeaders stores the headers, replacing the ones at the same heights.
+func (r *BlockHeaders) SaveHeaders(ctx context.Context, headers []chainmodels.BlockHeader) error {
+	if len(headers) == 0 {
+		return nil
+	}
+
+	rows := lo.Map(headers, func(header chainmodels.BlockHeader, _ int) *database.BlockHeader {
+		return &database.BlockHeader{
+			Height:     header.Height,
+			Hash:       header.Hash,
+			PrevHash:   header.PrevHash,
+			MerkleRoot: header.MerkleRoot,
+			Version:    header.Version,
+			Bits:       header.Bits,
+			Nonce:      header.Nonce,
+			Timestamp:  header.Timestamp,
+		}
+	})
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// headers at the same heights are replaced - removing them first also frees their unique hashes
+		heights := lo.Map(headers, func(header chainmodels.BlockHeader, _ int) uint32 { return header.Height })
+		if err := tx.Where("height IN ?", heights).Delete(&database.BlockHeader{}).Error; err != nil {
+			return err
+		}
+		return tx.CreateInBatches(rows, 500).Error
+	})
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to save block headers")
+	}
+	return nil
+}
+
+// DeleteFromHeight removes all headers with the height greater or equal to the given one.
+func (r *BlockHeaders) DeleteFromHeight(ctx context.Context, height uint32) error {
+	if err := r.db.WithContext(ctx).Where("height >= ?", height).Delete(&database.BlockHeader{}).Error; err != nil {
+		return spverrors.Wrapf(err, "failed to delete block headers")
+	}
+	return nil
+}
+
+func (r *BlockHeaders) first(query *gorm.DB) (*chainmodels.BlockHeader, error) {
+	var row database.BlockHeader
+	if err := query.First(&row).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, spverrors.Wrapf(err, "failed to get block header")
+	}
+	header := mapToBlockHeader(&row)
+	return &header, nil
+}
+
+func mapToBlockHeader(row *database.BlockHeader) chainmodels.BlockHeader {
+	return chainmodels.BlockHeader{
+		Height:     row.Height,
+		Hash:       row.Hash,
+		PrevHash:   row.PrevHash,
+		MerkleRoot: row.MerkleRoot,
+		Version:    row.Version,
+		Bits:       row.Bits,
+		Nonce:      row.Nonce,
+		Timestamp:  row.Timestamp,
+	}
+}
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/contacts/contactsmodels"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/database"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/database/dbquery"
+	"github.com/bitcoin-sv/spv-wallet/models"
+	"github.com/bitcoin-sv/spv-wallet/models/filter"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+// Contacts is a repository for user contacts.
+type Contacts struct {
+	db *gorm.DB
+}
+
+// NewContactsRepo creates a new repository for user contacts.
+func NewContactsRepo(db *gorm.DB) *Contacts {
+	return &Contacts{db: db}
+}
+
+// FindForUser returns a contact by its ID for given user.
+func (c *Contacts) FindForUser(ctx context.Context, id uint, userID string) (*contactsmodels.Contact, error) {
+	var row database.UserContact
+	if err := c.db.
+		WithContext(ctx).
+		Where("id = ? AND user_id = ?", id, userID).
+		First(&row).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return mapContact(&row), nil
+}
+
+// FindByPaymail returns a contact by its paymail for given user.
+func (c *Contacts) FindByPaymail(ctx context.Context, paymail, userID string) (*contactsmodels.Contact, error) {
+	var row database.UserContact
+	if err := c.db.
+		WithContext(ctx).
+		Where("paymail = ? AND user_id = ?", paymail, userID).
+		First(&row).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return mapContact(&row), nil
+}
+
+// PaginatedForUser returns contacts of a user based on the provided paging options.
+func (c *Contacts) PaginatedForUser(ctx context.Context, userID string, page filter.Page) (*models.PagedResult[contactsmodels.Contact], error) {
+	rows, err := dbquery.PaginatedQuery[database.UserContact](
+		ctx,
+		page,
+		c.db,
+		dbquery.UserID(userID),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.PagedResult[contactsmodels.Contact]{
+		PageDescription: rows.PageDescription,
+		Content: lo.Map(rows.Content, func(row *database.UserContact, _ int) *contactsmodels.Contact {
+			return mapContact(row)
+		}),
+	}, nil
+}
+
+// Save creates a new contact or updates the existing one (when ID is set).
+func (c *Contacts) Save(ctx context.Context, contact *contactsmodels.Contact) (*contactsmodels.Contact, error) {
+	row := database.UserContact{
+		ID:        contact.ID,
+		CreatedAt: contact.CreatedAt,
+
+		FullName: contact.FullName,
+		Paymail:  contact.Paymail,
+		PubKey:   contact.PubKey,
+		Status:   string(contact.Status),
+
+		UserID: contact.UserID,
+	}
+
+	if err := c.db.WithContext(ctx).Save(&row).Error; err != nil {
+		return nil, err
+	}
+
+	return mapContact(&row), nil
+}
+
+// Delete removes the contact of the user.
+func (c *Contacts) Delete(ctx context.Context, id uint, userID string) error {
+	return c.db.
+		WithContext(ctx).
+		Where("id = ? AND user_id = ?", id, userID).
+		Delete(&database.UserContact{}).Error
+}
+
+func mapContact(row *database.UserContact) *contactsmodels.Contact {
+	return &contactsmodels.Contact{
+		ID:        row.ID,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+
+		FullName: row.FullName,
+		Paymail:  row.Paymail,
+		PubKey:   row.PubKey,
+		Status:   contactsmodels.Status(row.Status),
+
+		UserID: row.UserID,
+	}
+}
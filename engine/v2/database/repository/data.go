@@ -7,7 +7,11 @@ import (
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/data/datamodels"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/database"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/database/dbquery"
+	"github.com/bitcoin-sv/spv-wallet/models"
 	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/bitcoin-sv/spv-wallet/models/filter"
+	"github.com/samber/lo"
 	"gorm.io/gorm"
 )
 
@@ -41,11 +45,76 @@ func (r *Data) FindForUser(ctx context.Context, id string, userID string) (*data
 		return nil, err
 	}
 
-	return &datamodels.Data{
-		TxID:   row.TxID,
-		Vout:   row.Vout,
-		UserID: row.UserID,
-		Blob:   row.Blob,
+	return mapData(&row), nil
+}
+
+// PaginatedForUser returns the data of a user matching the filter, based on the provided paging options.
+func (r *Data) PaginatedForUser(ctx context.Context, userID string, searchFilter datamodels.SearchFilter, page filter.Page) (*models.PagedResult[datamodels.Data], error) {
+	rows, err := dbquery.PaginatedQuery[database.Data](
+		ctx,
+		page,
+		r.db,
+		dbquery.UserID(userID),
+		dataSearchScope(searchFilter),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.PagedResult[datamodels.Data]{
+		PageDescription: rows.PageDescription,
+		Content: lo.Map(rows.Content, func(row *database.Data, _ int) *datamodels.Data {
+			return mapData(row)
+		}),
 	}, nil
+}
+
+func dataSearchScope(searchFilter datamodels.SearchFilter) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if searchFilter.TxID != nil {
+			db = db.Where("tx_id = ?", *searchFilter.TxID)
+		}
+		if searchFilter.CreatedFrom != nil {
+			db = db.Where("created_at >= ?", *searchFilter.CreatedFrom)
+		}
+		if searchFilter.CreatedTo != nil {
+			db = db.Where("created_at <= ?", *searchFilter.CreatedTo)
+		}
+		if len(searchFilter.Prefix) > 0 {
+			db = db.Where("substr(blob, 1, ?) = ?", len(searchFilter.Prefix), searchFilter.Prefix)
+		}
+		if searchFilter.MinSize != nil {
+			db = db.Where("length(blob) >= ?", *searchFilter.MinSize)
+		}
+		if searchFilter.MaxSize != nil {
+			db = db.Where("length(blob) <= ?", *searchFilter.MaxSize)
+		}
+		if searchFilter.Protocol != nil {
+			db = db.Where("protocol_names LIKE ?", "%|"+*searchFilter.Protocol+"|%")
+		}
+		if searchFilter.MediaType != nil {
+			db = db.Where("media_type = ?", *searchFilter.MediaType)
+		}
+		if searchFilter.MapApp != nil {
+			db = db.Where("map_app = ?", *searchFilter.MapApp)
+		}
+		if searchFilter.MapType != nil {
+			db = db.Where("map_type = ?", *searchFilter.MapType)
+		}
+		if searchFilter.AIPAddress != nil {
+			db = db.Where("aip_address = ?", *searchFilter.AIPAddress)
+		}
+		return db
+	}
+}
 
+func mapData(row *database.Data) *datamodels.Data {
+	return &datamodels.Data{
+		TxID:      row.TxID,
+		Vout:      row.Vout,
+		CreatedAt: row.CreatedAt,
+		UserID:    row.UserID,
+		Blob:      row.Blob,
+		Protocols: row.Protocols,
+	}
 }
@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/database"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymailserver/deferredmodels"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DeferredTransactions is a repository for incoming paymail transactions waiting for the verification of their merkle roots.
+type DeferredTransactions struct {
+	db *gorm.DB
+}
+
+// NewDeferredTransactionsRepo creates a new repository for deferred transactions.
+func NewDeferredTransactionsRepo(db *gorm.DB) *DeferredTransactions {
+	return &DeferredTransactions{db: db}
+}
+
+// Create stores the pending transaction; it does nothing if the transaction is already stored.
+func (r *DeferredTransactions) Create(ctx context.Context, tx *deferredmodels.NewDeferredTransaction) error {
+	row := &database.DeferredTransaction{
+		TxID:            tx.TxID,
+		BEEF:            tx.BEEF,
+		SenderPaymail:   tx.SenderPaymail,
+		SenderPubKey:    tx.SenderPubKey,
+		ReceiverPaymail: tx.ReceiverPaymail,
+		Reference:       tx.Reference,
+		Note:            tx.Note,
+		Status:          string(deferredmodels.StatusPending),
+	}
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(row).Error
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to create deferred transaction")
+	}
+	return nil
+}
+
+// FindPending returns the oldest pending transactions, at most limit of them.
+func (r *DeferredTransactions) FindPending(ctx context.Context, limit int) ([]*deferredmodels.DeferredTransaction, error) {
+	var rows []*database.DeferredTransaction
+	err := r.db.WithContext(ctx).
+		Where("status = ?", deferredmodels.StatusPending).
+		Order("created_at").
+		Limit(limit).
+		Find(&rows).Error
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get pending deferred transactions")
+	}
+	return lo.Map(rows, func(row *database.DeferredTransaction, _ int) *deferredmodels.DeferredTransaction {
+		return mapToDeferredTransaction(row)
+	}), nil
+}
+
+// MarkRejected marks the transaction as rejected with the given reason.
+func (r *DeferredTransactions) MarkRejected(ctx context.Context, txID, reason string) error {
+	err := r.db.WithContext(ctx).
+		Model(&database.DeferredTransaction{}).
+		Where("tx_id = ?", txID).
+		Updates(map[string]any{
+			"status": deferredmodels.StatusRejected,
+			"reason": reason,
+		}).Error
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to mark deferred transaction as rejected")
+	}
+	return nil
+}
+
+// Delete removes the transaction (after it's recorded).
+func (r *DeferredTransactions) Delete(ctx context.Context, txID string) error {
+	err := r.db.WithContext(ctx).
+		Where("tx_id = ?", txID).
+		Delete(&database.DeferredTransaction{}).Error
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to delete deferred transaction")
+	}
+	return nil
+}
+
+func mapToDeferredTransaction(row *database.DeferredTransaction) *deferredmodels.DeferredTransaction {
+	return &deferredmodels.DeferredTransaction{
+		NewDeferredTransaction: deferredmodels.NewDeferredTransaction{
+			TxID:            row.TxID,
+			BEEF:            row.BEEF,
+			SenderPaymail:   row.SenderPaymail,
+			SenderPubKey:    row.SenderPubKey,
+			ReceiverPaymail: row.ReceiverPaymail,
+			Reference:       row.Reference,
+			Note:            row.Note,
+		},
+		Status:    deferredmodels.Status(row.Status),
+		Reason:    row.Reason,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}
+}
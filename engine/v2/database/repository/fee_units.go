@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/feeunit"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/database"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+// FeeUnits is a repository for the history of fee units.
+type FeeUnits struct {
+	db *gorm.DB
+}
+
+// NewFeeUnitsRepo creates a new repository for the history of fee units.
+func NewFeeUnitsRepo(db *gorm.DB) *FeeUnits {
+	return &FeeUnits{db: db}
+}
+
+// Add appends the change to the history.
+func (r *FeeUnits) Add(ctx context.Context, change feeunit.Change) error {
+	row := &database.FeeUnit{
+		Satoshis:  uint64(change.FeeUnit.Satoshis),
+		Bytes:     change.FeeUnit.Bytes,
+		Source:    string(change.Source),
+		CreatedAt: change.CreatedAt,
+	}
+	if err := r.db.WithContext(ctx).Create(row).Error; err != nil {
+		return spverrors.Wrapf(err, "failed to save fee unit")
+	}
+	return nil
+}
+
+// GetLatest returns the most recent change or nil when the history is empty.
+func (r *FeeUnits) GetLatest(ctx context.Context) (*feeunit.Change, error) {
+	var row database.FeeUnit
+	if err := r.db.WithContext(ctx).Order("id DESC").First(&row).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, spverrors.Wrapf(err, "failed to get latest fee unit")
+	}
+	change := mapToFeeUnitChange(&row)
+	return &change, nil
+}
+
+// List returns up to limit most recent changes, the newest first.
+func (r *FeeUnits) List(ctx context.Context, limit int) ([]feeunit.Change, error) {
+	var rows []*database.FeeUnit
+	if err := r.db.WithContext(ctx).Order("id DESC").Limit(limit).Find(&rows).Error; err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get fee units")
+	}
+	return lo.Map(rows, func(row *database.FeeUnit, _ int) feeunit.Change {
+		return mapToFeeUnitChange(row)
+	}), nil
+}
+
+func mapToFeeUnitChange(row *database.FeeUnit) feeunit.Change {
+	return feeunit.Change{
+		FeeUnit: bsv.FeeUnit{
+			Satoshis: bsv.Satoshis(row.Satoshis),
+			Bytes:    row.Bytes,
+		},
+		Source:    feeunit.Source(row.Source),
+		CreatedAt: row.CreatedAt,
+	}
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/database"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/database/dbquery"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/invoices/invoicesmodels"
+	"github.com/bitcoin-sv/spv-wallet/models"
+	"github.com/bitcoin-sv/spv-wallet/models/filter"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+// Invoices is a repository for invoices of the users.
+type Invoices struct {
+	db *gorm.DB
+}
+
+// NewInvoicesRepo creates a new repository for invoices.
+func NewInvoicesRepo(db *gorm.DB) *Invoices {
+	return &Invoices{db: db}
+}
+
+// Create adds a new invoice to the database.
+func (r *Invoices) Create(ctx context.Context, invoice *invoicesmodels.Invoice) (*invoicesmodels.Invoice, error) {
+	row := database.Invoice{
+		Reference: invoice.Reference,
+		Paymail:   invoice.Paymail,
+		Satoshis:  invoice.Satoshis,
+		Memo:      invoice.Memo,
+		ExpiresAt: invoice.ExpiresAt,
+		Status:    string(invoice.Status),
+
+		UserID: invoice.UserID,
+	}
+
+	if err := r.db.WithContext(ctx).Create(&row).Error; err != nil {
+		return nil, err
+	}
+
+	return mapInvoice(&row), nil
+}
+
+// FindForUser returns an invoice by its ID for given user.
+func (r *Invoices) FindForUser(ctx context.Context, id uint, userID string) (*invoicesmodels.Invoice, error) {
+	return r.first(ctx, r.db.Where("id = ? AND user_id = ?", id, userID))
+}
+
+// FindByReference returns an invoice by its reference.
+func (r *Invoices) FindByReference(ctx context.Context, reference string) (*invoicesmodels.Invoice, error) {
+	return r.first(ctx, r.db.Where("reference = ?", reference))
+}
+
+// PaginatedForUser returns invoices of a user based on the provided paging options.
+func (r *Invoices) PaginatedForUser(ctx context.Context, userID string, page filter.Page) (*models.PagedResult[invoicesmodels.Invoice], error) {
+	rows, err := dbquery.PaginatedQuery[database.Invoice](
+		ctx,
+		page,
+		r.db,
+		dbquery.UserID(userID),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.PagedResult[invoicesmodels.Invoice]{
+		PageDescription: rows.PageDescription,
+		Content: lo.Map(rows.Content, func(row *database.Invoice, _ int) *invoicesmodels.Invoice {
+			return mapInvoice(row)
+		}),
+	}, nil
+}
+
+// SavePayment stores the status and the payment of the invoice if it is still pending.
+// It returns false when the invoice was already paid (e.g. by a concurrently recorded transaction).
+func (r *Invoices) SavePayment(ctx context.Context, invoice *invoicesmodels.Invoice) (bool, error) {
+	result := r.db.
+		WithContext(ctx).
+		Model(&database.Invoice{}).
+		Where("id = ? AND status = ?", invoice.ID, string(invoicesmodels.StatusPending)).
+		Updates(map[string]any{
+			"status":        string(invoice.Status),
+			"paid_satoshis": invoice.PaidSatoshis,
+			"tx_id":         invoice.TxID,
+			"paid_at":       invoice.PaidAt,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *Invoices) first(ctx context.Context, query *gorm.DB) (*invoicesmodels.Invoice, error) {
+	var row database.Invoice
+	if err := query.WithContext(ctx).First(&row).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return mapInvoice(&row), nil
+}
+
+func mapInvoice(row *database.Invoice) *invoicesmodels.Invoice {
+	return &invoicesmodels.Invoice{
+		ID:        row.ID,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+
+		Reference: row.Reference,
+		Paymail:   row.Paymail,
+		Satoshis:  row.Satoshis,
+		Memo:      row.Memo,
+		ExpiresAt: row.ExpiresAt,
+		Status:    invoicesmodels.Status(row.Status),
+
+		PaidSatoshis: row.PaidSatoshis,
+		TxID:         lo.FromPtr(row.TxID),
+		PaidAt:       row.PaidAt,
+
+		UserID: row.UserID,
+	}
+}
@@ -2,9 +2,11 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"iter"
 	"slices"
 
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/database"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/database/dbquery"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/operations/operationsmodels"
@@ -41,21 +43,44 @@ func (o *Operations) PaginatedForUser(ctx context.Context, userID string, page f
 	return &models.PagedResult[operationsmodels.Operation]{
 		PageDescription: rows.PageDescription,
 		Content: lo.Map(rows.Content, func(operation *database.Operation, _ int) *operationsmodels.Operation {
-			return &operationsmodels.Operation{
-				TxID:         operation.TxID,
-				UserID:       operation.UserID,
-				CreatedAt:    operation.CreatedAt,
-				Counterparty: operation.Counterparty,
-				Type:         operation.Type,
-				Value:        operation.Value,
-				TxStatus:     operation.Transaction.TxStatus,
-				BlockHeight:  operation.Transaction.BlockHeight,
-				BlockHash:    operation.Transaction.BlockHash,
-			}
+			return mapToOperation(operation)
 		}),
 	}, nil
 }
 
+func mapToOperation(operation *database.Operation) *operationsmodels.Operation {
+	return &operationsmodels.Operation{
+		TxID:         operation.TxID,
+		UserID:       operation.UserID,
+		CreatedAt:    operation.CreatedAt,
+		Counterparty: operation.Counterparty,
+		Type:         operation.Type,
+		Value:        operation.Value,
+		SenderPubKey: operation.SenderPubKey,
+		TxStatus:     operation.Transaction.TxStatus,
+		BlockHeight:  operation.Transaction.BlockHeight,
+		BlockHash:    operation.Transaction.BlockHash,
+	}
+}
+
+// GetForUser returns the user's operation on the transaction with the given ID, or nil if there is no such operation.
+func (o *Operations) GetForUser(ctx context.Context, userID, txID string) (*operationsmodels.Operation, error) {
+	var operation database.Operation
+	err := o.db.
+		WithContext(ctx).
+		Preload("Transaction").
+		Where("user_id = ? AND tx_id = ?", userID, txID).
+		First(&operation).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get operation of user %s for transaction %s", userID, txID)
+	}
+
+	return mapToOperation(&operation), nil
+}
+
 // SaveAll saves operations to the database.
 func (o *Operations) SaveAll(ctx context.Context, operations iter.Seq[*txmodels.NewOperation]) error {
 	rows := mapOperations(operations)
@@ -93,6 +118,8 @@ func mapOperations(operations iter.Seq[*txmodels.NewOperation]) []database.Opera
 				Type:         operation.Type,
 				Value:        operation.Value,
 
+				SenderPubKey: lo.EmptyableToPtr(operation.SenderPubKey),
+
 				TxID:        operation.Transaction.ID,
 				Transaction: tx,
 			})
@@ -108,6 +135,8 @@ func mapTransaction(operation *txmodels.NewOperation) *database.TrackedTransacti
 		TxStatus: string(operation.Transaction.TxStatus),
 		BeefHex:  lo.If(beefHex != "", &beefHex).Else(nil),
 		RawHex:   lo.If(rawHex != "", &rawHex).Else(nil),
+
+		NextBroadcastAt: operation.Transaction.NextBroadcastAt,
 	}
 
 	for _, input := range operation.Transaction.TransactionInputSources() {
@@ -128,7 +157,21 @@ func mapTransaction(operation *txmodels.NewOperation) *database.TrackedTransacti
 	}
 
 	for _, output := range operation.Transaction.Outputs {
-		if output.UTXO != nil {
+		if output.UTXO != nil && output.UTXO.Token != nil {
+			tx.CreateTokenUTXO(
+				&database.TrackedOutput{
+					TxID:     operation.Transaction.ID,
+					Vout:     output.Vout,
+					UserID:   output.UserID,
+					Satoshis: output.Satoshis,
+				},
+				output.Bucket,
+				output.UTXO.EstimatedInputSize,
+				output.UTXO.CustomInstructions,
+				output.UTXO.Token.ID,
+				output.UTXO.Token.Amount,
+			)
+		} else if output.UTXO != nil {
 			tx.CreateUTXO(
 				&database.TrackedOutput{
 					TxID:     operation.Transaction.ID,
@@ -142,10 +185,11 @@ func mapTransaction(operation *txmodels.NewOperation) *database.TrackedTransacti
 			)
 		} else if output.Data != nil {
 			tx.CreateDataOutput(&database.Data{
-				TxID:   operation.Transaction.ID,
-				Vout:   output.Vout,
-				UserID: output.UserID,
-				Blob:   output.Data,
+				TxID:      operation.Transaction.ID,
+				Vout:      output.Vout,
+				UserID:    output.UserID,
+				Blob:      output.Data,
+				Protocols: output.Protocols,
 			})
 		}
 	}
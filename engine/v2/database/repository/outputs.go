@@ -9,6 +9,7 @@ import (
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/database"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/txmodels"
 	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/bitcoin-sv/spv-wallet/models/transaction/bucket"
 	"github.com/samber/lo"
 	"gorm.io/gorm"
 )
@@ -40,6 +41,27 @@ func (o *Outputs) FindByOutpoints(ctx context.Context, outpoints iter.Seq[bsv.Ou
 		return nil, spverrors.Wrapf(err, "failed to get outputs")
 	}
 
+	var tokenUTXOs []*database.UserUTXO
+	if len(outputs) > 0 {
+		if err := o.db.WithContext(ctx).
+			Model(&database.UserUTXO{}).
+			Where("bucket = ?", bucket.Tokens).
+			Where("(tx_id, vout) IN ?", outpointsClause).
+			Find(&tokenUTXOs).Error; err != nil {
+			return nil, spverrors.Wrapf(err, "failed to get token UTXOs")
+		}
+	}
+	tokens := make(map[bsv.Outpoint]*txmodels.Token, len(tokenUTXOs))
+	for _, utxo := range tokenUTXOs {
+		if utxo.TokenID == nil || utxo.TokenAmount == nil {
+			continue
+		}
+		tokens[bsv.Outpoint{TxID: utxo.TxID, Vout: utxo.Vout}] = &txmodels.Token{
+			ID:     *utxo.TokenID,
+			Amount: *utxo.TokenAmount,
+		}
+	}
+
 	return lo.Map(outputs, func(output *database.TrackedOutput, _ int) txmodels.TrackedOutput {
 		return txmodels.TrackedOutput{
 			TxID:       output.TxID,
@@ -47,6 +69,7 @@ func (o *Outputs) FindByOutpoints(ctx context.Context, outpoints iter.Seq[bsv.Ou
 			SpendingTX: output.SpendingTX,
 			UserID:     output.UserID,
 			Satoshis:   output.Satoshis,
+			Token:      tokens[bsv.Outpoint{TxID: output.TxID, Vout: output.Vout}],
 			CreatedAt:  output.CreatedAt,
 			UpdatedAt:  output.UpdatedAt,
 		}
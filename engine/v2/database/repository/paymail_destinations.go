@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/database"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymaildestinations/destinationmodels"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+// PaymailDestinations is a repository for the destinations derived for paymails.
+type PaymailDestinations struct {
+	db *gorm.DB
+}
+
+// NewPaymailDestinationsRepo creates a new repository for paymail destinations.
+func NewPaymailDestinationsRepo(db *gorm.DB) *PaymailDestinations {
+	return &PaymailDestinations{db: db}
+}
+
+// Create adds a new paymail destination to the database.
+func (r *PaymailDestinations) Create(ctx context.Context, destination *destinationmodels.NewDestination) error {
+	row := &database.PaymailDestination{
+		Address:            destination.Address,
+		ReferenceID:        destination.ReferenceID,
+		UserID:             destination.UserID,
+		Paymail:            destination.Paymail,
+		Request:            string(destination.Request),
+		Satoshis:           destination.Satoshis,
+		RequesterIP:        destination.Requester.IP,
+		RequesterUserAgent: destination.Requester.UserAgent,
+		SenderPaymail:      destination.Requester.SenderPaymail,
+		SenderName:         destination.Requester.SenderName,
+		Purpose:            destination.Requester.Purpose,
+	}
+	if err := r.db.WithContext(ctx).Create(row).Error; err != nil {
+		return spverrors.Wrapf(err, "failed to create paymail destination")
+	}
+	return nil
+}
+
+// FindByReference returns all the destinations derived for the given reference.
+func (r *PaymailDestinations) FindByReference(ctx context.Context, referenceID string) ([]*destinationmodels.Destination, error) {
+	var rows []*database.PaymailDestination
+	if err := r.db.WithContext(ctx).
+		Where("reference_id = ?", referenceID).
+		Find(&rows).Error; err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get paymail destinations")
+	}
+	return lo.Map(rows, func(row *database.PaymailDestination, _ int) *destinationmodels.Destination {
+		return mapToPaymailDestination(row)
+	}), nil
+}
+
+// FindByAddress returns the destination of the user by its address or nil when it doesn't exist.
+func (r *PaymailDestinations) FindByAddress(ctx context.Context, userID, address string) (*destinationmodels.Destination, error) {
+	return r.first(ctx, r.db.Where("address = ? AND user_id = ?", address, userID))
+}
+
+// FindByOutpoint returns the destination of the user paid by the given output or nil when it doesn't exist.
+func (r *PaymailDestinations) FindByOutpoint(ctx context.Context, userID string, outpoint bsv.Outpoint) (*destinationmodels.Destination, error) {
+	return r.first(ctx, r.db.Where("tx_id = ? AND vout = ? AND user_id = ?", outpoint.TxID, outpoint.Vout, userID))
+}
+
+// SavePayment stores the outpoint and the sender of the payment to the destination.
+func (r *PaymailDestinations) SavePayment(ctx context.Context, address string, payment *destinationmodels.Payment) error {
+	err := r.db.WithContext(ctx).
+		Model(&database.PaymailDestination{}).
+		Where("address = ?", address).
+		Updates(map[string]any{
+			"tx_id":          payment.Outpoint.TxID,
+			"vout":           payment.Outpoint.Vout,
+			"sender_paymail": payment.SenderPaymail,
+			"sender_pub_key": payment.SenderPubKey,
+			"note":           payment.Note,
+		}).Error
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to save payment of paymail destination")
+	}
+	return nil
+}
+
+// MarkPaid stores the outpoint of the payment to the destination with the given address unless the destination is already paid.
+// It does nothing if there is no destination with the given address.
+func (r *PaymailDestinations) MarkPaid(ctx context.Context, address string, outpoint bsv.Outpoint) error {
+	err := r.db.WithContext(ctx).
+		Model(&database.PaymailDestination{}).
+		Where("address = ? AND tx_id IS NULL", address).
+		Updates(map[string]any{
+			"tx_id": outpoint.TxID,
+			"vout":  outpoint.Vout,
+		}).Error
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to mark paymail destination as paid")
+	}
+	return nil
+}
+
+// DeleteUnpaid removes the destinations created before the given time which were not paid,
+// together with their addresses (so they are no longer tracked). It returns the number of removed destinations.
+// Destinations of invoices are kept until the invoice expires.
+// NOTE: A destination is paid once any output to its address is recorded (see MarkPaid and SavePayment).
+func (r *PaymailDestinations) DeleteUnpaid(ctx context.Context, createdBefore time.Time) (int64, error) {
+	var deleted int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		unexpiredInvoices := tx.Model(&database.Invoice{}).
+			Select("reference").
+			Where("expires_at > ?", time.Now())
+		unpaidCondition := tx.Where("tx_id IS NULL AND created_at < ?", createdBefore).
+			Where("reference_id NOT IN (?)", unexpiredInvoices)
+
+		unpaid := tx.Model(&database.PaymailDestination{}).
+			Select("address").
+			Where(unpaidCondition)
+
+		if err := tx.Where("address IN (?)", unpaid).Delete(&database.Address{}).Error; err != nil {
+			return spverrors.Wrapf(err, "failed to delete addresses of unpaid paymail destinations")
+		}
+
+		result := tx.Where(unpaidCondition).Delete(&database.PaymailDestination{})
+		if result.Error != nil {
+			return spverrors.Wrapf(result.Error, "failed to delete unpaid paymail destinations")
+		}
+		deleted = result.RowsAffected
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return deleted, nil
+}
+
+func (r *PaymailDestinations) first(ctx context.Context, query *gorm.DB) (*destinationmodels.Destination, error) {
+	var row database.PaymailDestination
+	if err := query.WithContext(ctx).First(&row).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, spverrors.Wrapf(err, "failed to get paymail destination")
+	}
+	return mapToPaymailDestination(&row), nil
+}
+
+func mapToPaymailDestination(row *database.PaymailDestination) *destinationmodels.Destination {
+	destination := &destinationmodels.Destination{
+		Address:     row.Address,
+		ReferenceID: row.ReferenceID,
+		UserID:      row.UserID,
+		Paymail:     row.Paymail,
+		Request:     destinationmodels.RequestType(row.Request),
+		Satoshis:    row.Satoshis,
+		Requester: destinationmodels.Requester{
+			IP:            row.RequesterIP,
+			UserAgent:     row.RequesterUserAgent,
+			SenderPaymail: row.SenderPaymail,
+			SenderName:    row.SenderName,
+			Purpose:       row.Purpose,
+		},
+		SenderPubKey: row.SenderPubKey,
+		Note:         row.Note,
+		CreatedAt:    row.CreatedAt,
+		UpdatedAt:    row.UpdatedAt,
+	}
+	if row.TxID != nil && row.Vout != nil {
+		destination.Outpoint = &bsv.Outpoint{TxID: *row.TxID, Vout: *row.Vout}
+	}
+	return destination
+}
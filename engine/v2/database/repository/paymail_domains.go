@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/paymaildomains"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/database"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+// PaymailDomains is a repository for paymail domains managed at runtime.
+type PaymailDomains struct {
+	db *gorm.DB
+}
+
+// NewPaymailDomainsRepo creates a new repository for paymail domains.
+func NewPaymailDomainsRepo(db *gorm.DB) *PaymailDomains {
+	return &PaymailDomains{db: db}
+}
+
+// Get returns the domain or nil when it doesn't exist.
+func (r *PaymailDomains) Get(ctx context.Context, name string) (*paymaildomains.Domain, error) {
+	var row database.PaymailDomain
+	if err := r.db.WithContext(ctx).Where("name = ?", name).First(&row).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, spverrors.Wrapf(err, "failed to get paymail domain")
+	}
+	return mapToPaymailDomain(&row), nil
+}
+
+// List returns all the domains ordered by name.
+func (r *PaymailDomains) List(ctx context.Context) ([]*paymaildomains.Domain, error) {
+	var rows []*database.PaymailDomain
+	if err := r.db.WithContext(ctx).Order("name").Find(&rows).Error; err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get paymail domains")
+	}
+	return lo.Map(rows, func(row *database.PaymailDomain, _ int) *paymaildomains.Domain {
+		return mapToPaymailDomain(row)
+	}), nil
+}
+
+// Create saves a new domain.
+func (r *PaymailDomains) Create(ctx context.Context, domain *paymaildomains.Domain) error {
+	if err := r.db.WithContext(ctx).Create(mapToPaymailDomainRow(domain)).Error; err != nil {
+		return spverrors.Wrapf(err, "failed to save paymail domain")
+	}
+	return nil
+}
+
+// Update saves the settings and the validation time of the domain.
+func (r *PaymailDomains) Update(ctx context.Context, domain *paymaildomains.Domain) error {
+	row := mapToPaymailDomainRow(domain)
+	err := r.db.WithContext(ctx).
+		Model(row).
+		Select("domain_validation_enabled", "default_from_paymail", "require_beef", "sender_validation", "validated_at", "updated_at").
+		Updates(row).Error
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to update paymail domain")
+	}
+	return nil
+}
+
+// Delete removes the domain; it returns false when the domain doesn't exist.
+func (r *PaymailDomains) Delete(ctx context.Context, name string) (bool, error) {
+	res := r.db.WithContext(ctx).Where("name = ?", name).Delete(&database.PaymailDomain{})
+	if res.Error != nil {
+		return false, spverrors.Wrapf(res.Error, "failed to delete paymail domain")
+	}
+	return res.RowsAffected > 0, nil
+}
+
+func mapToPaymailDomain(row *database.PaymailDomain) *paymaildomains.Domain {
+	return &paymaildomains.Domain{
+		Name: row.Name,
+		Settings: paymaildomains.Settings{
+			DomainValidationEnabled: row.DomainValidationEnabled,
+			DefaultFromPaymail:      row.DefaultFromPaymail,
+			RequireBEEF:             row.RequireBEEF,
+			SenderValidation:        paymaildomains.SenderValidationPolicy(row.SenderValidation),
+		},
+		ValidatedAt: row.ValidatedAt,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+}
+
+func mapToPaymailDomainRow(domain *paymaildomains.Domain) *database.PaymailDomain {
+	return &database.PaymailDomain{
+		Name:                    domain.Name,
+		DomainValidationEnabled: domain.DomainValidationEnabled,
+		DefaultFromPaymail:      domain.DefaultFromPaymail,
+		RequireBEEF:             domain.RequireBEEF,
+		SenderValidation:        string(domain.SenderValidation),
+		ValidatedAt:             domain.ValidatedAt,
+		CreatedAt:               domain.CreatedAt,
+		UpdatedAt:               domain.UpdatedAt,
+	}
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/paymailhosts"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/database"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PaymailHostRules is a repository for the allow/deny rules of paymail hosts.
+type PaymailHostRules struct {
+	db *gorm.DB
+}
+
+// NewPaymailHostRulesRepo creates a new repository for paymail host rules.
+func NewPaymailHostRulesRepo(db *gorm.DB) *PaymailHostRules {
+	return &PaymailHostRules{db: db}
+}
+
+// List returns all the rules ordered by domain.
+func (r *PaymailHostRules) List(ctx context.Context) ([]*paymailhosts.Rule, error) {
+	var rows []*database.PaymailHostRule
+	if err := r.db.WithContext(ctx).Order("domain").Find(&rows).Error; err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get paymail host rules")
+	}
+	return lo.Map(rows, func(row *database.PaymailHostRule, _ int) *paymailhosts.Rule {
+		return &paymailhosts.Rule{
+			Domain:    row.Domain,
+			Policy:    paymailhosts.Policy(row.Policy),
+			Note:      row.Note,
+			CreatedAt: row.CreatedAt,
+		}
+	}), nil
+}
+
+// Save creates the rule or replaces the existing rule for the domain.
+func (r *PaymailHostRules) Save(ctx context.Context, rule *paymailhosts.Rule) error {
+	row := &database.PaymailHostRule{
+		Domain:    rule.Domain,
+		Policy:    string(rule.Policy),
+		Note:      rule.Note,
+		CreatedAt: rule.CreatedAt,
+	}
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "domain"}},
+			DoUpdates: clause.AssignmentColumns([]string{"policy", "note", "created_at"}),
+		}).
+		Create(row).Error
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to save paymail host rule")
+	}
+	return nil
+}
+
+// Delete removes the rule; it returns false when the rule doesn't exist.
+func (r *PaymailHostRules) Delete(ctx context.Context, domain string) (bool, error) {
+	res := r.db.WithContext(ctx).Where("domain = ?", domain).Delete(&database.PaymailHostRule{})
+	if res.Error != nil {
+		return false, spverrors.Wrapf(res.Error, "failed to delete paymail host rule")
+	}
+	return res.RowsAffected > 0, nil
+}
@@ -84,6 +84,43 @@ func (p *Paymails) GetDefault(ctx context.Context, userID string) (*paymailsmode
 	return p.newPaymailModel(row), nil
 }
 
+// FindByIDForUser returns a paymail by its ID for given user.
+func (p *Paymails) FindByIDForUser(ctx context.Context, id uint, userID string) (*paymailsmodels.Paymail, error) {
+	var row database.Paymail
+	if err := p.db.
+		WithContext(ctx).
+		Where("id = ? AND user_id = ?", id, userID).
+		First(&row).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return p.newPaymailModel(row), nil
+}
+
+// UpdateProfile sets the public name and avatar of the paymail.
+func (p *Paymails) UpdateProfile(ctx context.Context, id uint, publicName, avatar string) (*paymailsmodels.Paymail, error) {
+	if err := p.db.
+		WithContext(ctx).
+		Model(&database.Paymail{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"public_name": publicName,
+			"avatar":      avatar,
+		}).Error; err != nil {
+		return nil, err
+	}
+
+	var row database.Paymail
+	if err := p.db.WithContext(ctx).First(&row, id).Error; err != nil {
+		return nil, err
+	}
+
+	return p.newPaymailModel(row), nil
+}
+
 func (p *Paymails) newPaymailModel(row database.Paymail) *paymailsmodels.Paymail {
 	return &paymailsmodels.Paymail{
 		ID:        row.ID,
@@ -4,11 +4,13 @@ import (
 	"context"
 	"maps"
 	"slices"
+	"time"
 
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/database"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/beef"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/txmodels"
+	"github.com/samber/lo"
 	"gorm.io/gorm"
 )
 
@@ -72,6 +74,10 @@ func (t *Transactions) GetTransaction(ctx context.Context, txID string) (transac
 		return nil, spverrors.Wrapf(err, "failed to query transaction hex for %s", txID)
 	}
 
+	return mapToTrackedTransaction(&record), nil
+}
+
+func mapToTrackedTransaction(record *database.TrackedTransaction) *txmodels.TrackedTransaction {
 	return &txmodels.TrackedTransaction{
 		ID:       record.ID,
 		TxStatus: txmodels.TxStatus(record.TxStatus),
@@ -84,7 +90,98 @@ func (t *Transactions) GetTransaction(ctx context.Context, txID string) (transac
 
 		BeefHex: record.BeefHex,
 		RawHex:  record.RawHex,
-	}, nil
+
+		BroadcastAttempts: record.BroadcastAttempts,
+		NextBroadcastAt:   record.NextBroadcastAt,
+	}
+}
+
+// FindTransactionsToBroadcast returns CREATED transactions which are queued for broadcasting and whose next attempt is due.
+func (t *Transactions) FindTransactionsToBroadcast(ctx context.Context, limit int) ([]*txmodels.TrackedTransaction, error) {
+	var rows []database.TrackedTransaction
+	err := t.db.
+		WithContext(ctx).
+		Where("tx_status = ? AND next_broadcast_at <= ?", txmodels.TxStatusCreated, time.Now()).
+		Order("next_broadcast_at ASC").
+		Limit(limit).
+		Find(&rows).Error
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to query transactions to broadcast")
+	}
+
+	return lo.Map(rows, func(row database.TrackedTransaction, _ int) *txmodels.TrackedTransaction {
+		return mapToTrackedTransaction(&row)
+	}), nil
+}
+
+// UpdateBroadcastStatus updates the status of the transaction along with the information about broadcast attempts.
+// nextBroadcastAt set to nil removes the transaction from the broadcasting queue.
+// When the transaction becomes PROBLEMATIC, the UTXOs spent by it are restored and its outputs are removed,
+// so the user's balance is the same as if the transaction wasn't recorded.
+func (t *Transactions) UpdateBroadcastStatus(ctx context.Context, txID string, status txmodels.TxStatus, attempts int, nextBroadcastAt *time.Time) error {
+	err := t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Model(&database.TrackedTransaction{}).
+			Where("id = ?", txID).
+			Updates(map[string]any{
+				"tx_status":          status,
+				"broadcast_attempts": attempts,
+				"next_broadcast_at":  nextBroadcastAt,
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		switch status {
+		case txmodels.TxStatusCreated:
+			return nil
+		case txmodels.TxStatusProblematic:
+			return releaseOutputs(tx, txID)
+		default:
+			// the transaction is broadcasted, so its inputs are spent for good
+			return tx.Where("spending_tx = ?", txID).Delete(&database.QueuedSpentUTXO{}).Error
+		}
+	})
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to update broadcast status of transaction %s", txID)
+	}
+	return nil
+}
+
+// releaseOutputs restores the UTXOs spent by the transaction and removes the outputs created by it.
+func releaseOutputs(tx *gorm.DB, txID string) error {
+	var spent []*database.QueuedSpentUTXO
+	if err := tx.Where("spending_tx = ?", txID).Find(&spent).Error; err != nil {
+		return err
+	}
+	if len(spent) > 0 {
+		utxos := lo.Map(spent, func(utxo *database.QueuedSpentUTXO, _ int) *database.UserUTXO {
+			return utxo.ToUserUTXO()
+		})
+		if err := tx.Create(utxos).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("spending_tx = ?", txID).Delete(&database.QueuedSpentUTXO{}).Error; err != nil {
+			return err
+		}
+	}
+
+	err := tx.
+		Model(&database.TrackedOutput{}).
+		Where("spending_tx = ?", txID).
+		Update("spending_tx", "").Error
+	if err != nil {
+		return err
+	}
+
+	if err = tx.Where("tx_id = ?", txID).Delete(&database.UserUTXO{}).Error; err != nil {
+		return err
+	}
+	// the outputs could be already spent by other queued transactions, which then cannot be broadcasted either
+	if err = tx.Where("tx_id = ?", txID).Delete(&database.QueuedSpentUTXO{}).Error; err != nil {
+		return err
+	}
+	return tx.Where("tx_id = ?", txID).Delete(&database.TrackedOutput{}).Error
 }
 
 // HasTransactionInputSources checks if all the provided input source transaction IDs exist in the database.
@@ -105,6 +105,24 @@ func (u *Users) GetBalance(ctx context.Context, userID string, bucket bucket.Nam
 	return balance, nil
 }
 
+// GetTokenBalances returns the balances of the BSV-21 and STAS tokens held by a user.
+func (u *Users) GetTokenBalances(ctx context.Context, userID string) ([]usersmodels.TokenBalance, error) {
+	balances := make([]usersmodels.TokenBalance, 0)
+	err := u.db.
+		WithContext(ctx).
+		Model(&database.UserUTXO{}).
+		Where("user_id = ? AND bucket = ?", userID, bucket.Tokens).
+		Select("token_id, COALESCE(SUM(token_amount), 0) AS amount").
+		Group("token_id").
+		Order("token_id").
+		Scan(&balances).Error
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get token balances")
+	}
+
+	return balances, nil
+}
+
 func mapToDomainUser(user *database.User) *usersmodels.User {
 	return &usersmodels.User{
 		ID:        user.ID,
@@ -27,6 +27,10 @@ type UserUtxoFixture interface {
 	P2PKH() UserUtxoFixture
 	// WithSatoshis sets the satoshis value of the UTXO.
 	WithSatoshis(satoshis bsv.Satoshis) UserUtxoFixture
+	// Ordinal ensures that the UTXO is a 1-satoshi output in the ordinals bucket.
+	Ordinal() UserUtxoFixture
+	// Token ensures that the UTXO is a 1-satoshi output in the tokens bucket holding the amount of the token.
+	Token(tokenID string, amount uint64) UserUtxoFixture
 
 	Storable[database.UserUTXO]
 }
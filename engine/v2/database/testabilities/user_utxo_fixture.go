@@ -23,6 +23,9 @@ type userUtxoFixture struct {
 	vout               uint32
 	satoshis           bsv.Satoshis
 	estimatedInputSize uint64
+	bucket             bucket.Name
+	tokenID            *string
+	tokenAmount        *uint64
 }
 
 func newUtxoFixture(t testing.TB, db *gorm.DB, index uint32) *userUtxoFixture {
@@ -35,6 +38,7 @@ func newUtxoFixture(t testing.TB, db *gorm.DB, index uint32) *userUtxoFixture {
 		vout:               index,
 		satoshis:           1,
 		estimatedInputSize: database.EstimatedInputSizeForP2PKH,
+		bucket:             bucket.BSV,
 	}
 }
 
@@ -62,6 +66,20 @@ func (f *userUtxoFixture) WithSatoshis(satoshis bsv.Satoshis) UserUtxoFixture {
 	return f
 }
 
+func (f *userUtxoFixture) Ordinal() UserUtxoFixture {
+	f.satoshis = 1
+	f.bucket = bucket.Ordinals
+	return f
+}
+
+func (f *userUtxoFixture) Token(tokenID string, amount uint64) UserUtxoFixture {
+	f.satoshis = 1
+	f.bucket = bucket.Tokens
+	f.tokenID = &tokenID
+	f.tokenAmount = &amount
+	return f
+}
+
 func (f *userUtxoFixture) Stored() *database.UserUTXO {
 	utxo := &database.UserUTXO{
 		UserID:             f.userID,
@@ -69,9 +87,11 @@ func (f *userUtxoFixture) Stored() *database.UserUTXO {
 		Vout:               f.vout,
 		Satoshis:           uint64(f.satoshis),
 		EstimatedInputSize: f.estimatedInputSize,
-		Bucket:             string(bucket.BSV),
+		Bucket:             string(f.bucket),
 		CreatedAt:          FirstCreatedAt.Add(time.Duration(f.index) * time.Second),
 		TouchedAt:          FirstCreatedAt.Add(time.Duration(24) * time.Hour),
+		TokenID:            f.tokenID,
+		TokenAmount:        f.tokenAmount,
 	}
 
 	f.db.Create(utxo)
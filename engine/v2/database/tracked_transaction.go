@@ -30,6 +30,10 @@ type TrackedTransaction struct {
 	BlockHeight *int64
 	BlockHash   *string
 
+	// BroadcastAttempts and NextBroadcastAt are used by the asynchronous broadcasting of CREATED transactions.
+	BroadcastAttempts int
+	NextBroadcastAt   *time.Time `gorm:"index"`
+
 	Data []*Data `gorm:"foreignKey:TxID"`
 
 	Inputs  []*TrackedOutput `gorm:"foreignKey:SpendingTX"`
@@ -68,13 +72,29 @@ func (t *TrackedTransaction) CreateUTXO(
 	t.newUTXOs = append(t.newUTXOs, NewUTXO(output, bucket, estimatedInputSize, customInstructions))
 }
 
+// CreateTokenUTXO prepares a new UTXO holding the amount of the token and adds it to the transaction.
+func (t *TrackedTransaction) CreateTokenUTXO(
+	output *TrackedOutput,
+	bucket string,
+	estimatedInputSize uint64,
+	customInstructions bsv.CustomInstructions,
+	tokenID string,
+	tokenAmount uint64,
+) {
+	t.CreateUTXO(output, bucket, estimatedInputSize, customInstructions)
+	utxo := t.newUTXOs[len(t.newUTXOs)-1]
+	utxo.TokenID = &tokenID
+	utxo.TokenAmount = &tokenAmount
+}
+
 // CreateDataOutput prepares a new Data output and adds it to the transaction.
 func (t *TrackedTransaction) CreateDataOutput(data *Data) {
 	t.Data = append(t.Data, data)
 }
 
 // AfterCreate is a hook that is called after creating the transaction.
-// It is responsible for adding new (User's) UTXOs and removing spent UTXOs.
+// It is responsible for adding new (User's) UTXOs and removing spent UTXOs
+// (kept as QueuedSpentUTXO when the transaction is queued for broadcasting).
 func (t *TrackedTransaction) AfterCreate(tx *gorm.DB) error {
 	// Add new UTXOs
 	if len(t.newUTXOs) > 0 {
@@ -91,6 +111,23 @@ func (t *TrackedTransaction) AfterCreate(tx *gorm.DB) error {
 				yield([]any{outpoint.TxID, outpoint.Vout})
 			}
 		})
+	if len(spentOutpoints) > 0 && t.NextBroadcastAt != nil {
+		// Keep spent UTXOs, so they can be restored if the queued transaction cannot be broadcasted
+		var spent []*UserUTXO
+		if err := tx.Where("(tx_id, vout) IN ?", spentOutpoints).Find(&spent).Error; err != nil {
+			return spverrors.Wrapf(err, "failed to get spent utxos")
+		}
+		if len(spent) > 0 {
+			queued := make([]*QueuedSpentUTXO, 0, len(spent))
+			for _, utxo := range spent {
+				queued = append(queued, NewQueuedSpentUTXO(t.ID, utxo))
+			}
+			if err := tx.Create(queued).Error; err != nil {
+				return spverrors.Wrapf(err, "failed to save spent utxos of queued transaction")
+			}
+		}
+	}
+
 	if len(spentOutpoints) > 0 {
 		// Remove spent UTXOs
 		err := tx.
@@ -0,0 +1,18 @@
+package database
+
+import "time"
+
+// UserContact represents a contact (other paymail) in the user's contact book
+type UserContact struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	FullName string
+	Paymail  string `gorm:"uniqueIndex:idx_user_contact_paymail"`
+	PubKey   string
+	Status   string `gorm:"index"`
+
+	UserID string `gorm:"uniqueIndex:idx_user_contact_paymail"`
+	User   *User  `gorm:"foreignKey:UserID"`
+}
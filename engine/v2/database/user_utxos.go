@@ -29,6 +29,11 @@ type UserUTXO struct {
 	TouchedAt time.Time `gorm:"uniqueIndex:idx_window,sort:asc,priority:2"`
 	// CustomInstructions is the list of instructions for unlocking given UTXO (it should be understood by client).
 	CustomInstructions datatypes.JSONSlice[bsv.CustomInstruction]
+
+	// TokenID is the ID of the BSV-21 or STAS token held by the UTXO (only for the tokens bucket).
+	TokenID *string `gorm:"index"`
+	// TokenAmount is the amount of the token held by the UTXO (only for the tokens bucket).
+	TokenAmount *uint64
 }
 
 // NewUTXO creates a new UserUTXO from the given TrackedOutput and additional data.
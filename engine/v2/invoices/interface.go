@@ -0,0 +1,31 @@
+package invoices
+
+import (
+	"context"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/invoices/invoicesmodels"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymaildestinations/destinationmodels"
+	"github.com/bitcoin-sv/spv-wallet/models"
+	"github.com/bitcoin-sv/spv-wallet/models/filter"
+)
+
+// Repo is an interface for invoices repository.
+type Repo interface {
+	Create(ctx context.Context, invoice *invoicesmodels.Invoice) (*invoicesmodels.Invoice, error)
+	FindForUser(ctx context.Context, id uint, userID string) (*invoicesmodels.Invoice, error)
+	FindByReference(ctx context.Context, reference string) (*invoicesmodels.Invoice, error)
+	PaginatedForUser(ctx context.Context, userID string, page filter.Page) (*models.PagedResult[invoicesmodels.Invoice], error)
+	// SavePayment stores the payment of the invoice only if it is still pending; it returns false when the invoice was already paid.
+	SavePayment(ctx context.Context, invoice *invoicesmodels.Invoice) (bool, error)
+}
+
+// PaymailsService is the paymails domain service.
+type PaymailsService interface {
+	HasPaymailAddress(ctx context.Context, userID string, address string) (bool, error)
+	GetDefaultPaymailAddress(ctx context.Context, userID string) (string, error)
+}
+
+// DestinationsService is the paymail destinations domain service.
+type DestinationsService interface {
+	FindByReference(ctx context.Context, referenceID string) ([]*destinationmodels.Destination, error)
+}
@@ -0,0 +1,218 @@
+package invoices
+
+import (
+	"context"
+	"time"
+
+	"github.com/bitcoin-sv/go-sdk/script"
+	trx "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/go-sdk/transaction/template/p2pkh"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/utils"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/invoices/invoiceserrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/invoices/invoicesmodels"
+	"github.com/bitcoin-sv/spv-wallet/models"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/bitcoin-sv/spv-wallet/models/filter"
+	"github.com/rs/zerolog"
+)
+
+// DefaultExpiry is the expiry of the invoice created without explicit expiry.
+const DefaultExpiry = 24 * time.Hour
+
+// Service is the domain service for invoices (payment requests) of the users.
+type Service struct {
+	logger       *zerolog.Logger
+	invoicesRepo Repo
+	paymails     PaymailsService
+	destinations DestinationsService
+}
+
+// NewService creates a new invoices service.
+func NewService(logger *zerolog.Logger, invoicesRepo Repo, paymails PaymailsService, destinations DestinationsService) *Service {
+	return &Service{
+		logger:       logger,
+		invoicesRepo: invoicesRepo,
+		paymails:     paymails,
+		destinations: destinations,
+	}
+}
+
+// Create creates a new invoice with a single-use reference.
+func (s *Service) Create(ctx context.Context, newInvoice *invoicesmodels.NewInvoice) (*invoicesmodels.Invoice, error) {
+	if newInvoice.Satoshis == 0 {
+		return nil, invoiceserrors.ErrInvalidInvoiceAmount
+	}
+
+	now := time.Now().UTC()
+	expiresAt := newInvoice.ExpiresAt.UTC()
+	if newInvoice.ExpiresAt.IsZero() {
+		expiresAt = now.Add(DefaultExpiry)
+	} else if !expiresAt.After(now) {
+		return nil, invoiceserrors.ErrInvalidInvoiceExpiry
+	}
+
+	paymail, err := s.invoicePaymail(ctx, newInvoice)
+	if err != nil {
+		return nil, err
+	}
+
+	reference, err := utils.RandomHex(16)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to generate invoice reference")
+	}
+
+	invoice, err := s.invoicesRepo.Create(ctx, &invoicesmodels.Invoice{
+		Reference: reference,
+		Paymail:   paymail,
+		Satoshis:  newInvoice.Satoshis,
+		Memo:      newInvoice.Memo,
+		ExpiresAt: expiresAt,
+		Status:    invoicesmodels.StatusPending,
+		UserID:    newInvoice.UserID,
+	})
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to create invoice")
+	}
+	return invoice, nil
+}
+
+// FindForUser returns the invoice of the user.
+func (s *Service) FindForUser(ctx context.Context, userID string, id uint) (*invoicesmodels.Invoice, error) {
+	invoice, err := s.invoicesRepo.FindForUser(ctx, id, userID)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to find invoice")
+	}
+	if invoice == nil {
+		return nil, invoiceserrors.ErrInvoiceNotFound
+	}
+	return withCurrentStatus(invoice), nil
+}
+
+// PaginatedForUser returns the invoices of the user.
+func (s *Service) PaginatedForUser(ctx context.Context, userID string, page filter.Page) (*models.PagedResult[invoicesmodels.Invoice], error) {
+	result, err := s.invoicesRepo.PaginatedForUser(ctx, userID, page)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get invoices")
+	}
+	for _, invoice := range result.Content {
+		withCurrentStatus(invoice)
+	}
+	return result, nil
+}
+
+// FindPayable returns the invoice served through the given paymail, which still awaits the payment.
+func (s *Service) FindPayable(ctx context.Context, paymail, reference string) (*invoicesmodels.Invoice, error) {
+	invoice, err := s.invoicesRepo.FindByReference(ctx, reference)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to find invoice")
+	}
+	if invoice == nil || invoice.Paymail != paymail {
+		return nil, invoiceserrors.ErrInvoiceNotFound
+	}
+
+	switch withCurrentStatus(invoice).Status {
+	case invoicesmodels.StatusPending:
+		return invoice, nil
+	case invoicesmodels.StatusExpired:
+		return nil, invoiceserrors.ErrInvoiceExpired
+	default:
+		return nil, invoiceserrors.ErrInvoiceNotPayable
+	}
+}
+
+// RecordPayment marks the invoice with the given reference as paid, underpaid or expired.
+// Only the outputs of the transaction locked with the scripts of the destinations derived for the invoice are counted as the payment.
+// References which don't belong to the invoices of the user are ignored (they are regular p2p references).
+func (s *Service) RecordPayment(ctx context.Context, reference, userID string, tx *trx.Transaction) error {
+	invoice, err := s.invoicesRepo.FindByReference(ctx, reference)
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to find invoice")
+	}
+	if invoice == nil || invoice.UserID != userID {
+		return nil
+	}
+	txID := tx.TxID().String()
+	if invoice.Status != invoicesmodels.StatusPending {
+		s.logger.Warn().Uint("invoiceID", invoice.ID).Str("txID", txID).Msg("Invoice was already paid, the payment is not recorded")
+		return nil
+	}
+
+	satoshis, err := s.paidToDestinations(ctx, reference, tx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	switch {
+	case invoice.IsExpired(now):
+		invoice.Status = invoicesmodels.StatusExpired
+	case satoshis >= invoice.Satoshis:
+		invoice.Status = invoicesmodels.StatusPaid
+	default:
+		invoice.Status = invoicesmodels.StatusUnderpaid
+	}
+	invoice.PaidSatoshis = satoshis
+	invoice.TxID = txID
+	invoice.PaidAt = &now
+
+	saved, err := s.invoicesRepo.SavePayment(ctx, invoice)
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to save invoice payment")
+	}
+	if !saved {
+		s.logger.Warn().Uint("invoiceID", invoice.ID).Str("txID", txID).Msg("Invoice was already paid, the payment is not recorded")
+	}
+	return nil
+}
+
+// paidToDestinations sums the outputs of the transaction locked with the scripts of the destinations derived for the reference.
+func (s *Service) paidToDestinations(ctx context.Context, reference string, tx *trx.Transaction) (bsv.Satoshis, error) {
+	destinations, err := s.destinations.FindByReference(ctx, reference)
+	if err != nil {
+		return 0, spverrors.Wrapf(err, "failed to find destinations of invoice")
+	}
+
+	lockingScripts := make(map[string]struct{}, len(destinations))
+	for _, destination := range destinations {
+		address, err := script.NewAddressFromString(destination.Address)
+		if err != nil {
+			return 0, spverrors.Wrapf(err, "invalid address of invoice destination")
+		}
+		lockingScript, err := p2pkh.Lock(address)
+		if err != nil {
+			return 0, spverrors.Wrapf(err, "failed to create locking script of invoice destination")
+		}
+		lockingScripts[lockingScript.String()] = struct{}{}
+	}
+
+	var satoshis bsv.Satoshis
+	for _, output := range tx.Outputs {
+		if _, ok := lockingScripts[output.LockingScript.String()]; ok {
+			satoshis += bsv.Satoshis(output.Satoshis)
+		}
+	}
+	return satoshis, nil
+}
+
+func (s *Service) invoicePaymail(ctx context.Context, newInvoice *invoicesmodels.NewInvoice) (string, error) {
+	if newInvoice.Paymail == "" {
+		address, err := s.paymails.GetDefaultPaymailAddress(ctx, newInvoice.UserID)
+		if err != nil {
+			return "", invoiceserrors.ErrInvalidInvoicePaymail.Wrap(err)
+		}
+		return address, nil
+	}
+	if ownsPaymail, err := s.paymails.HasPaymailAddress(ctx, newInvoice.UserID, newInvoice.Paymail); err != nil || !ownsPaymail {
+		return "", invoiceserrors.ErrInvalidInvoicePaymail
+	}
+	return newInvoice.Paymail, nil
+}
+
+// withCurrentStatus reports the pending invoice after its expiry as expired (the status is stored only with the payment).
+func withCurrentStatus(invoice *invoicesmodels.Invoice) *invoicesmodels.Invoice {
+	if invoice.IsExpired(time.Now()) {
+		invoice.Status = invoicesmodels.StatusExpired
+	}
+	return invoice
+}
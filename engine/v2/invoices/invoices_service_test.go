@@ -0,0 +1,319 @@
+package invoices_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bitcoin-sv/go-sdk/script"
+	trx "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/go-sdk/transaction/template/p2pkh"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/invoices"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/invoices/invoiceserrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/invoices/invoicesmodels"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymaildestinations/destinationmodels"
+	"github.com/bitcoin-sv/spv-wallet/models"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/bitcoin-sv/spv-wallet/models/filter"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	userID    = "user-id"
+	paymail   = "user@example.com"
+	reference = "e7861c9a6e3ea8a175c88ae15a57026b"
+)
+
+// paymentTx creates a transaction paying the given amounts to the given addresses.
+func paymentTx(t *testing.T, payments map[*script.Address]bsv.Satoshis) *trx.Transaction {
+	tx := trx.NewTransaction()
+	for address, satoshis := range payments {
+		lockingScript, err := p2pkh.Lock(address)
+		require.NoError(t, err)
+		tx.AddOutput(&trx.TransactionOutput{LockingScript: lockingScript, Satoshis: uint64(satoshis)})
+	}
+	return tx
+}
+
+func TestRecordPayment(t *testing.T) {
+	tests := map[string]struct {
+		expiresIn      time.Duration
+		paidSatoshis   bsv.Satoshis
+		expectedStatus invoicesmodels.Status
+	}{
+		"paid with the requested amount": {
+			expiresIn:      time.Hour,
+			paidSatoshis:   1000,
+			expectedStatus: invoicesmodels.StatusPaid,
+		},
+		"paid with more than the requested amount": {
+			expiresIn:      time.Hour,
+			paidSatoshis:   1500,
+			expectedStatus: invoicesmodels.StatusPaid,
+		},
+		"paid with less than the requested amount": {
+			expiresIn:      time.Hour,
+			paidSatoshis:   999,
+			expectedStatus: invoicesmodels.StatusUnderpaid,
+		},
+		"paid after the expiry": {
+			expiresIn:      -time.Hour,
+			paidSatoshis:   1000,
+			expectedStatus: invoicesmodels.StatusExpired,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given:
+			service, repo := givenService(t)
+			invoice := repo.givenInvoice(test.expiresIn)
+			tx := paymentTx(t, map[*script.Address]bsv.Satoshis{fixtures.RecipientInternal.Address(): test.paidSatoshis})
+
+			// when:
+			err := service.RecordPayment(context.Background(), invoice.Reference, userID, tx)
+
+			// then:
+			require.NoError(t, err)
+			paid, err := service.FindForUser(context.Background(), userID, invoice.ID)
+			require.NoError(t, err)
+			require.Equal(t, test.expectedStatus, paid.Status)
+			require.Equal(t, test.paidSatoshis, paid.PaidSatoshis)
+			require.Equal(t, tx.TxID().String(), paid.TxID)
+			require.NotNil(t, paid.PaidAt)
+		})
+	}
+
+	t.Run("reference of other user's invoice is ignored", func(t *testing.T) {
+		// given:
+		service, repo := givenService(t)
+		invoice := repo.givenInvoice(time.Hour)
+		tx := paymentTx(t, map[*script.Address]bsv.Satoshis{fixtures.RecipientInternal.Address(): 1000})
+
+		// when:
+		err := service.RecordPayment(context.Background(), invoice.Reference, "other-user-id", tx)
+
+		// then:
+		require.NoError(t, err)
+		require.Equal(t, invoicesmodels.StatusPending, repo.invoices[invoice.Reference].Status)
+	})
+
+	t.Run("outputs to other addresses are not counted", func(t *testing.T) {
+		// given:
+		service, repo := givenService(t)
+		invoice := repo.givenInvoice(time.Hour)
+		tx := paymentTx(t, map[*script.Address]bsv.Satoshis{
+			fixtures.RecipientInternal.Address(): 400,
+			fixtures.Sender.Address():            600,
+		})
+
+		// when:
+		err := service.RecordPayment(context.Background(), invoice.Reference, userID, tx)
+
+		// then:
+		require.NoError(t, err)
+		paid := repo.invoices[invoice.Reference]
+		require.Equal(t, invoicesmodels.StatusUnderpaid, paid.Status)
+		require.Equal(t, bsv.Satoshis(400), paid.PaidSatoshis)
+	})
+
+	t.Run("second payment is not recorded", func(t *testing.T) {
+		// given:
+		service, repo := givenService(t)
+		invoice := repo.givenInvoice(time.Hour)
+		firstTx := paymentTx(t, map[*script.Address]bsv.Satoshis{fixtures.RecipientInternal.Address(): 1000})
+		require.NoError(t, service.RecordPayment(context.Background(), invoice.Reference, userID, firstTx))
+
+		// when:
+		secondTx := paymentTx(t, map[*script.Address]bsv.Satoshis{fixtures.RecipientInternal.Address(): 500})
+		err := service.RecordPayment(context.Background(), invoice.Reference, userID, secondTx)
+
+		// then:
+		require.NoError(t, err)
+		require.Equal(t, firstTx.TxID().String(), repo.invoices[invoice.Reference].TxID)
+	})
+
+	t.Run("concurrent payments are recorded once", func(t *testing.T) {
+		// given:
+		service, repo := givenService(t)
+		invoice := repo.givenInvoice(time.Hour)
+		txs := []*trx.Transaction{
+			paymentTx(t, map[*script.Address]bsv.Satoshis{fixtures.RecipientInternal.Address(): 1000}),
+			paymentTx(t, map[*script.Address]bsv.Satoshis{fixtures.RecipientInternal.Address(): 500}),
+		}
+
+		// when:
+		var wg sync.WaitGroup
+		for _, tx := range txs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				require.NoError(t, service.RecordPayment(context.Background(), invoice.Reference, userID, tx))
+			}()
+		}
+		wg.Wait()
+
+		// then:
+		require.Equal(t, 1, repo.savedPayments)
+	})
+}
+
+func TestFindPayable(t *testing.T) {
+	t.Run("pending invoice", func(t *testing.T) {
+		// given:
+		service, repo := givenService(t)
+		invoice := repo.givenInvoice(time.Hour)
+
+		// when:
+		payable, err := service.FindPayable(context.Background(), paymail, invoice.Reference)
+
+		// then:
+		require.NoError(t, err)
+		require.Equal(t, invoice.Satoshis, payable.Satoshis)
+	})
+
+	t.Run("invoice of other paymail", func(t *testing.T) {
+		// given:
+		service, repo := givenService(t)
+		invoice := repo.givenInvoice(time.Hour)
+
+		// when:
+		_, err := service.FindPayable(context.Background(), "other@example.com", invoice.Reference)
+
+		// then:
+		require.ErrorIs(t, err, invoiceserrors.ErrInvoiceNotFound)
+	})
+
+	t.Run("expired invoice", func(t *testing.T) {
+		// given:
+		service, repo := givenService(t)
+		invoice := repo.givenInvoice(-time.Hour)
+
+		// when:
+		_, err := service.FindPayable(context.Background(), paymail, invoice.Reference)
+
+		// then:
+		require.ErrorIs(t, err, invoiceserrors.ErrInvoiceExpired)
+	})
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("invoice with default paymail and expiry", func(t *testing.T) {
+		// given:
+		service, _ := givenService(t)
+
+		// when:
+		invoice, err := service.Create(context.Background(), &invoicesmodels.NewInvoice{UserID: userID, Satoshis: 1000})
+
+		// then:
+		require.NoError(t, err)
+		require.Equal(t, paymail, invoice.Paymail)
+		require.Equal(t, invoicesmodels.StatusPending, invoice.Status)
+		require.Len(t, invoice.Reference, 32)
+		require.WithinDuration(t, time.Now().Add(invoices.DefaultExpiry), invoice.ExpiresAt, time.Minute)
+	})
+
+	t.Run("invoice without amount", func(t *testing.T) {
+		// given:
+		service, _ := givenService(t)
+
+		// when:
+		_, err := service.Create(context.Background(), &invoicesmodels.NewInvoice{UserID: userID})
+
+		// then:
+		require.ErrorIs(t, err, invoiceserrors.ErrInvalidInvoiceAmount)
+	})
+}
+
+func givenService(t *testing.T) (*invoices.Service, *memoryRepo) {
+	logger := tester.Logger(t)
+	repo := &memoryRepo{invoices: map[string]*invoicesmodels.Invoice{}}
+	return invoices.NewService(&logger, repo, fakePaymails{}, fakeDestinations{}), repo
+}
+
+type fakeDestinations struct{}
+
+func (fakeDestinations) FindByReference(_ context.Context, referenceID string) ([]*destinationmodels.Destination, error) {
+	if referenceID != reference {
+		return nil, nil
+	}
+	return []*destinationmodels.Destination{{Address: fixtures.RecipientInternal.Address().AddressString, ReferenceID: referenceID}}, nil
+}
+
+type fakePaymails struct{}
+
+func (fakePaymails) HasPaymailAddress(_ context.Context, user string, address string) (bool, error) {
+	return user == userID && address == paymail, nil
+}
+
+func (fakePaymails) GetDefaultPaymailAddress(_ context.Context, _ string) (string, error) {
+	return paymail, nil
+}
+
+type memoryRepo struct {
+	mu            sync.Mutex
+	invoices      map[string]*invoicesmodels.Invoice
+	savedPayments int
+}
+
+func (r *memoryRepo) givenInvoice(expiresIn time.Duration) *invoicesmodels.Invoice {
+	invoice, _ := r.Create(context.Background(), &invoicesmodels.Invoice{
+		Reference: reference,
+		Paymail:   paymail,
+		Satoshis:  1000,
+		ExpiresAt: time.Now().Add(expiresIn),
+		Status:    invoicesmodels.StatusPending,
+		UserID:    userID,
+	})
+	return invoice
+}
+
+func (r *memoryRepo) Create(_ context.Context, invoice *invoicesmodels.Invoice) (*invoicesmodels.Invoice, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	invoice.ID = uint(len(r.invoices) + 1)
+	stored := *invoice
+	r.invoices[invoice.Reference] = &stored
+	return invoice, nil
+}
+
+func (r *memoryRepo) FindForUser(_ context.Context, id uint, user string) (*invoicesmodels.Invoice, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, invoice := range r.invoices {
+		if invoice.ID == id && invoice.UserID == user {
+			found := *invoice
+			return &found, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryRepo) FindByReference(_ context.Context, reference string) (*invoicesmodels.Invoice, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	invoice, ok := r.invoices[reference]
+	if !ok {
+		return nil, nil
+	}
+	found := *invoice
+	return &found, nil
+}
+
+func (r *memoryRepo) PaginatedForUser(_ context.Context, _ string, _ filter.Page) (*models.PagedResult[invoicesmodels.Invoice], error) {
+	panic("not used in tests")
+}
+
+func (r *memoryRepo) SavePayment(_ context.Context, invoice *invoicesmodels.Invoice) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.invoices[invoice.Reference].Status != invoicesmodels.StatusPending {
+		return false, nil
+	}
+	stored := *invoice
+	r.invoices[invoice.Reference] = &stored
+	r.savedPayments++
+	return true, nil
+}
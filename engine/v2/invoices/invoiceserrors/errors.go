@@ -0,0 +1,21 @@
+package invoiceserrors
+
+import "github.com/bitcoin-sv/spv-wallet/models"
+
+// ErrInvoiceNotFound is when the invoice does not exist or does not belong to the user (or paymail).
+var ErrInvoiceNotFound = models.SPVError{Message: "invoice not found", StatusCode: 404, Code: "error-invoice-not-found"}
+
+// ErrInvalidInvoiceAmount is when the invoice is created without the amount.
+var ErrInvalidInvoiceAmount = models.SPVError{Message: "invoice amount must be greater than zero", StatusCode: 400, Code: "error-invoice-invalid-amount"}
+
+// ErrInvalidInvoiceExpiry is when the invoice is created with the expiry in the past.
+var ErrInvalidInvoiceExpiry = models.SPVError{Message: "invoice expiry must be in the future", StatusCode: 400, Code: "error-invoice-invalid-expiry"}
+
+// ErrInvalidInvoicePaymail is when the paymail of the invoice is invalid or does not belong to the user.
+var ErrInvalidInvoicePaymail = models.SPVError{Message: "invalid invoice paymail", StatusCode: 400, Code: "error-invoice-invalid-paymail"}
+
+// ErrInvoiceNotPayable is when the destination is requested for the invoice which is already paid.
+var ErrInvoiceNotPayable = models.SPVError{Message: "invoice is already paid", StatusCode: 422, Code: "error-invoice-not-payable"}
+
+// ErrInvoiceExpired is when the destination is requested for the expired invoice.
+var ErrInvoiceExpired = models.SPVError{Message: "invoice is expired", StatusCode: 422, Code: "error-invoice-expired"}
@@ -0,0 +1,58 @@
+package invoicesmodels
+
+import (
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+)
+
+// Status represents the status of the invoice.
+type Status string
+
+const (
+	// StatusPending is a status of the invoice which awaits the payment.
+	StatusPending Status = "pending"
+	// StatusPaid is a status of the invoice paid with (at least) the requested amount.
+	StatusPaid Status = "paid"
+	// StatusUnderpaid is a status of the invoice paid with less than the requested amount.
+	StatusUnderpaid Status = "underpaid"
+	// StatusExpired is a status of the invoice which was not paid before its expiry.
+	StatusExpired Status = "expired"
+)
+
+// Invoice is a domain model of the payment request of the user, served through the paymail invoice extension.
+type Invoice struct {
+	ID        uint
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// Reference is the single-use reference of the invoice, used by the payer to get the destination and to send the transaction.
+	Reference string
+	Paymail   string
+	Satoshis  bsv.Satoshis
+	Memo      string
+	ExpiresAt time.Time
+	Status    Status
+
+	PaidSatoshis bsv.Satoshis
+	TxID         string
+	PaidAt       *time.Time
+
+	UserID string
+}
+
+// IsExpired checks if the invoice awaits the payment after its expiry.
+func (i *Invoice) IsExpired(now time.Time) bool {
+	return i.Status == StatusPending && !now.Before(i.ExpiresAt)
+}
+
+// NewInvoice represents data for creating a new invoice by the user.
+type NewInvoice struct {
+	UserID   string
+	Satoshis bsv.Satoshis
+	Memo     string
+	// ExpiresAt is the expiry of the invoice; if zero, the default expiry is used.
+	ExpiresAt time.Time
+	// Paymail is the user's paymail through which the invoice is served; if empty, the default paymail is used.
+	Paymail string
+}
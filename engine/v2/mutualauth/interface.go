@@ -0,0 +1,15 @@
+package mutualauth
+
+import (
+	"context"
+	"time"
+)
+
+// SessionCache is the subset of the cachestore used to keep the sessions and the already used nonces.
+type SessionCache interface {
+	Get(ctx context.Context, key string) (string, error)
+	GetModel(ctx context.Context, key string, model interface{}) error
+	SetModel(ctx context.Context, key string, model interface{}, ttl time.Duration, dependencies ...string) error
+	// WriteLock sets the key only if it is not set yet (ttl in seconds), it fails otherwise.
+	WriteLock(ctx context.Context, lockKey string, ttl int64) (string, error)
+}
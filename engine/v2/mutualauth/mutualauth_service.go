@@ -0,0 +1,320 @@
+package mutualauth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	primitives "github.com/bitcoin-sv/go-sdk/primitives/ec"
+	"github.com/bitcoin-sv/spv-wallet/config"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/mutualauth/mutualautherrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/mutualauth/mutualauthmodels"
+	"github.com/mrz1836/go-cachestore"
+)
+
+const (
+	// signatureProtocol is the BRC-43 protocol (security level 2) used for signing the auth messages
+	signatureProtocol = "2-auth message signature"
+
+	nonceRandomLength = 16
+	requestIDLength   = 32
+
+	cacheKeySessionPrefix = "mutualauth-session-"
+	cacheKeyNoncePrefix   = "mutualauth-nonce-"
+)
+
+// Service is the domain service for BRC-103/104 mutual authentication.
+type Service struct {
+	enabled     bool
+	identityKey *primitives.PrivateKey
+	sessionTTL  time.Duration
+	cache       SessionCache
+	nonceLock   sync.Mutex
+}
+
+// NewService creates a new mutual authentication service.
+// When no identity key is configured, a random one is generated (so peers have to redo the handshake after restart).
+func NewService(cfg *config.AppConfig, cache SessionCache) (*Service, error) {
+	s := &Service{cache: cache}
+
+	var authCfg *config.MutualAuthConfig
+	if cfg != nil && cfg.Authentication != nil {
+		authCfg = cfg.Authentication.MutualAuth
+	}
+
+	var err error
+	if authCfg != nil && authCfg.IdentityKey != "" {
+		s.identityKey, err = authCfg.ParseIdentityKey()
+	} else {
+		s.identityKey, err = primitives.NewPrivateKey()
+	}
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to load mutual auth identity key")
+	}
+
+	if authCfg != nil {
+		s.enabled = authCfg.Enabled
+		s.sessionTTL = authCfg.SessionTTL
+	}
+	// the used nonces are remembered for the session ttl, so it cannot be too short to prevent replaying the requests
+	s.sessionTTL = max(s.sessionTTL, config.MinMutualAuthSessionTTL)
+
+	return s, nil
+}
+
+// Enabled returns true if mutual authentication is turned on.
+func (s *Service) Enabled() bool {
+	return s.enabled
+}
+
+// IdentityKey returns the server identity public key (compressed, hex).
+func (s *Service) IdentityKey() string {
+	return s.identityKey.PubKey().ToDERHex()
+}
+
+// Handshake handles the BRC-103 initialRequest and returns the signed initialResponse establishing a new session.
+func (s *Service) Handshake(ctx context.Context, request *mutualauthmodels.AuthMessage) (*mutualauthmodels.AuthMessage, error) {
+	if !s.enabled {
+		return nil, mutualautherrors.ErrMutualAuthDisabled
+	}
+	if request.Version != mutualauthmodels.Version {
+		return nil, mutualautherrors.ErrUnsupportedVersion
+	}
+	if request.MessageType != mutualauthmodels.MessageTypeInitialRequest {
+		return nil, mutualautherrors.ErrInvalidMessageType
+	}
+
+	peerKey, err := primitives.PublicKeyFromString(request.IdentityKey)
+	if err != nil {
+		return nil, mutualautherrors.ErrInvalidIdentityKey.Wrap(err)
+	}
+
+	peerNonce, err := base64.StdEncoding.DecodeString(request.InitialNonce)
+	if err != nil || len(peerNonce) == 0 {
+		return nil, mutualautherrors.ErrInvalidNonce
+	}
+
+	sessionNonce, err := s.createNonce()
+	if err != nil {
+		return nil, err
+	}
+	sessionNonceBytes, _ := base64.StdEncoding.DecodeString(sessionNonce)
+
+	signature, err := s.sign(
+		peerKey,
+		keyID(request.InitialNonce, sessionNonce),
+		append(peerNonce, sessionNonceBytes...),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	session := mutualauthmodels.Session{
+		PeerIdentityKey: peerKey.ToDERHex(),
+		PeerNonce:       request.InitialNonce,
+		SessionNonce:    sessionNonce,
+		CreatedAt:       time.Now(),
+	}
+	if err = s.cache.SetModel(ctx, cacheKeySessionPrefix+sessionNonce, &session, s.sessionTTL); err != nil {
+		return nil, spverrors.Wrapf(err, "failed to store mutual auth session")
+	}
+
+	return &mutualauthmodels.AuthMessage{
+		Version:      mutualauthmodels.Version,
+		MessageType:  mutualauthmodels.MessageTypeInitialResponse,
+		IdentityKey:  s.IdentityKey(),
+		Nonce:        sessionNonce,
+		InitialNonce: sessionNonce,
+		YourNonce:    request.InitialNonce,
+		Signature:    signature,
+	}, nil
+}
+
+// VerifyRequest checks the BRC-104 authentication of a general request and returns the session it belongs to.
+func (s *Service) VerifyRequest(ctx context.Context, auth *mutualauthmodels.AuthHeaders, request *mutualauthmodels.HTTPRequest) (*mutualauthmodels.Session, error) {
+	if !s.enabled {
+		return nil, mutualautherrors.ErrMutualAuthDisabled
+	}
+	if auth.Version != mutualauthmodels.Version {
+		return nil, mutualautherrors.ErrUnsupportedVersion
+	}
+	if !s.verifyNonce(auth.YourNonce) || auth.Nonce == "" {
+		return nil, mutualautherrors.ErrInvalidNonce
+	}
+
+	session, err := s.getSession(ctx, auth.YourNonce)
+	if err != nil {
+		return nil, err
+	}
+	if session.PeerIdentityKey != auth.IdentityKey {
+		return nil, mutualautherrors.ErrInvalidIdentityKey
+	}
+
+	peerKey, err := primitives.PublicKeyFromString(auth.IdentityKey)
+	if err != nil {
+		return nil, mutualautherrors.ErrInvalidIdentityKey.Wrap(err)
+	}
+
+	requestID, err := decodeRequestID(auth.RequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := serializeRequest(requestID, request)
+	if err = s.verify(peerKey, keyID(auth.Nonce, auth.YourNonce), payload, auth.Signature); err != nil {
+		return nil, err
+	}
+
+	if err = s.markNonceAsUsed(ctx, auth.Nonce); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// SignResponse signs the response to a request authenticated within the given session.
+func (s *Service) SignResponse(session *mutualauthmodels.Session, requestID string, response *mutualauthmodels.HTTPResponse) (*mutualauthmodels.AuthHeaders, error) {
+	peerKey, err := primitives.PublicKeyFromString(session.PeerIdentityKey)
+	if err != nil {
+		return nil, mutualautherrors.ErrInvalidIdentityKey.Wrap(err)
+	}
+
+	requestIDBytes, err := decodeRequestID(requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := s.createNonce()
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := s.sign(peerKey, keyID(nonce, session.PeerNonce), serializeResponse(requestIDBytes, response))
+	if err != nil {
+		return nil, err
+	}
+
+	return &mutualauthmodels.AuthHeaders{
+		Version:     mutualauthmodels.Version,
+		IdentityKey: s.IdentityKey(),
+		Nonce:       nonce,
+		YourNonce:   session.PeerNonce,
+		Signature:   signature,
+		RequestID:   requestID,
+	}, nil
+}
+
+func (s *Service) getSession(ctx context.Context, sessionNonce string) (*mutualauthmodels.Session, error) {
+	session := new(mutualauthmodels.Session)
+	err := s.cache.GetModel(ctx, cacheKeySessionPrefix+sessionNonce, session)
+	if errors.Is(err, cachestore.ErrKeyNotFound) {
+		return nil, mutualautherrors.ErrSessionNotFound
+	}
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get mutual auth session")
+	}
+	if session.SessionNonce != sessionNonce {
+		return nil, mutualautherrors.ErrSessionNotFound
+	}
+	return session, nil
+}
+
+// markNonceAsUsed remembers the nonce for the session ttl, failing if it was already used.
+// The nonce is stored with the write lock of the cache, which sets it only when it is not set yet,
+// so the same request cannot be accepted twice even when replayed concurrently.
+func (s *Service) markNonceAsUsed(ctx context.Context, nonce string) error {
+	// the lock of freecache checks and sets the key in separate steps, so it is guarded within the process (the lock of redis is atomic)
+	s.nonceLock.Lock()
+	defer s.nonceLock.Unlock()
+
+	key := cacheKeyNoncePrefix + nonce
+	if _, err := s.cache.WriteLock(ctx, key, int64(s.sessionTTL.Seconds())); err != nil {
+		if _, getErr := s.cache.Get(ctx, key); getErr == nil {
+			return mutualautherrors.ErrNonceAlreadyUsed
+		}
+		return spverrors.Wrapf(err, "failed to store mutual auth nonce")
+	}
+	return nil
+}
+
+// createNonce returns base64 encoded random bytes followed by their HMAC, so the server can later recognize its own nonces
+func (s *Service) createNonce() (string, error) {
+	random := make([]byte, nonceRandomLength)
+	if _, err := rand.Read(random); err != nil {
+		return "", spverrors.Wrapf(err, "failed to generate nonce")
+	}
+	return base64.StdEncoding.EncodeToString(append(random, s.nonceHMAC(random)...)), nil
+}
+
+func (s *Service) verifyNonce(nonce string) bool {
+	decoded, err := base64.StdEncoding.DecodeString(nonce)
+	if err != nil || len(decoded) != nonceRandomLength+sha256.Size {
+		return false
+	}
+	return hmac.Equal(decoded[nonceRandomLength:], s.nonceHMAC(decoded[:nonceRandomLength]))
+}
+
+func (s *Service) nonceHMAC(data []byte) []byte {
+	mac := hmac.New(sha256.New, s.identityKey.Serialize())
+	mac.Write(data)
+	return mac.Sum(nil)
+}
+
+// sign signs the data with the BRC-42 child key derived for the peer
+func (s *Service) sign(peer *primitives.PublicKey, keyID string, data []byte) (string, error) {
+	childKey, err := s.identityKey.DeriveChild(peer, invoiceNumber(keyID))
+	if err != nil {
+		return "", spverrors.Wrapf(err, "failed to derive signing key")
+	}
+	hash := sha256.Sum256(data)
+	signature, err := childKey.Sign(hash[:])
+	if err != nil {
+		return "", spverrors.Wrapf(err, "failed to sign mutual auth message")
+	}
+	return hex.EncodeToString(signature.Serialize()), nil
+}
+
+// verify checks the signature made by the peer with its BRC-42 child key derived for the server
+func (s *Service) verify(peer *primitives.PublicKey, keyID string, data []byte, signatureHex string) error {
+	signatureBytes, err := hex.DecodeString(signatureHex)
+	if err != nil {
+		return mutualautherrors.ErrInvalidSignature
+	}
+	signature, err := primitives.ParseDERSignature(signatureBytes)
+	if err != nil {
+		return mutualautherrors.ErrInvalidSignature
+	}
+	childKey, err := peer.DeriveChild(s.identityKey, invoiceNumber(keyID))
+	if err != nil {
+		return mutualautherrors.ErrInvalidSignature
+	}
+	hash := sha256.Sum256(data)
+	if !signature.Verify(hash[:], childKey) {
+		return mutualautherrors.ErrInvalidSignature
+	}
+	return nil
+}
+
+func decodeRequestID(requestID string) ([]byte, error) {
+	decoded, err := base64.StdEncoding.DecodeString(requestID)
+	if err != nil || len(decoded) != requestIDLength {
+		return nil, mutualautherrors.ErrInvalidRequestID
+	}
+	return decoded, nil
+}
+
+func keyID(nonce, otherNonce string) string {
+	return nonce + " " + otherNonce
+}
+
+func invoiceNumber(keyID string) string {
+	return fmt.Sprintf("%s-%s", signatureProtocol, keyID)
+}
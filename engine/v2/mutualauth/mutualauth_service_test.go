@@ -0,0 +1,280 @@
+package mutualauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	primitives "github.com/bitcoin-sv/go-sdk/primitives/ec"
+	"github.com/bitcoin-sv/spv-wallet/config"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/mutualauth/mutualautherrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/mutualauth/mutualauthmodels"
+	"github.com/mrz1836/go-cachestore"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMutualAuth(t *testing.T) {
+	t.Run("handshake, signed request and signed response", func(t *testing.T) {
+		// given:
+		service := givenService(t)
+		client := givenClientKey(t)
+		clientNonce := randomBase64(t, 32)
+
+		// when:
+		response, err := service.Handshake(context.Background(), &mutualauthmodels.AuthMessage{
+			Version:      mutualauthmodels.Version,
+			MessageType:  mutualauthmodels.MessageTypeInitialRequest,
+			IdentityKey:  client.PubKey().ToDERHex(),
+			InitialNonce: clientNonce,
+		})
+
+		// then:
+		require.NoError(t, err)
+		require.Equal(t, mutualauthmodels.MessageTypeInitialResponse, response.MessageType)
+		require.Equal(t, service.IdentityKey(), response.IdentityKey)
+		require.Equal(t, clientNonce, response.YourNonce)
+
+		// and:
+		serverKey, err := primitives.PublicKeyFromString(response.IdentityKey)
+		require.NoError(t, err)
+		handshakeData := append(decodeBase64(t, clientNonce), decodeBase64(t, response.InitialNonce)...)
+		requireSignedBy(t, client, serverKey, keyID(clientNonce, response.InitialNonce), handshakeData, response.Signature)
+
+		// given:
+		request := &mutualauthmodels.HTTPRequest{
+			Method:  http.MethodPost,
+			Path:    "/api/v2/data",
+			Query:   "?a=b",
+			Headers: http.Header{"Content-Type": []string{"application/json; charset=utf-8"}, "X-Bsv-Custom": []string{"value"}},
+			Body:    []byte(`{"key":"value"}`),
+		}
+		auth := givenSignedRequest(t, client, serverKey, response.InitialNonce, request)
+
+		// when:
+		session, err := service.VerifyRequest(context.Background(), auth, request)
+
+		// then:
+		require.NoError(t, err)
+		require.Equal(t, client.PubKey().ToDERHex(), session.PeerIdentityKey)
+
+		// when:
+		httpResponse := &mutualauthmodels.HTTPResponse{StatusCode: http.StatusOK, Body: []byte(`{"ok":true}`)}
+		responseAuth, err := service.SignResponse(session, auth.RequestID, httpResponse)
+
+		// then:
+		require.NoError(t, err)
+		require.Equal(t, clientNonce, responseAuth.YourNonce)
+		requestID := decodeBase64(t, auth.RequestID)
+		requireSignedBy(t, client, serverKey, keyID(responseAuth.Nonce, clientNonce), serializeResponse(requestID, httpResponse), responseAuth.Signature)
+
+		// when:
+		_, err = service.VerifyRequest(context.Background(), auth, request)
+
+		// then:
+		require.ErrorIs(t, err, mutualautherrors.ErrNonceAlreadyUsed)
+	})
+
+	t.Run("reject tampered request", func(t *testing.T) {
+		// given:
+		service := givenService(t)
+		client := givenClientKey(t)
+		response, err := service.Handshake(context.Background(), &mutualauthmodels.AuthMessage{
+			Version:      mutualauthmodels.Version,
+			MessageType:  mutualauthmodels.MessageTypeInitialRequest,
+			IdentityKey:  client.PubKey().ToDERHex(),
+			InitialNonce: randomBase64(t, 32),
+		})
+		require.NoError(t, err)
+		serverKey, err := primitives.PublicKeyFromString(response.IdentityKey)
+		require.NoError(t, err)
+
+		request := &mutualauthmodels.HTTPRequest{Method: http.MethodGet, Path: "/api/v2/users/current"}
+		auth := givenSignedRequest(t, client, serverKey, response.InitialNonce, request)
+
+		// when:
+		request.Path = "/api/v2/admin/users"
+		_, err = service.VerifyRequest(context.Background(), auth, request)
+
+		// then:
+		require.ErrorIs(t, err, mutualautherrors.ErrInvalidSignature)
+	})
+
+	t.Run("accept concurrently replayed request once", func(t *testing.T) {
+		// given:
+		service := givenService(t)
+		client := givenClientKey(t)
+		response, err := service.Handshake(context.Background(), &mutualauthmodels.AuthMessage{
+			Version:      mutualauthmodels.Version,
+			MessageType:  mutualauthmodels.MessageTypeInitialRequest,
+			IdentityKey:  client.PubKey().ToDERHex(),
+			InitialNonce: randomBase64(t, 32),
+		})
+		require.NoError(t, err)
+		serverKey, err := primitives.PublicKeyFromString(response.IdentityKey)
+		require.NoError(t, err)
+
+		request := &mutualauthmodels.HTTPRequest{Method: http.MethodGet, Path: "/api/v2/users/current"}
+		auth := givenSignedRequest(t, client, serverKey, response.InitialNonce, request)
+
+		// when:
+		const replays = 20
+		var accepted atomic.Int32
+		var wg sync.WaitGroup
+		for range replays {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if _, err := service.VerifyRequest(context.Background(), auth, request); err == nil {
+					accepted.Add(1)
+				}
+			}()
+		}
+		wg.Wait()
+
+		// then:
+		require.Equal(t, int32(1), accepted.Load())
+	})
+
+	t.Run("reject replayed request when session ttl is not configured", func(t *testing.T) {
+		// given:
+		cache, err := cachestore.NewClient(context.Background(), cachestore.WithFreeCache())
+		require.NoError(t, err)
+		cfg := config.GetDefaultAppConfig()
+		cfg.Authentication.MutualAuth.Enabled = true
+		cfg.Authentication.MutualAuth.SessionTTL = 0
+		service, err := NewService(cfg, cache)
+		require.NoError(t, err)
+
+		// and:
+		client := givenClientKey(t)
+		response, err := service.Handshake(context.Background(), &mutualauthmodels.AuthMessage{
+			Version:      mutualauthmodels.Version,
+			MessageType:  mutualauthmodels.MessageTypeInitialRequest,
+			IdentityKey:  client.PubKey().ToDERHex(),
+			InitialNonce: randomBase64(t, 32),
+		})
+		require.NoError(t, err)
+		serverKey, err := primitives.PublicKeyFromString(response.IdentityKey)
+		require.NoError(t, err)
+
+		request := &mutualauthmodels.HTTPRequest{Method: http.MethodGet, Path: "/api/v2/users/current"}
+		auth := givenSignedRequest(t, client, serverKey, response.InitialNonce, request)
+
+		// when:
+		_, err = service.VerifyRequest(context.Background(), auth, request)
+
+		// then:
+		require.NoError(t, err)
+
+		// when:
+		_, err = service.VerifyRequest(context.Background(), auth, request)
+
+		// then:
+		require.ErrorIs(t, err, mutualautherrors.ErrNonceAlreadyUsed)
+	})
+
+	t.Run("reject nonce not issued by the server", func(t *testing.T) {
+		// given:
+		service := givenService(t)
+		client := givenClientKey(t)
+		auth := &mutualauthmodels.AuthHeaders{
+			Version:     mutualauthmodels.Version,
+			IdentityKey: client.PubKey().ToDERHex(),
+			Nonce:       randomBase64(t, 32),
+			YourNonce:   randomBase64(t, 48),
+			RequestID:   randomBase64(t, 32),
+		}
+
+		// when:
+		_, err := service.VerifyRequest(context.Background(), auth, &mutualauthmodels.HTTPRequest{Method: http.MethodGet})
+
+		// then:
+		require.ErrorIs(t, err, mutualautherrors.ErrInvalidNonce)
+	})
+
+	t.Run("handshake when disabled", func(t *testing.T) {
+		// given:
+		service, err := NewService(config.GetDefaultAppConfig(), nil)
+		require.NoError(t, err)
+
+		// when:
+		_, err = service.Handshake(context.Background(), &mutualauthmodels.AuthMessage{})
+
+		// then:
+		require.ErrorIs(t, err, mutualautherrors.ErrMutualAuthDisabled)
+	})
+}
+
+func givenService(t *testing.T) *Service {
+	cache, err := cachestore.NewClient(context.Background(), cachestore.WithFreeCache())
+	require.NoError(t, err)
+
+	cfg := config.GetDefaultAppConfig()
+	cfg.Authentication.MutualAuth.Enabled = true
+	cfg.Authentication.MutualAuth.SessionTTL = time.Minute
+
+	service, err := NewService(cfg, cache)
+	require.NoError(t, err)
+	return service
+}
+
+func givenClientKey(t *testing.T) *primitives.PrivateKey {
+	key, err := primitives.NewPrivateKey()
+	require.NoError(t, err)
+	return key
+}
+
+func givenSignedRequest(t *testing.T, client *primitives.PrivateKey, server *primitives.PublicKey, sessionNonce string, request *mutualauthmodels.HTTPRequest) *mutualauthmodels.AuthHeaders {
+	requestID := randomBytes(t, 32)
+	nonce := randomBase64(t, 32)
+
+	childKey, err := client.DeriveChild(server, invoiceNumber(keyID(nonce, sessionNonce)))
+	require.NoError(t, err)
+	hash := sha256.Sum256(serializeRequest(requestID, request))
+	signature, err := childKey.Sign(hash[:])
+	require.NoError(t, err)
+
+	return &mutualauthmodels.AuthHeaders{
+		Version:     mutualauthmodels.Version,
+		IdentityKey: client.PubKey().ToDERHex(),
+		Nonce:       nonce,
+		YourNonce:   sessionNonce,
+		Signature:   hex.EncodeToString(signature.Serialize()),
+		RequestID:   base64.StdEncoding.EncodeToString(requestID),
+	}
+}
+
+func requireSignedBy(t *testing.T, client *primitives.PrivateKey, server *primitives.PublicKey, keyID string, data []byte, signatureHex string) {
+	signatureBytes, err := hex.DecodeString(signatureHex)
+	require.NoError(t, err)
+	signature, err := primitives.ParseDERSignature(signatureBytes)
+	require.NoError(t, err)
+	childKey, err := server.DeriveChild(client, invoiceNumber(keyID))
+	require.NoError(t, err)
+	hash := sha256.Sum256(data)
+	require.True(t, signature.Verify(hash[:], childKey))
+}
+
+func randomBytes(t *testing.T, length int) []byte {
+	data := make([]byte, length)
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+	return data
+}
+
+func randomBase64(t *testing.T, length int) string {
+	return base64.StdEncoding.EncodeToString(randomBytes(t, length))
+}
+
+func decodeBase64(t *testing.T, value string) []byte {
+	data, err := base64.StdEncoding.DecodeString(value)
+	require.NoError(t, err)
+	return data
+}
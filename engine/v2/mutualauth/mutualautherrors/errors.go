@@ -0,0 +1,30 @@
+package mutualautherrors
+
+import "github.com/bitcoin-sv/spv-wallet/models"
+
+// ErrMutualAuthDisabled is when a mutual authentication is requested but it is not enabled.
+var ErrMutualAuthDisabled = models.SPVError{Message: "mutual authentication is not enabled", StatusCode: 400, Code: "error-mutual-auth-disabled"}
+
+// ErrUnsupportedVersion is when the peer uses a version of BRC-103 which is not supported.
+var ErrUnsupportedVersion = models.SPVError{Message: "unsupported mutual authentication version", StatusCode: 400, Code: "error-mutual-auth-unsupported-version"}
+
+// ErrInvalidMessageType is when the handshake message is not an initial request.
+var ErrInvalidMessageType = models.SPVError{Message: "invalid mutual authentication message type", StatusCode: 400, Code: "error-mutual-auth-invalid-message-type"}
+
+// ErrInvalidIdentityKey is when the identity key is not a valid public key.
+var ErrInvalidIdentityKey = models.SPVError{Message: "invalid identity key", StatusCode: 401, Code: "error-mutual-auth-invalid-identity-key"}
+
+// ErrInvalidNonce is when a nonce is missing, malformed or was not issued by the server.
+var ErrInvalidNonce = models.SPVError{Message: "invalid mutual authentication nonce", StatusCode: 401, Code: "error-mutual-auth-invalid-nonce"}
+
+// ErrNonceAlreadyUsed is when a request nonce is reused (replay attempt).
+var ErrNonceAlreadyUsed = models.SPVError{Message: "mutual authentication nonce has already been used", StatusCode: 401, Code: "error-mutual-auth-nonce-used"}
+
+// ErrSessionNotFound is when there is no (or expired) session for the provided nonce.
+var ErrSessionNotFound = models.SPVError{Message: "mutual authentication session not found", StatusCode: 401, Code: "error-mutual-auth-session-not-found"}
+
+// ErrInvalidRequestID is when the request ID is missing or malformed.
+var ErrInvalidRequestID = models.SPVError{Message: "invalid mutual authentication request id", StatusCode: 401, Code: "error-mutual-auth-invalid-request-id"}
+
+// ErrInvalidSignature is when the signature of the message does not match.
+var ErrInvalidSignature = models.SPVError{Message: "invalid mutual authentication signature", StatusCode: 401, Code: "error-mutual-auth-invalid-signature"}
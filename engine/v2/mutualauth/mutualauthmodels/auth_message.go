@@ -0,0 +1,25 @@
+package mutualauthmodels
+
+// Version is the BRC-103 protocol version supported by the server.
+const Version = "0.1"
+
+// MessageType is the type of the BRC-103 message.
+type MessageType string
+
+// Supported BRC-103 message types.
+const (
+	MessageTypeInitialRequest  MessageType = "initialRequest"
+	MessageTypeInitialResponse MessageType = "initialResponse"
+	MessageTypeGeneral         MessageType = "general"
+)
+
+// AuthMessage is a BRC-103 handshake message exchanged on the /.well-known/auth endpoint.
+type AuthMessage struct {
+	Version      string      `json:"version"`
+	MessageType  MessageType `json:"messageType"`
+	IdentityKey  string      `json:"identityKey"`
+	Nonce        string      `json:"nonce,omitempty"`
+	InitialNonce string      `json:"initialNonce,omitempty"`
+	YourNonce    string      `json:"yourNonce,omitempty"`
+	Signature    string      `json:"signature,omitempty"`
+}
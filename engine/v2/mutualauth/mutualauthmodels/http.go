@@ -0,0 +1,19 @@
+package mutualauthmodels
+
+import "net/http"
+
+// HTTPRequest is the part of an HTTP request which is covered by the BRC-104 request signature.
+type HTTPRequest struct {
+	Method  string
+	Path    string
+	Query   string
+	Headers http.Header
+	Body    []byte
+}
+
+// HTTPResponse is the part of an HTTP response which is covered by the BRC-104 response signature.
+type HTTPResponse struct {
+	StatusCode int
+	Headers    http.Header
+	Body       []byte
+}
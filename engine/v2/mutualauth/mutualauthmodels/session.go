@@ -0,0 +1,24 @@
+package mutualauthmodels
+
+import "time"
+
+// Session is an established BRC-103 session between the server and a peer identified by its identity key.
+type Session struct {
+	// PeerIdentityKey is the compressed public key (hex) of the peer
+	PeerIdentityKey string `json:"peerIdentityKey"`
+	// PeerNonce is the initial nonce sent by the peer in the handshake
+	PeerNonce string `json:"peerNonce"`
+	// SessionNonce is the nonce generated by the server for this session
+	SessionNonce string    `json:"sessionNonce"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// AuthHeaders holds the BRC-104 authentication data transported in the x-bsv-auth-* headers of general requests and responses.
+type AuthHeaders struct {
+	Version     string
+	IdentityKey string
+	Nonce       string
+	YourNonce   string
+	Signature   string
+	RequestID   string
+}
@@ -0,0 +1,104 @@
+package mutualauth
+
+import (
+	"bytes"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+
+	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/mutualauth/mutualauthmodels"
+)
+
+const (
+	headerPrefixBSV     = "x-bsv-"
+	headerPrefixBSVAuth = "x-bsv-auth"
+	headerContentType   = "content-type"
+	headerAuthorization = "authorization"
+)
+
+// serializeRequest builds the BRC-104 request payload which is signed by the client.
+func serializeRequest(requestID []byte, req *mutualauthmodels.HTTPRequest) []byte {
+	buf := new(bytes.Buffer)
+	buf.Write(requestID)
+	writeString(buf, req.Method)
+	writeOptionalString(buf, req.Path)
+	writeOptionalString(buf, req.Query)
+	writeHeaders(buf, signedHeaders(req.Headers, true))
+	writeOptionalBytes(buf, req.Body)
+	return buf.Bytes()
+}
+
+// serializeResponse builds the BRC-104 response payload which is signed by the server.
+func serializeResponse(requestID []byte, res *mutualauthmodels.HTTPResponse) []byte {
+	buf := new(bytes.Buffer)
+	buf.Write(requestID)
+	writeVarInt(buf, int64(res.StatusCode))
+	writeHeaders(buf, signedHeaders(res.Headers, false))
+	writeOptionalBytes(buf, res.Body)
+	return buf.Bytes()
+}
+
+// signedHeaders returns the lowercase, sorted key-value pairs of the headers which are covered by the signature.
+// Those are x-bsv-* headers (except the x-bsv-auth-* ones) and authorization; for requests also the content-type (without params).
+func signedHeaders(headers http.Header, isRequest bool) [][2]string {
+	var pairs [][2]string
+	for name, values := range headers {
+		if len(values) == 0 {
+			continue
+		}
+		key := strings.ToLower(name)
+		value := values[0]
+		switch {
+		case strings.HasPrefix(key, headerPrefixBSVAuth):
+			continue
+		case strings.HasPrefix(key, headerPrefixBSV), key == headerAuthorization:
+		case isRequest && key == headerContentType:
+			value = strings.TrimSpace(strings.Split(value, ";")[0])
+		default:
+			continue
+		}
+		pairs = append(pairs, [2]string{key, value})
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		return pairs[i][0] < pairs[j][0]
+	})
+	return pairs
+}
+
+func writeHeaders(buf *bytes.Buffer, headers [][2]string) {
+	writeVarInt(buf, int64(len(headers)))
+	for _, header := range headers {
+		writeString(buf, header[0])
+		writeString(buf, header[1])
+	}
+}
+
+func writeString(buf *bytes.Buffer, value string) {
+	writeVarInt(buf, int64(len(value)))
+	buf.WriteString(value)
+}
+
+func writeOptionalString(buf *bytes.Buffer, value string) {
+	writeOptionalBytes(buf, []byte(value))
+}
+
+// writeOptionalBytes writes the length-prefixed value or -1 when the value is empty
+func writeOptionalBytes(buf *bytes.Buffer, value []byte) {
+	if len(value) == 0 {
+		writeVarInt(buf, -1)
+		return
+	}
+	writeVarInt(buf, int64(len(value)))
+	buf.Write(value)
+}
+
+// writeVarInt writes the number as Bitcoin VarInt; negative numbers (-1) are encoded as max uint64, following the BRC-104 reference implementation
+func writeVarInt(buf *bytes.Buffer, value int64) {
+	if value < 0 {
+		buf.Write(sdk.VarInt(math.MaxUint64).Bytes())
+		return
+	}
+	buf.Write(sdk.VarInt(uint64(value)).Bytes())
+}
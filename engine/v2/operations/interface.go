@@ -11,4 +11,5 @@ import (
 // Repo is an interface for operations repository.
 type Repo interface {
 	PaginatedForUser(ctx context.Context, userID string, page filter.Page) (*models.PagedResult[operationsmodels.Operation], error)
+	GetForUser(ctx context.Context, userID, txID string) (*operationsmodels.Operation, error)
 }
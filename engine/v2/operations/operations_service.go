@@ -5,6 +5,7 @@ import (
 
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/operations/operationsmodels"
+	txerrors "github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
 	"github.com/bitcoin-sv/spv-wallet/models"
 	"github.com/bitcoin-sv/spv-wallet/models/filter"
 )
@@ -28,3 +29,16 @@ func (s *Service) PaginatedForUser(ctx context.Context, userID string, page filt
 
 	return entities, nil
 }
+
+// GetForUser returns the user's operation on the transaction with the given ID.
+func (s *Service) GetForUser(ctx context.Context, userID, txID string) (*operationsmodels.Operation, error) {
+	operation, err := s.repo.GetForUser(ctx, userID, txID)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get operation for user")
+	}
+	if operation == nil {
+		return nil, txerrors.ErrTransactionNotFound
+	}
+
+	return operation, nil
+}
@@ -13,6 +13,8 @@ type Operation struct {
 	Type         string
 	Value        int64
 
+	SenderPubKey *string
+
 	TxStatus string
 
 	BlockHeight *int64
@@ -0,0 +1,101 @@
+package ordinals
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// BSV21ContentType is the content type of the BSV-21 fungible token inscriptions.
+const BSV21ContentType = "application/bsv-20"
+
+// BSV-21 operations supported by the wallet.
+const (
+	BSV21OpDeployMint = "deploy+mint"
+	BSV21OpTransfer   = "transfer"
+)
+
+const bsv21Protocol = "bsv-20"
+
+// BSV21 is the fungible token operation inscribed on the ordinal.
+type BSV21 struct {
+	Op string
+	// ID of the token; it is empty for deploy+mint, where the token is identified by the outpoint of the inscription.
+	ID       string
+	Amount   uint64
+	Symbol   string
+	Decimals uint8
+}
+
+type bsv21JSON struct {
+	Protocol string `json:"p"`
+	Op       string `json:"op"`
+	ID       string `json:"id,omitempty"`
+	Amount   string `json:"amt"`
+	Symbol   string `json:"sym,omitempty"`
+	Decimals string `json:"dec,omitempty"`
+}
+
+// TokenID returns the ID of the token held by the output with the given outpoint.
+func (t *BSV21) TokenID(txID string, vout uint32) string {
+	if t.Op == BSV21OpDeployMint {
+		return fmt.Sprintf("%s_%d", txID, vout)
+	}
+	return t.ID
+}
+
+// ParseBSV21 returns the BSV-21 token operation of the inscription or nil if the inscription is not a valid BSV-21 deploy+mint or transfer.
+func ParseBSV21(inscription *Inscription) *BSV21 {
+	if inscription == nil || inscription.ContentType != BSV21ContentType {
+		return nil
+	}
+
+	var content bsv21JSON
+	if err := json.Unmarshal(inscription.Content, &content); err != nil {
+		return nil
+	}
+	if content.Protocol != bsv21Protocol {
+		return nil
+	}
+
+	amount, err := strconv.ParseUint(content.Amount, 10, 64)
+	if err != nil || amount == 0 {
+		return nil
+	}
+
+	token := &BSV21{Op: content.Op, Amount: amount}
+	switch content.Op {
+	case BSV21OpDeployMint:
+		token.Symbol = content.Symbol
+		if content.Decimals != "" {
+			decimals, err := strconv.ParseUint(content.Decimals, 10, 8)
+			if err != nil || decimals > 18 {
+				return nil
+			}
+			token.Decimals = uint8(decimals)
+		}
+	case BSV21OpTransfer:
+		if content.ID == "" {
+			return nil
+		}
+		token.ID = content.ID
+	default:
+		return nil
+	}
+	return token
+}
+
+// NewBSV21Transfer creates the inscription transferring the amount of the token.
+func NewBSV21Transfer(tokenID string, amount uint64) *Inscription {
+	// marshaling the struct of strings cannot fail
+	content, _ := json.Marshal(bsv21JSON{
+		Protocol: bsv21Protocol,
+		Op:       BSV21OpTransfer,
+		ID:       tokenID,
+		Amount:   strconv.FormatUint(amount, 10),
+	})
+	return &Inscription{
+		ContentType: BSV21ContentType,
+		Content:     content,
+	}
+}
@@ -0,0 +1,62 @@
+package ordinals_test
+
+import (
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/ordinals"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBSV21(t *testing.T) {
+	t.Run("parse transfer created by the wallet", func(t *testing.T) {
+		// given:
+		inscription := ordinals.NewBSV21Transfer("a0000000001e1b81dd2c9c0c6cd67f9bdf832e9c2bb12a1d57f30cb6ebbe78d9_0", 100)
+
+		// when:
+		token := ordinals.ParseBSV21(inscription)
+
+		// then:
+		require.Equal(t, &ordinals.BSV21{
+			Op:     ordinals.BSV21OpTransfer,
+			ID:     "a0000000001e1b81dd2c9c0c6cd67f9bdf832e9c2bb12a1d57f30cb6ebbe78d9_0",
+			Amount: 100,
+		}, token)
+		require.Equal(t, token.ID, token.TokenID("b000000000000000000000000000000000000000000000000000000000000000", 1))
+	})
+
+	t.Run("parse deploy+mint identified by the outpoint", func(t *testing.T) {
+		// given:
+		inscription := &ordinals.Inscription{
+			ContentType: ordinals.BSV21ContentType,
+			Content:     []byte(`{"p":"bsv-20","op":"deploy+mint","sym":"TKN","amt":"1000000","dec":"2"}`),
+		}
+
+		// when:
+		token := ordinals.ParseBSV21(inscription)
+
+		// then:
+		require.Equal(t, &ordinals.BSV21{
+			Op:       ordinals.BSV21OpDeployMint,
+			Amount:   1000000,
+			Symbol:   "TKN",
+			Decimals: 2,
+		}, token)
+		require.Equal(t, "a0000000001e1b81dd2c9c0c6cd67f9bdf832e9c2bb12a1d57f30cb6ebbe78d9_3", token.TokenID("a0000000001e1b81dd2c9c0c6cd67f9bdf832e9c2bb12a1d57f30cb6ebbe78d9", 3))
+	})
+
+	notTokens := map[string]*ordinals.Inscription{
+		"other content type":  {ContentType: "text/plain", Content: []byte(`{"p":"bsv-20","op":"transfer","id":"x_0","amt":"1"}`)},
+		"invalid json":        {ContentType: ordinals.BSV21ContentType, Content: []byte(`{"p":`)},
+		"other protocol":      {ContentType: ordinals.BSV21ContentType, Content: []byte(`{"p":"brc-20","op":"transfer","id":"x_0","amt":"1"}`)},
+		"BSV-20 v1 mint":      {ContentType: ordinals.BSV21ContentType, Content: []byte(`{"p":"bsv-20","op":"mint","tick":"ORDI","amt":"1"}`)},
+		"transfer without id": {ContentType: ordinals.BSV21ContentType, Content: []byte(`{"p":"bsv-20","op":"transfer","amt":"1"}`)},
+		"zero amount":         {ContentType: ordinals.BSV21ContentType, Content: []byte(`{"p":"bsv-20","op":"transfer","id":"x_0","amt":"0"}`)},
+		"negative amount":     {ContentType: ordinals.BSV21ContentType, Content: []byte(`{"p":"bsv-20","op":"transfer","id":"x_0","amt":"-1"}`)},
+		"too many decimals":   {ContentType: ordinals.BSV21ContentType, Content: []byte(`{"p":"bsv-20","op":"deploy+mint","amt":"1","dec":"19"}`)},
+	}
+	for name, inscription := range notTokens {
+		t.Run("not a token: "+name, func(t *testing.T) {
+			require.Nil(t, ordinals.ParseBSV21(inscription))
+		})
+	}
+}
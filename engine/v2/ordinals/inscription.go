@@ -0,0 +1,142 @@
+// Package ordinals contains the 1Sat Ordinals inscriptions and the BSV-21 fungible tokens inscribed on them.
+// An inscription is stored in a 1-satoshi output with the envelope
+// OP_FALSE OP_IF "ord" OP_1 <content type> OP_0 <content> OP_ENDIF followed by the P2PKH locking script of the owner.
+// The STAS tokens, which are not inscriptions, are recognised by the stas package.
+package ordinals
+
+import (
+	"bytes"
+
+	"github.com/bitcoin-sv/go-sdk/script"
+	"github.com/bitcoin-sv/go-sdk/transaction/template/p2pkh"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+)
+
+// OrdinalSatoshis is the value of the output holding an ordinal.
+const OrdinalSatoshis = bsv.Satoshis(1)
+
+// envelopeMarker is the push which follows OP_FALSE OP_IF in the inscription envelope.
+var envelopeMarker = []byte("ord")
+
+// Inscription is the content inscribed on the ordinal.
+type Inscription struct {
+	ContentType string
+	Content     []byte
+}
+
+// Lock creates the locking script of the inscription owned by the address.
+func Lock(address *script.Address, inscription *Inscription) (*script.Script, error) {
+	if inscription.ContentType == "" || len(inscription.Content) == 0 {
+		return nil, spverrors.Newf("inscription requires content type and content")
+	}
+
+	lockingScript := &script.Script{}
+	if err := lockingScript.AppendOpcodes(script.OpFALSE, script.OpIF); err != nil {
+		return nil, spverrors.Wrapf(err, "failed to create inscription envelope")
+	}
+	if err := lockingScript.AppendPushData(envelopeMarker); err != nil {
+		return nil, spverrors.Wrapf(err, "failed to create inscription envelope")
+	}
+	if err := lockingScript.AppendOpcodes(script.Op1); err != nil {
+		return nil, spverrors.Wrapf(err, "failed to create inscription envelope")
+	}
+	if err := lockingScript.AppendPushData([]byte(inscription.ContentType)); err != nil {
+		return nil, spverrors.Wrapf(err, "failed to add inscription content type")
+	}
+	if err := lockingScript.AppendOpcodes(script.OpFALSE); err != nil {
+		return nil, spverrors.Wrapf(err, "failed to create inscription envelope")
+	}
+	if err := lockingScript.AppendPushData(inscription.Content); err != nil {
+		return nil, spverrors.Wrapf(err, "failed to add inscription content")
+	}
+	if err := lockingScript.AppendOpcodes(script.OpENDIF); err != nil {
+		return nil, spverrors.Wrapf(err, "failed to create inscription envelope")
+	}
+
+	ownerScript, err := p2pkh.Lock(address)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to create P2PKH locking script of the inscription")
+	}
+
+	return script.NewFromBytes(append(*lockingScript, *ownerScript...)), nil
+}
+
+// Parse returns the inscription and the owner address of the locking script.
+// It returns nil if the script is not an inscription envelope followed (or preceded) by the P2PKH locking script.
+func Parse(lockingScript *script.Script) (*Inscription, *script.Address) {
+	chunks, err := lockingScript.Chunks()
+	if err != nil {
+		return nil, nil
+	}
+
+	start, end := envelopeBounds(chunks)
+	if start < 0 {
+		return nil, nil
+	}
+
+	inscription := parseEnvelope(chunks[start:end])
+	if inscription == nil {
+		return nil, nil
+	}
+
+	owner := append(chunks[:start:start], chunks[end:]...)
+	address := p2pkhAddress(owner)
+	if address == nil {
+		return nil, nil
+	}
+
+	return inscription, address
+}
+
+// envelopeBounds returns the range of chunks from OP_FALSE OP_IF "ord" to OP_ENDIF or -1 when there is no envelope.
+func envelopeBounds(chunks []*script.ScriptChunk) (start int, end int) {
+	for i := 0; i+2 < len(chunks); i++ {
+		if chunks[i].Op != script.OpFALSE || chunks[i+1].Op != script.OpIF || !bytes.Equal(chunks[i+2].Data, envelopeMarker) {
+			continue
+		}
+		for j := i + 3; j < len(chunks); j++ {
+			if chunks[j].Op == script.OpENDIF {
+				return i, j + 1
+			}
+		}
+	}
+	return -1, -1
+}
+
+// parseEnvelope reads the fields (tag, value) of the envelope until the content which is tagged with OP_0.
+func parseEnvelope(envelope []*script.ScriptChunk) *Inscription {
+	inscription := &Inscription{}
+	fields := envelope[3 : len(envelope)-1]
+	for i := 0; i+1 < len(fields); i += 2 {
+		tag, value := fields[i], fields[i+1]
+		switch {
+		case tag.Op == script.OpFALSE:
+			inscription.Content = value.Data
+			if inscription.ContentType == "" || len(inscription.Content) == 0 {
+				return nil
+			}
+			return inscription
+		case tag.Op == script.Op1 || bytes.Equal(tag.Data, []byte{1}):
+			inscription.ContentType = string(value.Data)
+		}
+	}
+	return nil
+}
+
+// p2pkhAddress returns the address if the chunks are OP_DUP OP_HASH160 <pubKeyHash> OP_EQUALVERIFY OP_CHECKSIG.
+func p2pkhAddress(chunks []*script.ScriptChunk) *script.Address {
+	if len(chunks) != 5 ||
+		chunks[0].Op != script.OpDUP ||
+		chunks[1].Op != script.OpHASH160 ||
+		len(chunks[2].Data) != 20 ||
+		chunks[3].Op != script.OpEQUALVERIFY ||
+		chunks[4].Op != script.OpCHECKSIG {
+		return nil
+	}
+	address, err := script.NewAddressFromPublicKeyHash(chunks[2].Data, true)
+	if err != nil {
+		return nil
+	}
+	return address
+}
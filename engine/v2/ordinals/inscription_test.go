@@ -0,0 +1,82 @@
+package ordinals_test
+
+import (
+	"testing"
+
+	"github.com/bitcoin-sv/go-sdk/script"
+	"github.com/bitcoin-sv/go-sdk/transaction/template/p2pkh"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/ordinals"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInscription(t *testing.T) {
+	t.Run("parse locked inscription", func(t *testing.T) {
+		// given:
+		address := fixtures.Sender.Address()
+		inscription := &ordinals.Inscription{
+			ContentType: "text/plain",
+			Content:     []byte("hello world"),
+		}
+
+		// and:
+		lockingScript, err := ordinals.Lock(address, inscription)
+		require.NoError(t, err)
+
+		// when:
+		parsed, owner := ordinals.Parse(lockingScript)
+
+		// then:
+		require.Equal(t, inscription, parsed)
+		require.Equal(t, address.AddressString, owner.AddressString)
+	})
+
+	t.Run("parse inscription after P2PKH", func(t *testing.T) {
+		// given:
+		address := fixtures.Sender.Address()
+		ownerScript, err := p2pkh.Lock(address)
+		require.NoError(t, err)
+
+		// and:
+		envelope, err := script.NewFromASM("OP_0 OP_IF 6f7264 OP_1 746578742f706c61696e OP_0 68656c6c6f OP_ENDIF")
+		require.NoError(t, err)
+
+		// when:
+		parsed, owner := ordinals.Parse(script.NewFromBytes(append(*ownerScript, *envelope...)))
+
+		// then:
+		require.Equal(t, &ordinals.Inscription{ContentType: "text/plain", Content: []byte("hello")}, parsed)
+		require.Equal(t, address.AddressString, owner.AddressString)
+	})
+
+	t.Run("not an inscription", func(t *testing.T) {
+		tests := map[string]string{
+			"P2PKH only":            "OP_DUP OP_HASH160 0000000000000000000000000000000000000000 OP_EQUALVERIFY OP_CHECKSIG",
+			"envelope only":         "OP_0 OP_IF 6f7264 OP_1 746578742f706c61696e OP_0 68656c6c6f OP_ENDIF",
+			"envelope without body": "OP_0 OP_IF 6f7264 OP_1 746578742f706c61696e OP_ENDIF OP_DUP OP_HASH160 0000000000000000000000000000000000000000 OP_EQUALVERIFY OP_CHECKSIG",
+			"other marker":          "OP_0 OP_IF 6f7265 OP_1 746578742f706c61696e OP_0 68656c6c6f OP_ENDIF OP_DUP OP_HASH160 0000000000000000000000000000000000000000 OP_EQUALVERIFY OP_CHECKSIG",
+		}
+		for name, asm := range tests {
+			t.Run(name, func(t *testing.T) {
+				// given:
+				lockingScript, err := script.NewFromASM(asm)
+				require.NoError(t, err)
+
+				// when:
+				parsed, owner := ordinals.Parse(lockingScript)
+
+				// then:
+				require.Nil(t, parsed)
+				require.Nil(t, owner)
+			})
+		}
+	})
+
+	t.Run("lock without content", func(t *testing.T) {
+		// when:
+		_, err := ordinals.Lock(fixtures.Sender.Address(), &ordinals.Inscription{ContentType: "text/plain"})
+
+		// then:
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,67 @@
+package destinationmodels
+
+import (
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+)
+
+// RequestType is the type of the paymail request for which the destination was derived.
+type RequestType string
+
+// Known request types
+const (
+	RequestP2PDestination    RequestType = "p2p-destination"
+	RequestAddressResolution RequestType = "address-resolution"
+	RequestInvoice           RequestType = "invoice"
+)
+
+// Requester holds the information about the requester of the destination (as provided in the paymail request).
+type Requester struct {
+	IP            string
+	UserAgent     string
+	SenderPaymail string
+	SenderName    string
+	Purpose       string
+}
+
+// NewDestination is a data for storing a destination derived for a paymail.
+type NewDestination struct {
+	Address     string
+	ReferenceID string
+
+	UserID  string
+	Paymail string
+
+	Request   RequestType
+	Satoshis  bsv.Satoshis
+	Requester Requester
+}
+
+// Payment holds the data of the (p2p) transaction paying to the destination.
+type Payment struct {
+	Outpoint      bsv.Outpoint
+	SenderPaymail string
+	SenderPubKey  string
+	Note          string
+}
+
+// Destination is a domain model of the address derived for a paymail, with its origin and (optional) payment.
+type Destination struct {
+	Address     string
+	ReferenceID string
+
+	UserID  string
+	Paymail string
+
+	Request   RequestType
+	Satoshis  bsv.Satoshis
+	Requester Requester
+
+	SenderPubKey string
+	Note         string
+	Outpoint     *bsv.Outpoint
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
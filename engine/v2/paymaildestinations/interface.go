@@ -0,0 +1,20 @@
+package paymaildestinations
+
+import (
+	"context"
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymaildestinations/destinationmodels"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+)
+
+// Repo is the interface that wraps the basic operations with paymail destinations.
+type Repo interface {
+	Create(ctx context.Context, destination *destinationmodels.NewDestination) error
+	FindByReference(ctx context.Context, referenceID string) ([]*destinationmodels.Destination, error)
+	FindByAddress(ctx context.Context, userID, address string) (*destinationmodels.Destination, error)
+	FindByOutpoint(ctx context.Context, userID string, outpoint bsv.Outpoint) (*destinationmodels.Destination, error)
+	SavePayment(ctx context.Context, address string, payment *destinationmodels.Payment) error
+	MarkPaid(ctx context.Context, address string, outpoint bsv.Outpoint) error
+	DeleteUnpaid(ctx context.Context, createdBefore time.Time) (int64, error)
+}
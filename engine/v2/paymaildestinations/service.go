@@ -0,0 +1,131 @@
+package paymaildestinations
+
+import (
+	"context"
+	"time"
+
+	trx "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/spv-wallet/conv"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymaildestinations/destinationmodels"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/rs/zerolog"
+)
+
+// Service keeps the origin of the addresses derived for paymails,
+// so it's possible to find out which paymail request produced an address or a received output (e.g. for reconciliation).
+type Service struct {
+	logger zerolog.Logger
+	repo   Repo
+}
+
+// NewService creates a new paymail destinations service.
+func NewService(logger zerolog.Logger, repo Repo) *Service {
+	return &Service{
+		logger: logger,
+		repo:   repo,
+	}
+}
+
+// Create stores the destination derived for a paymail.
+func (s *Service) Create(ctx context.Context, destination *destinationmodels.NewDestination) error {
+	if err := s.repo.Create(ctx, destination); err != nil {
+		return spverrors.Wrapf(err, "failed to create paymail destination")
+	}
+	return nil
+}
+
+// RecordPayment stores the outpoint and the sender of every output of the transaction
+// which pays to a destination derived for the given reference.
+func (s *Service) RecordPayment(ctx context.Context, referenceID string, tx *trx.Transaction, payment destinationmodels.Payment) error {
+	destinations, err := s.repo.FindByReference(ctx, referenceID)
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to find paymail destinations by reference")
+	}
+	if len(destinations) == 0 {
+		return nil
+	}
+
+	referenced := make(map[string]struct{}, len(destinations))
+	for _, destination := range destinations {
+		referenced[destination.Address] = struct{}{}
+	}
+
+	txID := tx.TxID().String()
+	for vout, output := range tx.Outputs {
+		if !output.LockingScript.IsP2PKH() {
+			continue
+		}
+		address, err := output.LockingScript.Address()
+		if err != nil {
+			continue
+		}
+		if _, ok := referenced[address.AddressString]; !ok {
+			continue
+		}
+		voutU32, err := conv.IntToUint32(vout)
+		if err != nil {
+			return spverrors.Wrapf(err, "failed to convert vout")
+		}
+
+		payment.Outpoint = bsv.Outpoint{TxID: txID, Vout: voutU32}
+		if err = s.repo.SavePayment(ctx, address.AddressString, &payment); err != nil {
+			return spverrors.Wrapf(err, "failed to save payment of paymail destination")
+		}
+	}
+	return nil
+}
+
+// FindByReference returns the destinations derived for the given reference (e.g. of the invoice).
+func (s *Service) FindByReference(ctx context.Context, referenceID string) ([]*destinationmodels.Destination, error) {
+	destinations, err := s.repo.FindByReference(ctx, referenceID)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to find paymail destinations by reference")
+	}
+	return destinations, nil
+}
+
+// MarkPaid stores the outpoint of the output paying to the address, if the address is a paymail destination which was not paid yet.
+// It records the payment of destinations obtained without P2P (e.g. by basic address resolution), so they are not removed as unpaid.
+func (s *Service) MarkPaid(ctx context.Context, address string, outpoint bsv.Outpoint) error {
+	if err := s.repo.MarkPaid(ctx, address, outpoint); err != nil {
+		return spverrors.Wrapf(err, "failed to mark paymail destination as paid")
+	}
+	return nil
+}
+
+// FindByAddress returns the origin of the address derived for a paymail of the user.
+func (s *Service) FindByAddress(ctx context.Context, userID, address string) (*destinationmodels.Destination, error) {
+	destination, err := s.repo.FindByAddress(ctx, userID, address)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to find paymail destination by address")
+	}
+	if destination == nil {
+		return nil, spverrors.ErrPaymailDestinationNotFound
+	}
+	return destination, nil
+}
+
+// FindByOutpoint returns the origin of the destination paid by the given output received by the user.
+func (s *Service) FindByOutpoint(ctx context.Context, userID string, outpoint bsv.Outpoint) (*destinationmodels.Destination, error) {
+	destination, err := s.repo.FindByOutpoint(ctx, userID, outpoint)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to find paymail destination by outpoint")
+	}
+	if destination == nil {
+		return nil, spverrors.ErrPaymailDestinationNotFound
+	}
+	return destination, nil
+}
+
+// DeleteUnpaid removes the destinations (and their addresses) which were not paid within the given TTL.
+func (s *Service) DeleteUnpaid(ctx context.Context, ttl time.Duration) error {
+	deleted, err := s.repo.DeleteUnpaid(ctx, time.Now().Add(-ttl))
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to delete unpaid paymail destinations")
+	}
+	if deleted > 0 {
+		s.logger.Info().Int64("deleted", deleted).Msg("Unpaid paymail destinations removed")
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package paymails
 
 import (
 	"context"
+	"time"
 
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymails/paymailsmodels"
 )
@@ -14,9 +15,25 @@ type PaymailRepo interface {
 	FindForUser(ctx context.Context, alias, domain, userID string) (*paymailsmodels.Paymail, error)
 	// GetDefault returns a default paymail for user.
 	GetDefault(ctx context.Context, userID string) (*paymailsmodels.Paymail, error)
+	// FindByIDForUser returns a paymail by its ID for given user.
+	FindByIDForUser(ctx context.Context, id uint, userID string) (*paymailsmodels.Paymail, error)
+	// UpdateProfile sets the public name and avatar of the paymail.
+	UpdateProfile(ctx context.Context, id uint, publicName, avatar string) (*paymailsmodels.Paymail, error)
+}
+
+// Cache is the subset of the cachestore used to keep the paymails served to the public.
+type Cache interface {
+	GetModel(ctx context.Context, key string, model interface{}) error
+	SetModel(ctx context.Context, key string, model interface{}, ttl time.Duration, dependencies ...string) error
+	Delete(ctx context.Context, key string) error
 }
 
 // UsersService is a user domain service
 type UsersService interface {
 	Exists(ctx context.Context, userID string) (bool, error)
 }
+
+// DomainChecker checks if paymails can be created in the domain.
+type DomainChecker interface {
+	CheckDomain(ctx context.Context, domain string) error
+}
@@ -10,3 +10,6 @@ var ErrInvalidPaymailAddress = models.SPVError{Message: "invalid paymail address
 
 // ErrInvalidAvatarURL is when url provided for paymail is not empty and is invalid URL format
 var ErrInvalidAvatarURL = models.SPVError{Message: "invalid avatar url", StatusCode: 500, Code: "error-invalid-avatar-url"}
+
+// ErrPaymailNotFound is when the paymail does not exist or does not belong to the user.
+var ErrPaymailNotFound = models.SPVError{Message: "paymail not found", StatusCode: 404, Code: "error-paymail-not-found"}
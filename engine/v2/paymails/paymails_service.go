@@ -3,34 +3,43 @@ package paymails
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/bitcoin-sv/go-paymail"
-	"github.com/bitcoin-sv/spv-wallet/config"
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymails/paymailerrors"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymails/paymailsmodels"
+	"github.com/mrz1836/go-cachestore"
+	"github.com/rs/zerolog"
 	"gorm.io/gorm"
 )
 
+const cacheKeyPaymail = "paymail-public-profile-"
+const cacheTTLPaymail = 5 * time.Minute
+
 // Service for paymails
 type Service struct {
 	paymailsRepo PaymailRepo
 	usersService UsersService
-	config       *config.AppConfig
+	cache        Cache
+	domains      DomainChecker
+	logger       *zerolog.Logger
 }
 
 // NewService creates a new paymails service
-func NewService(paymails PaymailRepo, users UsersService, cfg *config.AppConfig) *Service {
+func NewService(paymails PaymailRepo, users UsersService, cache Cache, domains DomainChecker, logger *zerolog.Logger) *Service {
 	return &Service{
 		paymailsRepo: paymails,
 		usersService: users,
-		config:       cfg,
+		cache:        cache,
+		domains:      domains,
+		logger:       logger,
 	}
 }
 
 // Create creates a new paymail attached to a user
 func (s *Service) Create(ctx context.Context, newPaymail *paymailsmodels.NewPaymail) (*paymailsmodels.Paymail, error) {
-	if err := s.config.Paymail.CheckDomain(newPaymail.Domain); err != nil {
+	if err := s.domains.CheckDomain(ctx, newPaymail.Domain); err != nil {
 		return nil, spverrors.Wrapf(err, "invalid domain during paymail creation")
 	}
 
@@ -54,15 +63,73 @@ func (s *Service) Create(ctx context.Context, newPaymail *paymailsmodels.NewPaym
 	return createdPaymail, nil
 }
 
-// Find returns a paymail by alias and domain
+// Find returns a paymail by alias and domain.
 func (s *Service) Find(ctx context.Context, alias, domain string) (*paymailsmodels.Paymail, error) {
+	alias, domain, sanitized := paymail.SanitizePaymail(alias + "@" + domain)
+	if sanitized == "" {
+		return nil, nil
+	}
+	paymail, err := s.paymailsRepo.Find(ctx, alias, domain)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get paymail")
+	}
+	return paymail, nil
+}
+
+// FindPublicProfile returns a paymail by alias and domain for the public profile and PKI capabilities.
+// NOTE: Found paymails are cached, because they are served publicly; other lookups (e.g. P2P or PIKE) use Find to get the current state.
+func (s *Service) FindPublicProfile(ctx context.Context, alias, domain string) (*paymailsmodels.Paymail, error) {
+	alias, domain, sanitized := paymail.SanitizePaymail(alias + "@" + domain)
+	if sanitized == "" {
+		return nil, nil
+	}
+	cacheKey := paymailCacheKey(alias, domain)
+
+	if cached := s.loadFromCache(ctx, cacheKey); cached != nil {
+		return cached, nil
+	}
+
 	paymail, err := s.paymailsRepo.Find(ctx, alias, domain)
 	if err != nil {
 		return nil, spverrors.Wrapf(err, "failed to get paymail")
 	}
+	if paymail != nil {
+		s.putInCache(ctx, cacheKey, paymail)
+	}
 	return paymail, nil
 }
 
+// UpdateProfile updates the public name and avatar of the user's paymail with given ID
+func (s *Service) UpdateProfile(ctx context.Context, userID string, paymailID uint, profile *paymailsmodels.PaymailProfile) (*paymailsmodels.Paymail, error) {
+	paymail, err := s.paymailsRepo.FindByIDForUser(ctx, paymailID, userID)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get paymail")
+	}
+	if paymail == nil {
+		return nil, paymailerrors.ErrPaymailNotFound
+	}
+
+	return s.updateProfile(ctx, paymail, profile)
+}
+
+// UpdateProfileByAddress updates the public name and avatar of the user's paymail with given address
+func (s *Service) UpdateProfileByAddress(ctx context.Context, userID string, address string, profile *paymailsmodels.PaymailProfile) (*paymailsmodels.Paymail, error) {
+	alias, domain, sanitized := paymail.SanitizePaymail(address)
+	if sanitized == "" {
+		return nil, paymailerrors.ErrInvalidPaymailAddress
+	}
+
+	pm, err := s.paymailsRepo.FindForUser(ctx, alias, domain, userID)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get paymail")
+	}
+	if pm == nil {
+		return nil, paymailerrors.ErrPaymailNotFound
+	}
+
+	return s.updateProfile(ctx, pm, profile)
+}
+
 // HasPaymailAddress checks if the given address belongs to a given User.
 func (s *Service) HasPaymailAddress(ctx context.Context, userID string, address string) (bool, error) {
 	alias, domain, sanitized := paymail.SanitizePaymail(address)
@@ -88,3 +155,58 @@ func (s *Service) GetDefaultPaymailAddress(ctx context.Context, xPubID string) (
 
 	return pm.Alias + "@" + pm.Domain, nil
 }
+
+func (s *Service) updateProfile(ctx context.Context, pm *paymailsmodels.Paymail, profile *paymailsmodels.PaymailProfile) (*paymailsmodels.Paymail, error) {
+	if err := profile.ValidateAvatar(); err != nil {
+		return nil, spverrors.Wrapf(err, "invalid avatar url during paymail profile update")
+	}
+
+	publicName := pm.PublicName
+	if profile.PublicName != nil {
+		publicName = *profile.PublicName
+	}
+	if publicName == "" {
+		publicName = pm.Alias
+	}
+
+	avatar := pm.Avatar
+	if profile.Avatar != nil {
+		avatar = *profile.Avatar
+	}
+
+	updated, err := s.paymailsRepo.UpdateProfile(ctx, pm.ID, publicName, avatar)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to update paymail profile")
+	}
+
+	cacheKey := paymailCacheKey(pm.Alias, pm.Domain)
+	if err = s.cache.Delete(ctx, cacheKey); err != nil {
+		s.logger.Warn().Err(err).Msgf("failed to remove paymail for key %s from cache", cacheKey)
+	}
+
+	return updated, nil
+}
+
+// paymailCacheKey returns the key of the cached paymail; alias and domain must be sanitized, so every spelling of the address hits the same entry.
+func paymailCacheKey(alias, domain string) string {
+	return cacheKeyPaymail + alias + "@" + domain
+}
+
+func (s *Service) loadFromCache(ctx context.Context, key string) *paymailsmodels.Paymail {
+	cached := new(paymailsmodels.Paymail)
+	err := s.cache.GetModel(ctx, key, cached)
+	if errors.Is(err, cachestore.ErrKeyNotFound) {
+		return nil
+	}
+	if err != nil {
+		s.logger.Warn().Err(err).Msgf("failed to get paymail for key %s from cache", key)
+		return nil
+	}
+	return cached
+}
+
+func (s *Service) putInCache(ctx context.Context, key string, pm *paymailsmodels.Paymail) {
+	if err := s.cache.SetModel(ctx, key, pm, cacheTTLPaymail); err != nil {
+		s.logger.Warn().Err(err).Msgf("failed to store paymail for key %s in cache", key)
+	}
+}
@@ -0,0 +1,96 @@
+package paymails_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/tester"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymails"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymails/paymailsmodels"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+const userID = "user-1"
+
+func TestFindPaymailInOtherLetterCase(t *testing.T) {
+	// given:
+	repo := &memoryRepo{paymails: []paymailsmodels.Paymail{
+		{ID: 1, Alias: "alice", Domain: "example.com", PublicName: "Alice", UserID: userID},
+	}}
+	logger := tester.Logger(t)
+	service := paymails.NewService(repo, nil, tester.CacheStore(), nil, &logger)
+
+	// when:
+	found, err := service.FindPublicProfile(context.Background(), "Alice", "Example.COM")
+
+	// then:
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	require.Equal(t, "Alice", found.PublicName)
+
+	// when:
+	_, err = service.UpdateProfile(context.Background(), userID, 1, &paymailsmodels.PaymailProfile{PublicName: lo.ToPtr("Alice Updated")})
+	require.NoError(t, err)
+
+	found, err = service.FindPublicProfile(context.Background(), "ALICE", "example.com")
+
+	// then:
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	require.Equal(t, "Alice Updated", found.PublicName, "cached paymail should be invalidated")
+}
+
+func TestFindIsNotCached(t *testing.T) {
+	// given:
+	repo := &memoryRepo{paymails: []paymailsmodels.Paymail{
+		{ID: 1, Alias: "alice", Domain: "example.com", PublicName: "Alice", UserID: userID},
+	}}
+	logger := tester.Logger(t)
+	service := paymails.NewService(repo, nil, tester.CacheStore(), nil, &logger)
+
+	_, err := service.FindPublicProfile(context.Background(), "alice", "example.com")
+	require.NoError(t, err)
+
+	// when:
+	repo.paymails = nil
+	found, err := service.Find(context.Background(), "alice", "example.com")
+
+	// then:
+	require.NoError(t, err)
+	require.Nil(t, found, "removed paymail should not be found")
+}
+
+type memoryRepo struct {
+	paymails.PaymailRepo
+	paymails []paymailsmodels.Paymail
+}
+
+func (r *memoryRepo) Find(_ context.Context, alias, domain string) (*paymailsmodels.Paymail, error) {
+	for _, pm := range r.paymails {
+		if pm.Alias == alias && pm.Domain == domain {
+			return &pm, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryRepo) FindByIDForUser(_ context.Context, id uint, userID string) (*paymailsmodels.Paymail, error) {
+	for _, pm := range r.paymails {
+		if pm.ID == id && pm.UserID == userID {
+			return &pm, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryRepo) UpdateProfile(_ context.Context, id uint, publicName, avatar string) (*paymailsmodels.Paymail, error) {
+	for i := range r.paymails {
+		if r.paymails[i].ID == id {
+			r.paymails[i].PublicName = publicName
+			r.paymails[i].Avatar = avatar
+			return &r.paymails[i], nil
+		}
+	}
+	return nil, nil
+}
@@ -35,11 +35,30 @@ type NewPaymail struct {
 
 // ValidateAvatar checks if avatar is either empty string or a proper url link
 func (np *NewPaymail) ValidateAvatar() error {
-	if np.Avatar == "" {
+	return validateAvatar(np.Avatar)
+}
+
+// PaymailProfile represents data for updating the public profile of a paymail.
+// Nil fields are left unchanged.
+type PaymailProfile struct {
+	PublicName *string
+	Avatar     *string
+}
+
+// ValidateAvatar checks if avatar (when provided) is either empty string or a proper url link
+func (p *PaymailProfile) ValidateAvatar() error {
+	if p.Avatar == nil {
+		return nil
+	}
+	return validateAvatar(*p.Avatar)
+}
+
+func validateAvatar(avatar string) error {
+	if avatar == "" {
 		return nil
 	}
 
-	URL, err := url.Parse(np.Avatar)
+	URL, err := url.Parse(avatar)
 	if err != nil {
 		return paymailerrors.ErrInvalidAvatarURL.Wrap(err)
 	}
@@ -48,5 +67,9 @@ func (np *NewPaymail) ValidateAvatar() error {
 		return paymailerrors.ErrInvalidAvatarURL.Wrap(spverrors.Newf("avatarURL should have http(s) scheme"))
 	}
 
+	if URL.Host == "" {
+		return paymailerrors.ErrInvalidAvatarURL.Wrap(spverrors.Newf("avatarURL should have a host"))
+	}
+
 	return nil
 }
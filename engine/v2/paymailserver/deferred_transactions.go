@@ -0,0 +1,134 @@
+package paymailserver
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bitcoin-sv/go-paymail/beef"
+	"github.com/bitcoin-sv/go-paymail/spv"
+	trx "github.com/bitcoin-sv/go-sdk/transaction"
+	chainerrors "github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
+	pmerrors "github.com/bitcoin-sv/spv-wallet/engine/paymail/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymailserver/deferredmodels"
+)
+
+// deferredTransactionsBatchSize is the maximum number of deferred transactions processed in a single run.
+const deferredTransactionsBatchSize = 100
+
+// DeferredTransactionsProcessor records the incoming transactions which were received while their merkle roots couldn't be verified.
+type DeferredTransactionsProcessor interface {
+	ProcessDeferredTransactions(ctx context.Context) error
+}
+
+// ProcessDeferredTransactions verifies the merkle roots of the deferred transactions:
+// the transactions with valid merkle roots are recorded, the ones with invalid merkle roots are marked as rejected
+// and the rest stays pending until BHS is able to verify them.
+func (s *serviceProvider) ProcessDeferredTransactions(ctx context.Context) error {
+	if s.deferred == nil {
+		return nil
+	}
+
+	pending, err := s.deferred.FindPending(ctx, deferredTransactionsBatchSize)
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to get deferred transactions")
+	}
+
+	for _, deferredTx := range pending {
+		err = s.processDeferred(ctx, deferredTx)
+		if errors.Is(err, chainerrors.ErrMerkleRootsDeferred) {
+			// BHS still cannot verify merkle roots, so the rest of the transactions have to wait as well
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deferIfUnverified stores the incoming transaction for later if its merkle roots cannot be verified now.
+// It reports whether the transaction was deferred.
+func (s *serviceProvider) deferIfUnverified(ctx context.Context, dBeef *beef.DecodedBEEF, incoming *deferredmodels.NewDeferredTransaction) (bool, error) {
+	merkleRoots, err := merkleRootsOf(dBeef)
+	if err != nil {
+		return false, pmerrors.ErrParseIncomingTransaction.Wrap(err)
+	}
+	if len(merkleRoots) == 0 {
+		return false, nil
+	}
+
+	// VerifyMerkleRoots is called by go-paymail before RecordTransaction, but it cannot tell that the verification was deferred
+	valid, err := s.spv.VerifyMerkleRoots(ctx, merkleRoots)
+	switch {
+	case errors.Is(err, chainerrors.ErrMerkleRootsDeferred):
+		if err = s.deferred.Create(ctx, incoming); err != nil {
+			return false, pmerrors.ErrRecordTransaction.Wrap(err)
+		}
+		s.logger.Warn().Str("txID", incoming.TxID).Msg("Incoming transaction is deferred until its merkle roots are verified")
+		return true, nil
+	case err != nil:
+		return false, pmerrors.ErrPaymailMerkleRootVerificationFailed.Wrap(err)
+	case !valid:
+		return false, pmerrors.ErrPaymailInvalidMerkleRoots
+	default:
+		return false, nil
+	}
+}
+
+func (s *serviceProvider) processDeferred(ctx context.Context, deferredTx *deferredmodels.DeferredTransaction) error {
+	dBeef, err := beef.DecodeBEEF(deferredTx.BEEF)
+	if err != nil {
+		return s.rejectDeferred(ctx, deferredTx, "cannot decode BEEF")
+	}
+	merkleRoots, err := merkleRootsOf(dBeef)
+	if err != nil {
+		return s.rejectDeferred(ctx, deferredTx, "cannot calculate merkle roots")
+	}
+
+	valid, err := s.spv.VerifyMerkleRoots(ctx, merkleRoots)
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to verify merkle roots of deferred transaction %s", deferredTx.TxID)
+	}
+	if !valid {
+		return s.rejectDeferred(ctx, deferredTx, "invalid merkle roots")
+	}
+
+	tx, err := trx.NewTransactionFromBEEFHex(deferredTx.BEEF)
+	if err != nil {
+		return s.rejectDeferred(ctx, deferredTx, "cannot parse BEEF")
+	}
+	if err = s.recordIncoming(ctx, tx, &deferredTx.NewDeferredTransaction); err != nil {
+		return s.rejectDeferred(ctx, deferredTx, err.Error())
+	}
+
+	if err = s.deferred.Delete(ctx, deferredTx.TxID); err != nil {
+		return spverrors.Wrapf(err, "failed to remove recorded deferred transaction %s", deferredTx.TxID)
+	}
+	s.logger.Info().Str("txID", deferredTx.TxID).Msg("Deferred incoming transaction is recorded")
+	return nil
+}
+
+func (s *serviceProvider) rejectDeferred(ctx context.Context, deferredTx *deferredmodels.DeferredTransaction, reason string) error {
+	s.logger.Error().Str("txID", deferredTx.TxID).Str("reason", reason).Msg("Deferred incoming transaction is rejected")
+	if err := s.deferred.MarkRejected(ctx, deferredTx.TxID, reason); err != nil {
+		return spverrors.Wrapf(err, "failed to reject deferred transaction %s", deferredTx.TxID)
+	}
+	return nil
+}
+
+// merkleRootsOf returns the merkle roots of the BUMPs of the BEEF - the same ones which are verified by go-paymail.
+func merkleRootsOf(dBeef *beef.DecodedBEEF) ([]*spv.MerkleRootConfirmationRequestItem, error) {
+	merkleRoots := make([]*spv.MerkleRootConfirmationRequestItem, 0, len(dBeef.BUMPs))
+	for _, bump := range dBeef.BUMPs {
+		merkleRoot, err := bump.CalculateMerkleRoot()
+		if err != nil {
+			return nil, spverrors.Wrapf(err, "failed to calculate merkle root")
+		}
+		merkleRoots = append(merkleRoots, &spv.MerkleRootConfirmationRequestItem{
+			MerkleRoot:  merkleRoot,
+			BlockHeight: bump.BlockHeight,
+		})
+	}
+	return merkleRoots, nil
+}
@@ -0,0 +1,36 @@
+package deferredmodels
+
+import "time"
+
+// Status is the status of the incoming transaction kept until its merkle roots are verified.
+type Status string
+
+// Known statuses
+const (
+	// StatusPending means the merkle roots of the transaction are still waiting for verification.
+	StatusPending Status = "pending"
+	// StatusRejected means the transaction was not recorded (e.g. its merkle roots turned out to be invalid).
+	StatusRejected Status = "rejected"
+)
+
+// NewDeferredTransaction is the data of the incoming paymail transaction which could not be verified on receiving.
+type NewDeferredTransaction struct {
+	TxID            string
+	BEEF            string
+	SenderPaymail   string
+	SenderPubKey    string
+	ReceiverPaymail string
+	Reference       string
+	Note            string
+}
+
+// DeferredTransaction is the incoming paymail transaction which is recorded only after its merkle roots are verified.
+type DeferredTransaction struct {
+	NewDeferredTransaction
+
+	Status Status
+	Reason string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
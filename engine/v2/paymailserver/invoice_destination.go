@@ -0,0 +1,109 @@
+package paymailserver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	paymailserver "github.com/bitcoin-sv/go-paymail"
+	paymailerrors "github.com/bitcoin-sv/go-paymail/errors"
+	"github.com/bitcoin-sv/go-paymail/server"
+	pmerrors "github.com/bitcoin-sv/spv-wallet/engine/paymail/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymaildestinations/destinationmodels"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+)
+
+// InvoiceDestinationCapability is the name of the paymail extension serving the destinations for the invoices of the users.
+// The payer gets the destination with the invoice reference and sends the transaction (p2p) with the same reference.
+const InvoiceDestinationCapability = "p2p-invoice-destination"
+
+// InvoiceDestinationProvider creates the payment destinations for the invoices
+type InvoiceDestinationProvider interface {
+	CreateInvoiceDestinationResponse(ctx context.Context, alias, domain, reference string, metadata *server.RequestMetadata) (*InvoiceDestinationPayload, error)
+}
+
+// InvoiceDestinationPayload is the response of the invoice destination capability:
+// the p2p payment destination for the amount of the invoice, extended with the invoice details.
+type InvoiceDestinationPayload struct {
+	paymailserver.PaymentDestinationPayload
+	Memo      string    `json:"memo,omitempty"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+/*
+Incoming Data Object Example:
+
+	{
+	  "reference": "e7861c9a6e3ea8a175c88ae15a57026b"
+	}
+*/
+type invoiceDestinationRequestBody struct {
+	Reference string `json:"reference"`
+}
+
+// NewInvoiceDestinationCapability creates the callable capability of the invoice paymail extension
+func NewInvoiceDestinationCapability(logger *zerolog.Logger, provider InvoiceDestinationProvider) server.CallableCapability {
+	return server.CallableCapability{
+		Path:   fmt.Sprintf("/%s/%s", InvoiceDestinationCapability, server.PaymailAddressTemplate),
+		Method: http.MethodPost,
+		Handler: func(c *gin.Context) {
+			var body invoiceDestinationRequestBody
+			if err := c.Bind(&body); err != nil {
+				paymailerrors.ErrorResponse(c, paymailerrors.ErrCannotBindRequest, logger)
+				return
+			}
+			if body.Reference == "" {
+				paymailerrors.ErrorResponse(c, pmerrors.ErrMissingInvoiceReference, logger)
+				return
+			}
+
+			alias, domain, address := paymailserver.SanitizePaymail(c.Param(server.PaymailAddressParamName))
+			if address == "" {
+				paymailerrors.ErrorResponse(c, paymailerrors.ErrInvalidPaymail, logger)
+				return
+			}
+
+			metadata := server.CreateMetadata(c.Request, alias, domain, "")
+			response, err := provider.CreateInvoiceDestinationResponse(c.Request.Context(), alias, domain, body.Reference, metadata)
+			if err != nil {
+				paymailerrors.ErrorResponse(c, err, logger)
+				return
+			}
+
+			c.JSON(http.StatusOK, response)
+		},
+	}
+}
+
+func (s *serviceProvider) CreateInvoiceDestinationResponse(ctx context.Context, alias, domain, reference string, metadata *server.RequestMetadata) (*InvoiceDestinationPayload, error) {
+	invoice, err := s.invoices.FindPayable(ctx, alias+"@"+domain, reference)
+	if err != nil {
+		return nil, err
+	}
+
+	origin := destinationOrigin{
+		referenceID: invoice.Reference,
+		request:     destinationmodels.RequestInvoice,
+		satoshis:    uint64(invoice.Satoshis),
+		metadata:    metadata,
+	}
+	destination, err := s.createDestinationForUser(ctx, alias, domain, origin)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InvoiceDestinationPayload{
+		PaymentDestinationPayload: paymailserver.PaymentDestinationPayload{
+			Outputs: []*paymailserver.PaymentOutput{{
+				Address:  destination.address,
+				Satoshis: uint64(invoice.Satoshis),
+				Script:   destination.lockingScript,
+			}},
+			Reference: destination.referenceID,
+		},
+		Memo:      invoice.Memo,
+		ExpiresAt: invoice.ExpiresAt,
+	}, nil
+}
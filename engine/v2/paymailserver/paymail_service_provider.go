@@ -2,6 +2,7 @@ package paymailserver
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	paymailserver "github.com/bitcoin-sv/go-paymail"
@@ -11,45 +12,78 @@ import (
 	"github.com/bitcoin-sv/go-sdk/script"
 	trx "github.com/bitcoin-sv/go-sdk/transaction"
 	"github.com/bitcoin-sv/go-sdk/transaction/template/p2pkh"
+	chainerrors "github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
 	"github.com/bitcoin-sv/spv-wallet/engine/paymail"
 	pmerrors "github.com/bitcoin-sv/spv-wallet/engine/paymail/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/paymaildomains"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/addresses/addressesmodels"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/keys/type42"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymaildestinations/destinationmodels"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymails/paymailsmodels"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymailserver/deferredmodels"
 	"github.com/bitcoin-sv/spv-wallet/models/bsv"
 	"github.com/rs/zerolog"
 )
 
+// ServiceProvider handles incoming paymail requests, including the requests of the wallet's own paymail extensions.
+type ServiceProvider interface {
+	server.PaymailServiceProvider
+	InvoiceDestinationProvider
+	DeferredTransactionsProcessor
+}
+
 // NewServiceProvider create a new paymail service server which handlers incoming paymail requests
 func NewServiceProvider(
 	logger *zerolog.Logger,
+	domains paymail.DomainsService,
 	paymails paymail.PaymailsService,
 	users paymail.UsersService,
 	addresses paymail.AddressesService,
+	destinations paymail.DestinationsService,
+	invoices paymail.InvoicesService,
 	spv paymail.MerkleRootsVerifier,
 	recorder paymail.TxRecorder,
-) server.PaymailServiceProvider {
+	pkiProvider paymail.PKIProvider,
+	deferred paymail.DeferredTransactionsRepo,
+) ServiceProvider {
 	return &serviceProvider{
-		logger:    logger,
-		paymails:  paymails,
-		users:     users,
-		addresses: addresses,
-		spv:       spv,
-		recorder:  recorder,
+		logger:       logger,
+		domains:      domains,
+		paymails:     paymails,
+		users:        users,
+		addresses:    addresses,
+		destinations: destinations,
+		invoices:     invoices,
+		spv:          spv,
+		recorder:     recorder,
+		pkiProvider:  pkiProvider,
+		deferred:     deferred,
 	}
 }
 
 type serviceProvider struct {
-	logger    *zerolog.Logger
-	paymails  paymail.PaymailsService
-	users     paymail.UsersService
-	addresses paymail.AddressesService
-	spv       paymail.MerkleRootsVerifier
-	recorder  paymail.TxRecorder
+	logger       *zerolog.Logger
+	domains      paymail.DomainsService
+	paymails     paymail.PaymailsService
+	users        paymail.UsersService
+	addresses    paymail.AddressesService
+	destinations paymail.DestinationsService
+	invoices     paymail.InvoicesService
+	spv          paymail.MerkleRootsVerifier
+	recorder     paymail.TxRecorder
+	pkiProvider  paymail.PKIProvider
+
+	// deferred keeps incoming transactions which couldn't be verified on receiving (BHS down with the queue policy); nil when not used
+	deferred paymail.DeferredTransactionsRepo
 }
 
-func (s *serviceProvider) CreateAddressResolutionResponse(ctx context.Context, alias, domain string, _ bool, _ *server.RequestMetadata) (*paymailserver.ResolutionPayload, error) {
-	destination, err := s.createDestinationForUser(ctx, alias, domain)
+func (s *serviceProvider) CreateAddressResolutionResponse(ctx context.Context, alias, domain string, _ bool, requestMetadata *server.RequestMetadata) (*paymailserver.ResolutionPayload, error) {
+	origin := destinationOrigin{request: destinationmodels.RequestAddressResolution, metadata: requestMetadata}
+	if requestMetadata != nil && requestMetadata.ResolveAddress != nil {
+		origin.satoshis = requestMetadata.ResolveAddress.Amount
+	}
+	destination, err := s.createDestinationForUser(ctx, alias, domain, origin)
 	if err != nil {
 		return nil, err
 	}
@@ -61,8 +95,9 @@ func (s *serviceProvider) CreateAddressResolutionResponse(ctx context.Context, a
 	}, nil
 }
 
-func (s *serviceProvider) CreateP2PDestinationResponse(ctx context.Context, alias, domain string, satoshis uint64, _ *server.RequestMetadata) (*paymailserver.PaymentDestinationPayload, error) {
-	destination, err := s.createDestinationForUser(ctx, alias, domain)
+func (s *serviceProvider) CreateP2PDestinationResponse(ctx context.Context, alias, domain string, satoshis uint64, requestMetadata *server.RequestMetadata) (*paymailserver.PaymentDestinationPayload, error) {
+	origin := destinationOrigin{request: destinationmodels.RequestP2PDestination, satoshis: satoshis, metadata: requestMetadata}
+	destination, err := s.createDestinationForUser(ctx, alias, domain, origin)
 	if err != nil {
 		return nil, err
 	}
@@ -78,7 +113,11 @@ func (s *serviceProvider) CreateP2PDestinationResponse(ctx context.Context, alia
 }
 
 func (s *serviceProvider) GetPaymailByAlias(ctx context.Context, alias, domain string, _ *server.RequestMetadata) (*paymailserver.AddressInformation, error) {
-	model, err := s.paymails.Find(ctx, alias, domain)
+	if _, err := s.servedDomain(ctx, domain); err != nil {
+		return nil, err
+	}
+
+	model, err := s.paymails.FindPublicProfile(ctx, alias, domain)
 	if err != nil {
 		return nil, pmerrors.ErrPaymailDBFailed.Wrap(err)
 	}
@@ -109,8 +148,15 @@ func (s *serviceProvider) RecordTransaction(ctx context.Context, p2pTx *paymails
 		return nil, pmerrors.ErrParseIncomingTransaction
 	}
 
+	domain, err := s.servedDomain(ctx, requestMetadata.Domain)
+	if err != nil {
+		return nil, err
+	}
+	if domain.RequireBEEF && !isBEEF {
+		return nil, spverrors.ErrPaymailDomainRequiresBEEF
+	}
+
 	var tx *trx.Transaction
-	var err error
 	if isBEEF {
 		tx, err = trx.NewTransactionFromBEEFHex(p2pTx.Beef)
 	} else {
@@ -121,28 +167,76 @@ func (s *serviceProvider) RecordTransaction(ctx context.Context, p2pTx *paymails
 		return nil, pmerrors.ErrParseIncomingTransaction.Wrap(err)
 	}
 
-	receiverPaymail := requestMetadata.Alias + "@" + requestMetadata.Domain
-
-	err = s.recorder.RecordPaymailTransaction(ctx, tx, p2pTx.MetaData.Sender, receiverPaymail)
+	senderPubKey, err := s.verifySender(ctx, domain.SenderValidation, p2pTx.MetaData, tx.TxID().String())
 	if err != nil {
-		return nil, pmerrors.ErrRecordTransaction.Wrap(err)
+		return nil, err
+	}
+
+	incoming := &deferredmodels.NewDeferredTransaction{
+		TxID:            tx.TxID().String(),
+		BEEF:            p2pTx.Beef,
+		SenderPaymail:   p2pTx.MetaData.Sender,
+		SenderPubKey:    senderPubKey,
+		ReceiverPaymail: requestMetadata.Alias + "@" + requestMetadata.Domain,
+		Reference:       p2pTx.Reference,
+		Note:            p2pTx.MetaData.Note,
+	}
+
+	if s.deferred != nil && isBEEF {
+		deferred, err := s.deferIfUnverified(ctx, p2pTx.DecodedBeef, incoming)
+		if err != nil {
+			return nil, err
+		}
+		if deferred {
+			return &paymailserver.P2PTransactionPayload{
+				Note: p2pTx.MetaData.Note,
+				TxID: incoming.TxID,
+			}, nil
+		}
+	}
+
+	if err = s.recordIncoming(ctx, tx, incoming); err != nil {
+		return nil, err
 	}
 
 	// TODO: TrackMissingTxs for BEEF purposes (or handle it in other way)
 
 	return &paymailserver.P2PTransactionPayload{
 		Note: p2pTx.MetaData.Note,
-		TxID: tx.TxID().String(),
+		TxID: incoming.TxID,
 	}, nil
 }
 
-func (s *serviceProvider) VerifyMerkleRoots(ctx context.Context, merkleProofs []*spv.MerkleRootConfirmationRequestItem) error {
-	// TODO include metrics for VerifyMerkleRoots (perhaps on another level - maybe ChainService)
+// recordIncoming records the incoming paymail transaction together with the payment of the paymail destination.
+func (s *serviceProvider) recordIncoming(ctx context.Context, tx *trx.Transaction, incoming *deferredmodels.NewDeferredTransaction) error {
+	err := s.recorder.RecordPaymailTransaction(ctx, tx, incoming.SenderPaymail, incoming.SenderPubKey, incoming.ReceiverPaymail, incoming.Reference)
+	if err != nil {
+		return pmerrors.ErrRecordTransaction.Wrap(err)
+	}
+
+	err = s.destinations.RecordPayment(ctx, incoming.Reference, tx, destinationmodels.Payment{
+		SenderPaymail: incoming.SenderPaymail,
+		SenderPubKey:  incoming.SenderPubKey,
+		Note:          incoming.Note,
+	})
+	if err != nil {
+		// the transaction is already recorded, so the missing payment details shouldn't fail the request
+		s.logger.Warn().Err(err).Str("reference", incoming.Reference).Msg("Cannot record the payment of paymail destination")
+	}
+	return nil
+}
 
+func (s *serviceProvider) VerifyMerkleRoots(ctx context.Context, merkleProofs []*spv.MerkleRootConfirmationRequestItem) error {
 	valid, err := s.spv.VerifyMerkleRoots(ctx, merkleProofs)
 
 	// NOTE: these errors goes to go-paymail and are not logged there, so we need to log them here
 
+	if s.deferred != nil && errors.Is(err, chainerrors.ErrMerkleRootsDeferred) {
+		// the transaction is kept and recorded only after the merkle roots are verified (see RecordTransaction)
+		s.logger.Warn().Err(err).Msg("Merkle roots cannot be verified now, the incoming transaction will be deferred")
+		return nil
+	}
+
 	if err != nil {
 		s.logger.Error().Err(err).Msg("Error verifying merkle roots")
 		return pmerrors.ErrPaymailMerkleRootVerificationFailed.Wrap(err)
@@ -169,13 +263,54 @@ func (s *serviceProvider) pki(ctx context.Context, paymailModel *paymailsmodels.
 	return pki, derivationKey, nil
 }
 
+// destinationOrigin is the paymail request for which the destination is derived
+type destinationOrigin struct {
+	// referenceID is the reference of the payment; if empty, the random reference of the derived destination is used
+	referenceID string
+	request     destinationmodels.RequestType
+	satoshis    uint64
+	metadata    *server.RequestMetadata
+}
+
+func (o destinationOrigin) requester() destinationmodels.Requester {
+	if o.metadata == nil {
+		return destinationmodels.Requester{}
+	}
+	requester := destinationmodels.Requester{
+		IP:        o.metadata.IPAddress,
+		UserAgent: o.metadata.UserAgent,
+	}
+	if o.metadata.ResolveAddress != nil {
+		requester.SenderPaymail = o.metadata.ResolveAddress.SenderHandle
+		requester.SenderName = o.metadata.ResolveAddress.SenderName
+		requester.Purpose = o.metadata.ResolveAddress.Purpose
+	}
+	return requester
+}
+
 type destinationData struct {
 	address       string
 	lockingScript string
 	referenceID   string
 }
 
-func (s *serviceProvider) createDestinationForUser(ctx context.Context, alias, domain string) (*destinationData, error) {
+// servedDomain returns the paymail domain if it's served by the wallet (domains can be managed at runtime)
+func (s *serviceProvider) servedDomain(ctx context.Context, name string) (*paymaildomains.Domain, error) {
+	domain, err := s.domains.Find(ctx, name)
+	if err != nil {
+		return nil, pmerrors.ErrPaymailDBFailed.Wrap(err)
+	}
+	if domain == nil {
+		return nil, pmerrors.ErrPaymailNotFound
+	}
+	return domain, nil
+}
+
+func (s *serviceProvider) createDestinationForUser(ctx context.Context, alias, domain string, origin destinationOrigin) (*destinationData, error) {
+	if _, err := s.servedDomain(ctx, domain); err != nil {
+		return nil, err
+	}
+
 	paymailModel, err := s.paymails.Find(ctx, alias, domain)
 	if err != nil {
 		return nil, pmerrors.ErrPaymailDBFailed.Wrap(err)
@@ -219,9 +354,27 @@ func (s *serviceProvider) createDestinationForUser(ctx context.Context, alias, d
 		return nil, pmerrors.ErrAddressSave.Wrap(err)
 	}
 
+	referenceID := dest.ReferenceID
+	if origin.referenceID != "" {
+		referenceID = origin.referenceID
+	}
+
+	err = s.destinations.Create(ctx, &destinationmodels.NewDestination{
+		Address:     address.AddressString,
+		ReferenceID: referenceID,
+		UserID:      paymailModel.UserID,
+		Paymail:     paymailModel.Alias + "@" + paymailModel.Domain,
+		Request:     origin.request,
+		Satoshis:    bsv.Satoshis(origin.satoshis),
+		Requester:   origin.requester(),
+	})
+	if err != nil {
+		return nil, pmerrors.ErrAddressSave.Wrap(err)
+	}
+
 	return &destinationData{
 		address:       address.AddressString,
 		lockingScript: lockingScript.String(),
-		referenceID:   dest.ReferenceID,
+		referenceID:   referenceID,
 	}, nil
 }
@@ -0,0 +1,48 @@
+package paymailserver
+
+import (
+	"context"
+
+	paymailserver "github.com/bitcoin-sv/go-paymail"
+	"github.com/bitcoin-sv/go-paymail/server"
+	"github.com/bitcoin-sv/spv-wallet/engine/paymail"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/rs/zerolog"
+)
+
+// NewPikeContactServiceProvider creates a new PIKE contact service which handles incoming contact invitations
+func NewPikeContactServiceProvider(
+	logger *zerolog.Logger,
+	paymails paymail.PaymailsService,
+	contacts paymail.ContactsService,
+) server.PikeContactServiceProvider {
+	return &pikeContactServiceProvider{
+		logger:   logger,
+		paymails: paymails,
+		contacts: contacts,
+	}
+}
+
+type pikeContactServiceProvider struct {
+	logger   *zerolog.Logger
+	paymails paymail.PaymailsService
+	contacts paymail.ContactsService
+}
+
+// AddContact adds the requester (contact) to the contact book of the owner of the receiver paymail
+func (p *pikeContactServiceProvider) AddContact(ctx context.Context, receiverPaymail string, contact *paymailserver.PikeContactRequestPayload) error {
+	alias, domain, _ := paymailserver.SanitizePaymail(receiverPaymail)
+	receiver, err := p.paymails.Find(ctx, alias, domain)
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to find paymail %s", receiverPaymail)
+	}
+	if receiver == nil {
+		return spverrors.ErrCouldNotFindPaymail
+	}
+
+	if _, err = p.contacts.AddContactRequest(ctx, receiver.UserID, contact.FullName, contact.Paymail); err != nil {
+		p.logger.Warn().Err(err).Str("receiver", receiverPaymail).Str("requester", contact.Paymail).Msg("failed to add contact request")
+		return spverrors.Wrapf(err, "failed to add contact request")
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package paymailserver
+
+import (
+	"context"
+
+	paymailserver "github.com/bitcoin-sv/go-paymail"
+	bsm "github.com/bitcoin-sv/go-sdk/compat/bsm"
+	"github.com/bitcoin-sv/go-sdk/script"
+	pmerrors "github.com/bitcoin-sv/spv-wallet/engine/paymail/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/paymaildomains"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+)
+
+// verifySender checks that the incoming transaction is signed by the sender's key announced in the sender's PKI.
+// It returns the verified public key of the sender or an empty string when the sender is not verified
+// (the policy is "ignore" or the verification failed with the "warn" policy).
+func (s *serviceProvider) verifySender(ctx context.Context, policy paymaildomains.SenderValidationPolicy, metadata *paymailserver.P2PMetaData, txID string) (string, error) {
+	if policy == paymaildomains.SenderValidationIgnore || policy == "" {
+		return "", nil
+	}
+
+	err := s.checkSenderSignature(ctx, metadata, txID)
+	if err == nil {
+		return metadata.PublicKey, nil
+	}
+
+	if policy == paymaildomains.SenderValidationRequire {
+		return "", pmerrors.ErrSenderValidationFailed.Wrap(err)
+	}
+
+	s.logger.Warn().Err(err).Str("sender", senderOf(metadata)).Str("txID", txID).Msg("Sender of incoming paymail transaction is not verified")
+	return "", nil
+}
+
+func (s *serviceProvider) checkSenderSignature(ctx context.Context, metadata *paymailserver.P2PMetaData, txID string) error {
+	if metadata == nil || metadata.Sender == "" {
+		return spverrors.Newf("missing sender")
+	}
+	if metadata.Signature == "" || metadata.PublicKey == "" {
+		return spverrors.Newf("missing signature or public key of the sender")
+	}
+
+	address, err := script.NewAddressFromPublicKeyString(metadata.PublicKey, true)
+	if err != nil {
+		return spverrors.Wrapf(err, "invalid public key of the sender")
+	}
+	// the signature is decoded the same way as go-paymail does when it checks the optional signature of the request
+	if err = bsm.VerifyMessage(address.AddressString, []byte(metadata.Signature), []byte(txID)); err != nil {
+		return spverrors.Wrapf(err, "invalid signature of the sender")
+	}
+
+	sender, err := paymailserver.ValidateAndSanitisePaymail(metadata.Sender, false)
+	if err != nil {
+		return spverrors.Wrapf(err, "invalid sender paymail")
+	}
+	pki, err := s.pkiProvider.GetPkiForPaymail(ctx, sender)
+	if err != nil {
+		return spverrors.Wrapf(err, "cannot get PKI of the sender %s", sender.Address)
+	}
+	if pki.PubKey != metadata.PublicKey {
+		return spverrors.Newf("public key of the sender doesn't match the PKI of %s", sender.Address)
+	}
+	return nil
+}
+
+func senderOf(metadata *paymailserver.P2PMetaData) string {
+	if metadata == nil {
+		return ""
+	}
+	return metadata.Sender
+}
@@ -0,0 +1,99 @@
+package paymailserver
+
+import (
+	"context"
+	"testing"
+
+	paymailserver "github.com/bitcoin-sv/go-paymail"
+	bsm "github.com/bitcoin-sv/go-sdk/compat/bsm"
+	primitives "github.com/bitcoin-sv/go-sdk/primitives/ec"
+	pmerrors "github.com/bitcoin-sv/spv-wallet/engine/paymail/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/paymaildomains"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	senderPaymail = "sender@example.com"
+	txID          = "7cd2d5f1d3ae1b4f0d0a1cf0e2f5c6c0b8c5a3e2b1d0f9e8d7c6b5a4f3e2d1c0"
+)
+
+func TestVerifySender(t *testing.T) {
+	senderKey, err := primitives.NewPrivateKey()
+	require.NoError(t, err)
+	otherKey, err := primitives.NewPrivateKey()
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		policy        paymaildomains.SenderValidationPolicy
+		metadata      *paymailserver.P2PMetaData
+		expectPubKey  string
+		expectFailure bool
+	}{
+		"signed by the key from the PKI": {
+			policy:       paymaildomains.SenderValidationRequire,
+			metadata:     signedMetadata(t, senderKey),
+			expectPubKey: senderKey.PubKey().ToDERHex(),
+		},
+		"signed by other key with require policy": {
+			policy:        paymaildomains.SenderValidationRequire,
+			metadata:      signedMetadata(t, otherKey),
+			expectFailure: true,
+		},
+		"missing signature with require policy": {
+			policy:        paymaildomains.SenderValidationRequire,
+			metadata:      &paymailserver.P2PMetaData{Sender: senderPaymail},
+			expectFailure: true,
+		},
+		"signed by other key with warn policy": {
+			policy:   paymaildomains.SenderValidationWarn,
+			metadata: signedMetadata(t, otherKey),
+		},
+		"signed by the key from the PKI with warn policy": {
+			policy:       paymaildomains.SenderValidationWarn,
+			metadata:     signedMetadata(t, senderKey),
+			expectPubKey: senderKey.PubKey().ToDERHex(),
+		},
+		"signed by the key from the PKI with ignore policy": {
+			policy:   paymaildomains.SenderValidationIgnore,
+			metadata: signedMetadata(t, senderKey),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given:
+			logger := tester.Logger(t)
+			provider := &serviceProvider{
+				logger:      &logger,
+				pkiProvider: pkiProviderMock{senderPaymail: senderKey.PubKey().ToDERHex()},
+			}
+
+			// when:
+			pubKey, err := provider.verifySender(context.Background(), test.policy, test.metadata, txID)
+
+			// then:
+			if test.expectFailure {
+				require.ErrorIs(t, err, pmerrors.ErrSenderValidationFailed)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expectPubKey, pubKey)
+		})
+	}
+}
+
+func signedMetadata(t *testing.T, key *primitives.PrivateKey) *paymailserver.P2PMetaData {
+	signature, err := bsm.SignMessage(key, []byte(txID))
+	require.NoError(t, err)
+	return &paymailserver.P2PMetaData{
+		Sender:    senderPaymail,
+		PublicKey: key.PubKey().ToDERHex(),
+		Signature: string(signature),
+	}
+}
+
+type pkiProviderMock map[string]string
+
+func (m pkiProviderMock) GetPkiForPaymail(_ context.Context, sender *paymailserver.SanitisedPaymail) (*paymailserver.PKIResponse, error) {
+	return &paymailserver.PKIResponse{PKIPayload: paymailserver.PKIPayload{Handle: sender.Address, PubKey: m[sender.Address]}}, nil
+}
@@ -0,0 +1,88 @@
+// Package stas recognises the outputs holding STAS tokens.
+// STAS is a satoshi-backed fungible token: every satoshi of the output is one unit of the token,
+// and the rules of the token (e.g. the transfers preserving the amount) are enforced by the locking script itself.
+// The locking script is the P2PKH locking script of the owner followed by OP_VERIFY, the STAS contract
+// and the data OP_RETURN <redemption public key hash> <flags> [<symbol> <data>...].
+// The token is identified by its redemption address (the address of the issuer).
+package stas
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	"github.com/bitcoin-sv/go-sdk/script"
+)
+
+// contractPrefix is the beginning of the STAS contract which follows the owner part of the locking script:
+// OP_DUP OP_HASH256 OP_16 OP_SPLIT OP_15 OP_SPLIT OP_SWAP OP_14 OP_SPLIT OP_SWAP ... OP_1 OP_SPLIT OP_SWAP
+var contractPrefix, _ = hex.DecodeString("76aa607f5f7f7c5e7f7c5d7f7c5c7f7c5b7f7c5a7f7c597f7c587f7c577f7c567f7c557f7c547f7c537f7c527f7c517f7c")
+
+// ownerLength is the length of the owner part: OP_DUP OP_HASH160 <pubKeyHash> OP_EQUALVERIFY OP_CHECKSIG OP_VERIFY
+const ownerLength = 26
+
+// Token is the STAS token held by the output.
+type Token struct {
+	// ID of the token - the redemption address.
+	ID     string
+	Symbol string
+}
+
+// Parse returns the STAS token and the owner address of the locking script.
+// It returns nil if the script is not the STAS locking script.
+// NOTE: Only the shape of the script and the beginning of the contract are recognised; the wallet is not a STAS indexer,
+// so it doesn't validate the contract nor the history of the token.
+func Parse(lockingScript *script.Script) (*Token, *script.Address) {
+	if lockingScript == nil || len(*lockingScript) <= ownerLength+len(contractPrefix) {
+		return nil, nil
+	}
+	raw := []byte(*lockingScript)
+	if !script.NewFromBytes(raw[:ownerLength-1]).IsP2PKH() ||
+		raw[ownerLength-1] != script.OpVERIFY ||
+		!bytes.HasPrefix(raw[ownerLength:], contractPrefix) {
+		return nil, nil
+	}
+
+	chunks, err := script.NewFromBytes(raw[ownerLength:]).Chunks()
+	if err != nil {
+		return nil, nil
+	}
+	token := parseData(chunks)
+	if token == nil {
+		return nil, nil
+	}
+
+	owner, err := script.NewAddressFromPublicKeyHash(raw[3:23], true)
+	if err != nil {
+		return nil, nil
+	}
+	return token, owner
+}
+
+// IsTokenID tells if the token ID is the ID of STAS token (the redemption address).
+func IsTokenID(tokenID string) bool {
+	_, err := script.NewAddressFromString(tokenID)
+	return err == nil
+}
+
+// parseData reads the data after the last OP_RETURN of the contract, which starts with the redemption public key hash and the flags.
+func parseData(chunks []*script.ScriptChunk) *Token {
+	for i := len(chunks) - 1; i >= 0; i-- {
+		if chunks[i].Op != script.OpRETURN {
+			continue
+		}
+		data := chunks[i+1:]
+		if len(data) < 2 || len(data[0].Data) != 20 {
+			return nil
+		}
+		redemption, err := script.NewAddressFromPublicKeyHash(data[0].Data, true)
+		if err != nil {
+			return nil
+		}
+		token := &Token{ID: redemption.AddressString}
+		if len(data) > 2 {
+			token.Symbol = string(data[2].Data)
+		}
+		return token
+	}
+	return nil
+}
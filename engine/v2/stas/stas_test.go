@@ -0,0 +1,82 @@
+package stas_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/bitcoin-sv/go-sdk/script"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/stas"
+	"github.com/stretchr/testify/require"
+)
+
+// stasContract is the beginning of the STAS contract followed by a part of its body.
+const stasContract = "76aa607f5f7f7c5e7f7c5d7f7c5c7f7c5b7f7c5a7f7c597f7c587f7c577f7c567f7c557f7c547f7c537f7c527f7c517f7c7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e"
+
+func stasScript(t *testing.T, owner string, data string) *script.Script {
+	lockingScript, err := script.NewFromHex("76a914" + owner + "88ac69" + stasContract + data)
+	require.NoError(t, err)
+	return lockingScript
+}
+
+func TestParse(t *testing.T) {
+	owner := fixtures.Sender.Address()
+	ownerPKH := hex.EncodeToString(owner.PublicKeyHash)
+	redemption := fixtures.RecipientInternal.Address()
+	redemptionPKH := hex.EncodeToString(redemption.PublicKeyHash)
+
+	t.Run("parse STAS token with symbol", func(t *testing.T) {
+		// given:
+		lockingScript := stasScript(t, ownerPKH, "6a14"+redemptionPKH+"0100"+"03544b4e")
+
+		// when:
+		token, address := stas.Parse(lockingScript)
+
+		// then:
+		require.Equal(t, &stas.Token{ID: redemption.AddressString, Symbol: "TKN"}, token)
+		require.Equal(t, owner.AddressString, address.AddressString)
+		require.True(t, stas.IsTokenID(token.ID))
+	})
+
+	t.Run("parse STAS token without symbol", func(t *testing.T) {
+		// given:
+		lockingScript := stasScript(t, ownerPKH, "6a14"+redemptionPKH+"0101")
+
+		// when:
+		token, address := stas.Parse(lockingScript)
+
+		// then:
+		require.Equal(t, &stas.Token{ID: redemption.AddressString}, token)
+		require.Equal(t, owner.AddressString, address.AddressString)
+	})
+
+	t.Run("not a STAS token", func(t *testing.T) {
+		tests := map[string]string{
+			"P2PKH only":           "76a914" + ownerPKH + "88ac",
+			"P2PKH with OP_VERIFY": "76a914" + ownerPKH + "88ac69",
+			"other contract":       "76a914" + ownerPKH + "88ac69" + "76aa607f7c" + "6a14" + redemptionPKH + "0100",
+			"without data":         "76a914" + ownerPKH + "88ac69" + stasContract,
+			"without flags":        "76a914" + ownerPKH + "88ac69" + stasContract + "6a14" + redemptionPKH,
+			"invalid redemption":   "76a914" + ownerPKH + "88ac69" + stasContract + "6a13" + redemptionPKH[:38] + "0100",
+			"without OP_VERIFY":    "76a914" + ownerPKH + "88ac" + stasContract + "6a14" + redemptionPKH + "0100",
+		}
+		for name, scriptHex := range tests {
+			t.Run(name, func(t *testing.T) {
+				// given:
+				lockingScript, err := script.NewFromHex(scriptHex)
+				require.NoError(t, err)
+
+				// when:
+				token, owner := stas.Parse(lockingScript)
+
+				// then:
+				require.Nil(t, token)
+				require.Nil(t, owner)
+			})
+		}
+	})
+
+	t.Run("BSV-21 token ID is not STAS token ID", func(t *testing.T) {
+		require.False(t, stas.IsTokenID("a0000000001e1b81dd2c9c0c6cd67f9bdf832e9c2bb12a1d57f30cb6ebbe78d9_0"))
+	})
+}
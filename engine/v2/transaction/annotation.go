@@ -30,6 +30,8 @@ type OutputAnnotation struct {
 	Bucket bucket.Name
 	// Paymail is available if the output is the paymail output.
 	Paymail *PaymailAnnotation
+	// Address is available if the output is the P2PKH output to the address given by the user.
+	Address *AddressAnnotation
 	// CustomInstructions has instructions about how to unlock this output.
 	CustomInstructions *bsv.CustomInstructions
 }
@@ -37,6 +39,9 @@ type OutputAnnotation struct {
 // PaymailAnnotation is the metadata for the paymail output.
 type PaymailAnnotation api.ModelsPaymailAnnotationDetails
 
+// AddressAnnotation is the metadata for the output to the P2PKH address.
+type AddressAnnotation api.ModelsAddressAnnotationDetails
+
 // NewDataOutputAnnotation constructs a new OutputAnnotation for the data output.
 func NewDataOutputAnnotation() *OutputAnnotation {
 	return &OutputAnnotation{
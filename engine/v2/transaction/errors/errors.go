@@ -35,6 +35,63 @@ var (
 	// ErrTxOutlinePaymailCannotSplitWhenRecipientSplitting is returned when user choose to split paymail output but the recipient responds from p2p destinations with multiple outputs.
 	ErrTxOutlinePaymailCannotSplitWhenRecipientSplitting = models.SPVError{Code: "tx-outline-paymail-cannot-split-when-recipient-splitting", Message: "cannot split paymail output when recipient responds with multiple outputs", StatusCode: 400}
 
+	// ErrTxOutlineInvalidAddress is returned when the address of an address output is not a valid mainnet P2PKH address.
+	ErrTxOutlineInvalidAddress = models.SPVError{Code: "tx-outline-address-invalid", Message: "address must be a valid mainnet P2PKH address", StatusCode: 400}
+
+	// ErrTxOutlineScriptRequired is returned when a script output is created with no locking script.
+	ErrTxOutlineScriptRequired = models.SPVError{Code: "tx-outline-script-required", Message: "locking script is required for script output", StatusCode: 400}
+
+	// ErrTxOutlineScriptTooLarge is returned when the locking script of a script output exceeds the allowed size.
+	ErrTxOutlineScriptTooLarge = models.SPVError{Code: "tx-outline-script-too-large", Message: "locking script is too large", StatusCode: 400}
+
+	// ErrTxOutlineScriptNonStandard is returned when the locking script of a script output is not allowed (e.g. P2SH or OP_RETURN).
+	ErrTxOutlineScriptNonStandard = models.SPVError{Code: "tx-outline-script-non-standard", Message: "locking script is not standard or should use another output type", StatusCode: 400}
+
+	// ErrTxOutlineBitcomProtocolsRequired is returned when a bitcom output is created with no protocols.
+	ErrTxOutlineBitcomProtocolsRequired = models.SPVError{Code: "tx-outline-bitcom-protocols-required", Message: "at least one protocol is required for bitcom output", StatusCode: 400}
+
+	// ErrTxOutlineBitcomUnsupportedProtocol is returned when a bitcom output contains an unsupported protocol.
+	ErrTxOutlineBitcomUnsupportedProtocol = models.SPVError{Code: "tx-outline-bitcom-protocol-unsupported", Message: "unsupported bitcom protocol", StatusCode: 400}
+
+	// ErrTxOutlineBitcomInvalidB is returned when the B:// file has no content or media type.
+	ErrTxOutlineBitcomInvalidB = models.SPVError{Code: "tx-outline-bitcom-b-invalid", Message: "B:// file requires content and media type", StatusCode: 400}
+
+	// ErrTxOutlineBitcomInvalidMAP is returned when the MAP protocol is not a SET command with app and type keys.
+	ErrTxOutlineBitcomInvalidMAP = models.SPVError{Code: "tx-outline-bitcom-map-invalid", Message: "MAP requires SET command with app and type keys", StatusCode: 400}
+
+	// ErrTxOutlineBitcomInvalidAIPSignature is returned when the AIP signature doesn't match the address and the signed data.
+	ErrTxOutlineBitcomInvalidAIPSignature = models.SPVError{Code: "tx-outline-bitcom-aip-signature-invalid", Message: "AIP signature is invalid", StatusCode: 400}
+
+	// ErrTxOutlineInscriptionInvalid is returned when the inscription has no content or content type.
+	ErrTxOutlineInscriptionInvalid = models.SPVError{Code: "tx-outline-inscription-invalid", Message: "inscription requires content and content type", StatusCode: 400}
+
+	// ErrTxOutlineOrdinalTransferReceiverRequired is returned when the ordinal transfer has no receiver.
+	ErrTxOutlineOrdinalTransferReceiverRequired = models.SPVError{Code: "tx-outline-ordinal-transfer-receiver-required", Message: "ordinal transfer requires address or paymail of the receiver", StatusCode: 400}
+
+	// ErrTxOutlineOrdinalTransferDuplicated is returned when the same ordinal is transferred more than once in the transaction.
+	ErrTxOutlineOrdinalTransferDuplicated = models.SPVError{Code: "tx-outline-ordinal-transfer-duplicated", Message: "ordinal can be transferred only once in the transaction", StatusCode: 400}
+
+	// ErrTxOutlineOrdinalTransferPaymailDestination is returned when the paymail receiver doesn't provide a single 1-satoshi destination for the ordinal.
+	ErrTxOutlineOrdinalTransferPaymailDestination = models.SPVError{Code: "tx-outline-ordinal-transfer-paymail-destination", Message: "paymail receiver must provide single output for the ordinal", StatusCode: 422}
+
+	// ErrTxOutlineOrdinalNotFound is returned when the transferred ordinal is not an unspent ordinal of the user.
+	ErrTxOutlineOrdinalNotFound = models.SPVError{Code: "tx-outline-ordinal-not-found", Message: "ordinal not found among unspent ordinals of the user", StatusCode: 404}
+
+	// ErrTxOutlineTokenTransferInvalid is returned when the token transfer has no token ID, amount or receiver.
+	ErrTxOutlineTokenTransferInvalid = models.SPVError{Code: "tx-outline-token-transfer-invalid", Message: "token transfer requires token ID, amount and receiver", StatusCode: 400}
+
+	// ErrTxOutlineTokenTransferUnsupported is returned when the token cannot be transferred by the wallet (STAS tokens are only tracked).
+	ErrTxOutlineTokenTransferUnsupported = models.SPVError{Code: "tx-outline-token-transfer-unsupported", Message: "transfers of STAS tokens are not supported", StatusCode: 422}
+
+	// ErrTxOutlineTokenTransferDuplicated is returned when the same token is transferred more than once in the transaction.
+	ErrTxOutlineTokenTransferDuplicated = models.SPVError{Code: "tx-outline-token-transfer-duplicated", Message: "token can be transferred only once in the transaction", StatusCode: 400}
+
+	// ErrTxOutlineTokenTransferPaymailDestination is returned when the paymail receiver doesn't provide a single 1-satoshi P2PKH destination for the token.
+	ErrTxOutlineTokenTransferPaymailDestination = models.SPVError{Code: "tx-outline-token-transfer-paymail-destination", Message: "paymail receiver must provide single P2PKH output for the token", StatusCode: 422}
+
+	// ErrTxOutlineInsufficientTokens is returned when user has not enough of the token to make the transfer.
+	ErrTxOutlineInsufficientTokens = models.SPVError{Code: "tx-outline-not-enough-tokens", Message: "not enough tokens to make the transfer", StatusCode: 422}
+
 	// ErrFailedToDecodeHex is returned when hex decoding fails.
 	ErrFailedToDecodeHex = models.SPVError{Code: "failed-to-decode-hex", Message: "failed to decode hex", StatusCode: 400}
 
@@ -56,6 +113,9 @@ var (
 	// ErrParsingScript is when the script parsing fails.
 	ErrParsingScript = models.SPVError{Code: "error-parsing-script", Message: "failed to parse script", StatusCode: 400}
 
+	// ErrTransactionNotFound is when the transaction is not found (or is not related to the user).
+	ErrTransactionNotFound = models.SPVError{Code: "error-transaction-not-found", Message: "transaction not found", StatusCode: 404}
+
 	// ErrTxBroadcast is when the transaction broadcast fails.
 	ErrTxBroadcast = models.SPVError{Code: "error-tx-broadcast", Message: "failed to broadcast transaction", StatusCode: 500}
 
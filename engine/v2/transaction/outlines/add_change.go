@@ -19,9 +19,14 @@ func addChangeOutput(ctx *evaluationContext, outputs annotatedOutputs, change bs
 		return nil, spverrors.Wrapf(err, "failed to get user public key")
 	}
 
-	lockingScript, customInstructions, err := lockingScriptForChangeOutput(userPubKey)
+	address, customInstructions, err := newUserDestination(userPubKey)
 	if err != nil {
-		return nil, spverrors.Wrapf(err, "failed to create locking script for change output")
+		return nil, spverrors.Wrapf(err, "failed to create destination for change output")
+	}
+
+	lockingScript, err := p2pkh.Lock(address)
+	if err != nil {
+		return nil, pmerrors.ErrPaymentDestination.Wrap(err)
 	}
 	changeOutput := &annotatedOutput{
 		OutputAnnotation: &transaction.OutputAnnotation{
@@ -37,7 +42,8 @@ func addChangeOutput(ctx *evaluationContext, outputs annotatedOutputs, change bs
 	return append(outputs, changeOutput), nil
 }
 
-func lockingScriptForChangeOutput(pubKey *primitives.PublicKey) (*script.Script, bsv.CustomInstructions, error) {
+// newUserDestination derives a new type42 address of the user together with the custom instructions to unlock it.
+func newUserDestination(pubKey *primitives.PublicKey) (*script.Address, bsv.CustomInstructions, error) {
 	dest, err := type42.NewDestinationWithRandomReference(pubKey)
 	if err != nil {
 		return nil, nil, pmerrors.ErrPaymentDestination.Wrap(err)
@@ -48,11 +54,6 @@ func lockingScriptForChangeOutput(pubKey *primitives.PublicKey) (*script.Script,
 		return nil, nil, pmerrors.ErrPaymentDestination.Wrap(err)
 	}
 
-	lockingScript, err := p2pkh.Lock(address)
-	if err != nil {
-		return nil, nil, pmerrors.ErrPaymentDestination.Wrap(err)
-	}
-
 	customInstructions := bsv.CustomInstructions{
 		{
 			Type:        "type42",
@@ -60,5 +61,5 @@ func lockingScriptForChangeOutput(pubKey *primitives.PublicKey) (*script.Script,
 		},
 	}
 
-	return lockingScript, customInstructions, nil
+	return address, customInstructions, nil
 }
@@ -0,0 +1,177 @@
+package outlines_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/models"
+	"github.com/bitcoin-sv/spv-wallet/models/transaction/bucket"
+)
+
+const (
+	mainnetAddress       = "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa"
+	mainnetAddressScript = "76a91462e907b15cbf27d5425399ebf6f0fb50ebb88f1888ac"
+)
+
+func TestCreateAddressTransactionOutline(t *testing.T) {
+	t.Run("return transaction outline for address output", func(t *testing.T) {
+		given, then := testabilities.New(t)
+
+		// given:
+		service := given.NewTransactionOutlinesService()
+
+		// and:
+		spec := &outlines.TransactionSpec{
+			UserID: fixtures.Sender.ID(),
+			Outputs: outlines.NewOutputsSpecs(&outlines.Address{
+				To:       mainnetAddress,
+				Satoshis: 1000,
+			}),
+		}
+
+		// when:
+		tx, err := service.CreateBEEF(context.Background(), spec)
+
+		// then:
+		then.Created(tx).WithNoError(err).WithParseableBEEFHex().
+			Output(0).
+			HasBucket(bucket.BSV).
+			HasSatoshis(1000).
+			HasLockingScript(mainnetAddressScript).
+			HasAddressAnnotation(mainnetAddress)
+	})
+
+	errorTests := map[string]struct {
+		spec          *outlines.Address
+		expectedError models.SPVError
+	}{
+		"return error for zero satoshis": {
+			spec:          &outlines.Address{To: mainnetAddress},
+			expectedError: txerrors.ErrOutputValueTooLow,
+		},
+		"return error for invalid address": {
+			spec:          &outlines.Address{To: "invalid", Satoshis: 1000},
+			expectedError: txerrors.ErrTxOutlineInvalidAddress,
+		},
+		"return error for address with invalid checksum": {
+			spec:          &outlines.Address{To: "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNb", Satoshis: 1000},
+			expectedError: txerrors.ErrTxOutlineInvalidAddress,
+		},
+		"return error for testnet address": {
+			spec:          &outlines.Address{To: "mpXwg4jMtRhuSpVq4xS3HFHmCmWp9NyGKt", Satoshis: 1000},
+			expectedError: txerrors.ErrTxOutlineInvalidAddress,
+		},
+		"return error for P2SH address": {
+			spec:          &outlines.Address{To: "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy", Satoshis: 1000},
+			expectedError: txerrors.ErrTxOutlineInvalidAddress,
+		},
+	}
+	for name, test := range errorTests {
+		t.Run(name, func(t *testing.T) {
+			given, then := testabilities.New(t)
+
+			// given:
+			service := given.NewTransactionOutlinesService()
+
+			// and:
+			spec := &outlines.TransactionSpec{
+				UserID:  fixtures.Sender.ID(),
+				Outputs: outlines.NewOutputsSpecs(test.spec),
+			}
+
+			// when:
+			tx, err := service.CreateBEEF(context.Background(), spec)
+
+			// then:
+			then.Created(tx).WithError(err).ThatIs(test.expectedError)
+		})
+	}
+}
+
+func TestCreateScriptTransactionOutline(t *testing.T) {
+	t.Run("return transaction outline for script output", func(t *testing.T) {
+		given, then := testabilities.New(t)
+
+		// given:
+		service := given.NewTransactionOutlinesService()
+
+		// and:
+		spec := &outlines.TransactionSpec{
+			UserID: fixtures.Sender.ID(),
+			Outputs: outlines.NewOutputsSpecs(&outlines.Script{
+				LockingScript: mainnetAddressScript,
+				Satoshis:      1000,
+			}),
+		}
+
+		// when:
+		tx, err := service.CreateBEEF(context.Background(), spec)
+
+		// then:
+		then.Created(tx).WithNoError(err).WithParseableBEEFHex().
+			Output(0).
+			HasBucket(bucket.BSV).
+			HasSatoshis(1000).
+			HasLockingScript(mainnetAddressScript)
+	})
+
+	errorTests := map[string]struct {
+		spec          *outlines.Script
+		expectedError models.SPVError
+	}{
+		"return error for no locking script": {
+			spec:          &outlines.Script{Satoshis: 1000},
+			expectedError: txerrors.ErrTxOutlineScriptRequired,
+		},
+		"return error for zero satoshis": {
+			spec:          &outlines.Script{LockingScript: mainnetAddressScript},
+			expectedError: txerrors.ErrOutputValueTooLow,
+		},
+		"return error for invalid hex": {
+			spec:          &outlines.Script{LockingScript: "invalid hex", Satoshis: 1000},
+			expectedError: txerrors.ErrFailedToDecodeHex,
+		},
+		"return error for too large script": {
+			spec:          &outlines.Script{LockingScript: strings.Repeat("51", outlines.MaxLockingScriptSize+1), Satoshis: 1000},
+			expectedError: txerrors.ErrTxOutlineScriptTooLarge,
+		},
+		"return error for unparseable script": {
+			// OP_PUSHDATA1 declaring more bytes than the script contains
+			spec:          &outlines.Script{LockingScript: "4c05aabb", Satoshis: 1000},
+			expectedError: txerrors.ErrParsingScript,
+		},
+		"return error for P2SH script": {
+			spec:          &outlines.Script{LockingScript: "a91462e907b15cbf27d5425399ebf6f0fb50ebb88f1887", Satoshis: 1000},
+			expectedError: txerrors.ErrTxOutlineScriptNonStandard,
+		},
+		"return error for OP_RETURN script": {
+			spec:          &outlines.Script{LockingScript: "006a0c4578616d706c652064617461", Satoshis: 1000},
+			expectedError: txerrors.ErrTxOutlineScriptNonStandard,
+		},
+	}
+	for name, test := range errorTests {
+		t.Run(name, func(t *testing.T) {
+			given, then := testabilities.New(t)
+
+			// given:
+			service := given.NewTransactionOutlinesService()
+
+			// and:
+			spec := &outlines.TransactionSpec{
+				UserID:  fixtures.Sender.ID(),
+				Outputs: outlines.NewOutputsSpecs(test.spec),
+			}
+
+			// when:
+			tx, err := service.CreateBEEF(context.Background(), spec)
+
+			// then:
+			then.Created(tx).WithError(err).ThatIs(test.expectedError)
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package outlines_test
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	bsm "github.com/bitcoin-sv/go-sdk/compat/bsm"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/data/bitcom"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/models"
+	"github.com/bitcoin-sv/spv-wallet/models/transaction/bucket"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateBitcomTransactionOutline(t *testing.T) {
+	bFile := bitcom.NewB(&bitcom.B{
+		Content:   []byte("hello"),
+		MediaType: "text/plain",
+	})
+	mapSet := bitcom.NewMAP(&bitcom.MAP{
+		Keys: map[string]string{"app": "spv", "type": "post", "context": "test"},
+	})
+
+	t.Run("return transaction outline for B, MAP and AIP protocols", func(t *testing.T) {
+		given, then := testabilities.New(t)
+
+		// given:
+		service := given.NewTransactionOutlinesService()
+
+		// and:
+		signed := [][]byte{
+			[]byte(bitcom.BPrefix), []byte("hello"), []byte("text/plain"), []byte(bitcom.DefaultBEncoding),
+			[]byte(bitcom.Separator),
+			[]byte(bitcom.MAPPrefix), []byte("SET"), []byte("app"), []byte("spv"), []byte("type"), []byte("post"), []byte("context"), []byte("test"),
+			[]byte(bitcom.Separator),
+		}
+		signature, err := bsm.SignMessage(fixtures.Sender.PrivateKey(), bitcom.SignedMessage(signed))
+		require.NoError(t, err)
+
+		// and:
+		spec := &outlines.TransactionSpec{
+			UserID: fixtures.Sender.ID(),
+			Outputs: outlines.NewOutputsSpecs(&outlines.Bitcom{
+				Protocols: []bitcom.Protocol{
+					bFile,
+					mapSet,
+					bitcom.NewAIP(&bitcom.AIP{
+						Algorithm: bitcom.AIPAlgorithm,
+						Address:   fixtures.Sender.ID(),
+						Signature: base64.StdEncoding.EncodeToString(signature),
+					}),
+				},
+			}),
+		}
+
+		// when:
+		tx, err := service.CreateBEEF(context.Background(), spec)
+
+		// then:
+		then.Created(tx).WithNoError(err).WithParseableBEEFHex().
+			Output(0).
+			HasBucket(bucket.Data).
+			IsDataOnly()
+	})
+
+	errorTests := map[string]struct {
+		protocols     []bitcom.Protocol
+		expectedError models.SPVError
+	}{
+		"return error for no protocols": {
+			expectedError: txerrors.ErrTxOutlineBitcomProtocolsRequired,
+		},
+		"return error for unsupported protocol": {
+			protocols:     []bitcom.Protocol{{Name: "unknown"}},
+			expectedError: txerrors.ErrTxOutlineBitcomUnsupportedProtocol,
+		},
+		"return error for B without media type": {
+			protocols:     []bitcom.Protocol{bitcom.NewB(&bitcom.B{Content: []byte("hello")})},
+			expectedError: txerrors.ErrTxOutlineBitcomInvalidB,
+		},
+		"return error for MAP without type key": {
+			protocols:     []bitcom.Protocol{bitcom.NewMAP(&bitcom.MAP{Keys: map[string]string{"app": "spv"}})},
+			expectedError: txerrors.ErrTxOutlineBitcomInvalidMAP,
+		},
+		"return error for MAP with other command than SET": {
+			protocols:     []bitcom.Protocol{bitcom.NewMAP(&bitcom.MAP{Command: "ADD", Keys: map[string]string{"app": "spv", "type": "post"}})},
+			expectedError: txerrors.ErrTxOutlineBitcomInvalidMAP,
+		},
+		"return error for MAP with empty value": {
+			protocols:     []bitcom.Protocol{bitcom.NewMAP(&bitcom.MAP{Keys: map[string]string{"app": "spv", "type": "post", "empty": ""}})},
+			expectedError: txerrors.ErrTxOutlineBitcomInvalidMAP,
+		},
+		"return error for invalid AIP signature": {
+			protocols: []bitcom.Protocol{
+				bFile,
+				bitcom.NewAIP(&bitcom.AIP{
+					Algorithm: bitcom.AIPAlgorithm,
+					Address:   fixtures.Sender.ID(),
+					Signature: base64.StdEncoding.EncodeToString([]byte("invalid")),
+				}),
+			},
+			expectedError: txerrors.ErrTxOutlineBitcomInvalidAIPSignature,
+		},
+	}
+	for name, test := range errorTests {
+		t.Run(name, func(t *testing.T) {
+			given, then := testabilities.New(t)
+
+			// given:
+			service := given.NewTransactionOutlinesService()
+
+			// and:
+			spec := &outlines.TransactionSpec{
+				UserID:  fixtures.Sender.ID(),
+				Outputs: outlines.NewOutputsSpecs(&outlines.Bitcom{Protocols: test.protocols}),
+			}
+
+			// when:
+			tx, err := service.CreateBEEF(context.Background(), spec)
+
+			// then:
+			then.Created(tx).WithError(err).ThatIs(test.expectedError)
+		})
+	}
+}
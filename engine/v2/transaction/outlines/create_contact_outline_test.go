@@ -0,0 +1,102 @@
+package outlines_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/contacts/contactserrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/contacts/contactsmodels"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/bitcoin-sv/spv-wallet/models/transaction/bucket"
+)
+
+func TestCreateContactTransactionOutlineBEEF(t *testing.T) {
+	const transactionSatoshiValue = bsv.Satoshis(1)
+	var recipient = fixtures.RecipientExternal.DefaultPaymail().Address()
+	var sender = fixtures.Sender.DefaultPaymail().Address()
+
+	t.Run("return transaction outline with payment to paymail of the contact", func(t *testing.T) {
+		given, then := testabilities.New(t)
+
+		// given:
+		given.ExternalRecipientHost().WillRespondWithP2PCapabilities()
+		contactID := given.SenderHasContact(recipient, contactsmodels.StatusConfirmed)
+
+		// and:
+		service := given.NewTransactionOutlinesService()
+
+		// and:
+		spec := &outlines.TransactionSpec{
+			UserID: fixtures.Sender.ID(),
+			Outputs: outlines.NewOutputsSpecs(&outlines.Contact{
+				ContactID: contactID,
+				Satoshis:  transactionSatoshiValue,
+			}),
+		}
+
+		// when:
+		tx, err := service.CreateBEEF(context.Background(), spec)
+
+		// then:
+		paymailHostResponse := then.ExternalPaymailHost().ReceivedP2PDestinationRequest(transactionSatoshiValue)
+
+		thenTx := then.Created(tx).WithNoError(err).WithParseableBEEFHex()
+
+		thenTx.HasOutputs(1)
+
+		thenTx.Output(0).
+			HasBucket(bucket.BSV).
+			HasSatoshis(transactionSatoshiValue).
+			HasLockingScript(paymailHostResponse.Outputs[0].Script).
+			IsPaymail().
+			HasReceiver(recipient).
+			HasSender(sender).
+			HasReference(paymailHostResponse.Reference)
+	})
+
+	errorTests := map[string]struct {
+		contactStatus contactsmodels.Status
+		contactID     func(existingID uint) uint
+		expectedError error
+	}{
+		"return error when contact does not exist": {
+			contactStatus: contactsmodels.StatusConfirmed,
+			contactID:     func(existingID uint) uint { return existingID + 100 },
+			expectedError: contactserrors.ErrContactNotFound,
+		},
+		"return error when contact is rejected": {
+			contactStatus: contactsmodels.StatusRejected,
+			contactID:     func(existingID uint) uint { return existingID },
+			expectedError: contactserrors.ErrContactRejected,
+		},
+	}
+	for name, test := range errorTests {
+		t.Run(name, func(t *testing.T) {
+			given, then := testabilities.New(t)
+
+			// given:
+			contactID := given.SenderHasContact(recipient, test.contactStatus)
+
+			// and:
+			service := given.NewTransactionOutlinesService()
+
+			// and:
+			spec := &outlines.TransactionSpec{
+				UserID: fixtures.Sender.ID(),
+				Outputs: outlines.NewOutputsSpecs(&outlines.Contact{
+					ContactID: test.contactID(contactID),
+					Satoshis:  transactionSatoshiValue,
+				}),
+			}
+
+			// when:
+			tx, err := service.CreateBEEF(context.Background(), spec)
+
+			// then:
+			then.Created(tx).WithError(err).ThatIs(test.expectedError)
+		})
+	}
+}
@@ -0,0 +1,246 @@
+package outlines_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/models"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/bitcoin-sv/spv-wallet/models/transaction/bucket"
+)
+
+var ordinalOutpoint = bsv.Outpoint{
+	TxID: "b1d2c9c0c6cd67f9bdf832e9c2bb12a1d57f30cb6ebbe78d9a0000000000e1a8",
+	Vout: 2,
+}
+
+func TestCreateInscriptionTransactionOutline(t *testing.T) {
+	t.Run("return transaction outline with inscription owned by the user", func(t *testing.T) {
+		given, then := testabilities.New(t)
+
+		// given:
+		service := given.NewTransactionOutlinesService()
+
+		// and:
+		spec := &outlines.TransactionSpec{
+			UserID: fixtures.Sender.ID(),
+			Outputs: outlines.NewOutputsSpecs(&outlines.Inscription{
+				ContentType: "text/plain",
+				Content:     []byte("Hello, ordinals!"),
+			}),
+		}
+
+		// when:
+		tx, err := service.CreateBEEF(context.Background(), spec)
+
+		// then:
+		thenTx := then.Created(tx).WithNoError(err).WithParseableBEEFHex()
+
+		thenTx.HasOutputs(1)
+
+		thenTx.Output(0).
+			HasBucket(bucket.Ordinals).
+			HasSatoshis(1).
+			HasInscription("text/plain", []byte("Hello, ordinals!")).
+			UnlockableBySender()
+	})
+
+	errorTests := map[string]struct {
+		spec          *outlines.Inscription
+		expectedError models.SPVError
+	}{
+		"return error for inscription without content": {
+			spec:          &outlines.Inscription{ContentType: "text/plain"},
+			expectedError: txerrors.ErrTxOutlineInscriptionInvalid,
+		},
+		"return error for inscription without content type": {
+			spec:          &outlines.Inscription{Content: []byte("Hello, ordinals!")},
+			expectedError: txerrors.ErrTxOutlineInscriptionInvalid,
+		},
+	}
+	for name, test := range errorTests {
+		t.Run(name, func(t *testing.T) {
+			given, then := testabilities.New(t)
+
+			// given:
+			service := given.NewTransactionOutlinesService()
+
+			// and:
+			spec := &outlines.TransactionSpec{
+				UserID:  fixtures.Sender.ID(),
+				Outputs: outlines.NewOutputsSpecs(test.spec),
+			}
+
+			// when:
+			tx, err := service.CreateBEEF(context.Background(), spec)
+
+			// then:
+			then.Created(tx).WithError(err).ThatIs(test.expectedError)
+		})
+	}
+}
+
+func TestCreateOrdinalTransferTransactionOutline(t *testing.T) {
+	t.Run("return transaction outline with ordinal transferred to address", func(t *testing.T) {
+		given, then := testabilities.New(t)
+
+		// given:
+		service := given.NewTransactionOutlinesService()
+
+		// and:
+		spec := &outlines.TransactionSpec{
+			UserID: fixtures.Sender.ID(),
+			Outputs: outlines.NewOutputsSpecs(
+				&outlines.OpReturn{DataType: outlines.DataTypeStrings, Data: []string{"transfer"}},
+				&outlines.OrdinalTransfer{
+					Outpoint: ordinalOutpoint,
+					To:       mainnetAddress,
+				},
+			),
+		}
+
+		// when:
+		tx, err := service.CreateRawTx(context.Background(), spec)
+
+		// then:
+		thenTx := then.Created(tx).WithNoError(err).WithParseableRawHex()
+
+		thenTx.HasInputs(2)
+
+		thenTx.Input(0).
+			HasOutpoint(ordinalOutpoint).
+			HasCustomInstructions(testabilities.UserOrdinalCustomInstructions)
+
+		thenTx.Input(1).
+			HasOutpoint(testabilities.UserFundsTransactionOutpoint).
+			HasCustomInstructions(testabilities.UserFundsTransactionCustomInstructions)
+
+		thenTx.HasOutputs(2)
+
+		thenTx.Output(0).
+			HasBucket(bucket.Ordinals).
+			HasSatoshis(1).
+			HasLockingScript(mainnetAddressScript).
+			HasAddressAnnotation(mainnetAddress)
+
+		thenTx.Output(1).
+			IsDataOnly()
+	})
+
+	t.Run("return transaction outline with ordinal transferred to paymail", func(t *testing.T) {
+		given, then := testabilities.New(t)
+		recipient := fixtures.RecipientExternal.DefaultPaymail().Address()
+		sender := fixtures.Sender.DefaultPaymail().Address()
+
+		// given:
+		given.ExternalRecipientHost().WillRespondWithP2PCapabilities()
+
+		// and:
+		service := given.NewTransactionOutlinesService()
+
+		// and:
+		spec := &outlines.TransactionSpec{
+			UserID: fixtures.Sender.ID(),
+			Outputs: outlines.NewOutputsSpecs(&outlines.OrdinalTransfer{
+				Outpoint: ordinalOutpoint,
+				To:       recipient,
+			}),
+		}
+
+		// when:
+		tx, err := service.CreateRawTx(context.Background(), spec)
+
+		// then:
+		paymailHostResponse := then.ExternalPaymailHost().ReceivedP2PDestinationRequest(1)
+
+		thenTx := then.Created(tx).WithNoError(err).WithParseableRawHex()
+
+		thenTx.Input(0).
+			HasOutpoint(ordinalOutpoint)
+
+		thenTx.HasOutputs(1)
+
+		thenTx.Output(0).
+			HasBucket(bucket.Ordinals).
+			HasSatoshis(1).
+			HasLockingScript(paymailHostResponse.Outputs[0].Script).
+			IsPaymail().
+			HasReceiver(recipient).
+			HasSender(sender).
+			HasReference(paymailHostResponse.Reference)
+	})
+
+	t.Run("return error when paymail host splits the ordinal output", func(t *testing.T) {
+		given, then := testabilities.New(t)
+
+		// given:
+		given.ExternalRecipientHost().WillRespondWithP2PDestinationsWithSats(1, 0)
+
+		// and:
+		service := given.NewTransactionOutlinesService()
+
+		// and:
+		spec := &outlines.TransactionSpec{
+			UserID: fixtures.Sender.ID(),
+			Outputs: outlines.NewOutputsSpecs(&outlines.OrdinalTransfer{
+				Outpoint: ordinalOutpoint,
+				To:       fixtures.RecipientExternal.DefaultPaymail().Address(),
+			}),
+		}
+
+		// when:
+		tx, err := service.CreateRawTx(context.Background(), spec)
+
+		// then:
+		then.Created(tx).WithError(err).ThatIs(txerrors.ErrTxOutlineOrdinalTransferPaymailDestination)
+	})
+
+	errorTests := map[string]struct {
+		outputs       []outlines.OutputSpec
+		expectedError models.SPVError
+	}{
+		"return error for transfer without receiver": {
+			outputs: []outlines.OutputSpec{
+				&outlines.OrdinalTransfer{Outpoint: ordinalOutpoint},
+			},
+			expectedError: txerrors.ErrTxOutlineOrdinalTransferReceiverRequired,
+		},
+		"return error for transfer to invalid address": {
+			outputs: []outlines.OutputSpec{
+				&outlines.OrdinalTransfer{Outpoint: ordinalOutpoint, To: "invalid"},
+			},
+			expectedError: txerrors.ErrTxOutlineInvalidAddress,
+		},
+		"return error for ordinal transferred twice": {
+			outputs: []outlines.OutputSpec{
+				&outlines.OrdinalTransfer{Outpoint: ordinalOutpoint, To: mainnetAddress},
+				&outlines.OrdinalTransfer{Outpoint: ordinalOutpoint, To: mainnetAddress},
+			},
+			expectedError: txerrors.ErrTxOutlineOrdinalTransferDuplicated,
+		},
+	}
+	for name, test := range errorTests {
+		t.Run(name, func(t *testing.T) {
+			given, then := testabilities.New(t)
+
+			// given:
+			service := given.NewTransactionOutlinesService()
+
+			// and:
+			spec := &outlines.TransactionSpec{
+				UserID:  fixtures.Sender.ID(),
+				Outputs: outlines.NewOutputsSpecs(test.outputs...),
+			}
+
+			// when:
+			tx, err := service.CreateRawTx(context.Background(), spec)
+
+			// then:
+			then.Created(tx).WithError(err).ThatIs(test.expectedError)
+		})
+	}
+}
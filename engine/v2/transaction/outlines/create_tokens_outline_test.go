@@ -0,0 +1,209 @@
+package outlines_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/ordinals"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/models"
+	"github.com/bitcoin-sv/spv-wallet/models/transaction/bucket"
+)
+
+const tokenID = "a0000000001e1b81dd2c9c0c6cd67f9bdf832e9c2bb12a1d57f30cb6ebbe78d9_0"
+
+func tokenTransferContent(amount string) []byte {
+	return []byte(`{"p":"bsv-20","op":"transfer","id":"` + tokenID + `","amt":"` + amount + `"}`)
+}
+
+func TestCreateTokenTransferTransactionOutline(t *testing.T) {
+	t.Run("return transaction outline with token transferred to address and token change", func(t *testing.T) {
+		given, then := testabilities.New(t)
+
+		// given:
+		given.UTXOSelector().WillHaveTokens(60, 70)
+
+		// and:
+		service := given.NewTransactionOutlinesService()
+
+		// and:
+		spec := &outlines.TransactionSpec{
+			UserID: fixtures.Sender.ID(),
+			Outputs: outlines.NewOutputsSpecs(&outlines.TokenTransfer{
+				TokenID: tokenID,
+				Amount:  100,
+				To:      mainnetAddress,
+			}),
+		}
+
+		// when:
+		tx, err := service.CreateRawTx(context.Background(), spec)
+
+		// then:
+		thenTx := then.Created(tx).WithNoError(err).WithParseableRawHex()
+
+		thenTx.HasInputs(3)
+
+		thenTx.Input(0).
+			HasOutpoint(testabilities.UserTokenOutpoint(0)).
+			HasCustomInstructions(testabilities.UserOrdinalCustomInstructions)
+
+		thenTx.Input(1).
+			HasOutpoint(testabilities.UserTokenOutpoint(1))
+
+		thenTx.Input(2).
+			HasOutpoint(testabilities.UserFundsTransactionOutpoint)
+
+		thenTx.HasOutputs(2)
+
+		thenTx.Output(0).
+			HasBucket(bucket.Tokens).
+			HasSatoshis(1).
+			HasInscription(ordinals.BSV21ContentType, tokenTransferContent("100")).
+			HasAddressAnnotation(mainnetAddress)
+
+		thenTx.Output(1).
+			HasBucket(bucket.Tokens).
+			HasSatoshis(1).
+			HasInscription(ordinals.BSV21ContentType, tokenTransferContent("30")).
+			UnlockableBySender()
+	})
+
+	t.Run("return transaction outline without token change", func(t *testing.T) {
+		given, then := testabilities.New(t)
+
+		// given:
+		given.UTXOSelector().WillHaveTokens(100)
+
+		// and:
+		service := given.NewTransactionOutlinesService()
+
+		// and:
+		spec := &outlines.TransactionSpec{
+			UserID: fixtures.Sender.ID(),
+			Outputs: outlines.NewOutputsSpecs(&outlines.TokenTransfer{
+				TokenID: tokenID,
+				Amount:  100,
+				To:      mainnetAddress,
+			}),
+		}
+
+		// when:
+		tx, err := service.CreateRawTx(context.Background(), spec)
+
+		// then:
+		thenTx := then.Created(tx).WithNoError(err).WithParseableRawHex()
+
+		thenTx.HasInputs(2)
+
+		thenTx.HasOutputs(1)
+
+		thenTx.Output(0).
+			HasBucket(bucket.Tokens).
+			HasInscription(ordinals.BSV21ContentType, tokenTransferContent("100"))
+	})
+
+	t.Run("return transaction outline with token transferred to paymail", func(t *testing.T) {
+		given, then := testabilities.New(t)
+		recipient := fixtures.RecipientExternal.DefaultPaymail().Address()
+		sender := fixtures.Sender.DefaultPaymail().Address()
+
+		// given:
+		given.ExternalRecipientHost().WillRespondWithP2PCapabilities()
+
+		// and:
+		given.UTXOSelector().WillHaveTokens(100)
+
+		// and:
+		service := given.NewTransactionOutlinesService()
+
+		// and:
+		spec := &outlines.TransactionSpec{
+			UserID: fixtures.Sender.ID(),
+			Outputs: outlines.NewOutputsSpecs(&outlines.TokenTransfer{
+				TokenID: tokenID,
+				Amount:  100,
+				To:      recipient,
+			}),
+		}
+
+		// when:
+		tx, err := service.CreateRawTx(context.Background(), spec)
+
+		// then:
+		paymailHostResponse := then.ExternalPaymailHost().ReceivedP2PDestinationRequest(1)
+
+		thenTx := then.Created(tx).WithNoError(err).WithParseableRawHex()
+
+		thenTx.HasOutputs(1)
+
+		thenTx.Output(0).
+			HasBucket(bucket.Tokens).
+			HasSatoshis(1).
+			HasInscription(ordinals.BSV21ContentType, tokenTransferContent("100")).
+			IsPaymail().
+			HasReceiver(recipient).
+			HasSender(sender).
+			HasReference(paymailHostResponse.Reference)
+	})
+
+	errorTests := map[string]struct {
+		tokens        []uint64
+		spec          *outlines.TokenTransfer
+		expectedError models.SPVError
+	}{
+		"return error for transfer without token ID": {
+			spec:          &outlines.TokenTransfer{Amount: 100, To: mainnetAddress},
+			expectedError: txerrors.ErrTxOutlineTokenTransferInvalid,
+		},
+		"return error for transfer of zero amount": {
+			spec:          &outlines.TokenTransfer{TokenID: tokenID, To: mainnetAddress},
+			expectedError: txerrors.ErrTxOutlineTokenTransferInvalid,
+		},
+		"return error for transfer without receiver": {
+			spec:          &outlines.TokenTransfer{TokenID: tokenID, Amount: 100},
+			expectedError: txerrors.ErrTxOutlineTokenTransferInvalid,
+		},
+		"return error when user has not enough tokens": {
+			tokens:        []uint64{60, 30},
+			spec:          &outlines.TokenTransfer{TokenID: tokenID, Amount: 100, To: mainnetAddress},
+			expectedError: txerrors.ErrTxOutlineInsufficientTokens,
+		},
+		"return error for transfer of STAS token": {
+			tokens:        []uint64{100},
+			spec:          &outlines.TokenTransfer{TokenID: mainnetAddress, Amount: 100, To: mainnetAddress},
+			expectedError: txerrors.ErrTxOutlineTokenTransferUnsupported,
+		},
+		"return error for transfer to invalid address": {
+			tokens:        []uint64{100},
+			spec:          &outlines.TokenTransfer{TokenID: tokenID, Amount: 100, To: "invalid"},
+			expectedError: txerrors.ErrTxOutlineInvalidAddress,
+		},
+	}
+	for name, test := range errorTests {
+		t.Run(name, func(t *testing.T) {
+			given, then := testabilities.New(t)
+
+			// given:
+			given.UTXOSelector().WillHaveTokens(test.tokens...)
+
+			// and:
+			service := given.NewTransactionOutlinesService()
+
+			// and:
+			spec := &outlines.TransactionSpec{
+				UserID:  fixtures.Sender.ID(),
+				Outputs: outlines.NewOutputsSpecs(test.spec),
+			}
+
+			// when:
+			tx, err := service.CreateRawTx(context.Background(), spec)
+
+			// then:
+			then.Created(tx).WithError(err).ThatIs(test.expectedError)
+		})
+	}
+}
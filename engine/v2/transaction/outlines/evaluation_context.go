@@ -19,6 +19,7 @@ type evaluationContext struct {
 	utxoSelector          UTXOSelector
 	feeUnit               bsvmodel.FeeUnit
 	usersService          UsersService
+	contactsService       ContactsService
 }
 
 func (c *evaluationContext) UserID() string {
@@ -48,3 +49,7 @@ func (c *evaluationContext) PaymailAddressService() PaymailAddressService {
 func (c *evaluationContext) UTXOSelector() UTXOSelector {
 	return c.utxoSelector
 }
+
+func (c *evaluationContext) ContactsService() ContactsService {
+	return c.contactsService
+}
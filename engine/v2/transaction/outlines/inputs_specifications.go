@@ -1,9 +1,12 @@
 package outlines
 
 import (
+	"errors"
+
 	"github.com/bitcoin-sv/go-sdk/chainhash"
 	sdk "github.com/bitcoin-sv/go-sdk/transaction"
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/ordinals"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction"
 	txerrors "github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
 	"github.com/bitcoin-sv/spv-wallet/models/bsv"
@@ -18,8 +21,17 @@ func (s *InputsSpec) evaluate(ctx *evaluationContext, outputs annotatedOutputs)
 
 	tx := sdk.NewTransaction()
 	tx.Outputs = outs
+	for _, outpoint := range outputs.spentOutpoints() {
+		err := tx.AddInputFrom(outpoint.TxID, outpoint.Vout, "", uint64(ordinals.OrdinalSatoshis), nil)
+		if err != nil {
+			return nil, 0, txerrors.ErrTxOutlineOrdinalNotFound.Wrap(err)
+		}
+	}
 
 	utxos, change, err := ctx.UTXOSelector().Select(ctx, tx, ctx.UserID())
+	if errors.Is(err, txerrors.ErrTxOutlineOrdinalNotFound) {
+		return nil, 0, err
+	}
 	if err != nil {
 		return nil, 0, spverrors.ErrInternal.Wrap(err)
 	}
@@ -6,6 +6,7 @@ import (
 	primitives "github.com/bitcoin-sv/go-sdk/primitives/ec"
 	sdk "github.com/bitcoin-sv/go-sdk/transaction"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/bsv"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/contacts/contactsmodels"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction"
 	bsvmodel "github.com/bitcoin-sv/spv-wallet/models/bsv"
 )
@@ -19,6 +20,20 @@ type PaymailAddressService interface {
 // UTXOSelector is a component that provides methods for selecting UTXOs of given user to fund a transaction.
 type UTXOSelector interface {
 	Select(ctx context.Context, tx *sdk.Transaction, userID string) (utxos []*UTXO, change bsvmodel.Satoshis, err error)
+	SelectTokens(ctx context.Context, userID string, tokenID string, amount uint64) (tokens []*TokenUTXO, err error)
+}
+
+// FeeUnitProvider provides the fee unit currently used for transactions - it can change while the service is running.
+type FeeUnitProvider interface {
+	FeeUnit() bsvmodel.FeeUnit
+}
+
+// StaticFeeUnit is a FeeUnitProvider which always returns the same fee unit.
+type StaticFeeUnit bsvmodel.FeeUnit
+
+// FeeUnit returns the fee unit.
+func (f StaticFeeUnit) FeeUnit() bsvmodel.FeeUnit {
+	return bsvmodel.FeeUnit(f)
 }
 
 // Service is a service for creating transaction outlines.
@@ -32,6 +47,11 @@ type UsersService interface {
 	GetPubKey(ctx context.Context, userID string) (*primitives.PublicKey, error)
 }
 
+// ContactsService is a service for working with user contacts.
+type ContactsService interface {
+	FindForPayment(ctx context.Context, userID string, contactID uint) (*contactsmodels.Contact, error)
+}
+
 // TransactionBEEFService provides functionality to generate a BEEF-encoded
 // hex string from a given Bitcoin transaction.
 type TransactionBEEFService interface {
@@ -45,6 +65,13 @@ type UTXO struct {
 	bsvmodel.CustomInstructions
 }
 
+// TokenUTXO represents an unspent transaction output holding the amount of the BSV-21 token.
+type TokenUTXO struct {
+	TxID   string
+	Vout   uint32
+	Amount uint64
+}
+
 // Transaction represents a transaction outline.
 type Transaction struct {
 	Hex         bsv.TxHex
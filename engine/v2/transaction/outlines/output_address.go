@@ -0,0 +1,65 @@
+package outlines
+
+import (
+	"github.com/bitcoin-sv/go-sdk/script"
+	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/go-sdk/transaction/template/p2pkh"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/bitcoin-sv/spv-wallet/models/transaction/bucket"
+)
+
+// Address represents a P2PKH output to the Base58 encoded address.
+type Address struct {
+	To       string
+	Satoshis bsv.Satoshis
+}
+
+func (a *Address) evaluate(*evaluationContext) (annotatedOutputs, error) {
+	if a.Satoshis == 0 {
+		return nil, txerrors.ErrOutputValueTooLow
+	}
+
+	address, err := parseMainnetAddress(a.To)
+	if err != nil {
+		return nil, err
+	}
+
+	lockingScript, err := p2pkh.Lock(address)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to create locking script for address %s", a.To)
+	}
+
+	output := &sdk.TransactionOutput{
+		Satoshis:      uint64(a.Satoshis),
+		LockingScript: lockingScript,
+	}
+	annotation := &transaction.OutputAnnotation{
+		Bucket: bucket.BSV,
+		Address: &transaction.AddressAnnotation{
+			Address: address.AddressString,
+		},
+	}
+	return singleAnnotatedOutput(output, annotation), nil
+}
+
+// parseMainnetAddress parses the address and checks that it is a mainnet P2PKH address with a valid checksum.
+func parseMainnetAddress(value string) (*script.Address, error) {
+	address, err := script.NewAddressFromString(value)
+	if err != nil {
+		return nil, txerrors.ErrTxOutlineInvalidAddress.Wrap(err)
+	}
+
+	// NewAddressFromString accepts testnet addresses and doesn't verify the checksum,
+	// so the address is encoded back as a mainnet one and must be the same as provided.
+	mainnet, err := script.NewAddressFromPublicKeyHash(address.PublicKeyHash, true)
+	if err != nil {
+		return nil, txerrors.ErrTxOutlineInvalidAddress.Wrap(err)
+	}
+	if mainnet.AddressString != value {
+		return nil, txerrors.ErrTxOutlineInvalidAddress
+	}
+	return mainnet, nil
+}
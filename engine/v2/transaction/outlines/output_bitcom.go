@@ -0,0 +1,121 @@
+package outlines
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/bitcoin-sv/go-sdk/script"
+	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/data/bitcom"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
+	magic "github.com/bitcoinschema/go-map"
+	"github.com/samber/lo"
+)
+
+// Bitcom represents a data output with Bitcom protocols (B://, MAP, AIP) separated by "|".
+type Bitcom struct {
+	Protocols []bitcom.Protocol
+}
+
+func (b *Bitcom) evaluate(*evaluationContext) (annotatedOutputs, error) {
+	if len(b.Protocols) == 0 {
+		return nil, txerrors.ErrTxOutlineBitcomProtocolsRequired
+	}
+
+	var pushes [][]byte
+	for i, protocol := range b.Protocols {
+		if i > 0 {
+			pushes = append(pushes, []byte(bitcom.Separator))
+		}
+		protocolPushes, err := protocolToPushes(protocol, pushes)
+		if err != nil {
+			return nil, err
+		}
+		pushes = append(pushes, protocolPushes...)
+	}
+
+	output, err := sdk.CreateOpReturnOutput(pushes)
+	if err != nil {
+		if errors.Is(err, script.ErrPartTooBig) {
+			return nil, txerrors.ErrTxOutlineOpReturnDataTooLarge
+		}
+		return nil, spverrors.Wrapf(err, "failed to create OP_RETURN output")
+	}
+
+	annotation := transaction.NewDataOutputAnnotation()
+	return singleAnnotatedOutput(output, annotation), nil
+}
+
+func protocolToPushes(protocol bitcom.Protocol, before [][]byte) ([][]byte, error) {
+	switch {
+	case protocol.Name == bitcom.ProtocolB && protocol.B != nil:
+		return bPushes(protocol.B)
+	case protocol.Name == bitcom.ProtocolMAP && protocol.MAP != nil:
+		return mapPushes(protocol.MAP)
+	case protocol.Name == bitcom.ProtocolAIP && protocol.AIP != nil:
+		return aipPushes(protocol.AIP, before)
+	default:
+		return nil, txerrors.ErrTxOutlineBitcomUnsupportedProtocol
+	}
+}
+
+func bPushes(b *bitcom.B) ([][]byte, error) {
+	if len(b.Content) == 0 || b.MediaType == "" {
+		return nil, txerrors.ErrTxOutlineBitcomInvalidB
+	}
+	pushes := [][]byte{
+		[]byte(bitcom.BPrefix),
+		b.Content,
+		[]byte(b.MediaType),
+		[]byte(lo.Ternary(b.Encoding == "", bitcom.DefaultBEncoding, b.Encoding)),
+	}
+	if b.Filename != "" {
+		pushes = append(pushes, []byte(b.Filename))
+	}
+	return pushes, nil
+}
+
+// mapPushes writes MAP SET with app and type keys first and the remaining keys in alphabetical order,
+// so the pushes (and so the AIP signed message) are deterministic.
+func mapPushes(m *bitcom.MAP) ([][]byte, error) {
+	if m.Command != "" && m.Command != magic.Set {
+		return nil, txerrors.ErrTxOutlineBitcomInvalidMAP
+	}
+	app, hasApp := m.Keys[magic.MapAppKey]
+	mapType, hasType := m.Keys[magic.MapTypeKey]
+	if !hasApp || !hasType || app == "" || mapType == "" {
+		return nil, txerrors.ErrTxOutlineBitcomInvalidMAP
+	}
+
+	pushes := [][]byte{
+		[]byte(bitcom.MAPPrefix),
+		[]byte(magic.Set),
+		[]byte(magic.MapAppKey), []byte(app),
+		[]byte(magic.MapTypeKey), []byte(mapType),
+	}
+
+	keys := lo.Without(lo.Keys(m.Keys), magic.MapAppKey, magic.MapTypeKey)
+	slices.Sort(keys)
+	for _, key := range keys {
+		// empty pushes are written as OP_0, which is not allowed in data outputs
+		if key == "" || m.Keys[key] == "" {
+			return nil, txerrors.ErrTxOutlineBitcomInvalidMAP
+		}
+		pushes = append(pushes, []byte(key), []byte(m.Keys[key]))
+	}
+	return pushes, nil
+}
+
+func aipPushes(aip *bitcom.AIP, before [][]byte) ([][]byte, error) {
+	if !aip.Verify(bitcom.SignedMessage(before)) {
+		return nil, txerrors.ErrTxOutlineBitcomInvalidAIPSignature
+	}
+	return [][]byte{
+		[]byte(bitcom.AIPPrefix),
+		[]byte(aip.Algorithm),
+		[]byte(aip.Address),
+		[]byte(aip.Signature),
+	}, nil
+}
@@ -0,0 +1,30 @@
+package outlines
+
+import (
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/bitcoin-sv/spv-wallet/models/optional"
+)
+
+// Contact represents a paymail output to the paymail of the user's contact
+type Contact struct {
+	ContactID uint
+	Satoshis  bsv.Satoshis
+	Splits    uint64
+	From      optional.Param[string]
+}
+
+func (c *Contact) evaluate(ctx *evaluationContext) (annotatedOutputs, error) {
+	contact, err := ctx.ContactsService().FindForPayment(ctx, ctx.UserID(), c.ContactID)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get contact %d for user %s", c.ContactID, ctx.UserID())
+	}
+
+	paymail := &Paymail{
+		To:       contact.Paymail,
+		Satoshis: c.Satoshis,
+		Splits:   c.Splits,
+		From:     c.From,
+	}
+	return paymail.evaluate(ctx)
+}
@@ -0,0 +1,50 @@
+package outlines
+
+import (
+	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/ordinals"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
+	"github.com/bitcoin-sv/spv-wallet/models/transaction/bucket"
+)
+
+// Inscription represents a 1Sat Ordinals inscription of the content, owned by the user.
+type Inscription struct {
+	ContentType string
+	Content     []byte
+}
+
+func (i *Inscription) evaluate(ctx *evaluationContext) (annotatedOutputs, error) {
+	if i.ContentType == "" || len(i.Content) == 0 {
+		return nil, txerrors.ErrTxOutlineInscriptionInvalid
+	}
+
+	userPubKey, err := ctx.UserPubKey()
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get user public key")
+	}
+
+	address, customInstructions, err := newUserDestination(userPubKey)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to create destination for inscription")
+	}
+
+	lockingScript, err := ordinals.Lock(address, &ordinals.Inscription{
+		ContentType: i.ContentType,
+		Content:     i.Content,
+	})
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to create locking script for inscription")
+	}
+
+	output := &sdk.TransactionOutput{
+		Satoshis:      uint64(ordinals.OrdinalSatoshis),
+		LockingScript: lockingScript,
+	}
+	annotation := &transaction.OutputAnnotation{
+		Bucket:             bucket.Ordinals,
+		CustomInstructions: &customInstructions,
+	}
+	return singleAnnotatedOutput(output, annotation), nil
+}
@@ -0,0 +1,50 @@
+package outlines
+
+import (
+	"strings"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/ordinals"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/bitcoin-sv/spv-wallet/models/transaction/bucket"
+)
+
+// OrdinalTransfer represents a transfer of the user's ordinal to the address or paymail.
+type OrdinalTransfer struct {
+	Outpoint bsv.Outpoint
+	To       string
+}
+
+func (o *OrdinalTransfer) evaluate(ctx *evaluationContext) (annotatedOutputs, error) {
+	if o.To == "" {
+		return nil, txerrors.ErrTxOutlineOrdinalTransferReceiverRequired
+	}
+
+	var outputs annotatedOutputs
+	var err error
+	if strings.Contains(o.To, "@") {
+		outputs, err = o.paymailOutput(ctx)
+	} else {
+		outputs, err = (&Address{To: o.To, Satoshis: ordinals.OrdinalSatoshis}).evaluate(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	output := outputs[0]
+	output.Bucket = bucket.Ordinals
+	output.spends = []bsv.Outpoint{o.Outpoint}
+	return outputs, nil
+}
+
+func (o *OrdinalTransfer) paymailOutput(ctx *evaluationContext) (annotatedOutputs, error) {
+	outputs, err := (&Paymail{To: o.To, Satoshis: ordinals.OrdinalSatoshis}).evaluate(ctx)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to evaluate paymail output for ordinal transfer")
+	}
+	if len(outputs) != 1 || outputs[0].Satoshis != uint64(ordinals.OrdinalSatoshis) {
+		return nil, txerrors.ErrTxOutlineOrdinalTransferPaymailDestination
+	}
+	return outputs, nil
+}
@@ -0,0 +1,60 @@
+package outlines
+
+import (
+	"github.com/bitcoin-sv/go-sdk/script"
+	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/bitcoin-sv/spv-wallet/models/transaction/bucket"
+)
+
+// MaxLockingScriptSize is the maximum size (in bytes) of the locking script accepted by the script output.
+const MaxLockingScriptSize = 10_000
+
+// Script represents an output with the raw locking script provided by the user.
+type Script struct {
+	LockingScript string
+	Satoshis      bsv.Satoshis
+}
+
+func (s *Script) evaluate(*evaluationContext) (annotatedOutputs, error) {
+	if s.LockingScript == "" {
+		return nil, txerrors.ErrTxOutlineScriptRequired
+	}
+	if s.Satoshis == 0 {
+		return nil, txerrors.ErrOutputValueTooLow
+	}
+
+	lockingScript, err := script.NewFromHex(s.LockingScript)
+	if err != nil {
+		return nil, txerrors.ErrFailedToDecodeHex.Wrap(err)
+	}
+
+	if err = validateLockingScript(lockingScript); err != nil {
+		return nil, err
+	}
+
+	output := &sdk.TransactionOutput{
+		Satoshis:      uint64(s.Satoshis),
+		LockingScript: lockingScript,
+	}
+	annotation := &transaction.OutputAnnotation{
+		Bucket: bucket.BSV,
+	}
+	return singleAnnotatedOutput(output, annotation), nil
+}
+
+func validateLockingScript(lockingScript *script.Script) error {
+	if len(*lockingScript) > MaxLockingScriptSize {
+		return txerrors.ErrTxOutlineScriptTooLarge
+	}
+	if _, err := lockingScript.Chunks(); err != nil {
+		return txerrors.ErrParsingScript.Wrap(err)
+	}
+	// P2SH is not spendable after genesis and data should be stored with op_return output.
+	if lockingScript.IsP2SH() || lockingScript.IsData() {
+		return txerrors.ErrTxOutlineScriptNonStandard
+	}
+	return nil
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/bitcoin-sv/spv-wallet/models/transaction/bucket"
 )
 
 // OutputsSpec are representing a client specification for outputs part of the transaction.
@@ -65,6 +67,8 @@ type annotatedOutputs []*annotatedOutput
 type annotatedOutput struct {
 	*transaction.OutputAnnotation
 	*sdk.TransactionOutput
+	// spends are the outpoints of the user's ordinal or token outputs transferred to this output.
+	spends []bsv.Outpoint
 }
 
 func singleAnnotatedOutput(txOut *sdk.TransactionOutput, out *transaction.OutputAnnotation) annotatedOutputs {
@@ -76,6 +80,39 @@ func singleAnnotatedOutput(txOut *sdk.TransactionOutput, out *transaction.Output
 	}
 }
 
+// transfersFirst moves the outputs receiving transferred ordinals or tokens to the beginning,
+// so that each ordinal (spent by inputs in the same order) lands in its output.
+func (a annotatedOutputs) transfersFirst() (annotatedOutputs, error) {
+	transfers := make(annotatedOutputs, 0)
+	others := make(annotatedOutputs, 0, len(a))
+	seen := make(map[bsv.Outpoint]struct{})
+	for _, out := range a {
+		if len(out.spends) == 0 {
+			others = append(others, out)
+			continue
+		}
+		for _, outpoint := range out.spends {
+			if _, ok := seen[outpoint]; ok {
+				if out.Bucket == bucket.Tokens {
+					return nil, txerrors.ErrTxOutlineTokenTransferDuplicated
+				}
+				return nil, txerrors.ErrTxOutlineOrdinalTransferDuplicated
+			}
+			seen[outpoint] = struct{}{}
+		}
+		transfers = append(transfers, out)
+	}
+	return append(transfers, others...), nil
+}
+
+func (a annotatedOutputs) spentOutpoints() []bsv.Outpoint {
+	var outpoints []bsv.Outpoint
+	for _, out := range a {
+		outpoints = append(outpoints, out.spends...)
+	}
+	return outpoints
+}
+
 func (a annotatedOutputs) splitIntoTransactionOutputsAndAnnotations() ([]*sdk.TransactionOutput, transaction.OutputsAnnotations) {
 	return a.toTransactionOutputs(), a.toAnnotations()
 }
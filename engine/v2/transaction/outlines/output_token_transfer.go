@@ -0,0 +1,136 @@
+package outlines
+
+import (
+	"strings"
+
+	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/ordinals"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/stas"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/bitcoin-sv/spv-wallet/models/transaction/bucket"
+	"github.com/samber/lo"
+)
+
+// TokenTransfer represents a transfer of the amount of the user's BSV-21 token to the address or paymail.
+// The remaining amount of the spent token outputs is returned to the user.
+type TokenTransfer struct {
+	TokenID string
+	Amount  uint64
+	To      string
+}
+
+func (t *TokenTransfer) evaluate(ctx *evaluationContext) (annotatedOutputs, error) {
+	if t.TokenID == "" || t.Amount == 0 || t.To == "" {
+		return nil, txerrors.ErrTxOutlineTokenTransferInvalid
+	}
+	if stas.IsTokenID(t.TokenID) {
+		// the STAS outputs can be unlocked only with the STAS unlocking script, which the wallet doesn't create
+		return nil, txerrors.ErrTxOutlineTokenTransferUnsupported
+	}
+
+	tokens, err := ctx.UTXOSelector().SelectTokens(ctx, ctx.UserID(), t.TokenID, t.Amount)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to select outputs of token %s", t.TokenID)
+	}
+	if len(tokens) == 0 {
+		return nil, txerrors.ErrTxOutlineInsufficientTokens
+	}
+
+	receiverOutput, err := t.receiverOutput(ctx)
+	if err != nil {
+		return nil, err
+	}
+	receiverOutput.spends = lo.Map(tokens, func(token *TokenUTXO, _ int) bsv.Outpoint {
+		return bsv.Outpoint{TxID: token.TxID, Vout: token.Vout}
+	})
+	outputs := annotatedOutputs{receiverOutput}
+
+	total := lo.SumBy(tokens, func(token *TokenUTXO) uint64 { return token.Amount })
+	if change := total - t.Amount; change > 0 {
+		changeOutput, err := t.changeOutput(ctx, change)
+		if err != nil {
+			return nil, err
+		}
+		outputs = append(outputs, changeOutput)
+	}
+
+	return outputs, nil
+}
+
+func (t *TokenTransfer) receiverOutput(ctx *evaluationContext) (*annotatedOutput, error) {
+	inscription := ordinals.NewBSV21Transfer(t.TokenID, t.Amount)
+
+	if !strings.Contains(t.To, "@") {
+		address, err := parseMainnetAddress(t.To)
+		if err != nil {
+			return nil, err
+		}
+		lockingScript, err := ordinals.Lock(address, inscription)
+		if err != nil {
+			return nil, spverrors.Wrapf(err, "failed to create locking script of token transfer for address %s", t.To)
+		}
+		return &annotatedOutput{
+			TransactionOutput: &sdk.TransactionOutput{
+				Satoshis:      uint64(ordinals.OrdinalSatoshis),
+				LockingScript: lockingScript,
+			},
+			OutputAnnotation: &transaction.OutputAnnotation{
+				Bucket: bucket.Tokens,
+				Address: &transaction.AddressAnnotation{
+					Address: address.AddressString,
+				},
+			},
+		}, nil
+	}
+
+	outputs, err := (&Paymail{To: t.To, Satoshis: ordinals.OrdinalSatoshis}).evaluate(ctx)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to evaluate paymail output for token transfer")
+	}
+	if len(outputs) != 1 || !outputs[0].LockingScript.IsP2PKH() {
+		return nil, txerrors.ErrTxOutlineTokenTransferPaymailDestination
+	}
+
+	output := outputs[0]
+	address, err := output.LockingScript.Address()
+	if err != nil {
+		return nil, txerrors.ErrTxOutlineTokenTransferPaymailDestination.Wrap(err)
+	}
+	output.LockingScript, err = ordinals.Lock(address, inscription)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to create locking script of token transfer for paymail %s", t.To)
+	}
+	output.Bucket = bucket.Tokens
+	return output, nil
+}
+
+func (t *TokenTransfer) changeOutput(ctx *evaluationContext, change uint64) (*annotatedOutput, error) {
+	userPubKey, err := ctx.UserPubKey()
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get user public key")
+	}
+
+	address, customInstructions, err := newUserDestination(userPubKey)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to create destination for token change")
+	}
+
+	lockingScript, err := ordinals.Lock(address, ordinals.NewBSV21Transfer(t.TokenID, change))
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to create locking script for token change")
+	}
+
+	return &annotatedOutput{
+		TransactionOutput: &sdk.TransactionOutput{
+			Satoshis:      uint64(ordinals.OrdinalSatoshis),
+			LockingScript: lockingScript,
+		},
+		OutputAnnotation: &transaction.OutputAnnotation{
+			Bucket:             bucket.Tokens,
+			CustomInstructions: &customInstructions,
+		},
+	}, nil
+}
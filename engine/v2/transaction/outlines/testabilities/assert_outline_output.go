@@ -6,6 +6,7 @@ import (
 	sdk "github.com/bitcoin-sv/go-sdk/transaction"
 	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
 	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures/txtestability"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/ordinals"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction"
 	"github.com/bitcoin-sv/spv-wallet/models/bsv"
 	"github.com/bitcoin-sv/spv-wallet/models/transaction/bucket"
@@ -18,6 +19,8 @@ type OutputAssertion interface {
 	HasSatoshis(satoshis bsv.Satoshis) OutputAssertion
 	HasLockingScript(lockingScript string) OutputAssertion
 	IsDataOnly() OutputAssertion
+	HasAddressAnnotation(address string) OutputAssertion
+	HasInscription(contentType string, content []byte) OutputAssertion
 	IsPaymail() TransactionOutlinePaymailOutputAssertion
 	UnlockableBySender() TransactionOutlinePaymailOutputAssertion
 }
@@ -65,6 +68,24 @@ func (a *txOutputAssertion) IsDataOnly() OutputAssertion {
 	return a
 }
 
+func (a *txOutputAssertion) HasAddressAnnotation(address string) OutputAssertion {
+	a.t.Helper()
+	a.require.NotNil(a.annotation, "Output %d has no annotation", a.index)
+	a.require.NotNil(a.annotation.Address, "Output %d is not an address output", a.index)
+	a.assert.Equal(address, a.annotation.Address.Address, "Output %d has invalid address annotation", a.index)
+	return a
+}
+
+func (a *txOutputAssertion) HasInscription(contentType string, content []byte) OutputAssertion {
+	a.t.Helper()
+	inscription, address := ordinals.Parse(a.txout.LockingScript)
+	a.require.NotNil(inscription, "Output %d has no inscription", a.index)
+	a.assert.NotNil(address, "Output %d has inscription without owner", a.index)
+	a.assert.Equal(contentType, inscription.ContentType, "Output %d has inscription with invalid content type", a.index)
+	a.assert.Equal(content, inscription.Content, "Output %d has inscription with invalid content", a.index)
+	return a
+}
+
 func (a *txOutputAssertion) IsPaymail() TransactionOutlinePaymailOutputAssertion {
 	a.t.Helper()
 	a.require.NotNil(a.annotation, "Output %d has no annotation", a.index)
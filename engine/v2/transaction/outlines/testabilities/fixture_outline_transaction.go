@@ -8,6 +8,7 @@ import (
 	tpaymail "github.com/bitcoin-sv/spv-wallet/engine/paymail/testabilities"
 	"github.com/bitcoin-sv/spv-wallet/engine/tester"
 	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/contacts/contactsmodels"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines"
 	"github.com/bitcoin-sv/spv-wallet/models/bsv"
 )
@@ -19,6 +20,7 @@ type TransactionOutlineFixture interface {
 	ExternalRecipientHost() tpaymail.PaymailHostFixture
 	UserHasNotEnoughFunds()
 	UTXOSelector() UTXOSelectorFixture
+	SenderHasContact(paymail string, status contactsmodels.Status) (contactID uint)
 }
 
 type transactionOutlineAbility struct {
@@ -28,6 +30,7 @@ type transactionOutlineAbility struct {
 	transactionBEEFService outlines.TransactionBEEFService
 	utxoSelector           mockedUTXOSelector
 	feeUnit                bsv.FeeUnit
+	contactsService        *mockContactsService
 }
 
 func (a *transactionOutlineAbility) MinimumValidTransactionSpec() *outlines.TransactionSpec {
@@ -47,6 +50,7 @@ func Given(t testing.TB) (given TransactionOutlineFixture) {
 		paymailAddressService:  newPaymailAddressServiceMock(t),
 		feeUnit:                bsv.FeeUnit{Satoshis: 1, Bytes: 1000},
 		transactionBEEFService: newTransactionBEEFServiceMock(t),
+		contactsService:        newContactsServiceMock(t),
 	}
 	return ability
 }
@@ -63,9 +67,10 @@ func (a *transactionOutlineAbility) NewTransactionOutlinesService() outlines.Ser
 		a.paymailAddressService,
 		a.transactionBEEFService,
 		&a.utxoSelector,
-		a.feeUnit,
+		outlines.StaticFeeUnit(a.feeUnit),
 		tester.Logger(a.t),
 		pubKeyGetter{},
+		a.contactsService,
 	)
 }
 
@@ -73,6 +78,11 @@ func (a *transactionOutlineAbility) UTXOSelector() UTXOSelectorFixture {
 	return &a.utxoSelector
 }
 
+// SenderHasContact adds a contact with given paymail to the contact book of the sender.
+func (a *transactionOutlineAbility) SenderHasContact(paymail string, status contactsmodels.Status) uint {
+	return a.contactsService.add(fixtures.Sender.ID(), paymail, status)
+}
+
 func (a *transactionOutlineAbility) UserHasNotEnoughFunds() {
 	a.utxoSelector.WillReturnNoUTXOs()
 }
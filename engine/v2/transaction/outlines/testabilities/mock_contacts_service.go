@@ -0,0 +1,41 @@
+package testabilities
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/contacts/contactserrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/contacts/contactsmodels"
+)
+
+type mockContactsService struct {
+	t        testing.TB
+	contacts []*contactsmodels.Contact
+}
+
+func newContactsServiceMock(t testing.TB) *mockContactsService {
+	return &mockContactsService{t: t}
+}
+
+func (m *mockContactsService) add(userID, paymail string, status contactsmodels.Status) uint {
+	contact := &contactsmodels.Contact{
+		ID:      uint(len(m.contacts) + 1),
+		Paymail: paymail,
+		Status:  status,
+		UserID:  userID,
+	}
+	m.contacts = append(m.contacts, contact)
+	return contact.ID
+}
+
+func (m *mockContactsService) FindForPayment(_ context.Context, userID string, contactID uint) (*contactsmodels.Contact, error) {
+	for _, contact := range m.contacts {
+		if contact.ID == contactID && contact.UserID == userID {
+			if contact.Status == contactsmodels.StatusRejected {
+				return nil, contactserrors.ErrContactRejected
+			}
+			return contact, nil
+		}
+	}
+	return nil, contactserrors.ErrContactNotFound
+}
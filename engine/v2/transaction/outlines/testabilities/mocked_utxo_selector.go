@@ -15,6 +15,7 @@ type UTXOSelectorFixture interface {
 	WillReturnNoUTXOs()
 	WillReturnError()
 	WillReturnUTXOs(change bsv.Satoshis, utxos ...bsv.Satoshis)
+	WillHaveTokens(amounts ...uint64)
 }
 
 func templatedOutpoint(index uint) bsv.Outpoint {
@@ -26,16 +27,29 @@ func templatedOutpoint(index uint) bsv.Outpoint {
 
 var UserFundsTransactionOutpoint = templatedOutpoint(0)
 
+// UserTokenOutpoint returns the outpoint of the user's token output with the index as given to WillHaveTokens.
+func UserTokenOutpoint(index uint) bsv.Outpoint {
+	return bsv.Outpoint{
+		TxID: fmt.Sprintf("b%010de1b81dd2c9c0c6cd67f9bdf832e9c2bb12a1d57f30cb6ebbe78d9", index),
+		Vout: 1,
+	}
+}
+
 var UserFundsTransactionCustomInstructions = bsv.CustomInstructions{
 	{Type: "type42", Instruction: "1-paymail_pki-0"},
 	{Type: "type42", Instruction: "1-destination-0123"},
 }
 
+var UserOrdinalCustomInstructions = bsv.CustomInstructions{
+	{Type: "type42", Instruction: "1-destination-ord1"},
+}
+
 type mockedUTXOSelector struct {
 	returnNothing  bool
 	returnError    bool
 	utxosToReturn  []bsv.Satoshis
 	changeToReturn bsv.Satoshis
+	tokens         []uint64
 }
 
 func (m *mockedUTXOSelector) Select(ctx context.Context, tx *sdk.Transaction, userID string) ([]*outlines.UTXO, bsv.Satoshis, error) {
@@ -58,14 +72,52 @@ func (m *mockedUTXOSelector) Select(ctx context.Context, tx *sdk.Transaction, us
 		}
 	}
 
-	return lo.Map(distribution, func(satoshis bsv.Satoshis, index int) *outlines.UTXO {
+	// inputs already present in the transaction are spending ordinals, and they are returned first
+	ordinals := lo.Map(tx.Inputs, func(input *sdk.TransactionInput, _ int) *outlines.UTXO {
+		return &outlines.UTXO{
+			TxID:               input.SourceTXID.String(),
+			Vout:               input.SourceTxOutIndex,
+			CustomInstructions: UserOrdinalCustomInstructions,
+		}
+	})
+
+	return append(ordinals, lo.Map(distribution, func(satoshis bsv.Satoshis, index int) *outlines.UTXO {
 		outpoint := templatedOutpoint(uint(index))
 		return &outlines.UTXO{
 			TxID:               outpoint.TxID,
 			Vout:               outpoint.Vout,
 			CustomInstructions: UserFundsTransactionCustomInstructions,
 		}
-	}), m.changeToReturn, nil
+	})...), m.changeToReturn, nil
+}
+
+func (m *mockedUTXOSelector) SelectTokens(ctx context.Context, userID string, tokenID string, amount uint64) ([]*outlines.TokenUTXO, error) {
+	if m.returnError {
+		return nil, spverrors.Newf("mocked: failed to select tokens")
+	}
+
+	var selected []*outlines.TokenUTXO
+	var total uint64
+	for index, tokenAmount := range m.tokens {
+		if total >= amount {
+			break
+		}
+		outpoint := UserTokenOutpoint(uint(index))
+		selected = append(selected, &outlines.TokenUTXO{
+			TxID:   outpoint.TxID,
+			Vout:   outpoint.Vout,
+			Amount: tokenAmount,
+		})
+		total += tokenAmount
+	}
+	if total < amount {
+		return nil, nil
+	}
+	return selected, nil
+}
+
+func (m *mockedUTXOSelector) WillHaveTokens(amounts ...uint64) {
+	m.tokens = amounts
 }
 
 func (m *mockedUTXOSelector) WillReturnNoUTXOs() {
@@ -9,7 +9,6 @@ import (
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/bsv"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction"
 	txerrors "github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
-	bsvmodel "github.com/bitcoin-sv/spv-wallet/models/bsv"
 	"github.com/rs/zerolog"
 )
 
@@ -19,8 +18,9 @@ type service struct {
 	paymailAddressService  PaymailAddressService
 	transactionBEEFService TransactionBEEFService
 	utxoSelector           UTXOSelector
-	feeUnit                bsvmodel.FeeUnit
+	feeUnit                FeeUnitProvider
 	usersService           UsersService
+	contactsService        ContactsService
 }
 
 // NewService creates a new transaction outlines service.
@@ -29,9 +29,10 @@ func NewService(
 	paymailAddressService PaymailAddressService,
 	transactionBEEFService TransactionBEEFService,
 	utxoSelector UTXOSelector,
-	feeUnit bsvmodel.FeeUnit,
+	feeUnit FeeUnitProvider,
 	logger zerolog.Logger,
 	usersService UsersService,
+	contactsService ContactsService,
 ) Service {
 	if paymailService == nil {
 		panic("paymail.ServiceClient is required to create transaction outlines service")
@@ -49,6 +50,10 @@ func NewService(
 		panic("UTXO selector is required to create transaction outlines service")
 	}
 
+	if feeUnit == nil {
+		panic("Fee unit provider is required to create transaction outlines service")
+	}
+
 	return &service{
 		logger:                 &logger,
 		paymailService:         paymailService,
@@ -57,6 +62,7 @@ func NewService(
 		utxoSelector:           utxoSelector,
 		feeUnit:                feeUnit,
 		usersService:           usersService,
+		contactsService:        contactsService,
 	}
 }
 
@@ -116,7 +122,8 @@ func (s *service) createEvaluationContext(ctx context.Context, userID string) *e
 		paymail:               s.paymailService,
 		paymailAddressService: s.paymailAddressService,
 		utxoSelector:          s.utxoSelector,
-		feeUnit:               s.feeUnit,
+		feeUnit:               s.feeUnit.FeeUnit(),
 		usersService:          s.usersService,
+		contactsService:       s.contactsService,
 	}
 }
@@ -20,6 +20,11 @@ func (t *TransactionSpec) evaluate(ctx *evaluationContext) (*sdk.Transaction, tr
 		return nil, transaction.Annotations{}, spverrors.Wrapf(err, "failed to evaluate outputs")
 	}
 
+	outputs, err = outputs.transfersFirst()
+	if err != nil {
+		return nil, transaction.Annotations{}, err
+	}
+
 	inputs, change, err := t.Inputs.evaluate(ctx, outputs)
 	if err != nil {
 		return nil, transaction.Annotations{}, err
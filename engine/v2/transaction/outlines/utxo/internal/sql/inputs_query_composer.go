@@ -6,6 +6,7 @@ import (
 
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/database"
 	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/bitcoin-sv/spv-wallet/models/transaction/bucket"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
@@ -48,7 +49,8 @@ func (c *inputsQueryComposer) utxos(db *gorm.DB) *gorm.DB {
 			c.feeCalculatedWithoutChangeOutput(),
 			c.feeCalculatedWithChangeOutput(),
 		).
-		Where("user_id = @userId", sql.Named("userId", c.userID))
+		Where("user_id = @userId", sql.Named("userId", c.userID)).
+		Where("bucket = @bucket", sql.Named("bucket", bucket.BSV))
 }
 
 func (c *inputsQueryComposer) addChangeValueCalculation(db *gorm.DB, utxoTab *gorm.DB) *gorm.DB {
@@ -10,6 +10,8 @@ import (
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines"
 	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/bitcoin-sv/spv-wallet/models/transaction/bucket"
+	"github.com/samber/lo"
 	"gorm.io/gorm"
 )
 
@@ -29,12 +31,12 @@ const (
 
 // UTXOSelector is responsible for selecting UTXOs for a transaction in SQL databases.
 type UTXOSelector struct {
-	feeUnit bsv.FeeUnit
+	feeUnit outlines.FeeUnitProvider
 	db      *gorm.DB
 }
 
 // NewUTXOSelector creates a new instance of UTXOSelector.
-func NewUTXOSelector(db *gorm.DB, feeUnit bsv.FeeUnit) *UTXOSelector {
+func NewUTXOSelector(db *gorm.DB, feeUnit outlines.FeeUnitProvider) *UTXOSelector {
 	return &UTXOSelector{
 		db:      db,
 		feeUnit: feeUnit,
@@ -42,31 +44,133 @@ func NewUTXOSelector(db *gorm.DB, feeUnit bsv.FeeUnit) *UTXOSelector {
 }
 
 // Select selects UTXOs of user to fund a transaction.
+// Inputs already present in the transaction must spend the user's ordinals or tokens, they are returned first.
 func (r *UTXOSelector) Select(ctx context.Context, tx *sdk.Transaction, userID string) (utxos []*outlines.UTXO, change bsv.Satoshis, err error) {
-	// NOTE: this approach assumes that tx doesn't contain any predefined inputs and all should be selected to cover outputs
-	outputsTotalValue := tx.TotalOutputSatoshis()
+	outputsTotalValue := bsv.Satoshis(tx.TotalOutputSatoshis())
 	byteSizeOfTxToFund := outputOnlyTxSize(tx.Outputs)
 
+	ordinals, err := r.findOrdinals(ctx, userID, tx.Inputs)
+	if err != nil {
+		return nil, bsv.Satoshis(0), err
+	}
+	for _, ordinal := range ordinals {
+		outputsTotalValue -= min(outputsTotalValue, bsv.Satoshis(ordinal.Satoshis))
+		byteSizeOfTxToFund += ordinal.EstimatedInputSize
+	}
+
 	var selected []*selectedUTXO
-	selected, err = r.selectInputsForTransaction(ctx, userID, bsv.Satoshis(outputsTotalValue), byteSizeOfTxToFund)
+	selected, err = r.selectInputsForTransaction(ctx, userID, outputsTotalValue, byteSizeOfTxToFund)
 	if err != nil {
 		return nil, bsv.Satoshis(0), err
 	}
 
-	if len(selected) > 0 {
-		// final change value, calculated by SQL, is present in all rows
-		change = bsv.Satoshis(selected[0].Change)
+	if len(selected) == 0 {
+		return nil, bsv.Satoshis(0), nil
 	}
 
-	utxos = make([]*outlines.UTXO, len(selected))
-	for i, utxo := range selected {
-		utxos[i] = &outlines.UTXO{
+	// final change value, calculated by SQL, is present in all rows
+	change = bsv.Satoshis(selected[0].Change)
+
+	utxos = make([]*outlines.UTXO, 0, len(ordinals)+len(selected))
+	for _, ordinal := range ordinals {
+		utxos = append(utxos, &outlines.UTXO{
+			TxID:               ordinal.TxID,
+			Vout:               ordinal.Vout,
+			CustomInstructions: bsv.CustomInstructions(ordinal.CustomInstructions),
+		})
+	}
+	for _, utxo := range selected {
+		utxos = append(utxos, &outlines.UTXO{
 			TxID:               utxo.TxID,
 			Vout:               utxo.Vout,
 			CustomInstructions: bsv.CustomInstructions(utxo.CustomInstructions),
+		})
+	}
+	return utxos, change, nil
+}
+
+// findOrdinals returns the user's ordinals (or token outputs) spent by the inputs (in the same order).
+func (r *UTXOSelector) findOrdinals(ctx context.Context, userID string, inputs []*sdk.TransactionInput) ([]*database.UserUTXO, error) {
+	if len(inputs) == 0 {
+		return nil, nil
+	}
+
+	outpoints := make([][]any, 0, len(inputs))
+	for _, input := range inputs {
+		outpoints = append(outpoints, []any{input.SourceTXID.String(), input.SourceTxOutIndex})
+	}
+
+	var rows []*database.UserUTXO
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND bucket IN ?", userID, []bucket.Name{bucket.Ordinals, bucket.Tokens}).
+		Where("(tx_id, vout) in (?)", outpoints).
+		Find(&rows).Error
+	if err != nil {
+		return nil, txerrors.ErrUnexpectedErrorDuringInputsSelection.Wrap(err)
+	}
+
+	ordinals := make([]*database.UserUTXO, 0, len(inputs))
+	for _, input := range inputs {
+		ordinal, found := lo.Find(rows, func(row *database.UserUTXO) bool {
+			return row.TxID == input.SourceTXID.String() && row.Vout == input.SourceTxOutIndex
+		})
+		if !found {
+			return nil, txerrors.ErrTxOutlineOrdinalNotFound
 		}
+		ordinals = append(ordinals, ordinal)
+	}
+	return ordinals, nil
+}
+
+// SelectTokens selects the user's outputs holding the token to cover the amount, least recently touched first.
+// It returns nil when the user doesn't have enough of the token.
+func (r *UTXOSelector) SelectTokens(ctx context.Context, userID string, tokenID string, amount uint64) (tokens []*outlines.TokenUTXO, err error) {
+	err = r.db.WithContext(ctx).Transaction(func(db *gorm.DB) error {
+		var rows []*database.UserUTXO
+		err := db.
+			Where("user_id = ? AND bucket = ? AND token_id = ?", userID, bucket.Tokens, tokenID).
+			Order("touched_at ASC, created_at ASC, tx_id ASC, vout ASC").
+			Find(&rows).Error
+		if err != nil {
+			return spverrors.Wrapf(err, "failed to select token utxos")
+		}
+
+		var selected []*database.UserUTXO
+		var total uint64
+		for _, row := range rows {
+			if total >= amount {
+				break
+			}
+			selected = append(selected, row)
+			total += lo.FromPtr(row.TokenAmount)
+		}
+		if total < amount {
+			return nil
+		}
+
+		outpoints := lo.Map(selected, func(row *database.UserUTXO, _ int) []any {
+			return []any{row.TxID, row.Vout}
+		})
+		err = db.Model(&database.UserUTXO{}).
+			Where("(tx_id, vout) in (?)", outpoints).
+			Update("touched_at", time.Now()).Error
+		if err != nil {
+			return spverrors.Wrapf(err, "failed to update touched_at for selected token utxos")
+		}
+
+		tokens = lo.Map(selected, func(row *database.UserUTXO, _ int) *outlines.TokenUTXO {
+			return &outlines.TokenUTXO{
+				TxID:   row.TxID,
+				Vout:   row.Vout,
+				Amount: lo.FromPtr(row.TokenAmount),
+			}
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, txerrors.ErrUnexpectedErrorDuringInputsSelection.Wrap(err)
 	}
-	return
+	return tokens, nil
 }
 
 func (r *UTXOSelector) selectInputsForTransaction(ctx context.Context, userID string, outputsTotalValue bsv.Satoshis, byteSizeOfTxWithoutInputs uint64) (utxos []*selectedUTXO, err error) {
@@ -103,7 +207,7 @@ func (r *UTXOSelector) buildQueryForInputs(db *gorm.DB, userID string, outputsTo
 		userID:              userID,
 		outputsTotalValue:   outputsTotalValue,
 		txWithoutInputsSize: txWithoutInputsSize,
-		feeUnit:             r.feeUnit,
+		feeUnit:             r.feeUnit.FeeUnit(),
 	}
 	return composer.build(db)
 }
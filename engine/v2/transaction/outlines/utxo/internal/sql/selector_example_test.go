@@ -6,7 +6,7 @@ import (
 
 	"github.com/bitcoin-sv/spv-wallet/engine/tester/tgorm"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/database"
-	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines"
 	"gorm.io/gorm"
 )
 
@@ -25,7 +25,7 @@ func ExampleUTXOSelector_buildQueryForInputs_sqlite() {
 
 	fmt.Println(query)
 
-	// Output: SELECT ux.tx_id,ux.vout,ux.custom_instructions,sel.min_change as change FROM `xapi_user_utxos` ux join (SELECT tx_id,vout,min_change FROM (SELECT tx_id,vout,change,min(case when change >= 0 then change end) over () as min_change FROM (SELECT tx_id,vout,case when remaining_value - fee_no_change_output <= 0 then remaining_value - fee_no_change_output else remaining_value - fee_with_change_output end as change FROM (SELECT `tx_id`,`vout`,sum(satoshis) over (order by touched_at ASC, created_at ASC, tx_id ASC, vout ASC) - 1 as remaining_value,ceil((sum(estimated_input_size) over (order by touched_at ASC, created_at ASC, tx_id ASC, vout ASC) + 10) / cast(1000 as float)) * 1 as fee_no_change_output,ceil((sum(estimated_input_size) over (order by touched_at ASC, created_at ASC, tx_id ASC, vout ASC) + 10 + 34) / cast(1000 as float)) * 1 as fee_with_change_output FROM `xapi_user_utxos` WHERE user_id = "someuserid") as utxo) as utxoWithChange) as utxoWithMinChange WHERE change <= min_change AND min_change is not null) sel ON sel.tx_id = ux.tx_id AND sel.vout = ux.vout
+	// Output: SELECT ux.tx_id,ux.vout,ux.custom_instructions,sel.min_change as change FROM `xapi_user_utxos` ux join (SELECT tx_id,vout,min_change FROM (SELECT tx_id,vout,change,min(case when change >= 0 then change end) over () as min_change FROM (SELECT tx_id,vout,case when remaining_value - fee_no_change_output <= 0 then remaining_value - fee_no_change_output else remaining_value - fee_with_change_output end as change FROM (SELECT `tx_id`,`vout`,sum(satoshis) over (order by touched_at ASC, created_at ASC, tx_id ASC, vout ASC) - 1 as remaining_value,ceil((sum(estimated_input_size) over (order by touched_at ASC, created_at ASC, tx_id ASC, vout ASC) + 10) / cast(1000 as float)) * 1 as fee_no_change_output,ceil((sum(estimated_input_size) over (order by touched_at ASC, created_at ASC, tx_id ASC, vout ASC) + 10 + 34) / cast(1000 as float)) * 1 as fee_with_change_output FROM `xapi_user_utxos` WHERE user_id = "someuserid" AND bucket = "bsv") as utxo) as utxoWithChange) as utxoWithMinChange WHERE change <= min_change AND min_change is not null) sel ON sel.tx_id = ux.tx_id AND sel.vout = ux.vout
 }
 
 // ExampleUTXOSelector_buildQueryForInputs_postgresql demonstrates what would be the query used to select inputs for a transaction.
@@ -43,7 +43,7 @@ func ExampleUTXOSelector_buildQueryForInputs_postgresql() {
 
 	fmt.Println(query)
 
-	// Output: SELECT ux.tx_id,ux.vout,ux.custom_instructions,sel.min_change as change FROM "xapi_user_utxos" ux join (SELECT tx_id,vout,min_change FROM (SELECT tx_id,vout,change,min(case when change >= 0 then change end) over () as min_change FROM (SELECT tx_id,vout,case when remaining_value - fee_no_change_output <= 0 then remaining_value - fee_no_change_output else remaining_value - fee_with_change_output end as change FROM (SELECT "tx_id","vout",sum(satoshis) over (order by touched_at ASC, created_at ASC, tx_id ASC, vout ASC) - 1 as remaining_value,ceil((sum(estimated_input_size) over (order by touched_at ASC, created_at ASC, tx_id ASC, vout ASC) + 10) / cast(1000 as float)) * 1 as fee_no_change_output,ceil((sum(estimated_input_size) over (order by touched_at ASC, created_at ASC, tx_id ASC, vout ASC) + 10 + 34) / cast(1000 as float)) * 1 as fee_with_change_output FROM "xapi_user_utxos" WHERE user_id = 'someuserid') as utxo) as utxoWithChange) as utxoWithMinChange WHERE change <= min_change AND min_change is not null) sel ON sel.tx_id = ux.tx_id AND sel.vout = ux.vout
+	// Output: SELECT ux.tx_id,ux.vout,ux.custom_instructions,sel.min_change as change FROM "xapi_user_utxos" ux join (SELECT tx_id,vout,min_change FROM (SELECT tx_id,vout,change,min(case when change >= 0 then change end) over () as min_change FROM (SELECT tx_id,vout,case when remaining_value - fee_no_change_output <= 0 then remaining_value - fee_no_change_output else remaining_value - fee_with_change_output end as change FROM (SELECT "tx_id","vout",sum(satoshis) over (order by touched_at ASC, created_at ASC, tx_id ASC, vout ASC) - 1 as remaining_value,ceil((sum(estimated_input_size) over (order by touched_at ASC, created_at ASC, tx_id ASC, vout ASC) + 10) / cast(1000 as float)) * 1 as fee_no_change_output,ceil((sum(estimated_input_size) over (order by touched_at ASC, created_at ASC, tx_id ASC, vout ASC) + 10 + 34) / cast(1000 as float)) * 1 as fee_with_change_output FROM "xapi_user_utxos" WHERE user_id = 'someuserid' AND bucket = 'bsv') as utxo) as utxoWithChange) as utxoWithMinChange WHERE change <= min_change AND min_change is not null) sel ON sel.tx_id = ux.tx_id AND sel.vout = ux.vout
 }
 
 // ExampleUTXOSelector_buildUpdateTouchedAtQuery_sqlite demonstrates what would be the SQL statement used to update inputs after selecting them.
@@ -93,6 +93,6 @@ func ExampleUTXOSelector_buildUpdateTouchedAtQuery_postgres() {
 }
 
 func givenInputsSelector(db *gorm.DB) *UTXOSelector {
-	selector := NewUTXOSelector(db, bsv.FeeUnit{Satoshis: 1, Bytes: 1000})
+	selector := NewUTXOSelector(db, outlines.StaticFeeUnit{Satoshis: 1, Bytes: 1000})
 	return selector
 }
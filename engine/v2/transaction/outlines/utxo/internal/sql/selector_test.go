@@ -7,6 +7,7 @@ import (
 	sdk "github.com/bitcoin-sv/go-sdk/transaction"
 	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/database"
+	txerrors "github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines/utxo/internal/sql/testabilities"
 	"github.com/bitcoin-sv/spv-wallet/models/bsv"
 	"github.com/stretchr/testify/require"
@@ -177,6 +178,87 @@ func TestInputsSelector(t *testing.T) {
 	}
 }
 
+func TestInputsSelectorWithOrdinals(t *testing.T) {
+	t.Run("don't select ordinals to fund transaction", func(t *testing.T) {
+		// given:
+		given, then, cleanup := testabilities.New(t)
+		defer cleanup()
+
+		// and:
+		given.DB().HasUTXO().OwnedBySender().Ordinal().Stored()
+		given.DB().HasUTXO().OwnedBySender().Ordinal().Stored()
+
+		// and:
+		bsvTransaction := given.Transaction().ForSatoshisAndSize(&selectBy{satoshis: 1})
+
+		// and:
+		selector := given.NewInputSelector()
+
+		// when:
+		utxos, change, err := selector.Select(context.Background(), bsvTransaction, fixtures.Sender.ID())
+
+		// then:
+		thenSuccess := then.WithoutError(err)
+
+		thenSuccess.SelectedInputs(utxos).AreEmpty()
+
+		thenSuccess.Change(change).EqualsTo(0)
+	})
+
+	t.Run("return spent ordinal first and fund the fee", func(t *testing.T) {
+		// given:
+		given, _, cleanup := testabilities.New(t)
+		defer cleanup()
+
+		// and:
+		ordinal := given.DB().HasUTXO().OwnedBySender().Ordinal().Stored()
+		funds := given.DB().HasUTXO().OwnedBySender().P2PKH().WithSatoshis(10).Stored()
+
+		// and:
+		bsvTransaction := given.Transaction().ForSatoshisAndSize(&selectBy{satoshis: 1})
+		err := bsvTransaction.AddInputFrom(ordinal.TxID, ordinal.Vout, "", 1, nil)
+		require.NoError(t, err)
+
+		// and:
+		selector := given.NewInputSelector()
+
+		// when:
+		utxos, change, err := selector.Select(context.Background(), bsvTransaction, fixtures.Sender.ID())
+
+		// then:
+		require.NoError(t, err)
+		require.Len(t, utxos, 2)
+		require.Equal(t, ordinal.TxID, utxos[0].TxID)
+		require.Equal(t, ordinal.Vout, utxos[0].Vout)
+		require.Equal(t, funds.TxID, utxos[1].TxID)
+		require.EqualValues(t, 9, change) // ordinal(1) + utxo(10) - output(1) - fee(1)
+	})
+
+	t.Run("return error when spent ordinal is not owned by user", func(t *testing.T) {
+		// given:
+		given, _, cleanup := testabilities.New(t)
+		defer cleanup()
+
+		// and:
+		ordinal := given.DB().HasUTXO().OwnedByRecipient().Ordinal().Stored()
+		given.DB().HasUTXO().OwnedBySender().P2PKH().WithSatoshis(10).Stored()
+
+		// and:
+		bsvTransaction := given.Transaction().ForSatoshisAndSize(&selectBy{satoshis: 1})
+		err := bsvTransaction.AddInputFrom(ordinal.TxID, ordinal.Vout, "", 1, nil)
+		require.NoError(t, err)
+
+		// and:
+		selector := given.NewInputSelector()
+
+		// when:
+		_, _, err = selector.Select(context.Background(), bsvTransaction, fixtures.Sender.ID())
+
+		// then:
+		require.ErrorIs(t, err, txerrors.ErrTxOutlineOrdinalNotFound)
+	})
+}
+
 type selectBy struct {
 	satoshis            bsv.Satoshis
 	txSizeWithoutInputs int
@@ -192,3 +274,76 @@ func (s *selectBy) Size() int {
 	}
 	return s.txSizeWithoutInputs
 }
+
+func TestTokensSelector(t *testing.T) {
+	const tokenID = "a0000000001e1b81dd2c9c0c6cd67f9bdf832e9c2bb12a1d57f30cb6ebbe78d9_0"
+
+	t.Run("select token outputs of the user to cover the amount", func(t *testing.T) {
+		// given:
+		given, _, cleanup := testabilities.New(t)
+		defer cleanup()
+
+		// and:
+		first := given.DB().HasUTXO().OwnedBySender().Token(tokenID, 60).Stored()
+		second := given.DB().HasUTXO().OwnedBySender().Token(tokenID, 70).Stored()
+		given.DB().HasUTXO().OwnedBySender().Token(tokenID, 80).Stored()
+		given.DB().HasUTXO().OwnedBySender().Token("b0000000001e1b81dd2c9c0c6cd67f9bdf832e9c2bb12a1d57f30cb6ebbe78d9_0", 1000).Stored()
+		given.DB().HasUTXO().OwnedByRecipient().Token(tokenID, 1000).Stored()
+		given.DB().HasUTXO().OwnedBySender().P2PKH().WithSatoshis(1000).Stored()
+
+		// and:
+		selector := given.NewInputSelector()
+
+		// when:
+		tokens, err := selector.SelectTokens(context.Background(), fixtures.Sender.ID(), tokenID, 100)
+
+		// then:
+		require.NoError(t, err)
+		require.Len(t, tokens, 2)
+		require.Equal(t, first.TxID, tokens[0].TxID)
+		require.EqualValues(t, 60, tokens[0].Amount)
+		require.Equal(t, second.TxID, tokens[1].TxID)
+		require.EqualValues(t, 70, tokens[1].Amount)
+	})
+
+	t.Run("return nothing when user has not enough of the token", func(t *testing.T) {
+		// given:
+		given, _, cleanup := testabilities.New(t)
+		defer cleanup()
+
+		// and:
+		given.DB().HasUTXO().OwnedBySender().Token(tokenID, 60).Stored()
+		given.DB().HasUTXO().OwnedByRecipient().Token(tokenID, 1000).Stored()
+
+		// and:
+		selector := given.NewInputSelector()
+
+		// when:
+		tokens, err := selector.SelectTokens(context.Background(), fixtures.Sender.ID(), tokenID, 100)
+
+		// then:
+		require.NoError(t, err)
+		require.Empty(t, tokens)
+	})
+
+	t.Run("don't select tokens to fund transaction", func(t *testing.T) {
+		// given:
+		given, then, cleanup := testabilities.New(t)
+		defer cleanup()
+
+		// and:
+		given.DB().HasUTXO().OwnedBySender().Token(tokenID, 60).Stored()
+
+		// and:
+		bsvTransaction := given.Transaction().ForSatoshisAndSize(&selectBy{satoshis: 1})
+
+		// and:
+		selector := given.NewInputSelector()
+
+		// when:
+		utxos, _, err := selector.Select(context.Background(), bsvTransaction, fixtures.Sender.ID())
+
+		// then:
+		then.WithoutError(err).SelectedInputs(utxos).AreEmpty()
+	})
+}
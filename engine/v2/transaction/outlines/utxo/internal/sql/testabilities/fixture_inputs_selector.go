@@ -10,6 +10,7 @@ import (
 	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
 	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures/txtestability"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/database/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines/utxo/internal/sql"
 	"github.com/bitcoin-sv/spv-wallet/models/bsv"
 	"github.com/stretchr/testify/require"
@@ -50,7 +51,7 @@ func newFixture(t testing.TB) (InputsSelectorFixture, func()) {
 }
 
 func (i *inputsSelectorFixture) NewInputSelector() *sql.UTXOSelector {
-	return sql.NewUTXOSelector(i.db, fixtures.DefaultFeeUnit)
+	return sql.NewUTXOSelector(i.db, outlines.StaticFeeUnit(fixtures.DefaultFeeUnit))
 }
 
 func (i *inputsSelectorFixture) Transaction() InputsSelectorTransactionFixture {
@@ -0,0 +1,46 @@
+package record
+
+import (
+	"github.com/bitcoin-sv/spv-wallet/conv"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction"
+	txerrors "github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
+)
+
+// processAddressOutputs checks that the outputs annotated with an address are P2PKH outputs to that address
+// and returns the address of the first of them (used as the counterparty of the outgoing operation).
+func (f *txFlow) processAddressOutputs(annotations transaction.OutputsAnnotations) (string, error) {
+	outputsCount, err := conv.IntToUint32(len(f.tx.Outputs))
+	if err != nil {
+		return "", txerrors.ErrAnnotationIndexOutOfRange.Wrap(err)
+	}
+
+	var receiver string
+	firstVout := outputsCount
+	for vout, annotation := range annotations {
+		if annotation.Address == nil {
+			continue
+		}
+		if vout >= outputsCount {
+			return "", txerrors.ErrAnnotationIndexOutOfRange
+		}
+
+		lockingScript := f.tx.Outputs[vout].LockingScript
+		if !lockingScript.IsP2PKH() {
+			return "", spverrors.Wrapf(txerrors.ErrAnnotationMismatch, "output %d is not a P2PKH output", vout)
+		}
+		address, err := lockingScript.Address()
+		if err != nil {
+			return "", txerrors.ErrParsingScript.Wrap(err)
+		}
+		if address.AddressString != annotation.Address.Address {
+			return "", spverrors.Wrapf(txerrors.ErrAnnotationMismatch, "output %d is not locked to address %s", vout, annotation.Address.Address)
+		}
+
+		if vout < firstVout {
+			firstVout = vout
+			receiver = annotation.Address.Address
+		}
+	}
+	return receiver, nil
+}
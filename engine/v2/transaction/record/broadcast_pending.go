@@ -0,0 +1,83 @@
+package record
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	chainerrors "github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/txmodels"
+	"github.com/samber/lo"
+)
+
+// broadcastPendingLimit is the max number of transactions broadcasted in a single run of BroadcastPending.
+const broadcastPendingLimit = 100
+
+// BroadcastPending broadcasts transactions queued by asynchronous recording.
+// A transaction which cannot be broadcasted because of ARC unavailability is retried with exponential backoff
+// until the configured number of attempts is reached; then (or when ARC rejects it) it is marked as PROBLEMATIC,
+// which releases the inputs spent by the transaction and removes its outputs from the user's UTXOs.
+func (s *Service) BroadcastPending(ctx context.Context) error {
+	if s.asyncBroadcast == nil {
+		return nil
+	}
+
+	pending, err := s.transactions.FindTransactionsToBroadcast(ctx, broadcastPendingLimit)
+	if err != nil {
+		return err
+	}
+
+	for _, trackedTx := range pending {
+		if ctx.Err() != nil {
+			return spverrors.ErrCtxInterrupted.Wrap(ctx.Err())
+		}
+		if err = s.broadcastPendingTx(ctx, trackedTx); err != nil {
+			s.logger.Error().Err(err).Str("txID", trackedTx.ID).Msg("Cannot update broadcast status of transaction")
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) broadcastPendingTx(ctx context.Context, trackedTx *txmodels.TrackedTransaction) error {
+	attempts := trackedTx.BroadcastAttempts + 1
+
+	tx, err := trackedTx.TX()
+	if err != nil {
+		s.logger.Error().Err(err).Str("txID", trackedTx.ID).Msg("Cannot parse queued transaction")
+		return s.transactions.UpdateBroadcastStatus(ctx, trackedTx.ID, txmodels.TxStatusProblematic, attempts, nil)
+	}
+
+	txInfo, err := s.broadcaster.Broadcast(ctx, tx)
+	switch {
+	case err == nil && txInfo.TXStatus.IsMined():
+		return s.transactions.UpdateBroadcastStatus(ctx, trackedTx.ID, txmodels.TxStatusMined, attempts, nil)
+	case err == nil:
+		return s.transactions.UpdateBroadcastStatus(ctx, trackedTx.ID, txmodels.TxStatusBroadcasted, attempts, nil)
+	case !isRetryableBroadcastError(err) || attempts >= s.asyncBroadcast.MaxAttempts:
+		s.logger.Warn().Err(err).Str("txID", trackedTx.ID).Int("attempts", attempts).Msg("Queued transaction cannot be broadcasted")
+		return s.transactions.UpdateBroadcastStatus(ctx, trackedTx.ID, txmodels.TxStatusProblematic, attempts, nil)
+	default:
+		s.logger.Info().Err(err).Str("txID", trackedTx.ID).Int("attempts", attempts).Msg("Broadcast attempt has failed, will retry")
+		nextAt := time.Now().Add(s.retryBackoff(attempts))
+		return s.transactions.UpdateBroadcastStatus(ctx, trackedTx.ID, txmodels.TxStatusCreated, attempts, lo.ToPtr(nextAt))
+	}
+}
+
+// retryBackoff returns the delay before the next broadcast attempt, doubling the configured backoff after every failed attempt.
+func (s *Service) retryBackoff(attempts int) time.Duration {
+	backoff := s.asyncBroadcast.RetryBackoff
+	for i := 1; i < attempts && backoff < s.asyncBroadcast.MaxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, s.asyncBroadcast.MaxRetryBackoff)
+}
+
+// isRetryableBroadcastError checks if the broadcast failed because of ARC unavailability rather than the transaction itself.
+func isRetryableBroadcastError(err error) bool {
+	return errors.Is(err, chainerrors.ErrARCUnreachable) ||
+		errors.Is(err, chainerrors.ErrARCUnauthorized) ||
+		errors.Is(err, chainerrors.ErrARCUnsupportedStatusCode) ||
+		errors.Is(err, spverrors.ErrInternal)
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/custominstructions"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/txmodels"
-	"github.com/bitcoin-sv/spv-wallet/models/bsv"
 )
 
 type customOutputsResolver struct {
@@ -64,12 +63,7 @@ func (c *customOutputsResolver) annotatedOutputs() iter.Seq2[string, txmodels.Ne
 				break
 			}
 
-			yield(interpreted.Address.AddressString, txmodels.NewOutputForP2PKH(
-				bsv.Outpoint{TxID: c.flow.txID, Vout: vout},
-				c.userID,
-				bsv.Satoshis(c.flow.tx.Outputs[vout].Satoshis),
-				*annotation.CustomInstructions,
-			))
+			yield(interpreted.Address.AddressString, c.flow.newUserOutput(vout, c.userID, *annotation.CustomInstructions))
 		}
 	}
 }
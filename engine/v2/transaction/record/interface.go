@@ -3,6 +3,7 @@ package record
 import (
 	"context"
 	"iter"
+	"time"
 
 	"github.com/bitcoin-sv/go-paymail"
 	primitives "github.com/bitcoin-sv/go-sdk/primitives/ec"
@@ -38,6 +39,11 @@ type TransactionsRepo interface {
 	// If all of them are found, the transaction data can be serialized into Raw HEX format.
 	// Otherwise, serialization should be done using the BEEFHex format.
 	HasTransactionInputSources(ctx context.Context, sourceTXIDs ...string) (bool, error)
+	// FindTransactionsToBroadcast returns CREATED transactions which are queued for broadcasting and whose next attempt is due.
+	FindTransactionsToBroadcast(ctx context.Context, limit int) ([]*txmodels.TrackedTransaction, error)
+	// UpdateBroadcastStatus updates the status of the transaction along with the information about broadcast attempts.
+	// The PROBLEMATIC status releases the inputs spent by the transaction and removes its outputs.
+	UpdateBroadcastStatus(ctx context.Context, txID string, status txmodels.TxStatus, attempts int, nextBroadcastAt *time.Time) error
 }
 
 // OperationsRepo is an interface for operations repository.
@@ -50,6 +56,16 @@ type Broadcaster interface {
 	Broadcast(ctx context.Context, tx *trx.Transaction) (*chainmodels.TXInfo, error)
 }
 
+// InvoicesService is an interface for matching the payments of paymail transactions to the invoices.
+type InvoicesService interface {
+	RecordPayment(ctx context.Context, reference, userID string, tx *trx.Transaction) error
+}
+
+// PaymailDestinationsService is an interface for marking the paymail destinations as paid by the received outputs.
+type PaymailDestinationsService interface {
+	MarkPaid(ctx context.Context, address string, outpoint bsv.Outpoint) error
+}
+
 // PaymailNotifier is an interface for notifying paymail recipients about incoming transactions.
 type PaymailNotifier interface {
 	Notify(ctx context.Context, address string, p2pMetadata *paymail.P2PMetaData, reference string, tx *trx.Transaction) error
@@ -1,9 +1,12 @@
 package record
 
 import (
+	"bytes"
+
 	"github.com/bitcoin-sv/go-sdk/script"
 	trx "github.com/bitcoin-sv/go-sdk/transaction"
 	"github.com/bitcoin-sv/spv-wallet/conv"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/data/bitcom"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/txmodels"
@@ -11,7 +14,7 @@ import (
 	"github.com/bitcoin-sv/spv-wallet/models/transaction/bucket"
 )
 
-func getDataFromOpReturn(lockingScript *script.Script) ([]byte, error) {
+func getPushesFromOpReturn(lockingScript *script.Script) ([][]byte, error) {
 	if !lockingScript.IsData() {
 		return nil, txerrors.ErrAnnotationMismatch
 	}
@@ -26,15 +29,15 @@ func getDataFromOpReturn(lockingScript *script.Script) ([]byte, error) {
 		startIndex = 1
 	}
 
-	var bytes []byte
+	pushes := make([][]byte, 0, len(chunks)-startIndex)
 	for _, chunk := range chunks[startIndex:] {
 		if chunk.Op > script.OpPUSHDATA4 || chunk.Op == script.OpZERO {
 			return nil, txerrors.ErrOnlyPushDataAllowed
 		}
-		bytes = append(bytes, chunk.Data...)
+		pushes = append(pushes, chunk.Data)
 	}
 
-	return bytes, nil
+	return pushes, nil
 }
 
 func processDataOutputs(tx *trx.Transaction, userID string, annotations *transaction.Annotations) ([]txmodels.NewOutput, error) {
@@ -56,11 +59,12 @@ func processDataOutputs(tx *trx.Transaction, userID string, annotations *transac
 
 		lockingScript := tx.Outputs[vout].LockingScript
 
-		data, err := getDataFromOpReturn(lockingScript)
+		pushes, err := getPushesFromOpReturn(lockingScript)
 		if err != nil {
 			return nil, err
 		}
-		dataOutputs = append(dataOutputs, txmodels.NewOutputForData(outpoint, userID, data))
+		data := bytes.Join(pushes, nil)
+		dataOutputs = append(dataOutputs, txmodels.NewOutputForData(outpoint, userID, data, bitcom.Parse(pushes)))
 	}
 
 	return dataOutputs, nil
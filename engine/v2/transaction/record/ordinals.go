@@ -0,0 +1,137 @@
+package record
+
+import (
+	"github.com/bitcoin-sv/spv-wallet/conv"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/ordinals"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/txmodels"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/bitcoin-sv/spv-wallet/models/transaction/bucket"
+)
+
+// inscriptionAddresses returns the owners of the 1-satoshi outputs holding an inscription.
+// Such outputs are also marked as ordinals (or tokens for BSV-21 inscriptions), so they are kept out of the coin selection.
+// NOTE: It must be called after processInputs, because BSV-21 transfers are verified against the token inputs.
+func (f *txFlow) inscriptionAddresses() addresses {
+	addrs := make(addresses)
+	for vout, output := range f.tx.Outputs {
+		if output.Satoshis != uint64(ordinals.OrdinalSatoshis) {
+			continue
+		}
+		inscription, address := ordinals.Parse(output.LockingScript)
+		if inscription == nil {
+			continue
+		}
+		voutU32, err := conv.IntToUint32(vout)
+		if err != nil {
+			f.service.logger.Warn().Err(err).Msg("failed to convert vout to uint32")
+			continue
+		}
+
+		if token := ordinals.ParseBSV21(inscription); token != nil && f.verifyTokenTransfer(token) {
+			f.tokenVouts[voutU32] = txmodels.Token{
+				ID:     token.TokenID(f.txID, voutU32),
+				Amount: token.Amount,
+			}
+		} else {
+			f.markOrdinal(voutU32)
+		}
+		addrs.append(address.AddressString, voutU32)
+	}
+	return addrs
+}
+
+// verifyTokenTransfer checks if the BSV-21 operation can be credited.
+// A deploy+mint creates a new token, so it is always valid.
+// A transfer is valid only if the inputs spend outputs of the same token holding enough of it (not yet transferred to the previous outputs):
+// known token UTXOs or, for the outputs not tracked by the wallet, the token inscriptions of the source transactions (see addTokensOfUntrackedInputs).
+// Unverified transfers are kept as ordinals, so they don't affect the token balances.
+func (f *txFlow) verifyTokenTransfer(token *ordinals.BSV21) bool {
+	if token.Op != ordinals.BSV21OpTransfer {
+		return true
+	}
+	available := f.tokenInputs[token.ID]
+	if available < token.Amount {
+		f.service.logger.Warn().
+			Str("txID", f.txID).
+			Str("tokenID", token.ID).
+			Uint64("amount", token.Amount).
+			Uint64("available", available).
+			Msg("Token transfer is not covered by the token inputs, the output is recorded as an unverified ordinal")
+		return false
+	}
+	f.tokenInputs[token.ID] = available - token.Amount
+	return true
+}
+
+// addTokensOfUntrackedInputs adds the BSV-21 tokens held by the spent outputs which are not tracked by the wallet
+// (e.g. tokens transferred from external wallets) to the token inputs; they are read from the source transactions provided with the transaction (BEEF).
+// NOTE: Only the direct ancestors are checked - the wallet is not a BSV-21 indexer, so the whole history of such token is not validated.
+func (f *txFlow) addTokensOfUntrackedInputs(trackedOutputs []txmodels.TrackedOutput) {
+	tracked := make(map[bsv.Outpoint]struct{}, len(trackedOutputs))
+	for _, output := range trackedOutputs {
+		tracked[*output.Outpoint()] = struct{}{}
+	}
+
+	for _, input := range f.tx.Inputs {
+		outpoint := bsv.Outpoint{TxID: input.SourceTXID.String(), Vout: input.SourceTxOutIndex}
+		if _, ok := tracked[outpoint]; ok || input.SourceTransaction == nil || int(input.SourceTxOutIndex) >= len(input.SourceTransaction.Outputs) {
+			continue
+		}
+		source := input.SourceTransaction.Outputs[input.SourceTxOutIndex]
+		if source.Satoshis != uint64(ordinals.OrdinalSatoshis) {
+			continue
+		}
+		inscription, _ := ordinals.Parse(source.LockingScript)
+		if token := ordinals.ParseBSV21(inscription); token != nil {
+			f.tokenInputs[token.TokenID(outpoint.TxID, outpoint.Vout)] += token.Amount
+		}
+	}
+}
+
+// markOrdinalOutputs marks the 1-satoshi outputs annotated with the ordinals bucket (e.g. transferred ordinals)
+// and remembers the ones annotated with the bsv bucket, so they are not treated as ordinals (see isOrdinal).
+func (f *txFlow) markOrdinalOutputs(annotations transaction.OutputsAnnotations) {
+	for vout, annotation := range annotations {
+		if int(vout) >= len(f.tx.Outputs) || f.tx.Outputs[vout].Satoshis != uint64(ordinals.OrdinalSatoshis) {
+			continue
+		}
+		switch annotation.Bucket {
+		case bucket.Ordinals:
+			f.markOrdinal(vout)
+		case bucket.BSV:
+			f.bsvVouts[vout] = struct{}{}
+		}
+	}
+}
+
+func (f *txFlow) markOrdinal(vout uint32) {
+	f.ordinalVouts[vout] = struct{}{}
+}
+
+// newUserOutput creates a spendable output of the user, placed in the tokens or ordinals bucket when it holds a token or an ordinal.
+func (f *txFlow) newUserOutput(vout uint32, userID string, customInstructions bsv.CustomInstructions) txmodels.NewOutput {
+	outpoint := bsv.Outpoint{TxID: f.txID, Vout: vout}
+	satoshis := bsv.Satoshis(f.tx.Outputs[vout].Satoshis)
+	if token, ok := f.tokenVouts[vout]; ok {
+		return txmodels.NewOutputForToken(outpoint, userID, satoshis, customInstructions, token)
+	}
+	if f.isOrdinal(vout) {
+		return txmodels.NewOutputForOrdinal(outpoint, userID, satoshis, customInstructions)
+	}
+	return txmodels.NewOutputForP2PKH(outpoint, userID, satoshis, customInstructions)
+}
+
+// isOrdinal tells if the output is kept in the ordinals bucket.
+// Besides the inscriptions and the outputs annotated as ordinals, it's every 1-satoshi P2PKH output not annotated with the bsv bucket:
+// an ordinal transferred to the user is indistinguishable from a 1-satoshi payment, so it's kept out of the coin selection to not burn it as a fee.
+func (f *txFlow) isOrdinal(vout uint32) bool {
+	if _, ok := f.ordinalVouts[vout]; ok {
+		return true
+	}
+	if _, ok := f.bsvVouts[vout]; ok {
+		return false
+	}
+	output := f.tx.Outputs[vout]
+	return output.Satoshis == uint64(ordinals.OrdinalSatoshis) && output.LockingScript.IsP2PKH()
+}
@@ -76,7 +76,7 @@ func (f *txFlow) processPaymailOutputs(annotations transaction.Annotations) (pay
 		if annotation.Paymail == nil {
 			continue
 		}
-		if annotation.Bucket != bucket.BSV {
+		if annotation.Bucket != bucket.BSV && annotation.Bucket != bucket.Ordinals && annotation.Bucket != bucket.Tokens {
 			continue
 		}
 
@@ -49,6 +49,16 @@ func (s *Service) RecordTransactionOutline(ctx context.Context, userID string, o
 	sender := pmInfo.Sender()
 	receiver := pmInfo.Receiver()
 
+	addressReceiver, err := flow.processAddressOutputs(outline.Annotations.Outputs)
+	if err != nil {
+		return nil, err
+	}
+	if receiver == "" {
+		receiver = addressReceiver
+	}
+
+	flow.markOrdinalOutputs(outline.Annotations.Outputs)
+
 	trackedOutputs, err := flow.processInputs()
 	if err != nil {
 		return nil, err
@@ -117,6 +127,7 @@ func (s *Service) RecordTransactionOutline(ctx context.Context, userID string, o
 	}
 
 	return &txmodels.RecordedOutline{
-		TxID: tx.TxID().String(),
+		TxID:     tx.TxID().String(),
+		TxStatus: flow.txRow.TxStatus,
 	}, nil
 }
@@ -8,8 +8,10 @@ import (
 	txerrors "github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
 )
 
-// RecordPaymailTransaction will validate, broadcast and save paymail transaction
-func (s *Service) RecordPaymailTransaction(ctx context.Context, tx *trx.Transaction, senderPaymail, receiverPaymail string) error {
+// RecordPaymailTransaction will validate, broadcast and save paymail transaction.
+// The senderPubKey is the public key of the sender verified against the sender's PKI (empty if the sender was not verified).
+// The payment is matched to the invoice of the receiver when the reference is the reference of the invoice.
+func (s *Service) RecordPaymailTransaction(ctx context.Context, tx *trx.Transaction, senderPaymail, senderPubKey, receiverPaymail, reference string) error {
 	flow, err := newTxFlow(ctx, s, tx)
 	if err != nil {
 		return err
@@ -33,13 +35,16 @@ func (s *Service) RecordPaymailTransaction(ctx context.Context, tx *trx.Transact
 		return err
 	}
 
+	var receiverUserID string
 	for outputData := range p2pkhOutputs {
 		operation := flow.operationOfUser(outputData.UserID, "incoming", senderPaymail)
 		if len(flow.operations) > 2 {
 			return txerrors.ErrMultiPaymailRecipientsNotSupported
 		}
+		operation.SenderPubKey = senderPubKey
 		operation.Add(outputData.Satoshis)
 		flow.addOutputs(outputData)
+		receiverUserID = outputData.UserID
 	}
 
 	if err = flow.verify(); err != nil {
@@ -50,5 +55,15 @@ func (s *Service) RecordPaymailTransaction(ctx context.Context, tx *trx.Transact
 		return err
 	}
 
-	return flow.save()
+	if err = flow.save(); err != nil {
+		return err
+	}
+
+	if reference != "" && receiverUserID != "" {
+		if err = s.invoices.RecordPayment(ctx, reference, receiverUserID, tx); err != nil {
+			// the transaction is already recorded, so the invoice is not a reason to fail
+			s.logger.Warn().Err(err).Str("txID", flow.txID).Msg("Cannot record the payment of the invoice")
+		}
+	}
+	return nil
 }
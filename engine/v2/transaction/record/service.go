@@ -4,6 +4,7 @@ import (
 	"context"
 	"iter"
 
+	"github.com/bitcoin-sv/spv-wallet/config"
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/txmodels"
 	"github.com/rs/zerolog"
@@ -19,7 +20,12 @@ type Service struct {
 
 	broadcaster     Broadcaster
 	paymailNotifier PaymailNotifier
+	invoices        InvoicesService
+	destinations    PaymailDestinationsService
 	logger          zerolog.Logger
+
+	// asyncBroadcast is set when transactions should be queued for broadcasting instead of broadcasted during recording.
+	asyncBroadcast *config.ARCAsyncBroadcastConfig
 }
 
 // NewService creates a new service for transactions
@@ -32,7 +38,14 @@ func NewService(
 	transactionsRepo TransactionsRepo,
 	broadcaster Broadcaster,
 	paymailNotifier PaymailNotifier,
+	invoices InvoicesService,
+	destinations PaymailDestinationsService,
+	asyncBroadcast *config.ARCAsyncBroadcastConfig,
 ) *Service {
+	if asyncBroadcast != nil && !asyncBroadcast.Enabled {
+		asyncBroadcast = nil
+	}
+
 	return &Service{
 		addresses:       addresses,
 		users:           users,
@@ -42,6 +55,9 @@ func NewService(
 		transactions:    transactionsRepo,
 		logger:          logger,
 		paymailNotifier: paymailNotifier,
+		invoices:        invoices,
+		destinations:    destinations,
+		asyncBroadcast:  asyncBroadcast,
 	}
 }
 
@@ -0,0 +1,30 @@
+package record
+
+import (
+	"github.com/bitcoin-sv/spv-wallet/conv"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/stas"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/txmodels"
+)
+
+// appendSTASAddresses appends the owners of the outputs holding STAS tokens and marks such outputs as tokens,
+// so they are kept out of the coin selection. The amount of the token is the value of the output.
+// Unlike BSV-21 transfers, they are not verified against the token inputs - the STAS contract enforces that the transfers preserve the tokens.
+func (f *txFlow) appendSTASAddresses(addrs addresses) {
+	for vout, output := range f.tx.Outputs {
+		token, owner := stas.Parse(output.LockingScript)
+		if token == nil {
+			continue
+		}
+		voutU32, err := conv.IntToUint32(vout)
+		if err != nil {
+			f.service.logger.Warn().Err(err).Msg("failed to convert vout to uint32")
+			continue
+		}
+
+		f.tokenVouts[voutU32] = txmodels.Token{
+			ID:     token.ID,
+			Amount: output.Satoshis,
+		}
+		addrs.append(owner.AddressString, voutU32)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"iter"
 	"maps"
+	"time"
 
 	"github.com/bitcoin-sv/go-sdk/spv"
 	trx "github.com/bitcoin-sv/go-sdk/transaction"
@@ -12,6 +13,7 @@ import (
 	txerrors "github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/txmodels"
 	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/samber/lo"
 )
 
 type txFlow struct {
@@ -23,6 +25,17 @@ type txFlow struct {
 	txID  string
 
 	operations map[string]*txmodels.NewOperation
+
+	// ordinalVouts are the outputs holding ordinals
+	ordinalVouts map[uint32]struct{}
+	// bsvVouts are the 1-satoshi outputs explicitly annotated with the bsv bucket (so they are not treated as ordinals)
+	bsvVouts map[uint32]struct{}
+	// tokenVouts are the outputs holding BSV-21 or STAS tokens
+	tokenVouts map[uint32]txmodels.Token
+	// trackedAddressOutputs are the outputs created for the tracked addresses (which can be paymail destinations)
+	trackedAddressOutputs map[bsv.Outpoint]string
+	// tokenInputs are the amounts of the tokens spent by the inputs (known token UTXOs or token outputs of the source transactions) which can be transferred to the outputs
+	tokenInputs map[string]uint64
 }
 
 func newTxFlow(ctx context.Context, service *Service, tx *trx.Transaction) (*txFlow, error) {
@@ -38,7 +51,13 @@ func newTxFlow(ctx context.Context, service *Service, tx *trx.Transaction) (*txF
 			TxStatus: txmodels.TxStatusCreated,
 		},
 
-		operations: map[string]*txmodels.NewOperation{},
+		operations:   map[string]*txmodels.NewOperation{},
+		ordinalVouts: map[uint32]struct{}{},
+		bsvVouts:     map[uint32]struct{}{},
+		tokenVouts:   map[uint32]txmodels.Token{},
+		tokenInputs:  map[string]uint64{},
+
+		trackedAddressOutputs: map[bsv.Outpoint]string{},
 	}
 
 	if err := f.setHex(); err != nil {
@@ -109,6 +128,13 @@ func (f *txFlow) processInputs() ([]txmodels.TrackedOutput, error) {
 		}
 	}
 
+	for _, output := range trackedOutputs {
+		if output.Token != nil {
+			f.tokenInputs[output.Token.ID] += output.Token.Amount
+		}
+	}
+	f.addTokensOfUntrackedInputs(trackedOutputs)
+
 	f.txRow.AddInputs(trackedOutputs...)
 
 	return trackedOutputs, nil
@@ -132,8 +158,10 @@ func (f *txFlow) addOutputs(outputs ...txmodels.NewOutput) {
 	f.txRow.AddOutputs(outputs...)
 }
 
+// allP2PKHAddresses returns the addresses of P2PKH outputs and the owners of inscriptions and STAS tokens.
 func (f *txFlow) allP2PKHAddresses() addresses {
-	addrs := make(addresses)
+	addrs := f.inscriptionAddresses()
+	f.appendSTASAddresses(addrs)
 	for vout, output := range f.tx.Outputs {
 		lockingScript := output.LockingScript
 		if !lockingScript.IsP2PKH() {
@@ -169,12 +197,8 @@ func (f *txFlow) createUTXOsForTrackedAddresses(potentialTrackedUniqueAddresses
 				continue
 			}
 			for voutsContainingAddress := range addrInfo.vouts {
-				yield(txmodels.NewOutputForP2PKH(
-					bsv.Outpoint{TxID: f.txID, Vout: voutsContainingAddress},
-					tracked.UserID,
-					bsv.Satoshis(f.tx.Outputs[voutsContainingAddress].Satoshis),
-					tracked.CustomInstructions,
-				))
+				f.trackedAddressOutputs[bsv.Outpoint{TxID: f.txID, Vout: voutsContainingAddress}] = tracked.Address
+				yield(f.newUserOutput(voutsContainingAddress, tracked.UserID, tracked.CustomInstructions))
 			}
 		}
 	}, nil
@@ -188,6 +212,12 @@ func (f *txFlow) verify() error {
 }
 
 func (f *txFlow) broadcast() error {
+	if f.service.asyncBroadcast != nil {
+		// the transaction is saved as CREATED and broadcasted by the background task (see BroadcastPending)
+		f.txRow.NextBroadcastAt = lo.ToPtr(time.Now())
+		return nil
+	}
+
 	txInfo, err := f.service.broadcaster.Broadcast(f.ctx, f.tx)
 	if err != nil {
 		return txerrors.ErrTxBroadcast.Wrap(err)
@@ -203,5 +233,20 @@ func (f *txFlow) broadcast() error {
 }
 
 func (f *txFlow) save() error {
-	return f.service.SaveOperations(f.ctx, maps.Values(f.operations))
+	if err := f.service.SaveOperations(f.ctx, maps.Values(f.operations)); err != nil {
+		return err
+	}
+	f.markPaymailDestinationsPaid()
+	return nil
+}
+
+// markPaymailDestinationsPaid marks the paymail destinations (if any) of the tracked addresses as paid by the received outputs,
+// so the payments are recorded for every way of obtaining the destination (not only for P2P transactions).
+func (f *txFlow) markPaymailDestinationsPaid() {
+	for outpoint, address := range f.trackedAddressOutputs {
+		if err := f.service.destinations.MarkPaid(f.ctx, address, outpoint); err != nil {
+			// the transaction is already recorded, so the missing payment of the destination is not a reason to fail
+			f.service.logger.Warn().Err(err).Str("address", address).Str("txID", f.txID).Msg("Cannot mark paymail destination as paid")
+		}
+	}
 }
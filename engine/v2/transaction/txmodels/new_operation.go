@@ -13,6 +13,9 @@ type NewOperation struct {
 	Type         string
 	Value        int64
 
+	// SenderPubKey is the public key of the counterparty (sender) verified against its paymail PKI.
+	SenderPubKey string
+
 	Transaction *NewTransaction
 }
 
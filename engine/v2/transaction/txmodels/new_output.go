@@ -1,7 +1,9 @@
 package txmodels
 
 import (
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/data/bitcom"
 	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/bitcoin-sv/spv-wallet/models/transaction/bucket"
 )
 
 // EstimatedInputSizeForP2PKH is the estimated size increase when adding and unlocking P2PKH input to transaction.
@@ -19,6 +21,15 @@ type SpendableUTXO struct {
 
 	// CustomInstructions is the list of instructions for unlocking given UTXO (it should be understood by client).
 	CustomInstructions bsv.CustomInstructions
+
+	// Token is the amount of the BSV-21 or STAS token held by the UTXO (nil for other outputs).
+	Token *Token
+}
+
+// Token holds the amount of the BSV-21 or STAS token.
+type Token struct {
+	ID     string
+	Amount uint64
 }
 
 // NewOutput holds the data for creating a new output.
@@ -31,7 +42,8 @@ type NewOutput struct {
 
 	UTXO *SpendableUTXO
 
-	Data []byte
+	Data      []byte
+	Protocols []bitcom.Protocol
 }
 
 // NewOutputForP2PKH creates a new output for P2PKH address.
@@ -49,12 +61,28 @@ func NewOutputForP2PKH(outpoint bsv.Outpoint, userID string, satoshis bsv.Satosh
 	}
 }
 
-// NewOutputForData creates a new output for data.
-func NewOutputForData(outpoint bsv.Outpoint, userID string, data []byte) NewOutput {
+// NewOutputForOrdinal creates a new output for the 1Sat Ordinal locked to P2PKH address.
+func NewOutputForOrdinal(outpoint bsv.Outpoint, userID string, satoshis bsv.Satoshis, customInstructions bsv.CustomInstructions) NewOutput {
+	output := NewOutputForP2PKH(outpoint, userID, satoshis, customInstructions)
+	output.Bucket = bucket.Ordinals.String()
+	return output
+}
+
+// NewOutputForToken creates a new output holding the amount of the BSV-21 or STAS token.
+func NewOutputForToken(outpoint bsv.Outpoint, userID string, satoshis bsv.Satoshis, customInstructions bsv.CustomInstructions, token Token) NewOutput {
+	output := NewOutputForP2PKH(outpoint, userID, satoshis, customInstructions)
+	output.Bucket = bucket.Tokens.String()
+	output.UTXO.Token = &token
+	return output
+}
+
+// NewOutputForData creates a new output for data with the Bitcom protocols recognised in it.
+func NewOutputForData(outpoint bsv.Outpoint, userID string, data []byte, protocols []bitcom.Protocol) NewOutput {
 	return NewOutput{
-		UserID: userID,
-		TxID:   outpoint.TxID,
-		Vout:   outpoint.Vout,
-		Data:   data,
+		UserID:    userID,
+		TxID:      outpoint.TxID,
+		Vout:      outpoint.Vout,
+		Data:      data,
+		Protocols: protocols,
 	}
 }
@@ -1,6 +1,10 @@
 package txmodels
 
-import "github.com/samber/lo"
+import (
+	"time"
+
+	"github.com/samber/lo"
+)
 
 // TransactionInputSource represents a link between a transaction and its source transaction.
 // It is used to track which transaction inputs originate from which previous transactions.
@@ -20,6 +24,9 @@ type NewTransaction struct {
 	Inputs  []TrackedOutput
 	Outputs []NewOutput
 
+	// NextBroadcastAt is set when the transaction is queued for asynchronous broadcasting.
+	NextBroadcastAt *time.Time
+
 	transactionInputSources []TransactionInputSource
 	beefHex                 string
 	rawHex                  string
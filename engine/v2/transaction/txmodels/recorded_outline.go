@@ -2,5 +2,6 @@ package txmodels
 
 // RecordedOutline is a result of a transaction recording.
 type RecordedOutline struct {
-	TxID string
+	TxID     string
+	TxStatus TxStatus
 }
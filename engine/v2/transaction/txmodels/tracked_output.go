@@ -16,6 +16,9 @@ type TrackedOutput struct {
 
 	Satoshis bsv.Satoshis
 
+	// Token is the BSV-21 or STAS token held by the output (nil if the output is not an unspent token UTXO of the user).
+	Token *Token
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -21,6 +21,9 @@ type TrackedTransaction struct {
 
 	BeefHex *string
 	RawHex  *string
+
+	BroadcastAttempts int
+	NextBroadcastAt   *time.Time
 }
 
 // TX returns the transaction object for the tracked transaction based on the hex representation (either BEEF or raw).
@@ -200,3 +200,57 @@ func TestDoNothingOnCallbackWithOldStatus(t *testing.T) {
 	// then:
 	then.WithNoError(err).TransactionNotUpdated()
 }
+
+func TestNotUpdateOnMinedTxUnconfirmedByBHS(t *testing.T) {
+	tests := map[string]struct {
+		setupBHS func(bhs testabilities.BHSFixtures)
+	}{
+		"merkle root not confirmed": {
+			setupBHS: func(bhs testabilities.BHSFixtures) { bhs.WillNotConfirmMerkleRoot() },
+		},
+		"BHS unable to verify merkle root": {
+			setupBHS: func(bhs testabilities.BHSFixtures) { bhs.WillBeUnableToVerifyMerkleRoot() },
+		},
+		"BHS fails": {
+			setupBHS: func(bhs testabilities.BHSFixtures) { bhs.WillFail() },
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			given, then := testabilities.New(t)
+			// given:
+			service := given.Service()
+
+			// and:
+			given.Repo().ContainsBroadcastedTx(testabilities.FormatBEEF)
+			test.setupBHS(given.BHS())
+
+			// and:
+			spec := testabilities.MinedTXInfo(t)
+
+			// when:
+			err := service.Handle(context.Background(), chainmodels.TXInfo(spec))
+
+			// then:
+			then.WithError(err).TransactionNotUpdated()
+		})
+	}
+}
+
+func TestRejectCallbackWithTimestampFromFuture(t *testing.T) {
+	given, then := testabilities.New(t)
+	// given:
+	service := given.Service()
+
+	// and:
+	given.Repo().ContainsBroadcastedTx(testabilities.FormatBEEF)
+
+	// and:
+	spec := testabilities.TXInfo(t, chainmodels.Rejected).WithTimestamp(time.Now().Add(24 * time.Hour))
+
+	// when:
+	err := service.Handle(context.Background(), chainmodels.TXInfo(spec))
+
+	// then:
+	then.WithError(err).TransactionNotUpdated()
+}
@@ -3,6 +3,8 @@ package txsync
 import (
 	"context"
 
+	"github.com/bitcoin-sv/go-paymail/spv"
+	chainmodels "github.com/bitcoin-sv/spv-wallet/engine/chain/models"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/txmodels"
 )
 
@@ -11,3 +13,8 @@ type TransactionsRepo interface {
 	UpdateTransaction(ctx context.Context, trackedTx *txmodels.TrackedTransaction) error
 	GetTransaction(ctx context.Context, txID string) (transaction *txmodels.TrackedTransaction, err error)
 }
+
+// MerkleRootsConfirmer is an interface for confirming merkle roots against the block headers (BHS).
+type MerkleRootsConfirmer interface {
+	ConfirmMerkleRoots(ctx context.Context, merkleRoots []*spv.MerkleRootConfirmationRequestItem) (chainmodels.MerkleRootConfirmationState, error)
+}
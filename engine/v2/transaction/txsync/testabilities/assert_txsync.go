@@ -10,7 +10,11 @@ import (
 
 type AssertTXsync interface {
 	WithNoError(err error) AssertSucceededTXsync
-	WithError(err error)
+	WithError(err error) AssertFailedTXsync
+}
+
+type AssertFailedTXsync interface {
+	TransactionNotUpdated()
 }
 
 type AssertSucceededTXsync interface {
@@ -39,8 +43,9 @@ type assertTXsync struct {
 	given   *fixtureTXsync
 }
 
-func (a *assertTXsync) WithError(err error) {
+func (a *assertTXsync) WithError(err error) AssertFailedTXsync {
 	require.Error(a.t, err)
+	return a
 }
 
 func (a *assertTXsync) WithNoError(err error) AssertSucceededTXsync {
@@ -19,14 +19,17 @@ const mockBlockHeight = 885803
 type FixtureTXsync interface {
 	Service() *txsync.Service
 	Repo() RepoFixtures
+	BHS() BHSFixtures
 }
 
 func Given(t testing.TB) FixtureTXsync {
 	repo := newMockRepo(t)
+	bhs := newMockBHS()
 	return &fixtureTXsync{
 		t:       t,
 		repo:    repo,
-		service: txsync.NewService(tester.Logger(t), repo),
+		bhs:     bhs,
+		service: txsync.NewService(tester.Logger(t), repo, bhs),
 		givenTx: txtestability.Given(t),
 	}
 }
@@ -41,6 +44,7 @@ type fixtureTXsync struct {
 	givenTx txtestability.TransactionsFixtures
 	service *txsync.Service
 	repo    *MockRepo
+	bhs     *MockBHS
 }
 
 func (f *fixtureTXsync) Service() *txsync.Service {
@@ -51,6 +55,10 @@ func (f *fixtureTXsync) Repo() RepoFixtures {
 	return f.repo
 }
 
+func (f *fixtureTXsync) BHS() BHSFixtures {
+	return f.bhs
+}
+
 func TXInfo(t testing.TB, status chainmodels.TXStatus) TXInfoSpec {
 
 	return TXInfoSpec{
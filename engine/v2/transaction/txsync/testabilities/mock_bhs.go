@@ -0,0 +1,43 @@
+package testabilities
+
+import (
+	"context"
+
+	"github.com/bitcoin-sv/go-paymail/spv"
+	chainmodels "github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+)
+
+type BHSFixtures interface {
+	WillNotConfirmMerkleRoot()
+	WillBeUnableToVerifyMerkleRoot()
+	WillFail()
+}
+
+type MockBHS struct {
+	state chainmodels.MerkleRootConfirmationState
+	fail  bool
+}
+
+func newMockBHS() *MockBHS {
+	return &MockBHS{state: chainmodels.MRConfirmed}
+}
+
+func (m *MockBHS) WillNotConfirmMerkleRoot() {
+	m.state = chainmodels.MRInvalid
+}
+
+func (m *MockBHS) WillBeUnableToVerifyMerkleRoot() {
+	m.state = chainmodels.MRUnableToVerify
+}
+
+func (m *MockBHS) WillFail() {
+	m.fail = true
+}
+
+func (m *MockBHS) ConfirmMerkleRoots(_ context.Context, _ []*spv.MerkleRootConfirmationRequestItem) (chainmodels.MerkleRootConfirmationState, error) {
+	if m.fail {
+		return "", spverrors.Newf("ConfirmMerkleRoots failed")
+	}
+	return m.state, nil
+}
@@ -2,7 +2,9 @@ package txsync
 
 import (
 	"context"
+	"time"
 
+	"github.com/bitcoin-sv/go-paymail/spv"
 	trx "github.com/bitcoin-sv/go-sdk/transaction"
 	chainmodels "github.com/bitcoin-sv/spv-wallet/engine/chain/models"
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
@@ -10,17 +12,24 @@ import (
 	"github.com/rs/zerolog"
 )
 
+// maxCallbackTimestampDrift is how far in the future the callback timestamp can be.
+// Callbacks older than the last update of the transaction are ignored,
+// so a callback from the future could block (or be replayed over) the following updates.
+const maxCallbackTimestampDrift = 15 * time.Minute
+
 // Service is meant to handle the ARC callback and update the transaction status in the database.
 type Service struct {
-	logger           zerolog.Logger
-	transactionsRepo TransactionsRepo
+	logger               zerolog.Logger
+	transactionsRepo     TransactionsRepo
+	merkleRootsConfirmer MerkleRootsConfirmer
 }
 
 // NewService creates a new transaction sync service.
-func NewService(logger zerolog.Logger, transactionsRepo TransactionsRepo) *Service {
+func NewService(logger zerolog.Logger, transactionsRepo TransactionsRepo, merkleRootsConfirmer MerkleRootsConfirmer) *Service {
 	return &Service{
-		transactionsRepo: transactionsRepo,
-		logger:           logger,
+		transactionsRepo:     transactionsRepo,
+		logger:               logger,
+		merkleRootsConfirmer: merkleRootsConfirmer,
 	}
 }
 
@@ -32,6 +41,10 @@ func (s *Service) Handle(ctx context.Context, txInfo chainmodels.TXInfo) error {
 		return spverrors.Newf("Received ARC callback with empty transaction ID")
 	}
 
+	if txInfo.Timestamp.After(time.Now().Add(maxCallbackTimestampDrift)) {
+		return spverrors.Newf("Received ARC callback for transaction %s with timestamp from the future: %s", txInfo.TxID, txInfo.Timestamp)
+	}
+
 	trackedTx, err := s.transactionsRepo.GetTransaction(ctx, txInfo.TxID)
 	if err != nil {
 		return spverrors.Wrapf(err, "failed to get transaction %s", txInfo.TxID)
@@ -65,6 +78,10 @@ func (s *Service) Handle(ctx context.Context, txInfo chainmodels.TXInfo) error {
 		return spverrors.Newf("Block height in BUMP doesn't match the block height in the callback")
 	}
 
+	if err = s.verifyMerkleRoot(ctx, bump, txInfo.TxID); err != nil {
+		return err
+	}
+
 	if trackedTx.BlockHash != nil && *trackedTx.BlockHash != txInfo.BlockHash {
 		s.logger.Info().
 			Str("TxID", txInfo.TxID).
@@ -97,3 +114,31 @@ func parseMerklePath(merklePath string, txID string) (*trx.MerklePath, error) {
 
 	return bump, nil
 }
+
+// verifyMerkleRoot checks if the BUMP from the callback leads to the merkle root of a block confirmed by BHS,
+// so a forged callback cannot mark the transaction as MINED.
+// Only the confirmed merkle root is accepted - when BHS is unable to verify it (e.g. BHS is behind or down),
+// the transaction status is left unchanged, so it can be updated by the next callback or the transaction sync.
+func (s *Service) verifyMerkleRoot(ctx context.Context, bump *trx.MerklePath, txID string) error {
+	merkleRoot, err := bump.ComputeRootHex(&txID)
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to compute merkle root for transaction %s", txID)
+	}
+
+	state, err := s.merkleRootsConfirmer.ConfirmMerkleRoots(ctx, []*spv.MerkleRootConfirmationRequestItem{{
+		MerkleRoot:  merkleRoot,
+		BlockHeight: uint64(bump.BlockHeight),
+	}})
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to verify merkle root for transaction %s", txID)
+	}
+
+	switch state {
+	case chainmodels.MRConfirmed:
+		return nil
+	case chainmodels.MRUnableToVerify:
+		return spverrors.Newf("Merkle root %s from ARC callback for transaction %s cannot be verified by BHS yet", merkleRoot, txID)
+	default:
+		return spverrors.Newf("Merkle root %s from ARC callback for transaction %s is not confirmed by BHS", merkleRoot, txID)
+	}
+}
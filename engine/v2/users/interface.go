@@ -15,4 +15,10 @@ type UserRepo interface {
 	Get(ctx context.Context, userID string) (*usersmodels.User, error)
 	Create(ctx context.Context, newUser *usersmodels.NewUser) (*usersmodels.User, error)
 	GetBalance(ctx context.Context, userID string, name bucket.Name) (bsv.Satoshis, error)
+	GetTokenBalances(ctx context.Context, userID string) ([]usersmodels.TokenBalance, error)
+}
+
+// DomainChecker checks if paymails can be created in the domain.
+type DomainChecker interface {
+	CheckDomain(ctx context.Context, domain string) error
 }
@@ -4,7 +4,6 @@ import (
 	"context"
 
 	primitives "github.com/bitcoin-sv/go-sdk/primitives/ec"
-	"github.com/bitcoin-sv/spv-wallet/config"
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/users/usersmodels"
 	"github.com/bitcoin-sv/spv-wallet/models/bsv"
@@ -14,21 +13,21 @@ import (
 // Service is a user domain service
 type Service struct {
 	usersRepo UserRepo
-	config    *config.AppConfig
+	domains   DomainChecker
 }
 
 // NewService creates a new user service
-func NewService(users UserRepo, cfg *config.AppConfig) *Service {
+func NewService(users UserRepo, domains DomainChecker) *Service {
 	return &Service{
 		usersRepo: users,
-		config:    cfg,
+		domains:   domains,
 	}
 }
 
 // Create creates a new user
 func (s *Service) Create(ctx context.Context, newUser *usersmodels.NewUser) (*usersmodels.User, error) {
 	if newUser.Paymail != nil {
-		if err := s.config.Paymail.CheckDomain(newUser.Paymail.Domain); err != nil {
+		if err := s.domains.CheckDomain(ctx, newUser.Paymail.Domain); err != nil {
 			return nil, spverrors.Wrapf(err, "invalid domain during user creation")
 		}
 		if err := newUser.Paymail.ValidateAvatar(); err != nil {
@@ -87,6 +86,15 @@ func (s *Service) GetPubKey(ctx context.Context, userID string) (*primitives.Pub
 	return pubKey, nil
 }
 
+// GetTokenBalances returns the balances of the BSV-21 and STAS tokens held by the user
+func (s *Service) GetTokenBalances(ctx context.Context, userID string) ([]usersmodels.TokenBalance, error) {
+	balances, err := s.usersRepo.GetTokenBalances(ctx, userID)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "Cannot get user's token balances")
+	}
+	return balances, nil
+}
+
 // GetBalance returns current balance for the user
 func (s *Service) GetBalance(ctx context.Context, userID string) (bsv.Satoshis, error) {
 	balance, err := s.usersRepo.GetBalance(ctx, userID, bucket.BSV)
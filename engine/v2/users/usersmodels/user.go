@@ -24,6 +24,12 @@ type User struct {
 	Paymails  []*paymailsmodels.Paymail
 }
 
+// TokenBalance is the amount of the BSV-21 or STAS token held by the user.
+type TokenBalance struct {
+	TokenID string
+	Amount  uint64
+}
+
 // PubKeyObj returns the go-sdk primitives.PublicKey object from the user's PubKey string
 func (u *User) PubKeyObj() (*primitives.PublicKey, error) {
 	pub, err := primitives.PublicKeyFromString(u.PublicKey)
@@ -14,6 +14,7 @@ require (
 	github.com/bitcoin-sv/go-paymail v0.23.0
 	github.com/bitcoin-sv/go-sdk v1.1.18
 	github.com/bitcoin-sv/spv-wallet/models v0.28.0
+	github.com/bitcoinschema/go-bpu v0.2.1
 	github.com/bitcoinschema/go-map v0.2.1
 	github.com/coocood/freecache v1.2.4
 	github.com/fergusstrange/embedded-postgres v1.30.0
@@ -80,7 +81,6 @@ require (
 	github.com/KyleBanks/depth v1.2.1 // indirect
 	github.com/asaskevich/govalidator v0.0.0-20230301143203-a9d515a09cc2 // indirect
 	github.com/beorn7/perks v1.0.1 // indirect
-	github.com/bitcoinschema/go-bpu v0.2.1 // indirect
 	github.com/bsm/redislock v0.9.4 // indirect
 	github.com/bytedance/sonic v1.12.6 // indirect
 	github.com/capnm/sysinfo v0.0.0-20130621111458-5909a53897f3 // indirect
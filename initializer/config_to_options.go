@@ -59,8 +59,12 @@ func ToEngineOptions(c *config.AppConfig, logger zerolog.Logger) (options []engi
 
 	options = addBHSOpts(c, options)
 
+	options = addRegtestOpts(c, options)
+
 	options = addCustomFeeUnit(c, options)
 
+	options = addFeeUnitRefreshOpts(c, options)
+
 	return options, nil
 }
 
@@ -76,6 +80,13 @@ func addHttpClientOpts(c *config.AppConfig, options []engine.ClientOps) []engine
 	return append(options, engine.WithHTTPClient(client))
 }
 
+func addFeeUnitRefreshOpts(c *config.AppConfig, options []engine.ClientOps) []engine.ClientOps {
+	if c.ARC != nil && c.ARC.FeeUnitRefreshInterval > 0 {
+		options = append(options, engine.WithFeeUnitRefresh(c.ARC.FeeUnitRefreshInterval))
+	}
+	return options
+}
+
 func addCustomFeeUnit(c *config.AppConfig, options []engine.ClientOps) []engine.ClientOps {
 	if c.CustomFeeUnit != nil {
 		satoshis, err := conv.IntToUint64(c.CustomFeeUnit.Satoshis)
@@ -280,6 +291,24 @@ func addARCOpts(c *config.AppConfig, options []engine.ClientOps) ([]engine.Clien
 		Token:        c.ARC.Token,
 		DeploymentID: c.ARC.DeploymentID,
 		WaitFor:      c.ARC.WaitForStatus,
+
+		BroadcastBEEF:    c.ARC.BroadcastBEEF,
+		BroadcastPolicy:  chainmodels.ARCBroadcastPolicy(c.ARC.BroadcastPolicy),
+		EndpointCooldown: c.ARC.EndpointCooldown,
+	}
+
+	if c.ARC.Batch != nil && c.ARC.Batch.Enabled {
+		arcCfg.Batch = &chainmodels.ARCBatchConfig{
+			MaxSize:  c.ARC.Batch.MaxSize,
+			Interval: c.ARC.Batch.Interval,
+		}
+	}
+
+	for _, endpoint := range c.ARC.Endpoints {
+		arcCfg.Endpoints = append(arcCfg.Endpoints, chainmodels.ARCEndpoint{
+			URL:   endpoint.URL,
+			Token: endpoint.Token,
+		})
 	}
 
 	if c.ARCCallbackEnabled() {
@@ -295,8 +324,24 @@ func addARCOpts(c *config.AppConfig, options []engine.ClientOps) ([]engine.Clien
 			return nil, spverrors.Wrapf(err, "error while getting callback url")
 		}
 		arcCfg.Callback = &chainmodels.ARCCallbackConfig{
-			URL:   callbackURL.String(),
-			Token: c.ARC.Callback.Token,
+			URL:                 callbackURL.String(),
+			Token:               c.ARC.Callback.Token,
+			TokenPerTransaction: c.ARC.Callback.TokenPerTransaction,
+		}
+	}
+
+	for _, provider := range c.ARC.TxsProviders {
+		arcCfg.TxsProviders = append(arcCfg.TxsProviders, chainmodels.TxsProviderConfig{
+			Type:    chainmodels.TxsProviderType(provider.Type),
+			URL:     provider.URL,
+			Token:   provider.Token,
+			Timeout: provider.Timeout,
+		})
+	}
+
+	if c.ARC.TxsCache != nil && c.ARC.TxsCache.Enabled {
+		arcCfg.TxsCache = &chainmodels.TxsCacheConfig{
+			TTL: c.ARC.TxsCache.TTL,
 		}
 	}
 
@@ -307,6 +352,28 @@ func addARCOpts(c *config.AppConfig, options []engine.ClientOps) ([]engine.Clien
 	return append(options, engine.WithARC(arcCfg)), nil
 }
 
+func addRegtestOpts(c *config.AppConfig, options []engine.ClientOps) []engine.ClientOps {
+	if !c.IsRegtest() {
+		return options
+	}
+	regtestCfg := chainmodels.RegtestConfig{}
+	if c.Chain.Regtest != nil {
+		regtestCfg.BlockInterval = c.Chain.Regtest.BlockInterval
+	}
+	return append(options, engine.WithRegtestChain(regtestCfg))
+}
+
 func addBHSOpts(c *config.AppConfig, options []engine.ClientOps) []engine.ClientOps {
-	return append(options, engine.WithBHS(c.BHS.URL, c.BHS.AuthToken))
+	options = append(options, engine.WithBHS(c.BHS.URL, c.BHS.AuthToken))
+	if c.BHS.MerkleRootsCache != nil && c.BHS.MerkleRootsCache.Enabled {
+		options = append(options, engine.WithBHSMerkleRootsCache(c.BHS.MerkleRootsCache.TTL))
+	}
+	if cb := c.BHS.CircuitBreaker; cb != nil && cb.Enabled {
+		whenDown := chainmodels.BHSDownPolicy(cb.WhenDown)
+		options = append(options, engine.WithBHSCircuitBreaker(cb.FailureThreshold, cb.OpenTimeout, whenDown))
+	}
+	if c.BHS.Embedded {
+		options = append(options, engine.WithEmbeddedBHS(c.ImportBlockHeaders))
+	}
+	return options
 }
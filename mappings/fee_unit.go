@@ -1,6 +1,7 @@
 package mappings
 
 import (
+	"github.com/bitcoin-sv/spv-wallet/engine/feeunit"
 	"github.com/bitcoin-sv/spv-wallet/models/bsv"
 	"github.com/bitcoin-sv/spv-wallet/models/response"
 )
@@ -28,3 +29,23 @@ func MapFeeUnitModelToEngine(fu *response.FeeUnit) (fc *bsv.FeeUnit) {
 		Bytes:    fu.Bytes,
 	}
 }
+
+// MapToFeeUnitPolicyContract will map the current fee unit and its history to the spv-wallet-models contract
+func MapToFeeUnitPolicyContract(current feeunit.Change, history []feeunit.Change) *response.FeeUnitPolicy {
+	policy := &response.FeeUnitPolicy{
+		Current: mapToFeeUnitChangeContract(current),
+		History: make([]response.FeeUnitChange, 0, len(history)),
+	}
+	for _, change := range history {
+		policy.History = append(policy.History, mapToFeeUnitChangeContract(change))
+	}
+	return policy
+}
+
+func mapToFeeUnitChangeContract(change feeunit.Change) response.FeeUnitChange {
+	return response.FeeUnitChange{
+		FeeUnit:   *MapToFeeUnitContract(&change.FeeUnit),
+		Source:    string(change.Source),
+		CreatedAt: change.CreatedAt,
+	}
+}
@@ -0,0 +1,52 @@
+package mappings
+
+import (
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/paymaildomains"
+	"github.com/bitcoin-sv/spv-wallet/models/response"
+	"github.com/samber/lo"
+)
+
+// MapToPaymailDomainContract will map the paymail domain from the engine to the spv-wallet-models contract
+func MapToPaymailDomainContract(domain *paymaildomains.Domain) *response.PaymailDomain {
+	return &response.PaymailDomain{
+		Name:                    domain.Name,
+		DomainValidationEnabled: domain.DomainValidationEnabled,
+		DefaultFromPaymail:      domain.DefaultFromPaymail,
+		RequireBEEF:             domain.RequireBEEF,
+		SenderValidation:        string(domain.SenderValidation),
+		Served:                  domain.IsServed(),
+		Static:                  domain.Static,
+		ValidatedAt:             domain.ValidatedAt,
+		CreatedAt:               optionalTime(domain.CreatedAt),
+		UpdatedAt:               optionalTime(domain.UpdatedAt),
+	}
+}
+
+// MapToPaymailDomainsContract will map the paymail domains from the engine to the spv-wallet-models contract
+func MapToPaymailDomainsContract(domains []*paymaildomains.Domain) []*response.PaymailDomain {
+	return lo.Map(domains, func(domain *paymaildomains.Domain, _ int) *response.PaymailDomain {
+		return MapToPaymailDomainContract(domain)
+	})
+}
+
+// MapToPaymailDomainCheckContract will map the result of the paymail domain self-check to the spv-wallet-models contract
+func MapToPaymailDomainCheckContract(result *paymaildomains.CheckResult) *response.PaymailDomainCheck {
+	return &response.PaymailDomainCheck{
+		Domain:       result.Domain,
+		Valid:        result.Valid,
+		SRVTargets:   lo.Ternary(result.SRVTargets == nil, []string{}, result.SRVTargets),
+		WellKnownURL: result.WellKnownURL,
+		Capabilities: result.Capabilities,
+		Errors:       lo.Ternary(result.Errors == nil, []string{}, result.Errors),
+		CheckedAt:    result.CheckedAt,
+	}
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,57 @@
+package mappings
+
+import (
+	"github.com/bitcoin-sv/spv-wallet/engine/paymail"
+	"github.com/bitcoin-sv/spv-wallet/engine/paymailhosts"
+	"github.com/bitcoin-sv/spv-wallet/models/response"
+	"github.com/samber/lo"
+)
+
+// MapToPaymailHostRuleContract will map the paymail host rule from the engine to the spv-wallet-models contract
+func MapToPaymailHostRuleContract(rule *paymailhosts.Rule) *response.PaymailHostRule {
+	return &response.PaymailHostRule{
+		Domain:    rule.Domain,
+		Policy:    string(rule.Policy),
+		Note:      rule.Note,
+		CreatedAt: rule.CreatedAt,
+	}
+}
+
+// MapToPaymailHostRulesContract will map the paymail host rules from the engine to the spv-wallet-models contract
+func MapToPaymailHostRulesContract(rules []*paymailhosts.Rule) []*response.PaymailHostRule {
+	return lo.Map(rules, func(rule *paymailhosts.Rule, _ int) *response.PaymailHostRule {
+		return MapToPaymailHostRuleContract(rule)
+	})
+}
+
+// MapToPaymailHostReputationsContract will map the reputations of the paymail hosts to the spv-wallet-models contract
+func MapToPaymailHostReputationsContract(reputations []*paymailhosts.Reputation) []*response.PaymailHostReputation {
+	return lo.Map(reputations, func(reputation *paymailhosts.Reputation, _ int) *response.PaymailHostReputation {
+		return &response.PaymailHostReputation{
+			Domain:              reputation.Domain,
+			Status:              string(reputation.Status),
+			Calls:               reputation.Calls,
+			Failures:            reputation.Failures,
+			ConsecutiveFailures: reputation.ConsecutiveFailures,
+			AverageLatencyMs:    reputation.AverageLatency.Milliseconds(),
+			LastError:           reputation.LastError,
+			LastCallAt:          reputation.LastCallAt,
+			LastFailureAt:       reputation.LastFailureAt,
+		}
+	})
+}
+
+// MapToPaymailCapabilitiesCacheContract will map the cached paymail capabilities to the spv-wallet-models contract
+func MapToPaymailCapabilitiesCacheContract(cached *paymail.CachedCapabilities) *response.PaymailCapabilitiesCache {
+	result := &response.PaymailCapabilitiesCache{
+		Domain:    cached.Domain,
+		Error:     cached.Error,
+		CachedAt:  cached.CachedAt,
+		ExpiresAt: cached.ExpiresAt,
+	}
+	if cached.Capabilities != nil {
+		result.BsvAlias = cached.Capabilities.BsvAlias
+		result.Capabilities = cached.Capabilities.Capabilities
+	}
+	return result
+}
@@ -23,4 +23,25 @@ const (
 
 	// AuthSignatureTTL is the max TTL for a signature to be valid
 	AuthSignatureTTL = 20 * time.Second
+
+	// AuthBSVHeaderVersion is the BRC-104 header with the version of the mutual authentication protocol
+	AuthBSVHeaderVersion = "x-bsv-auth-version"
+
+	// AuthBSVHeaderMessageType is the BRC-104 header with the type of the mutual authentication message
+	AuthBSVHeaderMessageType = "x-bsv-auth-message-type"
+
+	// AuthBSVHeaderIdentityKey is the BRC-104 header with the identity (public) key of the sender
+	AuthBSVHeaderIdentityKey = "x-bsv-auth-identity-key"
+
+	// AuthBSVHeaderNonce is the BRC-104 header with the nonce of the sender
+	AuthBSVHeaderNonce = "x-bsv-auth-nonce"
+
+	// AuthBSVHeaderYourNonce is the BRC-104 header with the nonce of the recipient (session nonce)
+	AuthBSVHeaderYourNonce = "x-bsv-auth-your-nonce"
+
+	// AuthBSVHeaderSignature is the BRC-104 header with the signature of the message
+	AuthBSVHeaderSignature = "x-bsv-auth-signature"
+
+	// AuthBSVHeaderRequestID is the BRC-104 header with the request ID which pairs the request with the response
+	AuthBSVHeaderRequestID = "x-bsv-auth-request-id"
 )
@@ -0,0 +1,14 @@
+package address
+
+import "github.com/bitcoin-sv/spv-wallet/models/bsv"
+
+// Output represents a P2PKH output to the Base58 encoded address.
+type Output struct {
+	To       string       `json:"to"`
+	Satoshis bsv.Satoshis `json:"satoshis"`
+}
+
+// GetType returns a string typename of the output.
+func (o Output) GetType() string {
+	return "address"
+}
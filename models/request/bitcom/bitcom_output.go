@@ -0,0 +1,32 @@
+package bitcom
+
+// Output represents a data output with Bitcom protocols (B://, MAP, AIP) separated by "|".
+type Output struct {
+	Protocols []Protocol `json:"protocols"`
+}
+
+// GetType returns a string typename of the output.
+func (o Output) GetType() string {
+	return "bitcom"
+}
+
+// Protocol is a single Bitcom protocol of the output; fields are used depending on the protocol name.
+type Protocol struct {
+	// Protocol is one of "B", "MAP" or "AIP".
+	Protocol string `json:"protocol"`
+
+	// B:// file
+	Content   []byte `json:"content,omitempty"`
+	MediaType string `json:"mediaType,omitempty"`
+	Encoding  string `json:"encoding,omitempty"`
+	Filename  string `json:"filename,omitempty"`
+
+	// MAP key/value pairs
+	Command string            `json:"command,omitempty"`
+	Keys    map[string]string `json:"keys,omitempty"`
+
+	// AIP signature of the preceding data
+	Algorithm string `json:"algorithm,omitempty"`
+	Address   string `json:"address,omitempty"`
+	Signature string `json:"signature,omitempty"`
+}
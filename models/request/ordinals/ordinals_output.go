@@ -0,0 +1,24 @@
+package ordinals
+
+// InscriptionOutput represents a 1Sat Ordinals inscription of the content, owned by the user.
+type InscriptionOutput struct {
+	ContentType string `json:"contentType"`
+	Content     []byte `json:"content"`
+}
+
+// GetType returns a string typename of the output.
+func (o InscriptionOutput) GetType() string {
+	return "inscription"
+}
+
+// TransferOutput represents a transfer of the user's ordinal to the address or paymail of the receiver.
+type TransferOutput struct {
+	TxID string `json:"txID"`
+	Vout uint32 `json:"vout"`
+	To   string `json:"to"`
+}
+
+// GetType returns a string typename of the output.
+func (o TransferOutput) GetType() string {
+	return "ordinal_transfer"
+}
@@ -0,0 +1,14 @@
+package script
+
+import "github.com/bitcoin-sv/spv-wallet/models/bsv"
+
+// Output represents an output with the raw (hex encoded) locking script.
+type Output struct {
+	LockingScript string       `json:"lockingScript"`
+	Satoshis      bsv.Satoshis `json:"satoshis"`
+}
+
+// GetType returns a string typename of the output.
+func (o Output) GetType() string {
+	return "script"
+}
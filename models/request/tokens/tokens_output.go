@@ -0,0 +1,13 @@
+package tokens
+
+// TransferOutput represents a transfer of the amount of the user's BSV-21 token to the address or paymail of the receiver.
+type TransferOutput struct {
+	TokenID string `json:"tokenId"`
+	Amount  uint64 `json:"amount"`
+	To      string `json:"to"`
+}
+
+// GetType returns a string typename of the output.
+func (o TransferOutput) GetType() string {
+	return "token_transfer"
+}
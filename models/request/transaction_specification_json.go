@@ -4,8 +4,13 @@ import (
 	"encoding/json"
 	"errors"
 
+	addressreq "github.com/bitcoin-sv/spv-wallet/models/request/address"
+	bitcomreq "github.com/bitcoin-sv/spv-wallet/models/request/bitcom"
 	"github.com/bitcoin-sv/spv-wallet/models/request/opreturn"
+	ordinalsreq "github.com/bitcoin-sv/spv-wallet/models/request/ordinals"
 	paymailreq "github.com/bitcoin-sv/spv-wallet/models/request/paymail"
+	scriptreq "github.com/bitcoin-sv/spv-wallet/models/request/script"
+	tokensreq "github.com/bitcoin-sv/spv-wallet/models/request/tokens"
 )
 
 // unmarshalOutput used by TransactionSpecification unmarshalling to get Output object by type
@@ -24,6 +29,42 @@ func unmarshalOutput(rawOutput json.RawMessage, outputType string) (Output, erro
 			return nil, err //nolint:wrapcheck // unmarshalOutput is run internally by json.Unmarshal, so we don't want to wrap the error
 		}
 		return out, nil
+	case "address":
+		var out addressreq.Output
+		if err := json.Unmarshal(rawOutput, &out); err != nil {
+			return nil, err //nolint:wrapcheck // unmarshalOutput is run internally by json.Unmarshal, so we don't want to wrap the error
+		}
+		return out, nil
+	case "script":
+		var out scriptreq.Output
+		if err := json.Unmarshal(rawOutput, &out); err != nil {
+			return nil, err //nolint:wrapcheck // unmarshalOutput is run internally by json.Unmarshal, so we don't want to wrap the error
+		}
+		return out, nil
+	case "bitcom":
+		var out bitcomreq.Output
+		if err := json.Unmarshal(rawOutput, &out); err != nil {
+			return nil, err //nolint:wrapcheck // unmarshalOutput is run internally by json.Unmarshal, so we don't want to wrap the error
+		}
+		return out, nil
+	case "inscription":
+		var out ordinalsreq.InscriptionOutput
+		if err := json.Unmarshal(rawOutput, &out); err != nil {
+			return nil, err //nolint:wrapcheck // unmarshalOutput is run internally by json.Unmarshal, so we don't want to wrap the error
+		}
+		return out, nil
+	case "ordinal_transfer":
+		var out ordinalsreq.TransferOutput
+		if err := json.Unmarshal(rawOutput, &out); err != nil {
+			return nil, err //nolint:wrapcheck // unmarshalOutput is run internally by json.Unmarshal, so we don't want to wrap the error
+		}
+		return out, nil
+	case "token_transfer":
+		var out tokensreq.TransferOutput
+		if err := json.Unmarshal(rawOutput, &out); err != nil {
+			return nil, err //nolint:wrapcheck // unmarshalOutput is run internally by json.Unmarshal, so we don't want to wrap the error
+		}
+		return out, nil
 	default:
 		return nil, errors.New("unsupported output type")
 	}
@@ -51,6 +92,54 @@ func expandOutputForMarshaling(output Output) (any, error) {
 			Type:   o.GetType(),
 			Output: &o,
 		}, nil
+	case addressreq.Output:
+		return struct {
+			Type string `json:"type"`
+			*addressreq.Output
+		}{
+			Type:   o.GetType(),
+			Output: &o,
+		}, nil
+	case scriptreq.Output:
+		return struct {
+			Type string `json:"type"`
+			*scriptreq.Output
+		}{
+			Type:   o.GetType(),
+			Output: &o,
+		}, nil
+	case bitcomreq.Output:
+		return struct {
+			Type string `json:"type"`
+			*bitcomreq.Output
+		}{
+			Type:   o.GetType(),
+			Output: &o,
+		}, nil
+	case ordinalsreq.InscriptionOutput:
+		return struct {
+			Type string `json:"type"`
+			*ordinalsreq.InscriptionOutput
+		}{
+			Type:              o.GetType(),
+			InscriptionOutput: &o,
+		}, nil
+	case ordinalsreq.TransferOutput:
+		return struct {
+			Type string `json:"type"`
+			*ordinalsreq.TransferOutput
+		}{
+			Type:           o.GetType(),
+			TransferOutput: &o,
+		}, nil
+	case tokensreq.TransferOutput:
+		return struct {
+			Type string `json:"type"`
+			*tokensreq.TransferOutput
+		}{
+			Type:           o.GetType(),
+			TransferOutput: &o,
+		}, nil
 	default:
 		return nil, errors.New("unsupported output type")
 	}
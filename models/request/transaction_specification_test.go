@@ -9,8 +9,13 @@ import (
 
 	"github.com/bitcoin-sv/spv-wallet/models/optional"
 	"github.com/bitcoin-sv/spv-wallet/models/request"
+	addressreq "github.com/bitcoin-sv/spv-wallet/models/request/address"
+	bitcomreq "github.com/bitcoin-sv/spv-wallet/models/request/bitcom"
 	"github.com/bitcoin-sv/spv-wallet/models/request/opreturn"
+	ordinalsreq "github.com/bitcoin-sv/spv-wallet/models/request/ordinals"
 	paymailreq "github.com/bitcoin-sv/spv-wallet/models/request/paymail"
+	scriptreq "github.com/bitcoin-sv/spv-wallet/models/request/script"
+	tokensreq "github.com/bitcoin-sv/spv-wallet/models/request/tokens"
 	"github.com/stretchr/testify/require"
 )
 
@@ -153,6 +158,146 @@ func TestTransactionSpecification_TransactionJSON(t *testing.T) {
 				},
 			},
 		},
+		"Address output": {
+			json: `{
+			  "outputs": [
+				{
+				  "type": "address",
+				  "to": "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa",
+				  "satoshis": 1000
+				}
+			  ]
+			}`,
+			spec: &request.TransactionSpecification{
+				Outputs: []request.Output{
+					addressreq.Output{
+						To:       "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa",
+						Satoshis: 1000,
+					},
+				},
+			},
+		},
+		"Script output": {
+			json: `{
+			  "outputs": [
+				{
+				  "type": "script",
+				  "lockingScript": "76a914e069bd2e2fe3ea702c40d5e65b491b734c01686788ac",
+				  "satoshis": 1000
+				}
+			  ]
+			}`,
+			spec: &request.TransactionSpecification{
+				Outputs: []request.Output{
+					scriptreq.Output{
+						LockingScript: "76a914e069bd2e2fe3ea702c40d5e65b491b734c01686788ac",
+						Satoshis:      1000,
+					},
+				},
+			},
+		},
+		"Bitcom output": {
+			json: `{
+			  "outputs": [
+				{
+				  "type": "bitcom",
+				  "protocols": [
+					{
+					  "protocol": "B",
+					  "content": "aGVsbG8gd29ybGQ=",
+					  "mediaType": "text/plain",
+					  "encoding": "utf-8"
+					},
+					{
+					  "protocol": "MAP",
+					  "command": "SET",
+					  "keys": { "app": "spv-wallet", "type": "post" }
+					}
+				  ]
+				}
+			  ]
+			}`,
+			spec: &request.TransactionSpecification{
+				Outputs: []request.Output{
+					bitcomreq.Output{
+						Protocols: []bitcomreq.Protocol{
+							{
+								Protocol:  "B",
+								Content:   []byte("hello world"),
+								MediaType: "text/plain",
+								Encoding:  "utf-8",
+							},
+							{
+								Protocol: "MAP",
+								Command:  "SET",
+								Keys:     map[string]string{"app": "spv-wallet", "type": "post"},
+							},
+						},
+					},
+				},
+			},
+		},
+		"Inscription output": {
+			json: `{
+			  "outputs": [
+				{
+				  "type": "inscription",
+				  "contentType": "text/plain",
+				  "content": "aGVsbG8gd29ybGQ="
+				}
+			  ]
+			}`,
+			spec: &request.TransactionSpecification{
+				Outputs: []request.Output{
+					ordinalsreq.InscriptionOutput{
+						ContentType: "text/plain",
+						Content:     []byte("hello world"),
+					},
+				},
+			},
+		},
+		"Ordinal transfer output": {
+			json: `{
+			  "outputs": [
+				{
+				  "type": "ordinal_transfer",
+				  "txID": "a0000000001e1b81dd2c9c0c6cd67f9bdf832e9c2bb12a1d57f30cb6ebbe78d9",
+				  "vout": 0,
+				  "to": "bob@example.com"
+				}
+			  ]
+			}`,
+			spec: &request.TransactionSpecification{
+				Outputs: []request.Output{
+					ordinalsreq.TransferOutput{
+						TxID: "a0000000001e1b81dd2c9c0c6cd67f9bdf832e9c2bb12a1d57f30cb6ebbe78d9",
+						Vout: 0,
+						To:   "bob@example.com",
+					},
+				},
+			},
+		},
+		"Token transfer output": {
+			json: `{
+			  "outputs": [
+				{
+				  "type": "token_transfer",
+				  "tokenId": "a0000000001e1b81dd2c9c0c6cd67f9bdf832e9c2bb12a1d57f30cb6ebbe78d9_0",
+				  "amount": 100,
+				  "to": "bob@example.com"
+				}
+			  ]
+			}`,
+			spec: &request.TransactionSpecification{
+				Outputs: []request.Output{
+					tokensreq.TransferOutput{
+						TokenID: "a0000000001e1b81dd2c9c0c6cd67f9bdf832e9c2bb12a1d57f30cb6ebbe78d9_0",
+						Amount:  100,
+						To:      "bob@example.com",
+					},
+				},
+			},
+		},
 	}
 	for name, test := range tests {
 		t.Run("spec from JSON: "+name, func(t *testing.T) {
@@ -1,6 +1,10 @@
 package response
 
-import "github.com/bitcoin-sv/spv-wallet/models/bsv"
+import (
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+)
 
 // FeeUnit is a model that represents a fee unit (simplified version of fee unit from go-bt).
 type FeeUnit struct {
@@ -9,3 +13,21 @@ type FeeUnit struct {
 	// Bytes is a fee unit bytes representation.
 	Bytes int `json:"bytes" example:"1000"`
 }
+
+// FeeUnitChange is an entry of the fee unit history.
+type FeeUnitChange struct {
+	// FeeUnit is the fee unit set by this change.
+	FeeUnit FeeUnit `json:"feeUnit"`
+	// Source tells where the fee unit comes from (arc_policy, config or admin).
+	Source string `json:"source" example:"arc_policy"`
+	// CreatedAt is the time when the fee unit was set.
+	CreatedAt time.Time `json:"createdAt" example:"2024-02-26T11:00:28.069911Z"`
+}
+
+// FeeUnitPolicy is the fee unit currently used for transactions together with the history of its changes.
+type FeeUnitPolicy struct {
+	// Current is the fee unit currently used for transactions.
+	Current FeeUnitChange `json:"current"`
+	// History contains the most recent changes of the fee unit, the newest first.
+	History []FeeUnitChange `json:"history"`
+}
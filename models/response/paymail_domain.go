@@ -0,0 +1,45 @@
+package response
+
+import "time"
+
+// PaymailDomain is a paymail domain served by the wallet.
+type PaymailDomain struct {
+	// Name is the domain name.
+	Name string `json:"name" example:"spv-wallet.com"`
+	// DomainValidationEnabled makes the domain served only after it passes the DNS/.well-known self-check.
+	DomainValidationEnabled bool `json:"domainValidationEnabled" example:"false"`
+	// DefaultFromPaymail is the sender paymail used for transactions sent from this domain when the sender has no paymail.
+	DefaultFromPaymail string `json:"defaultFromPaymail" example:"from@spv-wallet.com"`
+	// RequireBEEF makes the domain accept incoming P2P transactions only in BEEF format.
+	RequireBEEF bool `json:"requireBeef" example:"false"`
+	// SenderValidation is the policy of verifying senders of incoming P2P transactions (empty - based on the configuration).
+	SenderValidation string `json:"senderValidation,omitempty" example:"warn"`
+	// Served is true if paymail requests for the domain are handled.
+	Served bool `json:"served" example:"true"`
+	// Static is true for domains from the configuration; they cannot be changed at runtime.
+	Static bool `json:"static" example:"false"`
+	// ValidatedAt is the time of the last successful self-check.
+	ValidatedAt *time.Time `json:"validatedAt,omitempty" example:"2024-02-26T11:00:28.069911Z"`
+	// CreatedAt is the time when the domain was added.
+	CreatedAt *time.Time `json:"createdAt,omitempty" example:"2024-02-26T11:00:28.069911Z"`
+	// UpdatedAt is the time when the domain was last changed.
+	UpdatedAt *time.Time `json:"updatedAt,omitempty" example:"2024-02-26T11:00:28.069911Z"`
+}
+
+// PaymailDomainCheck is the result of the DNS/.well-known self-check of the paymail domain.
+type PaymailDomainCheck struct {
+	// Domain is the checked domain name.
+	Domain string `json:"domain" example:"spv-wallet.com"`
+	// Valid is true if the domain passed the check.
+	Valid bool `json:"valid" example:"true"`
+	// SRVTargets are the hosts from the _bsvalias._tcp SRV records.
+	SRVTargets []string `json:"srvTargets"`
+	// WellKnownURL is the URL of the paymail capabilities document which was checked.
+	WellKnownURL string `json:"wellKnownUrl" example:"https://spv-wallet.com/.well-known/bsvalias"`
+	// Capabilities is the number of capabilities announced by the domain.
+	Capabilities int `json:"capabilities" example:"12"`
+	// Errors are the problems found during the check.
+	Errors []string `json:"errors"`
+	// CheckedAt is the time of the check.
+	CheckedAt time.Time `json:"checkedAt" example:"2024-02-26T11:00:28.069911Z"`
+}
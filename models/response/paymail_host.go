@@ -0,0 +1,53 @@
+package response
+
+import "time"
+
+// PaymailHostRule is an entry of the allow or deny list of paymail hosts.
+type PaymailHostRule struct {
+	// Domain is the domain of the paymail host; the rule applies also to its subdomains.
+	Domain string `json:"domain" example:"spv-wallet.com"`
+	// Policy is "allow" or "deny".
+	Policy string `json:"policy" example:"deny"`
+	// Note is an optional note, e.g. the reason of the rule.
+	Note string `json:"note" example:"sanctioned provider"`
+	// CreatedAt is the time when the rule was saved.
+	CreatedAt time.Time `json:"createdAt" example:"2024-02-26T11:00:28.069911Z"`
+}
+
+// PaymailHostReputation holds the failure/latency statistics of the paymail host called by the wallet.
+type PaymailHostReputation struct {
+	// Domain is the domain of the paymail host.
+	Domain string `json:"domain" example:"spv-wallet.com"`
+	// Status is "good", "degraded" (recent failures or slow responses) or "blocked" (too many failures in a row).
+	Status string `json:"status" example:"good"`
+	// Calls is the number of calls made to the host.
+	Calls int `json:"calls" example:"12"`
+	// Failures is the number of failed calls.
+	Failures int `json:"failures" example:"1"`
+	// ConsecutiveFailures is the number of failed calls since the last successful one.
+	ConsecutiveFailures int `json:"consecutiveFailures" example:"0"`
+	// AverageLatencyMs is the moving average of the response time in milliseconds.
+	AverageLatencyMs int64 `json:"averageLatencyMs" example:"250"`
+	// LastError is the error of the last failed call.
+	LastError string `json:"lastError,omitempty" example:"paymail host is responding with error"`
+	// LastCallAt is the time of the last call.
+	LastCallAt time.Time `json:"lastCallAt" example:"2024-02-26T11:00:28.069911Z"`
+	// LastFailureAt is the time of the last failed call.
+	LastFailureAt *time.Time `json:"lastFailureAt,omitempty" example:"2024-02-26T11:00:28.069911Z"`
+}
+
+// PaymailCapabilitiesCache is the cached capabilities of the paymail host or the cached error of the failed lookup.
+type PaymailCapabilitiesCache struct {
+	// Domain is the domain of the paymail host.
+	Domain string `json:"domain" example:"spv-wallet.com"`
+	// BsvAlias is the version of the paymail protocol announced by the host.
+	BsvAlias string `json:"bsvalias,omitempty" example:"1.0"`
+	// Capabilities are the capabilities announced by the host.
+	Capabilities map[string]any `json:"capabilities,omitempty"`
+	// Error is the error of the failed capabilities lookup (negative caching).
+	Error string `json:"error,omitempty" example:"paymail host is responding with error"`
+	// CachedAt is the time when the entry was cached.
+	CachedAt time.Time `json:"cachedAt" example:"2024-02-26T11:00:28.069911Z"`
+	// ExpiresAt is the time when the entry expires.
+	ExpiresAt time.Time `json:"expiresAt" example:"2024-02-26T11:02:28.069911Z"`
+}
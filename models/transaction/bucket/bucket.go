@@ -8,6 +8,10 @@ const (
 	Data Name = "data"
 	// BSV represents the bucket for the BSV outputs.
 	BSV Name = "bsv"
+	// Ordinals represents the bucket for the 1-satoshi outputs holding 1Sat Ordinals, they are not used to fund transactions.
+	Ordinals Name = "ordinals"
+	// Tokens represents the bucket for the outputs holding BSV-21 or STAS fungible tokens, they are not used to fund transactions.
+	Tokens Name = "tokens"
 )
 
 func (b Name) String() string {
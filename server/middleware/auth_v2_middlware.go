@@ -14,9 +14,15 @@ import (
 // AuthV2Middleware will check the request for the xPub and convert it to the user context.
 func AuthV2Middleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		xPub := strings.TrimSpace(c.GetHeader(models.AuthHeader))
+		var userContext *reqctx.UserContext
+		var err error
 
-		userContext, err := tryAuthWithPubKey(c, xPub)
+		if identityKey := strings.TrimSpace(c.GetHeader(models.AuthBSVHeaderIdentityKey)); identityKey != "" {
+			userContext, err = tryAuthWithIdentityKey(c, identityKey)
+		} else {
+			xPub := strings.TrimSpace(c.GetHeader(models.AuthHeader))
+			userContext, err = tryAuthWithPubKey(c, xPub)
+		}
 
 		if err == nil {
 			reqctx.SetUserContext(c, userContext)
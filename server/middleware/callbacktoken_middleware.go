@@ -1,14 +1,19 @@
 package middleware
 
 import (
+	"bytes"
+	"encoding/json"
+	"io"
 	"strings"
 
+	chainmodels "github.com/bitcoin-sv/spv-wallet/engine/chain/models"
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	"github.com/bitcoin-sv/spv-wallet/server/reqctx"
 	"github.com/gin-gonic/gin"
 )
 
 // CallbackTokenMiddleware verifies the callback token - if it's valid and matches the Bearer scheme.
+// When tokens per transaction are enabled, the token must be bound to the transaction ID from the callback body.
 func CallbackTokenMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		appConfig := reqctx.AppConfig(c)
@@ -16,17 +21,51 @@ func CallbackTokenMiddleware() gin.HandlerFunc {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
 			spverrors.AbortWithErrorResponse(c, spverrors.ErrMissingAuthHeader, nil)
+			return
 		}
 
 		if !strings.HasPrefix(authHeader, BearerSchema) || len(authHeader) <= len(BearerSchema) {
 			spverrors.AbortWithErrorResponse(c, spverrors.ErrInvalidOrMissingToken, nil)
+			return
+		}
+
+		callbackCfg := chainmodels.ARCCallbackConfig{
+			Token:               appConfig.ARC.Callback.Token,
+			TokenPerTransaction: appConfig.ARC.Callback.TokenPerTransaction,
+		}
+
+		var txID string
+		if callbackCfg.TokenPerTransaction {
+			var err error
+			if txID, err = peekCallbackTxID(c); err != nil {
+				spverrors.AbortWithErrorResponse(c, spverrors.ErrCannotBindRequest.WithTrace(err), nil)
+				return
+			}
 		}
 
 		providedToken := authHeader[len(BearerSchema):]
-		if providedToken != appConfig.ARC.Callback.Token {
+		if !callbackCfg.VerifyToken(txID, providedToken) {
 			spverrors.AbortWithErrorResponse(c, spverrors.ErrInvalidToken, nil)
+			return
 		}
 
 		c.Next()
 	}
 }
+
+// peekCallbackTxID reads the transaction ID from the callback body, leaving the body to be read again by the handler.
+func peekCallbackTxID(c *gin.Context) (string, error) {
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return "", spverrors.Wrapf(err, "failed to read callback body")
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	var callback struct {
+		TxID string `json:"txid"`
+	}
+	if err = json.Unmarshal(body, &callback); err != nil {
+		return "", spverrors.Wrapf(err, "failed to parse callback body")
+	}
+	return callback.TxID, nil
+}
@@ -0,0 +1,154 @@
+package middleware
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+
+	bip32 "github.com/bitcoin-sv/go-sdk/compat/bip32"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/mutualauth/mutualauthmodels"
+	"github.com/bitcoin-sv/spv-wallet/models"
+	"github.com/bitcoin-sv/spv-wallet/server/reqctx"
+	"github.com/gin-gonic/gin"
+)
+
+// MutualAuthResponseMiddleware signs responses to requests authenticated with BRC-103/104 mutual authentication.
+// NOTE: It must be registered before the routes, because the response has to be buffered to be signed.
+func MutualAuthResponseMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetHeader(models.AuthBSVHeaderIdentityKey) == "" {
+			c.Next()
+			return
+		}
+
+		original := c.Writer
+		buffered := &bufferedResponseWriter{ResponseWriter: original, body: new(bytes.Buffer), status: http.StatusOK}
+		c.Writer = buffered
+
+		c.Next()
+
+		c.Writer = original
+		signResponse(c, buffered)
+		original.WriteHeader(buffered.status)
+		_, _ = original.Write(buffered.body.Bytes())
+	}
+}
+
+func tryAuthWithIdentityKey(c *gin.Context, identityKey string) (*reqctx.UserContext, error) {
+	mutualAuth := reqctx.Engine(c).MutualAuthService()
+
+	bodyContent, err := readBodyContents(c)
+	if err != nil {
+		return nil, err
+	}
+
+	query := ""
+	if c.Request.URL.RawQuery != "" {
+		query = "?" + c.Request.URL.RawQuery
+	}
+
+	auth := &mutualauthmodels.AuthHeaders{
+		Version:     c.GetHeader(models.AuthBSVHeaderVersion),
+		IdentityKey: identityKey,
+		Nonce:       c.GetHeader(models.AuthBSVHeaderNonce),
+		YourNonce:   c.GetHeader(models.AuthBSVHeaderYourNonce),
+		Signature:   c.GetHeader(models.AuthBSVHeaderSignature),
+		RequestID:   c.GetHeader(models.AuthBSVHeaderRequestID),
+	}
+
+	session, err := mutualAuth.VerifyRequest(c.Request.Context(), auth, &mutualauthmodels.HTTPRequest{
+		Method:  c.Request.Method,
+		Path:    c.Request.URL.Path,
+		Query:   query,
+		Headers: c.Request.Header,
+		Body:    []byte(bodyContent),
+	})
+	if err != nil {
+		return nil, spverrors.ErrAuthorization.Wrap(err)
+	}
+
+	if isAdminIdentityKey(reqctx.AppConfig(c).Authentication.AdminKey, session.PeerIdentityKey) {
+		return reqctx.NewUserContextAsAdminWithIdentityKey(session, auth.RequestID), nil
+	}
+
+	userID, err := reqctx.Engine(c).UsersService().GetIDByPubKey(c.Request.Context(), session.PeerIdentityKey)
+	if err != nil {
+		return nil, spverrors.ErrAuthorization.Wrap(err)
+	}
+
+	return reqctx.NewUserContextWithIdentityKey(session.PeerIdentityKey, userID, session, auth.RequestID), nil
+}
+
+// isAdminIdentityKey checks if the identity key is the public key of the admin xPub
+func isAdminIdentityKey(adminXPub, identityKey string) bool {
+	hdKey, err := bip32.GetHDKeyFromExtendedPublicKey(adminXPub)
+	if err != nil {
+		return false
+	}
+	pubKey, err := bip32.GetPublicKeyFromHDKey(hdKey)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(pubKey.ToDERHex(), identityKey)
+}
+
+func signResponse(c *gin.Context, response *bufferedResponseWriter) {
+	userContext, ok := reqctx.FindUserContext(c)
+	if !ok || !userContext.IsMutuallyAuthenticated() {
+		return
+	}
+	session, requestID := userContext.GetMutualAuthSession()
+
+	auth, err := reqctx.Engine(c).MutualAuthService().SignResponse(session, requestID, &mutualauthmodels.HTTPResponse{
+		StatusCode: response.status,
+		Headers:    response.Header(),
+		Body:       response.body.Bytes(),
+	})
+	if err != nil {
+		reqctx.Logger(c).Error().Err(err).Msg("failed to sign mutually authenticated response")
+		return
+	}
+
+	headers := response.Header()
+	headers.Set(models.AuthBSVHeaderVersion, auth.Version)
+	headers.Set(models.AuthBSVHeaderMessageType, string(mutualauthmodels.MessageTypeGeneral))
+	headers.Set(models.AuthBSVHeaderIdentityKey, auth.IdentityKey)
+	headers.Set(models.AuthBSVHeaderNonce, auth.Nonce)
+	headers.Set(models.AuthBSVHeaderYourNonce, auth.YourNonce)
+	headers.Set(models.AuthBSVHeaderSignature, auth.Signature)
+	headers.Set(models.AuthBSVHeaderRequestID, auth.RequestID)
+}
+
+// bufferedResponseWriter keeps the status and the body in memory, so they can be signed before sending
+type bufferedResponseWriter struct {
+	gin.ResponseWriter
+	body   *bytes.Buffer
+	status int
+}
+
+func (w *bufferedResponseWriter) WriteHeader(code int) {
+	w.status = code
+}
+
+func (w *bufferedResponseWriter) WriteHeaderNow() {}
+
+func (w *bufferedResponseWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data) //nolint:wrapcheck // in-memory buffer
+}
+
+func (w *bufferedResponseWriter) WriteString(s string) (int, error) {
+	return w.body.WriteString(s) //nolint:wrapcheck // in-memory buffer
+}
+
+func (w *bufferedResponseWriter) Status() int {
+	return w.status
+}
+
+func (w *bufferedResponseWriter) Size() int {
+	return w.body.Len()
+}
+
+func (w *bufferedResponseWriter) Written() bool {
+	return w.body.Len() > 0
+}
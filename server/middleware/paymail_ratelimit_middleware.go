@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/bitcoin-sv/go-paymail"
+	paymailerrors "github.com/bitcoin-sv/go-paymail/errors"
+	"github.com/bitcoin-sv/go-paymail/server"
+	"github.com/bitcoin-sv/spv-wallet/config"
+	pmerrors "github.com/bitcoin-sv/spv-wallet/engine/paymail/errors"
+	"github.com/bitcoin-sv/spv-wallet/server/ratelimit"
+	"github.com/bitcoin-sv/spv-wallet/server/reqctx"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis_rate/v9"
+)
+
+const paymailRateLimitKeyPrefix = "paymail:"
+
+// PaymailRateLimitMiddleware limits the requests to the (unauthenticated) paymail endpoints per client IP and per paymail.
+// The client IP is taken from X-Forwarded-For header only when the request comes from a trusted proxy (see ServerConfig.TrustedProxies).
+// NOTE: The paymail routes are registered directly on the engine, so it must be registered (with Use) before them;
+// requests to other endpoints are passed through.
+func PaymailRateLimitMiddleware(paymailConfig *server.Configuration, cfg *config.PaymailRateLimitConfig, limiter ratelimit.Limiter) gin.HandlerFunc {
+	prefixes := []string{
+		fmt.Sprintf("/%s/%s/", paymailConfig.APIVersion, paymailConfig.ServiceName),
+		"/.well-known/" + paymailConfig.ServiceName,
+	}
+
+	return func(c *gin.Context) {
+		if !hasAnyPrefix(c.Request.URL.Path, prefixes) {
+			c.Next()
+			return
+		}
+
+		if ip := c.ClientIP(); ip != "" && cfg.PerIP > 0 {
+			if !allowPaymailRequest(c, limiter, "ip:"+ip, redis_rate.PerMinute(cfg.PerIP)) {
+				return
+			}
+		}
+
+		if _, _, address := paymail.SanitizePaymail(c.Param(server.PaymailAddressParamName)); address != "" && cfg.PerAlias > 0 {
+			if !allowPaymailRequest(c, limiter, "alias:"+address, redis_rate.PerMinute(cfg.PerAlias)) {
+				return
+			}
+		}
+
+		c.Next()
+	}
+}
+
+func allowPaymailRequest(c *gin.Context, limiter ratelimit.Limiter, key string, limit redis_rate.Limit) bool {
+	logger := reqctx.Logger(c)
+
+	result, err := limiter.Allow(c.Request.Context(), paymailRateLimitKeyPrefix+key, limit)
+	if err != nil {
+		// the paymail endpoints shouldn't be down because of the rate limiter
+		logger.Warn().Err(err).Str("key", key).Msg("Cannot check paymail rate limit, the request is allowed")
+		return true
+	}
+	if result.Allowed > 0 {
+		return true
+	}
+
+	logger.Info().Str("key", key).Msg("Paymail rate limit exceeded")
+	c.Header("Retry-After", fmt.Sprint(int(math.Ceil(result.RetryAfter.Seconds()))))
+	paymailerrors.ErrorResponse(c, pmerrors.ErrPaymailRateLimitExceeded, nil)
+	c.Abort()
+	return false
+}
+
+func hasAnyPrefix(path string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -47,7 +47,7 @@ func SignatureAuthWithScopes(log *zerolog.Logger) api.MiddlewareFunc {
 				spverrors.AbortWithErrorResponse(c, spverrors.ErrAdminAuthOnUserEndpoint, reqctx.Logger(c))
 				return
 			}
-		case reqctx.AuthTypeXPub:
+		case reqctx.AuthTypeXPub, reqctx.AuthTypeIdentityKey:
 			if !slices.Contains(scopes, "user") {
 				spverrors.AbortWithErrorResponse(c, spverrors.ErrNotAnAdminKey, reqctx.Logger(c))
 				return
@@ -25,7 +25,9 @@ func CheckSignatureMiddleware() gin.HandlerFunc {
 		appConfig := reqctx.AppConfig(c)
 		userContext := reqctx.GetUserContext(c)
 
-		requireSigning := userContext.GetAuthType() == reqctx.AuthTypeAccessKey || appConfig.Authentication.RequireSigning
+		// requests authenticated with BRC-103/104 are already signed with the identity key
+		requireSigning := !userContext.IsMutuallyAuthenticated() &&
+			(userContext.GetAuthType() == reqctx.AuthTypeAccessKey || appConfig.Authentication.RequireSigning)
 
 		if requireSigning {
 			if err := verifyRequest(c, userContext); err != nil {
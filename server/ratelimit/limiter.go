@@ -0,0 +1,31 @@
+package ratelimit
+
+import (
+	"context"
+
+	"github.com/bitcoin-sv/spv-wallet/config"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/go-redis/redis/v8"
+	"github.com/go-redis/redis_rate/v9"
+	"github.com/mrz1836/go-cachestore"
+)
+
+// Limiter checks if the request identified by the key is allowed by the limit.
+// It's implemented by redis_rate.Limiter and by the in-memory limiter.
+type Limiter interface {
+	Allow(ctx context.Context, key string, limit redis_rate.Limit) (*redis_rate.Result, error)
+}
+
+// NewLimiter creates a limiter which keeps the limits in redis when it's the cache engine (so they are shared between instances),
+// otherwise the limits are kept in memory.
+func NewLimiter(appConfig *config.AppConfig) (Limiter, error) {
+	if appConfig.Cache == nil || appConfig.Cache.Engine != cachestore.Redis || appConfig.Cache.Redis == nil {
+		return NewMemoryLimiter(), nil
+	}
+
+	options, err := redis.ParseURL(appConfig.Cache.Redis.URL)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "invalid redis url for rate limiter")
+	}
+	return redis_rate.NewLimiter(redis.NewClient(options)), nil
+}
@@ -0,0 +1,77 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis_rate/v9"
+)
+
+// MemoryLimiter is an in-memory limiter using the same algorithm (GCRA) as redis_rate.
+// The limits are kept per instance of the wallet.
+type MemoryLimiter struct {
+	mu        sync.Mutex
+	tats      map[string]time.Time
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewMemoryLimiter creates a new in-memory limiter.
+func NewMemoryLimiter() *MemoryLimiter {
+	return &MemoryLimiter{
+		tats: make(map[string]time.Time),
+		now:  time.Now,
+	}
+}
+
+// Allow reports whether the request identified by the key is allowed by the limit.
+func (l *MemoryLimiter) Allow(_ context.Context, key string, limit redis_rate.Limit) (*redis_rate.Result, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now, limit.Period)
+
+	emissionInterval := limit.Period / time.Duration(limit.Rate)
+	burstOffset := emissionInterval * time.Duration(limit.Burst)
+
+	tat := l.tats[key]
+	if tat.Before(now) {
+		tat = now
+	}
+	newTat := tat.Add(emissionInterval)
+	diff := now.Sub(newTat.Add(-burstOffset))
+
+	if diff < 0 {
+		return &redis_rate.Result{
+			Limit:      limit,
+			Allowed:    0,
+			Remaining:  0,
+			RetryAfter: -diff,
+			ResetAfter: tat.Sub(now),
+		}, nil
+	}
+
+	l.tats[key] = newTat
+	return &redis_rate.Result{
+		Limit:      limit,
+		Allowed:    1,
+		Remaining:  int(diff / emissionInterval),
+		RetryAfter: -1,
+		ResetAfter: newTat.Sub(now),
+	}, nil
+}
+
+// sweep removes the keys which returned to their initial state, so the memory doesn't grow with every new client.
+func (l *MemoryLimiter) sweep(now time.Time, period time.Duration) {
+	if now.Sub(l.lastSweep) < period {
+		return
+	}
+	for key, tat := range l.tats {
+		if !tat.After(now) {
+			delete(l.tats, key)
+		}
+	}
+	l.lastSweep = now
+}
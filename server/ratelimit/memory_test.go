@@ -0,0 +1,84 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis_rate/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryLimiter(t *testing.T) {
+	t.Run("allows requests up to the burst", func(t *testing.T) {
+		// given:
+		limiter, _ := givenLimiterWithClock()
+		limit := redis_rate.PerMinute(3)
+
+		// when:
+		results := make([]*redis_rate.Result, 4)
+		for i := range results {
+			results[i], _ = limiter.Allow(context.Background(), "key", limit)
+		}
+
+		// then:
+		require.Equal(t, 1, results[0].Allowed)
+		require.Equal(t, 2, results[0].Remaining)
+		require.Equal(t, 1, results[2].Allowed)
+		require.Equal(t, 0, results[2].Remaining)
+		require.Equal(t, 0, results[3].Allowed)
+		require.Equal(t, 20*time.Second, results[3].RetryAfter)
+	})
+
+	t.Run("allows request again after the emission interval", func(t *testing.T) {
+		// given:
+		limiter, clock := givenLimiterWithClock()
+		limit := redis_rate.PerMinute(3)
+		for range 3 {
+			_, _ = limiter.Allow(context.Background(), "key", limit)
+		}
+
+		// when:
+		*clock = clock.Add(20 * time.Second)
+		result, err := limiter.Allow(context.Background(), "key", limit)
+
+		// then:
+		require.NoError(t, err)
+		require.Equal(t, 1, result.Allowed)
+	})
+
+	t.Run("limits keys independently", func(t *testing.T) {
+		// given:
+		limiter, _ := givenLimiterWithClock()
+		limit := redis_rate.PerMinute(1)
+		_, _ = limiter.Allow(context.Background(), "first", limit)
+
+		// when:
+		result, err := limiter.Allow(context.Background(), "second", limit)
+
+		// then:
+		require.NoError(t, err)
+		require.Equal(t, 1, result.Allowed)
+	})
+
+	t.Run("removes keys which returned to the initial state", func(t *testing.T) {
+		// given:
+		limiter, clock := givenLimiterWithClock()
+		limit := redis_rate.PerMinute(1)
+		_, _ = limiter.Allow(context.Background(), "first", limit)
+
+		// when:
+		*clock = clock.Add(2 * time.Minute)
+		_, _ = limiter.Allow(context.Background(), "second", limit)
+
+		// then:
+		require.NotContains(t, limiter.tats, "first")
+	})
+}
+
+func givenLimiterWithClock() (*MemoryLimiter, *time.Time) {
+	clock := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := NewMemoryLimiter()
+	limiter.now = func() time.Time { return clock }
+	return limiter, &clock
+}
@@ -3,6 +3,7 @@ package reqctx
 import (
 	"github.com/bitcoin-sv/spv-wallet/engine"
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/mutualauth/mutualauthmodels"
 	"github.com/gin-gonic/gin"
 )
 
@@ -20,6 +21,9 @@ const (
 
 	// AuthTypeAdmin is when provided xpub matches the admin key
 	AuthTypeAdmin
+
+	// AuthTypeIdentityKey is when user is authenticated with BRC-103/104 mutual authentication (identity key)
+	AuthTypeIdentityKey
 )
 
 // UserContext is the context for the user
@@ -32,6 +36,10 @@ type UserContext struct {
 	// v2
 	userID    string
 	publicKey string
+
+	// mutual authentication (BRC-103/104)
+	authSession   *mutualauthmodels.Session
+	authRequestID string
 }
 
 // NewUserContextWithXPub creates a new UserContext based on xpub authorization
@@ -72,6 +80,27 @@ func NewUserContextAsAdmin() *UserContext {
 	}
 }
 
+// NewUserContextWithIdentityKey creates a new UserContext based on BRC-103/104 mutual authentication
+// Note: This is used for API v2 authentication only
+func NewUserContextWithIdentityKey(publicKey, userID string, session *mutualauthmodels.Session, requestID string) *UserContext {
+	return &UserContext{
+		userID:        userID,
+		publicKey:     publicKey,
+		AuthType:      AuthTypeIdentityKey,
+		authSession:   session,
+		authRequestID: requestID,
+	}
+}
+
+// NewUserContextAsAdminWithIdentityKey creates a new UserContext as an admin authenticated with BRC-103/104 mutual authentication
+func NewUserContextAsAdminWithIdentityKey(session *mutualauthmodels.Session, requestID string) *UserContext {
+	return &UserContext{
+		AuthType:      AuthTypeAdmin,
+		authSession:   session,
+		authRequestID: requestID,
+	}
+}
+
 // GetAuthType returns the authentication type from the user context
 func (ctx *UserContext) GetAuthType() AuthType {
 	return ctx.AuthType
@@ -105,7 +134,7 @@ func (ctx *UserContext) GetXPubObj() *engine.Xpub {
 // ShouldGetUserID returns userID for NEW DB SCHEMA
 // Warning: Don't use it for old DB schema
 func (ctx *UserContext) ShouldGetUserID() (string, error) {
-	if ctx.AuthType != AuthTypeXPub {
+	if ctx.AuthType != AuthTypeXPub && ctx.AuthType != AuthTypeIdentityKey {
 		return "", spverrors.ErrXPubAuthRequired
 	}
 	if ctx.userID == "" {
@@ -115,12 +144,33 @@ func (ctx *UserContext) ShouldGetUserID() (string, error) {
 	return ctx.userID, nil
 }
 
+// GetMutualAuthSession returns the BRC-103 session and the BRC-104 request ID
+// If the request was not mutually authenticated, the session is nil
+func (ctx *UserContext) GetMutualAuthSession() (*mutualauthmodels.Session, string) {
+	return ctx.authSession, ctx.authRequestID
+}
+
+// IsMutuallyAuthenticated returns true if the request was authenticated with BRC-103/104 mutual authentication
+func (ctx *UserContext) IsMutuallyAuthenticated() bool {
+	return ctx.authSession != nil
+}
+
 // GetUserContext returns the user context from the request context
 func GetUserContext(c *gin.Context) *UserContext {
 	value := c.MustGet(userContextKey)
 	return value.(*UserContext)
 }
 
+// FindUserContext returns the user context from the request context if it was set
+func FindUserContext(c *gin.Context) (*UserContext, bool) {
+	value, ok := c.Get(userContextKey)
+	if !ok {
+		return nil, false
+	}
+	userContext, ok := value.(*UserContext)
+	return userContext, ok
+}
+
 // SetUserContext sets the user context in the request context
 func SetUserContext(c *gin.Context, userContext *UserContext) {
 	c.Set(userContextKey, userContext)
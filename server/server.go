@@ -18,6 +18,7 @@ import (
 	"github.com/bitcoin-sv/spv-wallet/metrics"
 	"github.com/bitcoin-sv/spv-wallet/server/handlers"
 	"github.com/bitcoin-sv/spv-wallet/server/middleware"
+	"github.com/bitcoin-sv/spv-wallet/server/ratelimit"
 	"github.com/gin-contrib/pprof"
 	"github.com/gin-gonic/gin"
 	"github.com/rs/zerolog"
@@ -91,6 +92,10 @@ func (s *Server) Handlers() *gin.Engine {
 	}
 	logging.SetGinWriters(&httpLogger)
 	ginEngine := gin.New()
+	if err := ginEngine.SetTrustedProxies(s.AppConfig.Server.TrustedProxies); err != nil {
+		httpLogger.Error().Err(err).Msg("Invalid trusted proxies, the IP of the connection is used as the client IP")
+		_ = ginEngine.SetTrustedProxies(nil)
+	}
 	ginEngine.Use(logging.GinMiddleware(httpLogger), gin.Recovery())
 	ginEngine.Use(middleware.AppContextMiddleware(s.AppConfig, s.SpvWalletEngine, s.Logger))
 	ginEngine.Use(middleware.CorsMiddleware())
@@ -110,9 +115,23 @@ func (s *Server) Handlers() *gin.Engine {
 func setupServerRoutes(appConfig *config.AppConfig, spvWalletEngine engine.ClientInterface, ginEngine *gin.Engine, log *zerolog.Logger) {
 	handlersManager := handlers.NewManager(ginEngine, appConfig)
 	actions.Register(handlersManager)
-	paymailserver.Register(spvWalletEngine.GetPaymailConfig().Configuration, ginEngine)
+	paymailConfig := spvWalletEngine.GetPaymailConfig().Configuration
+	if rateLimit := appConfig.Paymail.RateLimit; rateLimit != nil && rateLimit.Enabled {
+		limiter, err := ratelimit.NewLimiter(appConfig)
+		if err != nil {
+			log.Error().Err(err).Msg("Paymail rate limits are disabled")
+		} else {
+			// must be registered before the paymail routes to be able to limit them
+			ginEngine.Use(middleware.PaymailRateLimitMiddleware(paymailConfig, rateLimit, limiter))
+		}
+	}
+	paymailserver.Register(paymailConfig, ginEngine)
 
 	if appConfig.ExperimentalFeatures.V2 {
+		if appConfig.Authentication.MutualAuth != nil && appConfig.Authentication.MutualAuth.Enabled {
+			// must be registered before the v2 routes to be able to sign their responses
+			ginEngine.Use(middleware.MutualAuthResponseMiddleware())
+		}
 		v2.RegisterNonOpenAPIRoutes(handlersManager)
 		api.RegisterHandlersWithOptions(ginEngine, v2.NewV2API(appConfig, spvWalletEngine, log), api.GinServerOptions{
 			BaseURL: "",